@@ -6,18 +6,29 @@ import (
 	"log/slog"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/daemon"
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detection"
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors"
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/bmad"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/openspec"
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/speckit"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/taskmaster"
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/filesystem"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/git"
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/logreaders"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/metrics"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/notifiers"
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/persistence"
+	metricsstore "github.com/JeiKeiLim/vibe-dash/internal/adapters/persistence/metrics"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/usage"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/webhooks"
 	"github.com/JeiKeiLim/vibe-dash/internal/config"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/services"
 )
 
@@ -42,9 +53,34 @@ func (a *configPathAdapter) GetDirForPath(path string) string {
 	return dirName
 }
 
+// buildNotifiers creates the notification sinks selected in config.
+// An invalid command template is logged and skipped (graceful degradation).
+func buildNotifiers(nc ports.NotificationConfig) []ports.Notifier {
+	var sinks []ports.Notifier
+	if nc.Bell {
+		sinks = append(sinks, notifiers.NewBellNotifier(nil))
+	}
+	if nc.Desktop {
+		sinks = append(sinks, notifiers.NewDesktopNotifier())
+	}
+	if nc.Command != "" {
+		cmdNotifier, err := notifiers.NewCommandNotifier(nc.Command)
+		if err != nil {
+			slog.Warn("ignoring notification command", "error", err)
+		} else {
+			sinks = append(sinks, cmdNotifier)
+		}
+	}
+	return sinks
+}
+
 // shutdownTimeout is the maximum time to wait for graceful shutdown
 const shutdownTimeout = 5 * time.Second
 
+// webhookDrainTimeout bounds how long exit waits for queued webhook deliveries.
+// Kept below shutdownTimeout so a slow endpoint cannot trigger a forced exit.
+const webhookDrainTimeout = 3 * time.Second
+
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	done := make(chan struct{})
@@ -110,6 +146,7 @@ func run(ctx context.Context) error {
 
 	// Story 8.7: Store config for TUI help overlay display
 	cli.SetConfig(cfg)
+	cli.SetConfigLoader(loader)
 
 	// Get base path with safety check (Story 3.5.6)
 	basePath := config.GetDefaultBasePath()
@@ -141,6 +178,8 @@ func run(ctx context.Context) error {
 
 	// Set repository (coordinator implements ports.ProjectRepository)
 	cli.SetRepository(coordinator)
+	// Coordinator also stores per-project activity history (project_events)
+	cli.SetEventRepository(coordinator)
 
 	// Set DirectoryManager for remove command
 	cli.SetDirectoryManager(dirMgr)
@@ -149,6 +188,17 @@ func run(ctx context.Context) error {
 	registry := detectors.NewRegistry()
 	registry.Register(speckit.NewSpeckitDetector())
 	registry.Register(bmad.NewBMADDetector())
+	registry.Register(openspec.NewOpenSpecDetector())
+	registry.Register(taskmaster.NewTaskMasterDetector())
+
+	// Load user-defined declarative detectors from ~/.vibe-dash/detectors/.
+	// Invalid definitions are logged and skipped (graceful degradation).
+	detectorDir := filepath.Join(basePath, "detectors")
+	if n, err := registry.LoadDefinitions(detectorDir); err != nil {
+		slog.Warn("some detector definitions could not be loaded", "dir", detectorDir, "loaded", n, "error", err)
+	} else if n > 0 {
+		slog.Debug("declarative detectors loaded", "dir", detectorDir, "count", n)
+	}
 	detectionSvc := services.NewDetectionService(registry)
 	cli.SetDetectionService(detectionSvc)
 
@@ -160,12 +210,29 @@ func run(ctx context.Context) error {
 
 	slog.Debug("agent detection service initialized",
 		"claude_detector", "ClaudeCodeDetector",
+		"codex_detector", "CodexDetector",
 		"generic_detector", "GenericDetector",
 	)
 
 	// Story 4.5: Pass waitingDetector to TUI for WAITING indicator display
 	cli.SetWaitingDetector(waitingDetector)
 
+	// Deliver history events to configured webhooks. Registered on the coordinator
+	// so every recorded event (TUI, serve, CLI) is sent; the close runs before the
+	// coordinator's so queued deliveries can still load their project.
+	if len(cfg.Webhooks) > 0 {
+		dispatcher := webhooks.NewDispatcher(cfg.Webhooks, coordinator, webhooks.WithWaitingDetector(waitingDetector))
+		coordinator.AddEventListener(dispatcher)
+		defer func() {
+			drainCtx, drainCancel := context.WithTimeout(context.Background(), webhookDrainTimeout)
+			defer drainCancel()
+			if err := dispatcher.Close(drainCtx); err != nil {
+				slog.Warn("pending webhook deliveries dropped on exit", "error", err)
+			}
+		}()
+		slog.Debug("webhook dispatcher initialized", "webhooks", len(cfg.Webhooks))
+	}
+
 	// Story 4.6: Create FileWatcher for real-time dashboard updates
 	debounce := time.Duration(cfg.RefreshDebounceMs) * time.Millisecond
 	if debounce == 0 {
@@ -180,7 +247,7 @@ func run(ctx context.Context) error {
 	cli.SetFileWatcher(fileWatcher)
 
 	// Story 11.2: Create StateService and HibernationService for auto-hibernation
-	stateService := services.NewStateService(coordinator)
+	stateService := services.NewStateService(coordinator, services.WithEventRepository(coordinator))
 	hibernationSvc := services.NewHibernationService(coordinator, stateService, cfg, basePath)
 	cli.SetHibernationService(hibernationSvc)
 
@@ -191,12 +258,70 @@ func run(ctx context.Context) error {
 		"global_hibernation_days", cfg.HibernationDays,
 	)
 
-	// Story 12.1: Initialize log reader registry for Claude Code log viewing
+	// Story 12.1: Initialize log reader registry for agent log viewing
 	logReaderReg := logreaders.NewRegistry()
 	logReaderReg.Register(logreaders.NewClaudeCodeReader())
+	logReaderReg.Register(logreaders.NewCodexReader())
+	logReaderReg.Register(logreaders.NewAiderReader())
 	cli.SetLogReaderRegistry(logReaderReg)
 
 	slog.Debug("log reader registry initialized", "readers", len(logReaderReg.Readers()))
 
+	// Notify when an agent starts waiting (opt-in via notifications.enabled)
+	var notificationService ports.NotificationService
+	if cfg.Notifications.Enabled {
+		notificationService = services.NewNotificationService(waitingDetector, cfg, basePath, buildNotifiers(cfg.Notifications)...)
+		cli.SetNotificationService(notificationService)
+	}
+
+	// Workspace scanning: `vdash scan` plus workspace_roots pickup in the TUI and daemon
+	scanSvc := services.NewScanService(coordinator, filesystem.NewProjectDiscoverer(), detectionSvc)
+	cli.SetProjectScanner(scanSvc)
+
+	// Git status for the detail panel and JSON output; reads .git directly
+	gitInspector := git.NewInspector()
+	cli.SetGitInspector(gitInspector)
+
+	// Progress metrics: periodic samples in a separate metrics.db (optional;
+	// the dashboard works without it if the database cannot be opened)
+	var metricsCollector ports.MetricsCollector
+	if metricsRepo, err := metricsstore.NewRepository(filepath.Join(basePath, metricsstore.DBFileName)); err != nil {
+		slog.Warn("metrics disabled", "error", err)
+	} else {
+		metricsCollector = services.NewMetricsService(coordinator, metricsRepo,
+			services.WithMetricsWaitingDetector(waitingDetector),
+			services.WithProgressReader(metrics.NewProgressReader()))
+		cli.SetMetricsCollector(metricsCollector)
+	}
+
+	// Token usage and estimated cost from Claude Code logs; prices are
+	// user-editable and parsed logs are cached so reruns read only new lines
+	prices, err := usage.LoadPrices(filepath.Join(basePath, usage.PricesFileName))
+	if err != nil {
+		slog.Warn("using default model prices", "error", err)
+	}
+	cli.SetUsageReader(usage.NewAggregator(filepath.Join(basePath, usage.CacheFileName), prices))
+
+	// Re-detection shared by the TUI refresh and the daemon, so both record
+	// the same agent state history
+	refresher := services.NewRefreshService(coordinator, detectionSvc,
+		services.WithAgentStateRecording(coordinator, waitingDetector))
+	cli.SetProjectRefresher(refresher)
+
+	// Headless daemon: same watching, refresh and hibernation loop as the TUI,
+	// controlled over a Unix socket in the base directory
+	daemonSvc := services.NewDaemonService(coordinator, refresher,
+		services.WithDaemonHibernation(hibernationSvc),
+		services.WithDaemonStateActivator(stateService),
+		services.WithDaemonFileWatcher(fileWatcher),
+		services.WithStageRefreshInterval(time.Duration(cfg.StageRefreshIntervalSeconds)*time.Second),
+		services.WithWorkspaceScan(scanSvc, cfg.WorkspaceRoots, 0),
+		services.WithDaemonGitInspector(gitInspector),
+		services.WithDaemonMetrics(metricsCollector, 0),
+		services.WithDaemonNotifications(notificationService, 0),
+	)
+	cli.SetDaemonService(daemonSvc)
+	cli.SetDaemonPaths(daemon.SocketPath(basePath), daemon.PIDFilePath(basePath))
+
 	return cli.Execute(ctx)
 }
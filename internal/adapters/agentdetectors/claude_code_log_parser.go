@@ -25,7 +25,25 @@ type ClaudeLogEntry struct {
 	StopReason   string    // "end_turn", "tool_use", etc. (only for assistant, v2.1.7 format)
 	ContentTypes []string  // Content types from message.content[].type (v2.1.9+ format)
 	Timestamp    time.Time // Entry timestamp (supports RFC3339 and RFC3339Nano)
-	RawJSON      []byte    // Original JSON for debugging/troubleshooting
+	Model        string    // message.model (assistant entries only)
+	MessageID    string    // message.id; streamed content blocks of one reply share it
+	Usage        ClaudeUsage
+	RawJSON      []byte // Original JSON for debugging/troubleshooting
+}
+
+// ClaudeUsage holds the token counts from message.usage of an assistant entry.
+// Every content block of a streamed reply is logged as its own entry carrying
+// the reply's usage so far, so counts must be taken once per MessageID.
+type ClaudeUsage struct {
+	InputTokens              int64
+	OutputTokens             int64
+	CacheReadInputTokens     int64
+	CacheCreationInputTokens int64
+}
+
+// IsZero returns true if the entry carried no token counts.
+func (u ClaudeUsage) IsZero() bool {
+	return u == ClaudeUsage{}
 }
 
 // IsAssistant returns true if this is an assistant message entry.
@@ -255,6 +273,31 @@ func (p *ClaudeCodeLogParser) readAll(ctx context.Context, file *os.File) ([]Cla
 
 // readBackwards reads file from end to find last n entries.
 func (p *ClaudeCodeLogParser) readBackwards(ctx context.Context, file *os.File, fileSize int64, n int) ([]ClaudeLogEntry, error) {
+	lines, err := readTailLines(ctx, file, fileSize, n)
+	if err != nil || lines == nil {
+		return nil, err
+	}
+
+	// Parse lines into entries
+	var entries []ClaudeLogEntry
+	for _, line := range lines {
+		entry, err := p.parseLine(line)
+		if err != nil {
+			// Skip malformed lines (AC3)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// readTailLines reads up to n non-empty lines from the end of a file.
+// Lines are returned in chronological order (oldest first).
+// Returns nil, nil if the context is cancelled.
+// Shared by the Claude Code and Codex parsers so large session files are
+// never loaded fully.
+func readTailLines(ctx context.Context, file *os.File, fileSize int64, n int) ([][]byte, error) {
 	// Start from end, work backwards
 	offset := fileSize
 	// Collect lines in reverse order (newest first), then reverse at the end
@@ -323,22 +366,17 @@ func (p *ClaudeCodeLogParser) readBackwards(ctx context.Context, file *os.File,
 		reversedLines[i], reversedLines[j] = reversedLines[j], reversedLines[i]
 	}
 
-	// Parse lines into entries
-	var entries []ClaudeLogEntry
-	for _, line := range reversedLines {
-		entry, err := p.parseLine(line)
-		if err != nil {
-			// Skip malformed lines (AC3)
-			continue
-		}
-		entries = append(entries, entry)
-	}
-
-	return entries, nil
+	return reversedLines, nil
 }
 
 // parseLine parses a single JSONL line into a ClaudeLogEntry.
 func (p *ClaudeCodeLogParser) parseLine(line []byte) (ClaudeLogEntry, error) {
+	return ParseClaudeLine(line)
+}
+
+// ParseClaudeLine parses a single JSONL line into a ClaudeLogEntry.
+// Exported for the usage aggregator, which reads whole session files.
+func ParseClaudeLine(line []byte) (ClaudeLogEntry, error) {
 	if len(line) == 0 {
 		return ClaudeLogEntry{}, fmt.Errorf("empty line")
 	}
@@ -386,6 +424,20 @@ func (p *ClaudeCodeLogParser) parseLine(line []byte) (ClaudeLogEntry, error) {
 		}
 	}
 
+	// Extract model, message ID and token usage (assistant entries)
+	if msg, ok := raw["message"].(map[string]interface{}); ok {
+		entry.Model, _ = msg["model"].(string)
+		entry.MessageID, _ = msg["id"].(string)
+		if usage, ok := msg["usage"].(map[string]interface{}); ok {
+			entry.Usage = ClaudeUsage{
+				InputTokens:              jsonInt(usage["input_tokens"]),
+				OutputTokens:             jsonInt(usage["output_tokens"]),
+				CacheReadInputTokens:     jsonInt(usage["cache_read_input_tokens"]),
+				CacheCreationInputTokens: jsonInt(usage["cache_creation_input_tokens"]),
+			}
+		}
+	}
+
 	// Extract timestamp (support both RFC3339 and RFC3339Nano for Claude format variations)
 	if ts, ok := raw["timestamp"].(string); ok {
 		if parsed, err := time.Parse(time.RFC3339, ts); err == nil {
@@ -398,3 +450,11 @@ func (p *ClaudeCodeLogParser) parseLine(line []byte) (ClaudeLogEntry, error) {
 
 	return entry, nil
 }
+
+// jsonInt converts a decoded JSON number to int64 (0 if absent or not a number).
+func jsonInt(v interface{}) int64 {
+	if f, ok := v.(float64); ok {
+		return int64(f)
+	}
+	return 0
+}
@@ -700,6 +700,30 @@ func TestTimestamp_RFC3339Nano(t *testing.T) {
 	}
 }
 
+func TestParseClaudeLine_ModelAndUsage(t *testing.T) {
+	line := []byte(`{"type":"assistant","timestamp":"2026-01-16T10:00:00Z","message":{"id":"msg_01","model":"claude-sonnet-4-5-20250929","role":"assistant","content":[{"type":"text","text":"hi"}],"usage":{"input_tokens":12,"output_tokens":340,"cache_read_input_tokens":5000,"cache_creation_input_tokens":800}}}`)
+
+	entry, err := ParseClaudeLine(line)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.Model != "claude-sonnet-4-5-20250929" || entry.MessageID != "msg_01" {
+		t.Errorf("Model = %q, MessageID = %q", entry.Model, entry.MessageID)
+	}
+	want := ClaudeUsage{InputTokens: 12, OutputTokens: 340, CacheReadInputTokens: 5000, CacheCreationInputTokens: 800}
+	if entry.Usage != want {
+		t.Errorf("Usage = %+v, want %+v", entry.Usage, want)
+	}
+
+	user, err := ParseClaudeLine([]byte(`{"type":"user","message":{"role":"user","content":"hello"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !user.Usage.IsZero() || user.Model != "" {
+		t.Errorf("user entry should carry no usage, got %+v", user)
+	}
+}
+
 // =============================================================================
 // Task 6: Error handling tests
 // =============================================================================
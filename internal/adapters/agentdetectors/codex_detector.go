@@ -0,0 +1,115 @@
+package agentdetectors
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+const codexDetectorName = "Codex"
+
+// CodexDetector detects agent activity state from OpenAI Codex CLI rollout files.
+// Implements ports.AgentActivityDetector interface.
+type CodexDetector struct {
+	finder *CodexSessionFinder
+	parser *CodexLogParser
+}
+
+// CodexDetectorOption is a functional option for configuring CodexDetector.
+type CodexDetectorOption func(*CodexDetector)
+
+// WithCodexSessionFinder sets a custom session finder (for testing).
+func WithCodexSessionFinder(f *CodexSessionFinder) CodexDetectorOption {
+	return func(d *CodexDetector) {
+		d.finder = f
+	}
+}
+
+// NewCodexDetector creates a new detector with optional configuration.
+func NewCodexDetector(opts ...CodexDetectorOption) *CodexDetector {
+	d := &CodexDetector{}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.finder == nil {
+		d.finder = NewCodexSessionFinder()
+	}
+	if d.parser == nil {
+		d.parser = NewCodexLogParser()
+	}
+	return d
+}
+
+// Compile-time interface compliance check.
+var _ ports.AgentActivityDetector = (*CodexDetector)(nil)
+
+// Name returns the detector identifier.
+func (d *CodexDetector) Name() string {
+	return codexDetectorName
+}
+
+// Detect determines the current agent activity state for a project.
+// Returns AgentUnknown (not error) when Codex has no sessions for the project.
+func (d *CodexDetector) Detect(ctx context.Context, projectPath string) (domain.AgentState, error) {
+	unknown := domain.NewAgentState(codexDetectorName, domain.AgentUnknown, 0, domain.ConfidenceUncertain)
+
+	select {
+	case <-ctx.Done():
+		return unknown, nil
+	default:
+	}
+
+	// Step 1: Find the newest rollout whose cwd is this project
+	sessionPath, err := d.finder.FindMostRecentSession(ctx, projectPath)
+	if err != nil {
+		return unknown, err
+	}
+	if sessionPath == "" {
+		return unknown, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return unknown, nil
+	default:
+	}
+
+	// Step 2: Find the last state-bearing event
+	entry, err := d.parser.ParseLastStateEntry(ctx, sessionPath)
+	if err != nil {
+		return unknown, err
+	}
+	if entry == nil {
+		return domain.NewAgentState(codexDetectorName, domain.AgentInactive, 0, domain.ConfidenceCertain), nil
+	}
+
+	// Step 3: Map the event to a state
+	return d.determineState(entry, sessionPath), nil
+}
+
+// determineState interprets the last event of a session.
+// Duration is measured from the event timestamp, falling back to file mtime.
+func (d *CodexDetector) determineState(entry *CodexLogEntry, sessionPath string) domain.AgentState {
+	ts := entry.Timestamp
+	if ts.IsZero() {
+		if info, err := os.Stat(sessionPath); err == nil {
+			ts = info.ModTime()
+		}
+	}
+	duration := time.Since(ts)
+	if ts.IsZero() || duration < 0 {
+		duration = 0
+	}
+
+	switch {
+	case entry.IsWaitingForUser():
+		return domain.NewAgentState(codexDetectorName, domain.AgentWaitingForUser, duration, domain.ConfidenceCertain)
+	case entry.IsWorking():
+		return domain.NewAgentState(codexDetectorName, domain.AgentWorking, duration, domain.ConfidenceCertain)
+	default:
+		return domain.NewAgentState(codexDetectorName, domain.AgentUnknown, duration, domain.ConfidenceUncertain)
+	}
+}
@@ -0,0 +1,98 @@
+package agentdetectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// TestCodexDetector_Name verifies Name returns "Codex".
+func TestCodexDetector_Name(t *testing.T) {
+	if got := NewCodexDetector().Name(); got != "Codex" {
+		t.Errorf("Name() = %q, want %q", got, "Codex")
+	}
+}
+
+func TestCodexDetector_Detect(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		lines  string
+		status domain.AgentStatus
+		conf   domain.Confidence
+	}{
+		{
+			name:   "task complete is waiting",
+			lines:  codexMetaLine("/test/proj") + `{"timestamp":"` + now.Add(-5*time.Minute).UTC().Format(time.RFC3339Nano) + `","type":"event_msg","payload":{"type":"task_complete"}}` + "\n",
+			status: domain.AgentWaitingForUser,
+			conf:   domain.ConfidenceCertain,
+		},
+		{
+			name:   "function call is working",
+			lines:  codexMetaLine("/test/proj") + `{"timestamp":"` + now.UTC().Format(time.RFC3339Nano) + `","type":"response_item","payload":{"type":"function_call"}}` + "\n",
+			status: domain.AgentWorking,
+			conf:   domain.ConfidenceCertain,
+		},
+		{
+			name:   "header only is inactive",
+			lines:  codexMetaLine("/test/proj"),
+			status: domain.AgentInactive,
+			conf:   domain.ConfidenceCertain,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionsDir := t.TempDir()
+			writeCodexSession(t, sessionsDir, now, "rollout-a.jsonl", tt.lines, now)
+
+			d := NewCodexDetector(WithCodexSessionFinder(NewCodexSessionFinder(WithCodexSessionsDir(sessionsDir))))
+			state, err := d.Detect(context.Background(), "/test/proj")
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if state.Status != tt.status || state.Confidence != tt.conf {
+				t.Errorf("Detect() = %s, want %v (%v)", state.Summary(), tt.status, tt.conf)
+			}
+			if state.Tool != "Codex" {
+				t.Errorf("Tool = %q, want Codex", state.Tool)
+			}
+		})
+	}
+}
+
+func TestCodexDetector_WaitingDuration(t *testing.T) {
+	now := time.Now()
+	sessionsDir := t.TempDir()
+	lines := codexMetaLine("/test/proj") +
+		`{"timestamp":"` + now.Add(-10*time.Minute).UTC().Format(time.RFC3339Nano) + `","type":"event_msg","payload":{"type":"task_complete"}}` + "\n"
+	writeCodexSession(t, sessionsDir, now, "rollout-a.jsonl", lines, now)
+
+	d := NewCodexDetector(WithCodexSessionFinder(NewCodexSessionFinder(WithCodexSessionsDir(sessionsDir))))
+	state, _ := d.Detect(context.Background(), "/test/proj")
+	if state.Duration < 9*time.Minute || state.Duration > 11*time.Minute {
+		t.Errorf("Duration = %v, want ~10m", state.Duration)
+	}
+}
+
+func TestCodexDetector_NoSessions(t *testing.T) {
+	d := NewCodexDetector(WithCodexSessionFinder(NewCodexSessionFinder(WithCodexSessionsDir(t.TempDir()))))
+	state, err := d.Detect(context.Background(), "/test/proj")
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if !state.IsUnknown() {
+		t.Errorf("Detect() = %s, want Unknown", state.Summary())
+	}
+}
+
+func TestCodexDetector_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	state, err := NewCodexDetector().Detect(ctx, "/test/proj")
+	if err != nil || !state.IsUnknown() {
+		t.Errorf("Detect() = (%s, %v), want Unknown, nil", state.Summary(), err)
+	}
+}
@@ -0,0 +1,166 @@
+package agentdetectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// Codex event kinds that drive agent state.
+// Codex rollout lines are either {"type": "response_item"|"event_msg"|..., "payload": {...}}
+// (current format) or bare response items {"type": "message", ...} (legacy format).
+const (
+	codexKindMessage            = "message"
+	codexKindFunctionCall       = "function_call"
+	codexKindFunctionCallOutput = "function_call_output"
+	codexKindLocalShellCall     = "local_shell_call"
+	codexKindCustomToolCall     = "custom_tool_call"
+	codexKindCustomToolOutput   = "custom_tool_call_output"
+	codexKindReasoning          = "reasoning"
+	codexKindTaskStarted        = "task_started"
+	codexKindTaskComplete       = "task_complete"
+	codexKindTurnAborted        = "turn_aborted"
+	codexKindUserMessage        = "user_message"
+	codexKindAgentMessage       = "agent_message"
+	codexKindExecApproval       = "exec_approval_request"
+	codexKindPatchApproval      = "apply_patch_approval_request"
+	codexKindError              = "error"
+)
+
+// CodexLogEntry represents a parsed line from a Codex CLI rollout file.
+type CodexLogEntry struct {
+	Kind      string    // Payload type: "message", "function_call", "task_complete", ...
+	Role      string    // "user", "assistant", "developer" (message kinds only)
+	Timestamp time.Time // Line timestamp (zero if missing)
+	RawJSON   []byte    // Original JSON line
+}
+
+// IsWaitingForUser returns true if this entry ends the agent's turn.
+// A turn ends when the task completes, the agent replies with a final message,
+// the turn is aborted, or the agent asks for command/patch approval.
+func (e CodexLogEntry) IsWaitingForUser() bool {
+	switch e.Kind {
+	case codexKindTaskComplete, codexKindTurnAborted, codexKindExecApproval, codexKindPatchApproval, codexKindError:
+		return true
+	case codexKindMessage:
+		return e.Role == "assistant"
+	case codexKindAgentMessage:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsWorking returns true if this entry indicates the agent is mid-turn.
+func (e CodexLogEntry) IsWorking() bool {
+	switch e.Kind {
+	case codexKindFunctionCall, codexKindFunctionCallOutput, codexKindLocalShellCall,
+		codexKindCustomToolCall, codexKindCustomToolOutput, codexKindReasoning,
+		codexKindTaskStarted, codexKindUserMessage:
+		return true
+	case codexKindMessage:
+		return e.Role == "user"
+	default:
+		return false
+	}
+}
+
+// isStateSignal returns true if the entry says anything about agent state.
+// Bookkeeping lines (token_count, session_meta, turn_context) are skipped.
+func (e CodexLogEntry) isStateSignal() bool {
+	return e.IsWaitingForUser() || e.IsWorking()
+}
+
+// CodexLogParser parses Codex CLI rollout JSONL files with tail optimization.
+type CodexLogParser struct {
+	tailEntries int
+}
+
+// NewCodexLogParser creates a new parser reading the last defaultTailEntries lines.
+func NewCodexLogParser() *CodexLogParser {
+	return &CodexLogParser{tailEntries: defaultTailEntries}
+}
+
+// ParseLastStateEntry returns the most recent entry that carries agent state.
+// Returns nil if the session has no such entries.
+func (p *CodexLogParser) ParseLastStateEntry(ctx context.Context, sessionPath string) (*CodexLogEntry, error) {
+	file, err := os.Open(sessionPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+	if stat.Size() == 0 {
+		return nil, nil
+	}
+
+	lines, err := readTailLines(ctx, file, stat.Size(), p.tailEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(lines) - 1; i >= 0; i-- {
+		entry, err := ParseCodexLine(lines[i])
+		if err != nil {
+			continue // Skip malformed lines
+		}
+		if entry.isStateSignal() {
+			return &entry, nil
+		}
+	}
+	return nil, nil
+}
+
+// ParseCodexLine parses a single rollout line into a CodexLogEntry.
+// Exported for the Codex log reader, which shares the line format.
+func ParseCodexLine(line []byte) (CodexLogEntry, error) {
+	if len(line) == 0 {
+		return CodexLogEntry{}, fmt.Errorf("empty line")
+	}
+
+	var raw struct {
+		Timestamp string          `json:"timestamp"`
+		Type      string          `json:"type"`
+		Role      string          `json:"role"`
+		Payload   json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(line, &raw); err != nil {
+		slog.Debug("skipping malformed codex log line", "error", err)
+		return CodexLogEntry{}, err
+	}
+
+	entry := CodexLogEntry{RawJSON: line}
+
+	if len(raw.Payload) > 0 {
+		var payload struct {
+			Type string `json:"type"`
+			Role string `json:"role"`
+		}
+		if err := json.Unmarshal(raw.Payload, &payload); err == nil {
+			entry.Kind = payload.Type
+			entry.Role = payload.Role
+		}
+		if entry.Kind == "" {
+			entry.Kind = raw.Type
+		}
+	} else {
+		// Legacy format: response item inline
+		entry.Kind = raw.Type
+		entry.Role = raw.Role
+	}
+
+	if raw.Timestamp != "" {
+		if parsed, err := time.Parse(time.RFC3339Nano, raw.Timestamp); err == nil {
+			entry.Timestamp = parsed
+		}
+	}
+
+	return entry, nil
+}
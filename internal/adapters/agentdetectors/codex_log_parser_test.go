@@ -0,0 +1,95 @@
+package agentdetectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseCodexLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		kind    string
+		role    string
+		waiting bool
+		working bool
+	}{
+		{"task complete", `{"timestamp":"2026-01-12T08:00:09.300Z","type":"event_msg","payload":{"type":"task_complete"}}`, "task_complete", "", true, false},
+		{"assistant message", `{"type":"response_item","payload":{"type":"message","role":"assistant"}}`, "message", "assistant", true, false},
+		{"user message", `{"type":"response_item","payload":{"type":"message","role":"user"}}`, "message", "user", false, true},
+		{"function call", `{"type":"response_item","payload":{"type":"function_call","name":"shell"}}`, "function_call", "", false, true},
+		{"approval request", `{"type":"event_msg","payload":{"type":"exec_approval_request"}}`, "exec_approval_request", "", true, false},
+		{"token count", `{"type":"event_msg","payload":{"type":"token_count"}}`, "token_count", "", false, false},
+		{"session meta", `{"type":"session_meta","payload":{"id":"x","cwd":"/p"}}`, "session_meta", "", false, false},
+		{"legacy function call", `{"type":"function_call","name":"shell"}`, "function_call", "", false, true},
+		{"legacy assistant", `{"type":"message","role":"assistant"}`, "message", "assistant", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := ParseCodexLine([]byte(tt.line))
+			if err != nil {
+				t.Fatalf("ParseCodexLine() error = %v", err)
+			}
+			if entry.Kind != tt.kind || entry.Role != tt.role {
+				t.Errorf("got (%q, %q), want (%q, %q)", entry.Kind, entry.Role, tt.kind, tt.role)
+			}
+			if entry.IsWaitingForUser() != tt.waiting {
+				t.Errorf("IsWaitingForUser() = %v, want %v", entry.IsWaitingForUser(), tt.waiting)
+			}
+			if entry.IsWorking() != tt.working {
+				t.Errorf("IsWorking() = %v, want %v", entry.IsWorking(), tt.working)
+			}
+		})
+	}
+}
+
+func TestParseCodexLine_Malformed(t *testing.T) {
+	if _, err := ParseCodexLine([]byte("{not json")); err == nil {
+		t.Error("expected error for malformed line")
+	}
+	if _, err := ParseCodexLine(nil); err == nil {
+		t.Error("expected error for empty line")
+	}
+}
+
+func TestCodexLogParser_ParseLastStateEntry_SkipsBookkeeping(t *testing.T) {
+	p := NewCodexLogParser()
+	path := filepath.Join(codexFixturesDir(), "rollout-2026-01-12T08-00-00-0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b.jsonl")
+
+	entry, err := p.ParseLastStateEntry(context.Background(), path)
+	if err != nil {
+		t.Fatalf("ParseLastStateEntry() error = %v", err)
+	}
+	if entry == nil || entry.Kind != "task_complete" {
+		t.Fatalf("ParseLastStateEntry() = %+v, want task_complete", entry)
+	}
+	if entry.Timestamp.IsZero() {
+		t.Error("expected timestamp to be parsed")
+	}
+}
+
+func TestCodexLogParser_ParseLastStateEntry_LargeFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rollout-big.jsonl")
+	var b strings.Builder
+	filler := `{"type":"event_msg","payload":{"type":"token_count","info":{"pad":"` + strings.Repeat("x", 200) + `"}}}` + "\n"
+	b.WriteString(`{"type":"response_item","payload":{"type":"function_call","name":"shell"}}` + "\n")
+	for b.Len() < 2*smallFileThreshold {
+		b.WriteString(filler)
+	}
+	b.WriteString(`{"type":"response_item","payload":{"type":"reasoning"}}` + "\n")
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := NewCodexLogParser().ParseLastStateEntry(context.Background(), path)
+	if err != nil {
+		t.Fatalf("ParseLastStateEntry() error = %v", err)
+	}
+	if entry == nil || entry.Kind != "reasoning" {
+		t.Errorf("ParseLastStateEntry() = %+v, want reasoning", entry)
+	}
+}
@@ -0,0 +1,293 @@
+package agentdetectors
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	codexSessionsDir = ".codex/sessions"
+
+	// defaultCodexLookbackDays limits the YYYY/MM/DD directories scanned per lookup.
+	// Keeps detection under the 1s budget (NFR-P2-1) on machines with long history.
+	defaultCodexLookbackDays = 14
+
+	// codexHeaderLines is the number of leading lines inspected for the session cwd.
+	codexHeaderLines = 20
+)
+
+// codexCwdTagRegex extracts cwd from legacy <environment_context> user messages.
+var codexCwdTagRegex = regexp.MustCompile(`<cwd>([^<]+)</cwd>`)
+
+// CodexSessionFile describes one Codex CLI rollout file mapped to a project.
+type CodexSessionFile struct {
+	Path    string    // Absolute path to rollout-*.jsonl
+	ID      string    // Session ID (from session_meta, or derived from filename)
+	Cwd     string    // Working directory the session was started in
+	ModTime time.Time // Last modification time of the rollout file
+	Size    int64     // File size in bytes
+}
+
+// codexMetaCacheEntry caches header parsing per rollout file.
+// Keyed by file path; invalidated when mtime changes and cwd was not found yet.
+type codexMetaCacheEntry struct {
+	id      string
+	cwd     string
+	modTime time.Time
+}
+
+// CodexSessionFinder maps project paths to Codex CLI rollout files.
+// Codex stores sessions under ~/.codex/sessions/YYYY/MM/DD/rollout-*.jsonl
+// (or $CODEX_HOME/sessions); the project is identified by the cwd recorded
+// in the session header. This is a HELPER struct used by CodexDetector and
+// the Codex log reader, NOT an implementation of AgentActivityDetector.
+type CodexSessionFinder struct {
+	sessionsDir  string
+	lookbackDays int
+	now          func() time.Time
+	cache        map[string]codexMetaCacheEntry
+	cacheMu      sync.RWMutex
+}
+
+// CodexFinderOption is a functional option for configuring CodexSessionFinder.
+type CodexFinderOption func(*CodexSessionFinder)
+
+// WithCodexSessionsDir sets a custom sessions directory (for testing).
+func WithCodexSessionsDir(dir string) CodexFinderOption {
+	return func(f *CodexSessionFinder) {
+		f.sessionsDir = dir
+	}
+}
+
+// WithCodexLookbackDays sets how many days of dated directories are scanned.
+func WithCodexLookbackDays(days int) CodexFinderOption {
+	return func(f *CodexSessionFinder) {
+		if days > 0 {
+			f.lookbackDays = days
+		}
+	}
+}
+
+// withCodexClock sets the clock used to pick dated directories (for testing).
+func withCodexClock(now func() time.Time) CodexFinderOption {
+	return func(f *CodexSessionFinder) {
+		f.now = now
+	}
+}
+
+// NewCodexSessionFinder creates a finder rooted at $CODEX_HOME/sessions or ~/.codex/sessions.
+func NewCodexSessionFinder(opts ...CodexFinderOption) *CodexSessionFinder {
+	f := &CodexSessionFinder{
+		lookbackDays: defaultCodexLookbackDays,
+		now:          time.Now,
+		cache:        make(map[string]codexMetaCacheEntry),
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	if f.sessionsDir == "" {
+		f.sessionsDir = defaultCodexSessionsDir()
+	}
+	return f
+}
+
+// defaultCodexSessionsDir resolves the Codex sessions directory.
+// Returns empty string if the home directory cannot be determined.
+func defaultCodexSessionsDir() string {
+	if codexHome := os.Getenv("CODEX_HOME"); codexHome != "" {
+		return filepath.Join(codexHome, "sessions")
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, codexSessionsDir)
+}
+
+// SessionsDir returns the root directory scanned for rollout files.
+func (f *CodexSessionFinder) SessionsDir() string {
+	return f.sessionsDir
+}
+
+// FindMostRecentSession returns the newest rollout file whose cwd matches projectPath.
+// Returns empty string (not error) if Codex is not installed or has no sessions for the project.
+func (f *CodexSessionFinder) FindMostRecentSession(ctx context.Context, projectPath string) (string, error) {
+	sessions, err := f.ListSessions(ctx, projectPath)
+	if err != nil || len(sessions) == 0 {
+		return "", err
+	}
+	return sessions[0].Path, nil
+}
+
+// ListSessions returns rollout files for projectPath, newest first.
+// Only the configured lookback window of dated directories is scanned,
+// plus any legacy rollout files directly under the sessions directory.
+func (f *CodexSessionFinder) ListSessions(ctx context.Context, projectPath string) ([]CodexSessionFile, error) {
+	if f.sessionsDir == "" || projectPath == "" {
+		return nil, nil
+	}
+	if info, err := os.Stat(f.sessionsDir); err != nil || !info.IsDir() {
+		return nil, nil
+	}
+
+	target := normalizePath(projectPath)
+	var sessions []CodexSessionFile
+
+	for _, dir := range f.candidateDirs() {
+		select {
+		case <-ctx.Done():
+			return sessions, nil
+		default:
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue // Missing dated directory is normal
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || !strings.HasPrefix(name, "rollout-") || !strings.HasSuffix(name, ".jsonl") {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			path := filepath.Join(dir, name)
+			id, cwd := f.sessionMeta(path, info.ModTime())
+			if cwd == "" || normalizePath(cwd) != target {
+				continue
+			}
+			sessions = append(sessions, CodexSessionFile{
+				Path:    path,
+				ID:      id,
+				Cwd:     cwd,
+				ModTime: info.ModTime(),
+				Size:    info.Size(),
+			})
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].ModTime.After(sessions[j].ModTime)
+	})
+	return sessions, nil
+}
+
+// candidateDirs returns dated directories in the lookback window (newest first)
+// followed by the sessions root for legacy flat layouts.
+func (f *CodexSessionFinder) candidateDirs() []string {
+	dirs := make([]string, 0, f.lookbackDays+1)
+	today := f.now()
+	for day := 0; day < f.lookbackDays; day++ {
+		d := today.AddDate(0, 0, -day)
+		dirs = append(dirs, filepath.Join(f.sessionsDir, d.Format("2006"), d.Format("01"), d.Format("02")))
+	}
+	return append(dirs, f.sessionsDir)
+}
+
+// sessionMeta returns the session ID and cwd for a rollout file, using the cache
+// when the file has not changed since it was last inspected.
+func (f *CodexSessionFinder) sessionMeta(path string, modTime time.Time) (string, string) {
+	f.cacheMu.RLock()
+	cached, ok := f.cache[path]
+	f.cacheMu.RUnlock()
+	// cwd never changes once written; only re-read when it was missing and file grew
+	if ok && (cached.cwd != "" || cached.modTime.Equal(modTime)) {
+		return cached.id, cached.cwd
+	}
+
+	id, cwd := readCodexHeader(path)
+	if id == "" {
+		id = codexIDFromFilename(filepath.Base(path))
+	}
+
+	f.cacheMu.Lock()
+	f.cache[path] = codexMetaCacheEntry{id: id, cwd: cwd, modTime: modTime}
+	f.cacheMu.Unlock()
+	return id, cwd
+}
+
+// readCodexHeader scans the first lines of a rollout file for session id and cwd.
+// Supports the current format (session_meta / turn_context payloads) and the
+// legacy format (cwd embedded in an <environment_context> user message).
+func readCodexHeader(path string) (string, string) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	// session_meta embeds the full instructions text, so allow long lines
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+
+	var id, cwd string
+	for i := 0; i < codexHeaderLines && scanner.Scan(); i++ {
+		var raw struct {
+			ID      string          `json:"id"`
+			Type    string          `json:"type"`
+			Payload json.RawMessage `json:"payload"`
+		}
+		line := scanner.Bytes()
+		if err := json.Unmarshal(line, &raw); err != nil {
+			continue
+		}
+
+		switch raw.Type {
+		case "session_meta", "turn_context":
+			var payload struct {
+				ID  string `json:"id"`
+				Cwd string `json:"cwd"`
+			}
+			if json.Unmarshal(raw.Payload, &payload) == nil {
+				if id == "" {
+					id = payload.ID
+				}
+				if cwd == "" {
+					cwd = payload.Cwd
+				}
+			}
+		default:
+			// Legacy header line: {"id": "...", "timestamp": "...", "instructions": ...}
+			if id == "" && raw.ID != "" && raw.Type == "" {
+				id = raw.ID
+			}
+			if cwd == "" {
+				if m := codexCwdTagRegex.FindSubmatch(line); m != nil {
+					cwd = strings.TrimSpace(string(m[1]))
+				}
+			}
+		}
+		if id != "" && cwd != "" {
+			break
+		}
+	}
+	return id, cwd
+}
+
+// codexIDFromFilename derives a session ID from "rollout-<timestamp>-<uuid>.jsonl".
+// Falls back to the filename without extension.
+func codexIDFromFilename(name string) string {
+	base := strings.TrimSuffix(name, ".jsonl")
+	// UUID is the last 36 characters when present
+	if len(base) > 36 && base[len(base)-37] == '-' {
+		return base[len(base)-36:]
+	}
+	return strings.TrimPrefix(base, "rollout-")
+}
+
+// ClearCache clears cached header lookups. Used for testing.
+func (f *CodexSessionFinder) ClearCache() {
+	f.cacheMu.Lock()
+	f.cache = make(map[string]codexMetaCacheEntry)
+	f.cacheMu.Unlock()
+}
@@ -0,0 +1,143 @@
+package agentdetectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// codexFixturesDir returns the path to the Codex log fixtures.
+func codexFixturesDir() string {
+	return filepath.Join("..", "..", "..", "test", "fixtures", "codex-logs")
+}
+
+// writeCodexSession copies content into sessionsDir/YYYY/MM/DD/name and sets mtime.
+func writeCodexSession(t *testing.T, sessionsDir string, day time.Time, name, content string, mtime time.Time) string {
+	t.Helper()
+	dir := filepath.Join(sessionsDir, day.Format("2006"), day.Format("01"), day.Format("02"))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func codexMetaLine(cwd string) string {
+	return `{"timestamp":"2026-01-12T08:00:00Z","type":"session_meta","payload":{"id":"s1","cwd":"` + cwd + `"}}` + "\n"
+}
+
+func TestCodexSessionFinder_ListSessions_MatchesCwdNewestFirst(t *testing.T) {
+	sessionsDir := t.TempDir()
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+
+	older := writeCodexSession(t, sessionsDir, now.AddDate(0, 0, -1), "rollout-a.jsonl", codexMetaLine("/test/proj"), now.Add(-24*time.Hour))
+	newer := writeCodexSession(t, sessionsDir, now, "rollout-b.jsonl", codexMetaLine("/test/proj"), now.Add(-time.Hour))
+	writeCodexSession(t, sessionsDir, now, "rollout-c.jsonl", codexMetaLine("/test/other"), now)
+	writeCodexSession(t, sessionsDir, now, "notes.jsonl", codexMetaLine("/test/proj"), now)
+
+	f := NewCodexSessionFinder(WithCodexSessionsDir(sessionsDir), withCodexClock(func() time.Time { return now }))
+	sessions, err := f.ListSessions(context.Background(), "/test/proj")
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("ListSessions() returned %d sessions, want 2", len(sessions))
+	}
+	if sessions[0].Path != newer || sessions[1].Path != older {
+		t.Errorf("ListSessions() order = [%s %s], want newest first", sessions[0].Path, sessions[1].Path)
+	}
+	if sessions[0].ID != "s1" || sessions[0].Cwd != "/test/proj" {
+		t.Errorf("session meta = (%q, %q), want (s1, /test/proj)", sessions[0].ID, sessions[0].Cwd)
+	}
+}
+
+func TestCodexSessionFinder_LookbackWindow(t *testing.T) {
+	sessionsDir := t.TempDir()
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	writeCodexSession(t, sessionsDir, now.AddDate(0, 0, -30), "rollout-old.jsonl", codexMetaLine("/test/proj"), now.AddDate(0, 0, -30))
+
+	f := NewCodexSessionFinder(
+		WithCodexSessionsDir(sessionsDir),
+		WithCodexLookbackDays(7),
+		withCodexClock(func() time.Time { return now }),
+	)
+	path, err := f.FindMostRecentSession(context.Background(), "/test/proj")
+	if err != nil {
+		t.Fatalf("FindMostRecentSession() error = %v", err)
+	}
+	if path != "" {
+		t.Errorf("FindMostRecentSession() = %q, want empty (outside lookback)", path)
+	}
+}
+
+func TestCodexSessionFinder_LegacyFlatLayout(t *testing.T) {
+	sessionsDir := t.TempDir()
+	data, err := os.ReadFile(filepath.Join(codexFixturesDir(), "rollout-legacy.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sessionsDir, "rollout-legacy.jsonl"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := NewCodexSessionFinder(WithCodexSessionsDir(sessionsDir))
+	sessions, err := f.ListSessions(context.Background(), "/test/codex-legacy")
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("ListSessions() returned %d sessions, want 1", len(sessions))
+	}
+	if sessions[0].ID != "legacy-session-1" {
+		t.Errorf("ID = %q, want legacy-session-1", sessions[0].ID)
+	}
+}
+
+func TestCodexSessionFinder_MissingSessionsDir(t *testing.T) {
+	f := NewCodexSessionFinder(WithCodexSessionsDir(filepath.Join(t.TempDir(), "missing")))
+	path, err := f.FindMostRecentSession(context.Background(), "/test/proj")
+	if err != nil || path != "" {
+		t.Errorf("FindMostRecentSession() = (%q, %v), want (\"\", nil)", path, err)
+	}
+}
+
+func TestCodexSessionFinder_RereadsWhenCwdAppearsLater(t *testing.T) {
+	sessionsDir := t.TempDir()
+	now := time.Now()
+	path := writeCodexSession(t, sessionsDir, now, "rollout-x.jsonl", "", now.Add(-time.Minute))
+
+	f := NewCodexSessionFinder(WithCodexSessionsDir(sessionsDir))
+	if got, _ := f.FindMostRecentSession(context.Background(), "/test/proj"); got != "" {
+		t.Fatalf("expected no match for empty file, got %q", got)
+	}
+
+	if err := os.WriteFile(path, []byte(codexMetaLine("/test/proj")), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, now, now); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := f.FindMostRecentSession(context.Background(), "/test/proj"); got != path {
+		t.Errorf("FindMostRecentSession() = %q, want %q after header written", got, path)
+	}
+}
+
+func TestCodexIDFromFilename(t *testing.T) {
+	tests := map[string]string{
+		"rollout-2026-01-12T08-00-00-0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b.jsonl": "0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b",
+		"rollout-legacy.jsonl": "legacy",
+	}
+	for name, want := range tests {
+		if got := codexIDFromFilename(name); got != want {
+			t.Errorf("codexIDFromFilename(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
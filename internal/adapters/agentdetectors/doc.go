@@ -13,4 +13,7 @@
 //   - GenericDetector (Story 15.5): File activity fallback for any project. Scans filesystem for most
 //     recent file modification time and returns Working/WaitingForUser with ConfidenceUncertain.
 //     Used as fallback when tool-specific detectors (like ClaudeCodeDetector) don't match.
+//   - CodexSessionFinder / CodexLogParser / CodexDetector: OpenAI Codex CLI support. Maps
+//     ~/.codex/sessions/YYYY/MM/DD/rollout-*.jsonl files to projects by their recorded cwd and
+//     derives Working/WaitingForUser from the last turn event with ConfidenceCertain.
 package agentdetectors
@@ -0,0 +1,343 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/apitypes"
+)
+
+// Server-Sent Event names on /v1/events.
+const (
+	EventSnapshot       = "snapshot"        // Full ListResponse, sent once on connect
+	EventProjectUpdated = "project_updated" // StatusResponse for a project whose summary changed
+	EventProjectRemoved = "project_removed" // ProjectRemovedEvent for an untracked project
+	EventActivity       = "activity"        // ActivityEvent for a file change in a project
+)
+
+const (
+	// heartbeatInterval keeps idle streams open through proxies and detects dead clients.
+	heartbeatInterval = 15 * time.Second
+
+	// subscriberBuffer is the per-client event buffer; events are dropped for slower clients.
+	subscriberBuffer = 32
+)
+
+// ProjectRemovedEvent is the data of a project_removed event.
+type ProjectRemovedEvent struct {
+	APIVersion string `json:"api_version"`
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+}
+
+// ActivityEvent is the data of an activity event.
+type ActivityEvent struct {
+	APIVersion string `json:"api_version"`
+	Project    string `json:"project"`     // Project name
+	Path       string `json:"path"`        // Changed file
+	Operation  string `json:"operation"`   // "create", "modify" or "delete"
+	OccurredAt string `json:"occurred_at"` // RFC3339, UTC
+}
+
+// sseEvent is one encoded event ready to write to a stream.
+type sseEvent struct {
+	name string
+	data []byte
+}
+
+// hub fans events out to connected stream clients.
+type hub struct {
+	mu          sync.Mutex
+	subscribers map[chan sseEvent]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subscribers: make(map[chan sseEvent]struct{})}
+}
+
+// subscribe registers a new client. Caller must unsubscribe when done.
+func (h *hub) subscribe() chan sseEvent {
+	ch := make(chan sseEvent, subscriberBuffer)
+	h.mu.Lock()
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+	return ch
+}
+
+func (h *hub) unsubscribe(ch chan sseEvent) {
+	h.mu.Lock()
+	delete(h.subscribers, ch)
+	h.mu.Unlock()
+}
+
+// broadcast encodes v and delivers it to every client without blocking.
+func (h *hub) broadcast(name string, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		slog.Warn("failed to encode api event", "event", name, "error", err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers {
+		select {
+		case ch <- sseEvent{name: name, data: data}:
+		default:
+			slog.Debug("dropping api event for slow client", "event", name)
+		}
+	}
+}
+
+// handleEvents serves GET /v1/events as a Server-Sent Events stream.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, codeInternal, "streaming not supported")
+		return
+	}
+
+	// Subscribe before the snapshot so no change between the two is missed
+	sub := s.hub.subscribe()
+	defer s.hub.unsubscribe(sub)
+
+	snapshot, err := s.listResponse(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, codeInternal, err.Error())
+		return
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, codeInternal, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	if err := writeEvent(w, sseEvent{name: EventSnapshot, data: data}); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case ev := <-sub:
+			if err := writeEvent(w, ev); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// writeEvent writes one SSE frame. data is single-line JSON.
+func writeEvent(w http.ResponseWriter, ev sseEvent) error {
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.name, ev.data)
+	return err
+}
+
+// monitorState is the last published view of each project, keyed by path.
+type monitorState struct {
+	projects  []*domain.Project
+	summaries map[string][]byte // Encoded ProjectSummary
+	names     map[string]string
+}
+
+// monitor re-evaluates project state on file events, refresh requests and
+// every pollInterval, publishing differences to stream clients.
+// Runs until ctx is cancelled.
+func (s *Server) monitor(ctx context.Context) {
+	state := &monitorState{}
+	s.evaluate(ctx, state, false) // Baseline; clients get it via snapshot
+
+	var watchedKey string
+	var eventCh <-chan ports.FileEvent
+	rewatch := func() {
+		// A daemon's feed covers every project; resubscribe after it closes
+		if s.activityFeed != nil {
+			if eventCh == nil {
+				ch, err := s.activityFeed.Activity(ctx)
+				if err != nil {
+					slog.Debug("daemon activity unavailable", "error", err)
+					return
+				}
+				eventCh = ch
+			}
+			return
+		}
+		key := watchKey(state.projects)
+		if s.fileWatcher == nil || key == watchedKey {
+			return
+		}
+		watchedKey = key
+		eventCh = nil
+		if key == "" {
+			return
+		}
+		paths := strings.Split(key, "\n")
+		ch, err := s.fileWatcher.Watch(ctx, paths)
+		if err != nil {
+			slog.Warn("api server file watching unavailable", "error", err)
+			return
+		}
+		eventCh = ch
+		if failed := s.fileWatcher.GetFailedPaths(); len(failed) > 0 {
+			slog.Warn("some project paths could not be watched", "failed", len(failed), "total", len(paths))
+		}
+	}
+	rewatch()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.refresh:
+		case ev, ok := <-eventCh:
+			if !ok {
+				// Watcher or feed closed; retry on the next project set
+				// change or, for a feed, the next tick
+				eventCh = nil
+				watchedKey = ""
+				continue
+			}
+			if s.activityFeed != nil {
+				if p := findProjectByPath(state.projects, ev.Path); p != nil {
+					s.broadcastActivity(p, ev)
+				}
+			} else {
+				s.handleFileEvent(ctx, state, ev)
+			}
+		}
+		s.evaluate(ctx, state, true)
+		rewatch()
+	}
+}
+
+// evaluate reloads projects and, when publish is set, broadcasts
+// project_updated/project_removed for every difference from the last run.
+func (s *Server) evaluate(ctx context.Context, state *monitorState, publish bool) {
+	projects, err := s.repository.FindAll(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			slog.Warn("api server failed to load projects", "error", err)
+		}
+		return
+	}
+
+	summaries := make(map[string][]byte, len(projects))
+	names := make(map[string]string, len(projects))
+	for _, p := range projects {
+		status := s.statusResponse(ctx, p)
+		data, err := json.Marshal(status.Project)
+		if err != nil {
+			continue
+		}
+		summaries[p.Path] = data
+		names[p.Path] = p.Name
+
+		if publish && string(state.summaries[p.Path]) != string(data) {
+			s.hub.broadcast(EventProjectUpdated, status)
+		}
+	}
+
+	if publish {
+		for path, name := range state.names {
+			if _, ok := names[path]; !ok {
+				s.hub.broadcast(EventProjectRemoved, ProjectRemovedEvent{
+					APIVersion: apitypes.APIVersion,
+					Name:       name,
+					Path:       path,
+				})
+			}
+		}
+	}
+
+	state.projects = projects
+	state.summaries = summaries
+	state.names = names
+
+	if s.notifications != nil {
+		s.notifications.CheckWaiting(ctx, projects)
+	}
+}
+
+// handleFileEvent records activity for the project owning the changed file
+// and auto-activates it if hibernated (same behavior as the TUI, Story 11.3).
+func (s *Server) handleFileEvent(ctx context.Context, state *monitorState, ev ports.FileEvent) {
+	p := findProjectByPath(state.projects, ev.Path)
+	if p == nil {
+		return
+	}
+
+	if p.State == domain.StateHibernated && s.stateService != nil {
+		if err := s.stateService.Activate(ctx, p.ID); err != nil && !errors.Is(err, domain.ErrInvalidStateTransition) {
+			slog.Warn("failed to auto-activate project", "project_id", p.ID, "error", err)
+		}
+	}
+
+	if err := s.repository.UpdateLastActivity(ctx, p.ID, ev.Timestamp); err != nil {
+		slog.Warn("failed to update activity", "project_id", p.ID, "error", err)
+	}
+
+	s.broadcastActivity(p, ev)
+}
+
+// broadcastActivity publishes an activity event for a file change in p.
+func (s *Server) broadcastActivity(p *domain.Project, ev ports.FileEvent) {
+	s.hub.broadcast(EventActivity, ActivityEvent{
+		APIVersion: apitypes.APIVersion,
+		Project:    p.Name,
+		Path:       ev.Path,
+		Operation:  ev.Operation.String(),
+		OccurredAt: ev.Timestamp.UTC().Format(time.RFC3339),
+	})
+}
+
+// findProjectByPath returns the project whose path is a prefix of eventPath.
+func findProjectByPath(projects []*domain.Project, eventPath string) *domain.Project {
+	eventPath = strings.TrimSuffix(eventPath, "/")
+	for _, p := range projects {
+		projectPath := strings.TrimSuffix(p.Path, "/")
+		if eventPath == projectPath || strings.HasPrefix(eventPath, projectPath+"/") {
+			return p
+		}
+	}
+	return nil
+}
+
+// watchKey returns the sorted project paths joined by newlines, used to
+// detect when the watched set must change. Empty for no projects.
+func watchKey(projects []*domain.Project) string {
+	paths := make([]string, len(projects))
+	for i, p := range projects {
+		paths[i] = p.Path
+	}
+	sort.Strings(paths)
+	return strings.Join(paths, "\n")
+}
@@ -0,0 +1,264 @@
+package httpapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/apitypes"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/testhelpers"
+)
+
+// mockFileWatcher hands out a channel the test can push events into.
+type mockFileWatcher struct {
+	events  chan ports.FileEvent
+	watched chan []string
+}
+
+func newMockFileWatcher() *mockFileWatcher {
+	return &mockFileWatcher{
+		events:  make(chan ports.FileEvent, 10),
+		watched: make(chan []string, 10),
+	}
+}
+
+func (m *mockFileWatcher) Watch(_ context.Context, paths []string) (<-chan ports.FileEvent, error) {
+	m.watched <- paths
+	return m.events, nil
+}
+func (m *mockFileWatcher) GetFailedPaths() []string { return nil }
+func (m *mockFileWatcher) Close() error             { return nil }
+
+// mockActivityFeed hands out a channel the test can push daemon events into.
+type mockActivityFeed struct {
+	events chan ports.FileEvent
+}
+
+func (m *mockActivityFeed) Activity(_ context.Context) (<-chan ports.FileEvent, error) {
+	return m.events, nil
+}
+
+// sseReader reads "event:/data:" frames from a stream.
+type sseReader struct {
+	scanner *bufio.Scanner
+}
+
+func (r *sseReader) next(t *testing.T) (string, string) {
+	t.Helper()
+	var name, data string
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		case line == "" && name != "":
+			return name, data
+		}
+	}
+	t.Fatalf("stream ended: %v", r.scanner.Err())
+	return "", ""
+}
+
+// expect skips events until one named want arrives.
+func (r *sseReader) expect(t *testing.T, want string) string {
+	t.Helper()
+	for {
+		name, data := r.next(t)
+		if name == want {
+			return data
+		}
+	}
+}
+
+func startServer(t *testing.T, s *Server) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Serve(ctx, ln) }()
+
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Serve returned error: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Error("server did not shut down")
+		}
+	})
+	return "http://" + ln.Addr().String()
+}
+
+func openStream(t *testing.T, baseURL string) *sseReader {
+	t.Helper()
+	resp, err := http.Get(baseURL + "/v1/events")
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("expected text/event-stream, got %q", ct)
+	}
+	return &sseReader{scanner: bufio.NewScanner(resp.Body)}
+}
+
+func TestEvents_SnapshotAndUpdates(t *testing.T) {
+	repo := testhelpers.NewMockRepository().WithProjects([]*domain.Project{newTestProject("alpha", "/work/alpha")})
+	watcher := newMockFileWatcher()
+	baseURL := startServer(t, NewServer(repo, WithFileWatcher(watcher), WithPollInterval(time.Hour)))
+
+	stream := openStream(t, baseURL)
+
+	var snapshot apitypes.ListResponse
+	if err := json.Unmarshal([]byte(stream.expect(t, EventSnapshot)), &snapshot); err != nil {
+		t.Fatalf("invalid snapshot: %v", err)
+	}
+	if len(snapshot.Projects) != 1 || snapshot.Projects[0].Name != "alpha" {
+		t.Fatalf("unexpected snapshot: %+v", snapshot)
+	}
+
+	select {
+	case paths := <-watcher.watched:
+		if len(paths) != 1 || paths[0] != "/work/alpha" {
+			t.Errorf("unexpected watched paths: %v", paths)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("watcher was not started")
+	}
+
+	// File activity is published as an activity event
+	watcher.events <- ports.FileEvent{Path: "/work/alpha/src/main.go", Operation: ports.FileOpModify, Timestamp: time.Date(2026, 1, 10, 9, 30, 0, 0, time.UTC)}
+	var activity ActivityEvent
+	if err := json.Unmarshal([]byte(stream.expect(t, EventActivity)), &activity); err != nil {
+		t.Fatalf("invalid activity event: %v", err)
+	}
+	if activity.Project != "alpha" || activity.Operation != "modify" || activity.OccurredAt != "2026-01-10T09:30:00Z" {
+		t.Errorf("unexpected activity event: %+v", activity)
+	}
+
+	// Mutations through the API are published without waiting for the poll
+	resp, err := http.DefaultClient.Do(mustRequest(t, http.MethodPut, baseURL+"/v1/projects/alpha/favorite"))
+	if err != nil {
+		t.Fatalf("favorite request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	var updated apitypes.StatusResponse
+	if err := json.Unmarshal([]byte(stream.expect(t, EventProjectUpdated)), &updated); err != nil {
+		t.Fatalf("invalid update event: %v", err)
+	}
+	if updated.Project.Name != "alpha" || !updated.Project.IsFavorite {
+		t.Errorf("unexpected update event: %+v", updated.Project)
+	}
+}
+
+func TestEvents_RelaysDaemonActivity(t *testing.T) {
+	alpha := newTestProject("alpha", "/work/alpha")
+	repo := testhelpers.NewMockRepository().WithProjects([]*domain.Project{alpha})
+	watcher := newMockFileWatcher()
+	feed := &mockActivityFeed{events: make(chan ports.FileEvent, 10)}
+	baseURL := startServer(t, NewServer(repo, WithFileWatcher(watcher), WithActivityFeed(feed), WithPollInterval(time.Hour)))
+
+	stream := openStream(t, baseURL)
+	stream.expect(t, EventSnapshot)
+
+	feed.events <- ports.FileEvent{Path: "/work/alpha/README.md", Operation: ports.FileOpCreate, Timestamp: time.Date(2026, 1, 10, 9, 30, 0, 0, time.UTC)}
+	var activity ActivityEvent
+	if err := json.Unmarshal([]byte(stream.expect(t, EventActivity)), &activity); err != nil {
+		t.Fatalf("invalid activity event: %v", err)
+	}
+	if activity.Project != "alpha" || activity.Operation != "create" {
+		t.Errorf("unexpected activity event: %+v", activity)
+	}
+
+	// The daemon records activity; the server neither writes nor watches
+	got, err := repo.FindByID(context.Background(), alpha.ID)
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if want := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC); !got.LastActivityAt.Equal(want) {
+		t.Errorf("LastActivityAt changed to %v", got.LastActivityAt)
+	}
+	select {
+	case paths := <-watcher.watched:
+		t.Errorf("watcher started on %v while relaying", paths)
+	default:
+	}
+}
+
+func TestEvents_ProjectRemovedOnPoll(t *testing.T) {
+	alpha := newTestProject("alpha", "/work/alpha")
+	repo := testhelpers.NewMockRepository().WithProjects([]*domain.Project{alpha})
+	baseURL := startServer(t, NewServer(repo, WithPollInterval(20*time.Millisecond)))
+
+	stream := openStream(t, baseURL)
+	stream.expect(t, EventSnapshot)
+
+	if err := repo.Delete(context.Background(), alpha.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	var removed ProjectRemovedEvent
+	if err := json.Unmarshal([]byte(stream.expect(t, EventProjectRemoved)), &removed); err != nil {
+		t.Fatalf("invalid removed event: %v", err)
+	}
+	if removed.Name != "alpha" || removed.Path != "/work/alpha" {
+		t.Errorf("unexpected removed event: %+v", removed)
+	}
+}
+
+func TestFindProjectByPath(t *testing.T) {
+	projects := []*domain.Project{
+		newTestProject("app", "/work/app"),
+		newTestProject("app-two", "/work/app-two"),
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/work/app/main.go", "app"},
+		{"/work/app", "app"},
+		{"/work/app-two/main.go", "app-two"},
+		{"/work/other/main.go", ""},
+	}
+
+	for _, tt := range tests {
+		got := findProjectByPath(projects, tt.path)
+		name := ""
+		if got != nil {
+			name = got.Name
+		}
+		if name != tt.want {
+			t.Errorf("findProjectByPath(%q) = %q, want %q", tt.path, name, tt.want)
+		}
+	}
+}
+
+func mustRequest(t *testing.T, method, url string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	if method != http.MethodGet {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req
+}
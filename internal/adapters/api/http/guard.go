@@ -0,0 +1,85 @@
+package httpapi
+
+import (
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Error codes of requests rejected before routing.
+const (
+	codeForbidden        = "forbidden"
+	codeUnsupportedMedia = "unsupported_media_type"
+)
+
+// guard protects the unauthenticated API from web pages the user visits:
+//   - Host must name a loopback address (or the IP the server listens on),
+//     so DNS rebinding cannot expose project data to another site.
+//   - Origin, when sent, must be such a host too, so pages cannot read the
+//     event stream or trigger mutations cross-site.
+//   - Mutations must send Content-Type: application/json, which browsers
+//     only allow cross-origin after a CORS preflight the API never answers.
+func (s *Server) guard(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.allowedHost(r.Host) {
+			writeError(w, http.StatusForbidden, codeForbidden, "host not allowed: "+r.Host)
+			return
+		}
+		if origin := r.Header.Get("Origin"); origin != "" && !s.allowedOrigin(origin) {
+			writeError(w, http.StatusForbidden, codeForbidden, "origin not allowed: "+origin)
+			return
+		}
+		if isMutation(r.Method) && !isJSON(r.Header.Get("Content-Type")) {
+			writeError(w, http.StatusUnsupportedMediaType, codeUnsupportedMedia,
+				"mutating requests require Content-Type: application/json")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allowedHost reports whether a Host header (with optional port) names a
+// loopback address or the IP address the server listens on.
+func (s *Server) allowedHost(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	return ip.IsLoopback() || (s.listenIP != nil && ip.Equal(s.listenIP))
+}
+
+// allowedOrigin reports whether an Origin header is an http(s) origin on an
+// allowed host. The opaque "null" origin (sandboxed frames, file://) is not.
+func (s *Server) allowedOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	return s.allowedHost(u.Host)
+}
+
+// isMutation reports whether a method changes project state.
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// isJSON reports whether a Content-Type header is application/json.
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
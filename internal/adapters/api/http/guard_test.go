@@ -0,0 +1,142 @@
+package httpapi
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGuard_ForgedHost(t *testing.T) {
+	ts, _ := setupTestServer(t)
+
+	tests := []struct {
+		name string
+		host string
+		want int
+	}{
+		{"rebinding domain", "attacker.example.com", http.StatusForbidden},
+		{"rebinding domain with port", "attacker.example.com:7878", http.StatusForbidden},
+		{"lan address", "192.168.1.20:7878", http.StatusForbidden},
+		{"localhost", "localhost:7878", http.StatusOK},
+		{"ipv4 loopback", "127.0.0.1:7878", http.StatusOK},
+		{"ipv6 loopback", "[::1]:7878", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mustRequest(t, http.MethodGet, ts.URL+"/v1/projects")
+			req.Host = tt.host
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("Host %q: status = %d, want %d", tt.host, resp.StatusCode, tt.want)
+			}
+			if tt.want == http.StatusForbidden {
+				var body ErrorResponse
+				decodeBody(t, resp, &body)
+				if body.Error.Code != codeForbidden {
+					t.Errorf("error code = %q, want %q", body.Error.Code, codeForbidden)
+				}
+			}
+		})
+	}
+}
+
+func TestGuard_ForgedOrigin(t *testing.T) {
+	ts, repo := setupTestServer(t)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		origin string
+		want   int
+	}{
+		{"cross-site read", http.MethodGet, "/v1/projects", "https://attacker.example.com", http.StatusForbidden},
+		{"cross-site event stream", http.MethodGet, "/v1/events", "http://evil.test", http.StatusForbidden},
+		{"cross-site hibernate", http.MethodPost, "/v1/projects/alpha/hibernate", "https://attacker.example.com", http.StatusForbidden},
+		{"opaque origin", http.MethodPut, "/v1/projects/alpha/favorite", "null", http.StatusForbidden},
+		{"loopback origin", http.MethodGet, "/v1/projects", "http://localhost:3000", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mustRequest(t, tt.method, ts.URL+tt.path)
+			req.Header.Set("Origin", tt.origin)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("Origin %q: status = %d, want %d", tt.origin, resp.StatusCode, tt.want)
+			}
+		})
+	}
+
+	if p, _ := repo.FindByPath(context.Background(), "/work/alpha"); p == nil || p.IsHibernated() || p.IsFavorite {
+		t.Errorf("rejected requests must not mutate the project, got %+v", p)
+	}
+}
+
+func TestGuard_MutationRequiresJSON(t *testing.T) {
+	ts, _ := setupTestServer(t)
+
+	tests := []struct {
+		name        string
+		contentType string
+		want        int
+	}{
+		{"no content type (simple request)", "", http.StatusUnsupportedMediaType},
+		{"form post", "application/x-www-form-urlencoded", http.StatusUnsupportedMediaType},
+		{"plain text", "text/plain", http.StatusUnsupportedMediaType},
+		{"json", "application/json", http.StatusOK},
+		{"json with charset", "application/json; charset=utf-8", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mustRequest(t, http.MethodPost, ts.URL+"/v1/projects/alpha/activate")
+			req.Header.Del("Content-Type")
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("Content-Type %q: status = %d, want %d", tt.contentType, resp.StatusCode, tt.want)
+			}
+		})
+	}
+}
+
+func TestGuard_ListenIPAllowed(t *testing.T) {
+	s := NewServer(nil)
+	s.listenIP = net.ParseIP("192.168.1.20")
+
+	for host, want := range map[string]bool{
+		"192.168.1.20:7878": true,
+		"192.168.1.21:7878": false,
+		"nas.local:7878":    false,
+	} {
+		if got := s.allowedHost(host); got != want {
+			t.Errorf("allowedHost(%q) = %v, want %v", host, got, want)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/projects", nil)
+	req.Host = "192.168.1.20:7878"
+	req.Header.Set("Origin", "http://192.168.1.20:7878")
+	s.guard(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})).ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want request on listen IP to pass", rec.Code)
+	}
+}
@@ -0,0 +1,268 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/apitypes"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
+)
+
+// maxBodyBytes caps mutation request bodies (notes are short free text).
+const maxBodyBytes = 64 * 1024
+
+// Error codes returned in ErrorResponse.
+const (
+	codeNotFound           = "project_not_found"
+	codeBadRequest         = "bad_request"
+	codeFavoriteHibernate  = "favorite_cannot_hibernate"
+	codeServiceUnavailable = "unavailable"
+	codeInternal           = "internal_error"
+)
+
+// ErrorResponse is the JSON body of every non-2xx response.
+type ErrorResponse struct {
+	APIVersion string   `json:"api_version"`
+	Error      APIError `json:"error"`
+}
+
+// APIError describes a failed request.
+type APIError struct {
+	Code    string `json:"code"`    // Stable machine-readable code (e.g., "project_not_found")
+	Message string `json:"message"` // Human-readable detail
+}
+
+// NoteRequest is the body of PUT /v1/projects/{name}/note.
+type NoteRequest struct {
+	Note string `json:"note"`
+}
+
+// handleListProjects serves GET /v1/projects (same shape as `vdash list --json`).
+func (s *Server) handleListProjects(w http.ResponseWriter, r *http.Request) {
+	response, err := s.listResponse(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, codeInternal, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleGetProject serves GET /v1/projects/{name} (same shape as `vdash status --json`).
+func (s *Server) handleGetProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.lookupProject(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.statusResponse(r.Context(), p))
+}
+
+// handleSetFavorite returns a handler that sets (PUT) or clears (DELETE) favorite status.
+func (s *Server) handleSetFavorite(favorite bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mutateProject(w, r, func(p *domain.Project) {
+			p.IsFavorite = favorite
+		})
+	}
+}
+
+// handleSetNote serves PUT /v1/projects/{name}/note with a NoteRequest body.
+func (s *Server) handleSetNote(w http.ResponseWriter, r *http.Request) {
+	var req NoteRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxBodyBytes)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("invalid JSON body: %v", err))
+		return
+	}
+	s.mutateProject(w, r, func(p *domain.Project) {
+		p.Notes = req.Note
+	})
+}
+
+// handleClearNote serves DELETE /v1/projects/{name}/note.
+func (s *Server) handleClearNote(w http.ResponseWriter, r *http.Request) {
+	s.mutateProject(w, r, func(p *domain.Project) {
+		p.Notes = ""
+	})
+}
+
+// handleHibernate serves POST /v1/projects/{name}/hibernate.
+// Already-hibernated projects succeed unchanged (idempotent, same as the CLI).
+func (s *Server) handleHibernate(w http.ResponseWriter, r *http.Request) {
+	s.transitionProject(w, r, func(ctx context.Context, id string) error {
+		return s.stateService.Hibernate(ctx, id)
+	})
+}
+
+// handleActivate serves POST /v1/projects/{name}/activate.
+// Already-active projects succeed unchanged (idempotent, same as the CLI).
+func (s *Server) handleActivate(w http.ResponseWriter, r *http.Request) {
+	s.transitionProject(w, r, func(ctx context.Context, id string) error {
+		return s.stateService.Activate(ctx, id)
+	})
+}
+
+// mutateProject applies change to the project named in the URL, saves it and
+// responds with the updated project.
+func (s *Server) mutateProject(w http.ResponseWriter, r *http.Request, change func(*domain.Project)) {
+	p, ok := s.lookupProject(w, r)
+	if !ok {
+		return
+	}
+
+	// Copy: the repository may hand out shared instances the monitor is reading
+	updated := *p
+	change(&updated)
+	updated.UpdatedAt = time.Now()
+	if err := s.repository.Save(r.Context(), &updated); err != nil {
+		writeError(w, http.StatusInternalServerError, codeInternal, fmt.Sprintf("failed to save project: %v", err))
+		return
+	}
+
+	s.requestRefresh()
+	s.respondWithProject(w, r, p.ID)
+}
+
+// transitionProject runs a state transition for the project named in the URL.
+func (s *Server) transitionProject(w http.ResponseWriter, r *http.Request, transition func(context.Context, string) error) {
+	if s.stateService == nil {
+		writeError(w, http.StatusServiceUnavailable, codeServiceUnavailable, "state service not initialized")
+		return
+	}
+
+	p, ok := s.lookupProject(w, r)
+	if !ok {
+		return
+	}
+
+	if err := transition(r.Context(), p.ID); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidStateTransition):
+			// Already in target state - idempotent success
+		case errors.Is(err, domain.ErrFavoriteCannotHibernate):
+			writeError(w, http.StatusConflict, codeFavoriteHibernate, "cannot hibernate favorite project; remove favorite status first")
+			return
+		default:
+			writeError(w, http.StatusInternalServerError, codeInternal, err.Error())
+			return
+		}
+	}
+
+	s.requestRefresh()
+	s.respondWithProject(w, r, p.ID)
+}
+
+// respondWithProject re-reads a project after a mutation and writes it.
+func (s *Server) respondWithProject(w http.ResponseWriter, r *http.Request, id string) {
+	updated, err := s.repository.FindByID(r.Context(), id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, codeInternal, fmt.Sprintf("failed to reload project: %v", err))
+		return
+	}
+	writeJSON(w, http.StatusOK, s.statusResponse(r.Context(), updated))
+}
+
+// lookupProject resolves the {name} path value, writing a 404 if not found.
+func (s *Server) lookupProject(w http.ResponseWriter, r *http.Request) (*domain.Project, bool) {
+	identifier := r.PathValue("name")
+	p, err := s.findProject(r.Context(), identifier)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			writeError(w, http.StatusNotFound, codeNotFound, fmt.Sprintf("project not found: %s", identifier))
+		} else {
+			writeError(w, http.StatusInternalServerError, codeInternal, err.Error())
+		}
+		return nil, false
+	}
+	return p, true
+}
+
+// findProject finds a project by name, display name, or path (URL-escaped).
+// Lookup order matches the CLI: Name → DisplayName → Path.
+func (s *Server) findProject(ctx context.Context, identifier string) (*domain.Project, error) {
+	projects, err := s.repository.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find project: %w", err)
+	}
+
+	for _, p := range projects {
+		if p.Name == identifier {
+			return p, nil
+		}
+	}
+	for _, p := range projects {
+		if p.DisplayName == identifier {
+			return p, nil
+		}
+	}
+	if filepath.IsAbs(identifier) {
+		cleaned := filepath.Clean(identifier)
+		for _, p := range projects {
+			if p.Path == cleaned {
+				return p, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", domain.ErrProjectNotFound, identifier)
+}
+
+// listResponse builds the sorted project list (favorites first, then by name).
+func (s *Server) listResponse(ctx context.Context) (apitypes.ListResponse, error) {
+	projects, err := s.repository.FindAll(ctx)
+	if err != nil {
+		return apitypes.ListResponse{}, fmt.Errorf("failed to list projects: %w", err)
+	}
+	project.SortByName(projects)
+
+	response := apitypes.ListResponse{
+		APIVersion:    apitypes.APIVersion,
+		Projects:      make([]apitypes.ProjectSummary, 0, len(projects)),
+		ConfigWarning: s.configWarningPtr(),
+	}
+	for _, p := range projects {
+		response.Projects = append(response.Projects, apitypes.NewProjectSummary(ctx, p, s.waitingDetector, s.gitInspector))
+	}
+	return response, nil
+}
+
+// statusResponse builds the single-project response.
+func (s *Server) statusResponse(ctx context.Context, p *domain.Project) apitypes.StatusResponse {
+	return apitypes.StatusResponse{
+		APIVersion:    apitypes.APIVersion,
+		Project:       apitypes.NewProjectSummary(ctx, p, s.waitingDetector, s.gitInspector),
+		ConfigWarning: s.configWarningPtr(),
+	}
+}
+
+// configWarningPtr returns the config warning, or nil if none (Story 7.2).
+func (s *Server) configWarningPtr() *string {
+	if s.configWarning == "" {
+		return nil
+	}
+	warning := s.configWarning
+	return &warning
+}
+
+// writeJSON writes v as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Debug("failed to write api response", "error", err)
+	}
+}
+
+// writeError writes an ErrorResponse.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, ErrorResponse{
+		APIVersion: apitypes.APIVersion,
+		Error:      APIError{Code: code, Message: message},
+	})
+}
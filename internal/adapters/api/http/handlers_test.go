@@ -0,0 +1,300 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/apitypes"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/testhelpers"
+)
+
+// mockStateService records transitions and applies them to a MockRepository.
+type mockStateService struct {
+	repo *testhelpers.MockRepository
+	err  error
+}
+
+func (m *mockStateService) Hibernate(ctx context.Context, id string) error {
+	if m.err != nil {
+		return m.err
+	}
+	return m.repo.UpdateState(ctx, id, domain.StateHibernated)
+}
+
+func (m *mockStateService) Activate(ctx context.Context, id string) error {
+	if m.err != nil {
+		return m.err
+	}
+	return m.repo.UpdateState(ctx, id, domain.StateActive)
+}
+
+// mockWaitingDetector reports a fixed waiting state for every project.
+type mockWaitingDetector struct {
+	waiting  bool
+	duration time.Duration
+}
+
+func (m *mockWaitingDetector) IsWaiting(context.Context, *domain.Project) bool { return m.waiting }
+func (m *mockWaitingDetector) WaitingDuration(context.Context, *domain.Project) time.Duration {
+	return m.duration
+}
+func (m *mockWaitingDetector) AgentState(context.Context, *domain.Project) domain.AgentState {
+	return domain.AgentState{}
+}
+
+func newTestProject(name, path string) *domain.Project {
+	return &domain.Project{
+		ID:             domain.GenerateID(path),
+		Name:           name,
+		Path:           path,
+		CurrentStage:   domain.StagePlan,
+		DetectedMethod: "speckit",
+		State:          domain.StateActive,
+		LastActivityAt: time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func setupTestServer(t *testing.T, opts ...ServerOption) (*httptest.Server, *testhelpers.MockRepository) {
+	t.Helper()
+	alpha := newTestProject("alpha", "/work/alpha")
+	beta := newTestProject("beta", "/work/beta")
+	beta.DisplayName = "Beta App"
+	beta.IsFavorite = true
+	repo := testhelpers.NewMockRepository().WithProjects([]*domain.Project{alpha, beta})
+
+	opts = append([]ServerOption{WithStateService(&mockStateService{repo: repo})}, opts...)
+	ts := httptest.NewServer(NewServer(repo, opts...).Handler())
+	t.Cleanup(ts.Close)
+	return ts, repo
+}
+
+func doRequest(t *testing.T, method, url, body string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	if method != http.MethodGet {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, url, err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func decodeBody(t *testing.T, resp *http.Response, v interface{}) {
+	t.Helper()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+}
+
+func TestListProjects(t *testing.T) {
+	ts, _ := setupTestServer(t, WithWaitingDetector(&mockWaitingDetector{waiting: true, duration: 12 * time.Minute}))
+
+	resp := doRequest(t, http.MethodGet, ts.URL+"/v1/projects", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("expected application/json, got %q", ct)
+	}
+
+	var body apitypes.ListResponse
+	decodeBody(t, resp, &body)
+
+	if body.APIVersion != "v1" {
+		t.Errorf("expected api_version v1, got %q", body.APIVersion)
+	}
+	if len(body.Projects) != 2 {
+		t.Fatalf("expected 2 projects, got %d", len(body.Projects))
+	}
+	// Favorites first (same order as `vdash list`)
+	if body.Projects[0].Name != "beta" || body.Projects[1].Name != "alpha" {
+		t.Errorf("unexpected order: %s, %s", body.Projects[0].Name, body.Projects[1].Name)
+	}
+	p := body.Projects[1]
+	if p.Stage != "plan" || p.Method != "speckit" || p.State != "active" {
+		t.Errorf("unexpected summary: %+v", p)
+	}
+	if !p.IsWaiting || p.WaitingDurationMinutes == nil || *p.WaitingDurationMinutes != 12 {
+		t.Errorf("expected waiting 12 minutes, got %v %v", p.IsWaiting, p.WaitingDurationMinutes)
+	}
+	if p.LastActivityAt != "2026-01-10T09:00:00Z" {
+		t.Errorf("unexpected last_activity_at %q", p.LastActivityAt)
+	}
+}
+
+func TestListProjects_ConfigWarning(t *testing.T) {
+	ts, _ := setupTestServer(t, WithConfigWarning("bad config"))
+
+	var body apitypes.ListResponse
+	decodeBody(t, doRequest(t, http.MethodGet, ts.URL+"/v1/projects", ""), &body)
+
+	if body.ConfigWarning == nil || *body.ConfigWarning != "bad config" {
+		t.Errorf("expected config warning, got %v", body.ConfigWarning)
+	}
+}
+
+func TestGetProject_Lookup(t *testing.T) {
+	ts, _ := setupTestServer(t)
+
+	tests := []struct {
+		name       string
+		identifier string
+		wantName   string
+		wantStatus int
+	}{
+		{"by name", "alpha", "alpha", http.StatusOK},
+		{"by display name", url.PathEscape("Beta App"), "beta", http.StatusOK},
+		{"by escaped path", url.PathEscape("/work/alpha"), "alpha", http.StatusOK},
+		{"not found", "missing", "", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := doRequest(t, http.MethodGet, ts.URL+"/v1/projects/"+tt.identifier, "")
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("expected %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if tt.wantStatus != http.StatusOK {
+				var body ErrorResponse
+				decodeBody(t, resp, &body)
+				if body.Error.Code != codeNotFound {
+					t.Errorf("expected %s, got %q", codeNotFound, body.Error.Code)
+				}
+				return
+			}
+			var body apitypes.StatusResponse
+			decodeBody(t, resp, &body)
+			if body.Project.Name != tt.wantName {
+				t.Errorf("expected %s, got %s", tt.wantName, body.Project.Name)
+			}
+		})
+	}
+}
+
+func TestFavoriteEndpoints(t *testing.T) {
+	ts, repo := setupTestServer(t)
+	id := domain.GenerateID("/work/alpha")
+
+	var body apitypes.StatusResponse
+	resp := doRequest(t, http.MethodPut, ts.URL+"/v1/projects/alpha/favorite", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	decodeBody(t, resp, &body)
+	if !body.Project.IsFavorite {
+		t.Error("expected response to show favorite")
+	}
+	if p, _ := repo.FindByID(context.Background(), id); !p.IsFavorite {
+		t.Error("expected favorite to be saved")
+	}
+
+	doRequest(t, http.MethodDelete, ts.URL+"/v1/projects/alpha/favorite", "")
+	if p, _ := repo.FindByID(context.Background(), id); p.IsFavorite {
+		t.Error("expected favorite to be cleared")
+	}
+}
+
+func TestNoteEndpoints(t *testing.T) {
+	ts, repo := setupTestServer(t)
+	id := domain.GenerateID("/work/alpha")
+
+	resp := doRequest(t, http.MethodPut, ts.URL+"/v1/projects/alpha/note", `{"note":"waiting on API specs"}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var body apitypes.StatusResponse
+	decodeBody(t, resp, &body)
+	if body.Project.Notes == nil || *body.Project.Notes != "waiting on API specs" {
+		t.Errorf("unexpected notes in response: %v", body.Project.Notes)
+	}
+
+	doRequest(t, http.MethodDelete, ts.URL+"/v1/projects/alpha/note", "")
+	if p, _ := repo.FindByID(context.Background(), id); p.Notes != "" {
+		t.Errorf("expected note cleared, got %q", p.Notes)
+	}
+
+	resp = doRequest(t, http.MethodPut, ts.URL+"/v1/projects/alpha/note", `not json`)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected 400 for invalid body, got %d", resp.StatusCode)
+	}
+}
+
+func TestStateEndpoints(t *testing.T) {
+	ts, repo := setupTestServer(t)
+	id := domain.GenerateID("/work/alpha")
+
+	resp := doRequest(t, http.MethodPost, ts.URL+"/v1/projects/alpha/hibernate", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var body apitypes.StatusResponse
+	decodeBody(t, resp, &body)
+	if body.Project.State != "hibernated" {
+		t.Errorf("expected hibernated, got %s", body.Project.State)
+	}
+
+	resp = doRequest(t, http.MethodPost, ts.URL+"/v1/projects/alpha/activate", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if p, _ := repo.FindByID(context.Background(), id); p.State != domain.StateActive {
+		t.Errorf("expected active, got %v", p.State)
+	}
+}
+
+func TestStateEndpoints_Errors(t *testing.T) {
+	repo := testhelpers.NewMockRepository().WithProjects([]*domain.Project{newTestProject("alpha", "/work/alpha")})
+
+	tests := []struct {
+		name       string
+		svcErr     error
+		wantStatus int
+	}{
+		{"already in state is idempotent", domain.ErrInvalidStateTransition, http.StatusOK},
+		{"favorite cannot hibernate", domain.ErrFavoriteCannotHibernate, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(NewServer(repo, WithStateService(&mockStateService{repo: repo, err: tt.svcErr})).Handler())
+			defer ts.Close()
+
+			resp := doRequest(t, http.MethodPost, ts.URL+"/v1/projects/alpha/hibernate", "")
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+
+	t.Run("no state service", func(t *testing.T) {
+		ts := httptest.NewServer(NewServer(repo).Handler())
+		defer ts.Close()
+
+		resp := doRequest(t, http.MethodPost, ts.URL+"/v1/projects/alpha/activate", "")
+		if resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("expected 503, got %d", resp.StatusCode)
+		}
+	})
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	ts, _ := setupTestServer(t)
+
+	resp := doRequest(t, http.MethodPost, ts.URL+"/v1/projects", "")
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405, got %d", resp.StatusCode)
+	}
+}
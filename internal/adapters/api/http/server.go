@@ -0,0 +1,215 @@
+// Package httpapi exposes vibe-dash project state over a local HTTP/JSON API.
+//
+// REST endpoints reuse the versioned JSON contract of `vdash list --json` and
+// `vdash status --json` (see shared/apitypes). A Server-Sent Events stream
+// at /v1/events pushes project changes fed by the file watcher (or a running
+// daemon's activity) and agent detection, so status bars and editor plugins
+// can follow live state.
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+const (
+	// DefaultListenAddr binds to loopback only; the API has no authentication
+	// (see guard for the checks that keep web pages out).
+	DefaultListenAddr = "127.0.0.1:7878"
+
+	// DefaultPollInterval is how often project state is re-evaluated for
+	// changes that produce no file events (agent waiting, CLI mutations).
+	DefaultPollInterval = 5 * time.Second
+
+	// shutdownTimeout bounds graceful shutdown of open connections.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server serves the HTTP API. Create with NewServer and start with Serve
+// or ListenAndServe. Dependencies other than the repository are optional.
+type Server struct {
+	repository      ports.ProjectRepository
+	waitingDetector ports.WaitingDetector
+	gitInspector    ports.GitInspector
+	stateService    ports.StateActivator
+	fileWatcher     ports.FileWatcher
+	activityFeed    ports.ActivityFeed
+	notifications   ports.NotificationService
+	pollInterval    time.Duration
+	configWarning   string
+	listenIP        net.IP // Non-loopback IP the server listens on, accepted as Host
+
+	hub     *hub
+	refresh chan struct{} // Signals the monitor to re-evaluate state now
+}
+
+// ServerOption is a functional option for configuring Server.
+type ServerOption func(*Server)
+
+// WithWaitingDetector enables is_waiting in responses and waiting change events.
+func WithWaitingDetector(d ports.WaitingDetector) ServerOption {
+	return func(s *Server) {
+		s.waitingDetector = d
+	}
+}
+
+// WithGitInspector includes repository status ("git") in project responses.
+func WithGitInspector(g ports.GitInspector) ServerOption {
+	return func(s *Server) {
+		s.gitInspector = g
+	}
+}
+
+// WithStateService enables the hibernate/activate endpoints and
+// auto-activation of hibernated projects on file activity.
+func WithStateService(svc ports.StateActivator) ServerOption {
+	return func(s *Server) {
+		s.stateService = svc
+	}
+}
+
+// WithFileWatcher enables file activity events on the stream.
+func WithFileWatcher(w ports.FileWatcher) ServerOption {
+	return func(s *Server) {
+		s.fileWatcher = w
+	}
+}
+
+// WithActivityFeed relays a running daemon's file activity on the stream
+// instead of watching files. The daemon records the activity and
+// auto-activates projects, so the server does not write; it takes
+// precedence over WithFileWatcher.
+func WithActivityFeed(feed ports.ActivityFeed) ServerOption {
+	return func(s *Server) {
+		s.activityFeed = feed
+	}
+}
+
+// WithNotificationService sends waiting notifications on each poll.
+func WithNotificationService(svc ports.NotificationService) ServerOption {
+	return func(s *Server) {
+		s.notifications = svc
+	}
+}
+
+// WithPollInterval overrides DefaultPollInterval. Non-positive values are ignored.
+func WithPollInterval(d time.Duration) ServerOption {
+	return func(s *Server) {
+		if d > 0 {
+			s.pollInterval = d
+		}
+	}
+}
+
+// WithConfigWarning includes a config warning in list/status responses (Story 7.2).
+func WithConfigWarning(warning string) ServerOption {
+	return func(s *Server) {
+		s.configWarning = warning
+	}
+}
+
+// NewServer creates a new API server backed by the given repository.
+func NewServer(repo ports.ProjectRepository, opts ...ServerOption) *Server {
+	s := &Server{
+		repository:   repo,
+		pollInterval: DefaultPollInterval,
+		hub:          newHub(),
+		refresh:      make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Handler returns the HTTP handler with all API routes registered, behind
+// the Host/Origin/Content-Type guard.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/projects", s.handleListProjects)
+	mux.HandleFunc("GET /v1/projects/{name}", s.handleGetProject)
+	mux.HandleFunc("PUT /v1/projects/{name}/favorite", s.handleSetFavorite(true))
+	mux.HandleFunc("DELETE /v1/projects/{name}/favorite", s.handleSetFavorite(false))
+	mux.HandleFunc("PUT /v1/projects/{name}/note", s.handleSetNote)
+	mux.HandleFunc("DELETE /v1/projects/{name}/note", s.handleClearNote)
+	mux.HandleFunc("POST /v1/projects/{name}/hibernate", s.handleHibernate)
+	mux.HandleFunc("POST /v1/projects/{name}/activate", s.handleActivate)
+	mux.HandleFunc("GET /v1/events", s.handleEvents)
+	return s.guard(mux)
+}
+
+// ListenAndServe listens on addr and serves until ctx is cancelled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	return s.Serve(ctx, ln)
+}
+
+// Serve accepts connections on ln until ctx is cancelled, then shuts down
+// gracefully. The live monitor runs for the lifetime of the server.
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Accept the listen IP as Host when bound to a specific interface
+	if addr, ok := ln.Addr().(*net.TCPAddr); ok && !addr.IP.IsUnspecified() && !addr.IP.IsLoopback() {
+		s.listenIP = addr.IP
+	}
+
+	httpServer := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	monitorDone := make(chan struct{})
+	go func() {
+		defer close(monitorDone)
+		s.monitor(ctx)
+	}()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.Serve(ln)
+	}()
+
+	slog.Info("api server listening", "addr", ln.Addr().String())
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+	case serveErr = <-errCh:
+	}
+
+	// Cancel first so open SSE streams (which watch ctx) return before Shutdown waits on them
+	cancel()
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer shutdownCancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("api server shutdown incomplete", "error", err)
+	}
+	<-monitorDone
+
+	if serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+		return fmt.Errorf("api server failed: %w", serveErr)
+	}
+	return nil
+}
+
+// requestRefresh asks the monitor to re-evaluate state without waiting for
+// the next poll. Never blocks; concurrent requests coalesce.
+func (s *Server) requestRefresh() {
+	select {
+	case s.refresh <- struct{}{}:
+	default:
+	}
+}
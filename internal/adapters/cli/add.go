@@ -198,9 +198,7 @@ func runAdd(cmd *cobra.Command, args []string) error {
 
 		result, err := detectionService.Detect(ctx, canonicalPath)
 		if err == nil && result != nil {
-			project.DetectedMethod = result.Method
-			project.CurrentStage = result.Stage
-			project.DetectionReasoning = result.Reasoning
+			project.ApplyDetection(result, nil)
 		}
 		// Detection failure is non-fatal - project defaults to unknown
 	}
@@ -0,0 +1,223 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/daemon"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/timeformat"
+)
+
+// Package-level flags (same pattern as status.go)
+var daemonStatusJSON bool
+
+// ResetDaemonFlags resets daemon command flags for testing.
+// Call this before each test to ensure clean state.
+func ResetDaemonFlags() {
+	daemonStatusJSON = false
+}
+
+// DaemonStatusResponse represents the JSON output of `daemon status --json`.
+type DaemonStatusResponse struct {
+	APIVersion             string  `json:"api_version"`
+	Running                bool    `json:"running"`
+	PID                    *int    `json:"pid"`
+	StartedAt              *string `json:"started_at"` // RFC3339, UTC; null when not running
+	Projects               int     `json:"projects"`
+	WatchedPaths           int     `json:"watched_paths"`
+	LastRefreshAt          *string `json:"last_refresh_at"`
+	LastHibernationCheckAt *string `json:"last_hibernation_check_at"`
+	LastActivityAt         *string `json:"last_activity_at"`
+}
+
+// newDaemonCmd creates the daemon command and its subcommands.
+func newDaemonCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Keep detection running in the background without the dashboard",
+		Long: `Run file watching, periodic stage re-detection and auto-hibernation
+without the TUI, so 'list' and 'status' stay current while the dashboard is closed.
+
+Only one daemon runs at a time (guarded by ~/.vibe-dash/daemon.pid). While it
+runs, the dashboard and 'refresh' attach to it over ~/.vibe-dash/daemon.sock
+instead of scanning on their own.
+
+The daemon runs in the foreground; use your service manager (launchd,
+systemd --user) or 'nohup vdash daemon &' to keep it running.
+
+Examples:
+  vdash daemon                 # Run until Ctrl+C or 'vdash daemon stop'
+  vdash daemon status          # Show whether a daemon is running
+  vdash daemon stop            # Ask the running daemon to exit`,
+		Args: cobra.NoArgs,
+		RunE: runDaemon,
+	}
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show whether a daemon is running",
+		Args:  cobra.NoArgs,
+		RunE:  runDaemonStatus,
+	}
+	statusCmd.Flags().BoolVar(&daemonStatusJSON, "json", false, "Output as JSON")
+
+	stopCmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Ask the running daemon to exit",
+		Args:  cobra.NoArgs,
+		RunE:  runDaemonStop,
+	}
+
+	cmd.AddCommand(statusCmd, stopCmd)
+	return cmd
+}
+
+// RegisterDaemonCommand registers the daemon command with the given parent command.
+// Used for testing to create fresh command trees.
+func RegisterDaemonCommand(parent *cobra.Command) {
+	parent.AddCommand(newDaemonCmd())
+}
+
+func init() {
+	RootCmd.AddCommand(newDaemonCmd())
+}
+
+// attachedDaemon returns a controller for a running daemon, or nil if none
+// answers. Commands that would otherwise scan projects delegate to it.
+func attachedDaemon(ctx context.Context) ports.DaemonController {
+	if daemonSocketPath == "" {
+		return nil
+	}
+	client := daemon.NewClient(daemonSocketPath)
+	if !client.IsRunning(ctx) {
+		return nil
+	}
+	return client
+}
+
+// runDaemon runs the daemon in the foreground until the command context is
+// cancelled (SIGINT/SIGTERM via main.go) or a client sends "stop".
+func runDaemon(cmd *cobra.Command, _ []string) error {
+	if daemonService == nil || daemonSocketPath == "" || daemonPIDPath == "" {
+		return fmt.Errorf("daemon not initialized")
+	}
+
+	lock, err := daemon.AcquireLock(daemonPIDPath)
+	if err != nil {
+		if errors.Is(err, daemon.ErrAlreadyRunning) {
+			fmt.Fprintf(cmd.OutOrStdout(), "✗ %v\n", err)
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+		}
+		return err
+	}
+	defer lock.Release()
+
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	server := daemon.NewServer(daemonService, cancel)
+	serveErr := make(chan error, 1)
+	go func() {
+		err := server.Serve(ctx, daemonSocketPath)
+		if err != nil {
+			cancel() // No control socket means clients cannot attach; stop
+		}
+		serveErr <- err
+	}()
+
+	if !IsQuiet() {
+		fmt.Fprintf(cmd.OutOrStdout(), "vibe-dash daemon running (socket %s, Ctrl+C to stop)\n", daemonSocketPath)
+	}
+
+	runErr := daemonService.Run(ctx)
+	cancel()
+	if err := <-serveErr; err != nil && runErr == nil {
+		runErr = err
+	}
+	return runErr
+}
+
+// runDaemonStatus reports whether a daemon answers on the socket.
+func runDaemonStatus(cmd *cobra.Command, _ []string) error {
+	var status ports.DaemonStatus
+	running := false
+	if daemonSocketPath != "" {
+		s, err := daemon.NewClient(daemonSocketPath).Status(cmd.Context())
+		if err == nil {
+			status, running = s, true
+		}
+	}
+
+	if daemonStatusJSON {
+		return formatDaemonStatusJSON(cmd, running, status)
+	}
+
+	out := cmd.OutOrStdout()
+	if !running {
+		fmt.Fprintln(out, "Daemon not running")
+		return nil
+	}
+	fmt.Fprintf(out, "Daemon running (pid %d)\n", status.PID)
+	fmt.Fprintf(out, "  Started:       %s\n", timeformat.FormatRelativeTime(status.StartedAt))
+	fmt.Fprintf(out, "  Projects:      %d (%d watched)\n", status.Projects, status.WatchedPaths)
+	fmt.Fprintf(out, "  Last refresh:  %s\n", timeformat.FormatRelativeTime(status.LastRefreshAt))
+	fmt.Fprintf(out, "  Hibernation:   %s\n", timeformat.FormatRelativeTime(status.LastHibernationCheckAt))
+	fmt.Fprintf(out, "  Last activity: %s\n", timeformat.FormatRelativeTime(status.LastActivityAt))
+	return nil
+}
+
+// runDaemonStop asks the running daemon to exit.
+func runDaemonStop(cmd *cobra.Command, _ []string) error {
+	if daemonSocketPath == "" {
+		return fmt.Errorf("daemon not initialized")
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := daemon.NewClient(daemonSocketPath).Stop(ctx); err != nil {
+		if errors.Is(err, daemon.ErrNotRunning) {
+			fmt.Fprintln(cmd.OutOrStdout(), "Daemon not running")
+			return nil
+		}
+		return err
+	}
+	if !IsQuiet() {
+		fmt.Fprintln(cmd.OutOrStdout(), "✓ Daemon stopping")
+	}
+	return nil
+}
+
+// formatDaemonStatusJSON writes the daemon status as JSON.
+func formatDaemonStatusJSON(cmd *cobra.Command, running bool, s ports.DaemonStatus) error {
+	response := DaemonStatusResponse{APIVersion: "v1", Running: running}
+	if running {
+		pid := s.PID
+		response.PID = &pid
+		response.StartedAt = optionalTime(s.StartedAt)
+		response.Projects = s.Projects
+		response.WatchedPaths = s.WatchedPaths
+		response.LastRefreshAt = optionalTime(s.LastRefreshAt)
+		response.LastHibernationCheckAt = optionalTime(s.LastHibernationCheckAt)
+		response.LastActivityAt = optionalTime(s.LastActivityAt)
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(response)
+}
+
+// optionalTime returns nil for zero times so JSON renders null.
+func optionalTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	return optionalString(t.UTC().Format(time.RFC3339))
+}
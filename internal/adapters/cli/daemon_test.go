@@ -0,0 +1,202 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/daemon"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// fakeDaemon is a ports.Daemon whose Run blocks until cancelled.
+type fakeDaemon struct {
+	mu        sync.Mutex
+	refreshes int
+}
+
+func (f *fakeDaemon) Status(context.Context) (ports.DaemonStatus, error) {
+	return ports.DaemonStatus{
+		PID:          1234,
+		StartedAt:    time.Now().Add(-time.Hour),
+		Projects:     4,
+		WatchedPaths: 3,
+	}, nil
+}
+
+func (f *fakeDaemon) Refresh(context.Context) (ports.RefreshResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.refreshes++
+	return ports.RefreshResult{Refreshed: 4, Failed: 1}, nil
+}
+
+func (f *fakeDaemon) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (f *fakeDaemon) refreshCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.refreshes
+}
+
+// setupDaemonPaths points the daemon at a temp dir and restores globals after the test.
+func setupDaemonPaths(t *testing.T, d ports.Daemon) string {
+	t.Helper()
+	base := t.TempDir()
+	cli.SetDaemonService(d)
+	cli.SetDaemonPaths(daemon.SocketPath(base), daemon.PIDFilePath(base))
+	cli.ResetDaemonFlags()
+	t.Cleanup(func() {
+		cli.SetDaemonService(nil)
+		cli.SetDaemonPaths("", "")
+		cli.ResetDaemonFlags()
+	})
+	return base
+}
+
+// executeDaemonCommand runs a daemon subcommand and returns output/error.
+func executeDaemonCommand(ctx context.Context, args ...string) (string, error) {
+	cli.ResetDaemonFlags()
+	cmd := cli.NewRootCmd()
+	cli.RegisterDaemonCommand(cmd)
+	cli.RegisterRefreshCommand(cmd)
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	cmd.SetArgs(args)
+
+	err := cmd.ExecuteContext(ctx)
+	return buf.String(), err
+}
+
+func TestDaemonCmd_NotInitialized(t *testing.T) {
+	cli.SetDaemonService(nil)
+	cli.SetDaemonPaths("", "")
+
+	_, err := executeDaemonCommand(context.Background(), "daemon")
+	if err == nil || !strings.Contains(err.Error(), "not initialized") {
+		t.Errorf("expected 'not initialized' error, got %v", err)
+	}
+}
+
+func TestDaemonStatus_NotRunning(t *testing.T) {
+	setupDaemonPaths(t, &fakeDaemon{})
+
+	output, err := executeDaemonCommand(context.Background(), "daemon", "status")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Daemon not running") {
+		t.Errorf("expected 'Daemon not running', got: %s", output)
+	}
+
+	output, err = executeDaemonCommand(context.Background(), "daemon", "status", "--json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var resp cli.DaemonStatusResponse
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if resp.Running || resp.PID != nil || resp.APIVersion != "v1" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestDaemonStop_NotRunning(t *testing.T) {
+	setupDaemonPaths(t, &fakeDaemon{})
+
+	output, err := executeDaemonCommand(context.Background(), "daemon", "stop")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Daemon not running") {
+		t.Errorf("expected 'Daemon not running', got: %s", output)
+	}
+}
+
+func TestDaemonCmd_RunAttachAndStop(t *testing.T) {
+	fake := &fakeDaemon{}
+	base := setupDaemonPaths(t, fake)
+
+	runDone := make(chan error, 1)
+	go func() {
+		_, err := executeDaemonCommand(context.Background(), "daemon")
+		runDone <- err
+	}()
+
+	client := daemon.NewClient(daemon.SocketPath(base))
+	deadline := time.Now().Add(2 * time.Second)
+	for !client.IsRunning(context.Background()) {
+		if time.Now().After(deadline) {
+			t.Fatal("daemon did not start")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// A second daemon must refuse to start
+	output, err := executeDaemonCommand(context.Background(), "daemon")
+	if err == nil || !strings.Contains(output, "already running") {
+		t.Errorf("expected 'already running' error, got err=%v output=%s", err, output)
+	}
+
+	output, err = executeDaemonCommand(context.Background(), "daemon", "status", "--json")
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	var resp cli.DaemonStatusResponse
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if !resp.Running || resp.PID == nil || *resp.PID != 1234 || resp.Projects != 4 || resp.WatchedPaths != 3 {
+		t.Errorf("unexpected status: %+v", resp)
+	}
+	if resp.StartedAt == nil || resp.LastRefreshAt != nil {
+		t.Errorf("unexpected timestamps: started=%v last_refresh=%v", resp.StartedAt, resp.LastRefreshAt)
+	}
+
+	// refresh delegates to the daemon instead of scanning itself
+	mock := NewMockRepository()
+	_ = mock.Save(context.Background(), &domain.Project{ID: "1", Path: "/test1", Name: "test1"})
+	cli.SetRepository(mock)
+	detector := NewMockDetector()
+	cli.SetDetectionService(detector)
+
+	output, err = executeDaemonCommand(context.Background(), "refresh")
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if !strings.Contains(output, "Refreshed 4 projects") || !strings.Contains(output, "1 failed") {
+		t.Errorf("expected daemon refresh result, got: %s", output)
+	}
+	if fake.refreshCount() != 1 {
+		t.Errorf("daemon refreshes = %d, want 1", fake.refreshCount())
+	}
+
+	output, err = executeDaemonCommand(context.Background(), "daemon", "stop")
+	if err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+	if !strings.Contains(output, "Daemon stopping") {
+		t.Errorf("expected 'Daemon stopping', got: %s", output)
+	}
+
+	select {
+	case err := <-runDone:
+		if err != nil {
+			t.Errorf("daemon exited with error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("daemon did not exit after stop")
+	}
+}
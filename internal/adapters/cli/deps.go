@@ -39,9 +39,19 @@ var hibernationService ports.HibernationService
 // stateService handles state activation for auto-activation on file events (Story 11.3).
 var stateService ports.StateActivator
 
-// logReaderRegistry handles log reading for Claude Code logs (Story 12.1).
+// logReaderRegistry handles log reading for agent session logs (Story 12.1).
 var logReaderRegistry ports.LogReaderRegistry
 
+// eventRepository stores the per-project activity history.
+var eventRepository ports.ProjectEventRepository
+
+// projectRefresher re-detects and saves projects for the TUI refresh, recording
+// agent state history the same way the daemon does.
+var projectRefresher ports.ProjectRefresher
+
+// notificationService sends alerts when an agent starts waiting (nil = disabled).
+var notificationService ports.NotificationService
+
 // SetDirectoryManager sets the directory manager for CLI commands.
 func SetDirectoryManager(dm ports.DirectoryManager) {
 	directoryManager = dm
@@ -101,3 +111,79 @@ func SetStateService(svc ports.StateActivator) {
 func SetLogReaderRegistry(registry ports.LogReaderRegistry) {
 	logReaderRegistry = registry
 }
+
+// SetEventRepository sets the project history store for the history command and TUI.
+func SetEventRepository(events ports.ProjectEventRepository) {
+	eventRepository = events
+}
+
+// SetProjectRefresher sets the service the TUI uses to re-detect projects.
+func SetProjectRefresher(refresher ports.ProjectRefresher) {
+	projectRefresher = refresher
+}
+
+// SetNotificationService sets the waiting notification dispatcher for the TUI and serve command.
+func SetNotificationService(svc ports.NotificationService) {
+	notificationService = svc
+}
+
+// daemonService runs background detection for `vdash daemon` (nil = unavailable).
+var daemonService ports.Daemon
+
+// daemonSocketPath and daemonPIDPath locate the daemon's control socket and
+// pidfile lock. Empty socket path disables attaching to a running daemon.
+var (
+	daemonSocketPath string
+	daemonPIDPath    string
+)
+
+// SetDaemonService sets the background service run by `vdash daemon`.
+func SetDaemonService(d ports.Daemon) {
+	daemonService = d
+}
+
+// SetDaemonPaths sets where the daemon listens and keeps its pidfile.
+func SetDaemonPaths(socketPath, pidPath string) {
+	daemonSocketPath = socketPath
+	daemonPIDPath = pidPath
+}
+
+// projectScanner bulk-adds projects found under workspace roots (nil = unavailable).
+var projectScanner ports.ProjectScanner
+
+// SetProjectScanner sets the scanner for `vdash scan`, the TUI and the daemon.
+func SetProjectScanner(s ports.ProjectScanner) {
+	projectScanner = s
+}
+
+// gitInspector reads repository status for JSON output and the TUI (nil = no git info).
+var gitInspector ports.GitInspector
+
+// SetGitInspector sets the git status reader.
+func SetGitInspector(g ports.GitInspector) {
+	gitInspector = g
+}
+
+// configLoader persists settings changed from the TUI, e.g. sort mode (nil = not saved).
+var configLoader ports.ConfigLoader
+
+// SetConfigLoader sets the loader used to save user configuration.
+func SetConfigLoader(l ports.ConfigLoader) {
+	configLoader = l
+}
+
+// metricsCollector samples and aggregates progress metrics (nil = metrics disabled).
+var metricsCollector ports.MetricsCollector
+
+// SetMetricsCollector sets the progress metrics collector.
+func SetMetricsCollector(m ports.MetricsCollector) {
+	metricsCollector = m
+}
+
+// usageReader computes token usage and cost from agent session logs.
+var usageReader ports.UsageReader
+
+// SetUsageReader sets the token usage reader.
+func SetUsageReader(r ports.UsageReader) {
+	usageReader = r
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/declarative"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/filesystem"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// newDetectorsCmd creates the detectors command group.
+func newDetectorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "detectors",
+		Short: "Manage declarative methodology detectors",
+		Long: `Manage user-defined methodology detectors.
+
+Detector definitions are YAML files in ~/.vibe-dash/detectors/ and are
+loaded at startup alongside the built-in speckit, bmad, openspec and taskmaster detectors.`,
+	}
+	cmd.AddCommand(newDetectorsValidateCmd())
+	return cmd
+}
+
+// newDetectorsValidateCmd creates the 'detectors validate' subcommand.
+func newDetectorsValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate <definition.yaml> [path...]",
+		Short: "Validate a detector definition and try it on project paths",
+		Long: `Validate a detector definition file and optionally run it against
+one or more project directories (e.g., test/fixtures/*).
+
+For each path, prints whether the markers matched and the detected
+stage, confidence and reasoning. Definitions with a workflow also show
+the workflow stage and its position.
+
+Examples:
+  vdash detectors validate ~/.vibe-dash/detectors/rfc-flow.yaml
+  vdash detectors validate rfc-flow.yaml ~/work/api ~/work/web`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runDetectorsValidate,
+	}
+}
+
+// RegisterDetectorsCommand registers the detectors command with the given parent.
+// Used for testing to create fresh command trees.
+func RegisterDetectorsCommand(parent *cobra.Command) {
+	parent.AddCommand(newDetectorsCmd())
+}
+
+func init() {
+	RootCmd.AddCommand(newDetectorsCmd())
+}
+
+// runDetectorsValidate implements the 'detectors validate' command logic.
+func runDetectorsValidate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	out := cmd.OutOrStdout()
+
+	defPath, err := filesystem.ExpandHome(args[0])
+	if err != nil {
+		return err
+	}
+
+	def, err := declarative.LoadFile(defPath)
+	if err != nil {
+		if errors.Is(err, declarative.ErrInvalidDefinition) {
+			cmd.SilenceUsage = true
+			return fmt.Errorf("%w: %v", domain.ErrConfigInvalid, err)
+		}
+		return err
+	}
+
+	detector, err := declarative.NewDetector(def)
+	if err != nil {
+		return err
+	}
+
+	counts := fmt.Sprintf("%d stage rules", len(def.Stages))
+	if len(def.Workflow) > 0 {
+		counts += fmt.Sprintf(", %d workflow stages", len(def.Workflow))
+	}
+	fmt.Fprintf(out, "✓ %s: definition %q is valid (%s)\n", filepath.Base(defPath), def.Name, counts)
+
+	for _, arg := range args[1:] {
+		path, err := filesystem.ResolvePath(arg)
+		if err != nil {
+			fmt.Fprintf(out, "  %s: %v\n", arg, err)
+			continue
+		}
+
+		if !detector.CanDetect(ctx, path) {
+			fmt.Fprintf(out, "  %s: no markers found\n", arg)
+			continue
+		}
+
+		result, err := detector.Detect(ctx, path)
+		if err != nil {
+			fmt.Fprintf(out, "  %s: detection failed: %v\n", arg, err)
+			continue
+		}
+		summary := result.Summary()
+		if len(def.Workflow) > 0 && !result.MethodStage.IsZero() {
+			summary += fmt.Sprintf(" at %s (%d/%d)", result.MethodStage.ID, result.MethodStage.Ordinal, len(def.Workflow))
+		}
+		fmt.Fprintf(out, "  %s: %s - %s\n", arg, summary, result.Reasoning)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package cli_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func executeDetectorsCommand(args []string) (string, error) {
+	cmd := cli.NewRootCmd()
+	cli.RegisterDetectorsCommand(cmd)
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	cmd.SetArgs(append([]string{"detectors"}, args...))
+
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func TestDetectorsValidate_FixtureDefinition(t *testing.T) {
+	fixtures := filepath.Join("..", "..", "..", "test", "fixtures")
+
+	output, err := executeDetectorsCommand([]string{
+		"validate",
+		filepath.Join(fixtures, "detectors", "rfc-flow.yaml"),
+		filepath.Join(fixtures, "declarative-rfc-flow"),
+		filepath.Join(fixtures, "empty-project"),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	for _, want := range []string{
+		`definition "rfc-flow" is valid (4 stage rules, 4 workflow stages)`,
+		"rfc-flow/Plan (Certain) at design-review (2/4)",
+		"empty-project: no markers found",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestDetectorsValidate_InvalidDefinition(t *testing.T) {
+	defPath := filepath.Join(t.TempDir(), "bad.yaml")
+	if err := os.WriteFile(defPath, []byte("name: Bad\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := executeDetectorsCommand([]string{"validate", defPath})
+	if err == nil {
+		t.Fatal("expected error for invalid definition")
+	}
+	if !errors.Is(err, domain.ErrConfigInvalid) {
+		t.Errorf("error should wrap ErrConfigInvalid, got %v", err)
+	}
+}
@@ -3,8 +3,11 @@ package cli
 import (
 	"log/slog"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
 )
 
 var (
@@ -13,6 +16,7 @@ var (
 	quiet            bool
 	configFile       string
 	waitingThreshold int // -1 = use config, 0 = disabled, >0 = threshold in minutes
+	noColor          bool
 )
 
 func init() {
@@ -22,9 +26,11 @@ func init() {
 	RootCmd.PersistentFlags().StringVarP(&configFile, "config", "c", "", "Config file path (default: ~/.vibe-dash/config.yaml)")
 	RootCmd.PersistentFlags().IntVar(&waitingThreshold, "waiting-threshold", -1,
 		"Override agent waiting threshold in minutes (0 to disable, -1 to use config)")
+	RootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colors (same as NO_COLOR=1)")
 
 	RootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
 		initLogging()
+		initTheme()
 	}
 }
 
@@ -52,6 +58,26 @@ func initLogging() {
 	slog.SetDefault(logger)
 }
 
+// initTheme applies the configured color theme to TUI and CLI output.
+// --no-color (like NO_COLOR) switches to monochrome rendering; an unknown or
+// invalid theme logs a warning and keeps the default.
+func initTheme() {
+	if noColor {
+		styles.DisableColor()
+		return
+	}
+	name := ""
+	if appConfig != nil {
+		name = appConfig.Theme
+	}
+	theme, err := styles.ResolveTheme(name, filepath.Join(vibeHome, "themes"))
+	if err != nil {
+		slog.Warn("invalid theme, using default", "theme", name, "error", err)
+		theme = styles.DarkTheme
+	}
+	styles.ApplyTheme(theme)
+}
+
 // GetConfigFile returns the config file path specified by --config flag.
 // Returns empty string if not specified.
 func GetConfigFile() string {
@@ -5,6 +5,11 @@ import (
 	"log/slog"
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
 )
 
 func TestVerboseFlag(t *testing.T) {
@@ -103,7 +108,7 @@ func TestFlagsInHelpOutput(t *testing.T) {
 	}
 
 	output := buf.String()
-	expectedFlags := []string{"--verbose", "-v", "--debug", "--config", "-c"}
+	expectedFlags := []string{"--verbose", "-v", "--debug", "--config", "-c", "--no-color"}
 	for _, flag := range expectedFlags {
 		if !strings.Contains(output, flag) {
 			t.Errorf("Help output missing flag %q", flag)
@@ -416,3 +421,49 @@ func TestLoggingGoesToStderr(t *testing.T) {
 	// If we get here without panic, the test passes.
 	// The actual stderr routing is tested by integration tests.
 }
+
+func TestInitTheme_FromConfig(t *testing.T) {
+	resetTestState()
+	cfg := ports.NewConfig()
+	cfg.Theme = "light"
+	appConfig = cfg
+	t.Cleanup(func() {
+		appConfig = nil
+		styles.ApplyTheme(styles.DarkTheme)
+	})
+
+	initTheme()
+	if styles.Current().Name != "light" {
+		t.Errorf("theme = %q, want light", styles.Current().Name)
+	}
+
+	// Unknown themes fall back to the default
+	cfg.Theme = "no-such-theme"
+	initTheme()
+	if styles.Current().Name != styles.DefaultThemeName {
+		t.Errorf("theme = %q, want %s after invalid theme", styles.Current().Name, styles.DefaultThemeName)
+	}
+}
+
+func TestNoColorFlag(t *testing.T) {
+	resetTestState()
+	prevUseColor, prevProfile := styles.UseColor, lipgloss.ColorProfile()
+	t.Cleanup(func() {
+		resetTestState()
+		styles.UseColor = prevUseColor
+		lipgloss.SetColorProfile(prevProfile)
+		styles.ApplyTheme(styles.DarkTheme)
+	})
+
+	if err := RootCmd.PersistentFlags().Set("no-color", "true"); err != nil {
+		t.Fatalf("Set(no-color) error = %v", err)
+	}
+	initTheme()
+
+	if styles.UseColor || !styles.Monochrome() {
+		t.Error("--no-color should switch to monochrome rendering")
+	}
+	if got := styles.TitleStyle.Render("PROJECT"); got != "PROJECT" {
+		t.Errorf("styled output = %q, want plain text with --no-color", got)
+	}
+}
@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
+)
+
+// Package-level flags (same pattern as status.go)
+var historyJSON bool
+var historyLimit int
+var historyTypes []string
+
+// defaultHistoryLimit keeps plain-text output to a screenful by default
+const defaultHistoryLimit = 50
+
+// ResetHistoryFlags resets history command flags for testing.
+// Call this before each test to ensure clean state.
+func ResetHistoryFlags() {
+	historyJSON = false
+	historyLimit = defaultHistoryLimit
+	historyTypes = nil
+}
+
+// HistoryResponse represents the JSON output structure for project history.
+type HistoryResponse struct {
+	APIVersion string         `json:"api_version"` // Schema version (currently "v1")
+	Project    string         `json:"project"`     // Effective project name
+	Events     []HistoryEvent `json:"events"`      // Oldest first
+}
+
+// HistoryEvent is one timeline entry in JSON output.
+type HistoryEvent struct {
+	Type       string  `json:"type"`
+	From       *string `json:"from"`
+	To         *string `json:"to"`
+	Detail     *string `json:"detail"`
+	OccurredAt string  `json:"occurred_at"` // RFC3339, UTC
+}
+
+// historyLabels are short labels for the plain-text timeline.
+var historyLabels = map[domain.EventType]string{
+	domain.EventProjectAdded:      "added",
+	domain.EventStageChanged:      "stage",
+	domain.EventMethodChanged:     "method",
+	domain.EventHibernated:        "hibernated",
+	domain.EventActivated:         "activated",
+	domain.EventAgentStateChanged: "agent",
+}
+
+// newHistoryCmd creates the history command.
+func newHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history <project-name>",
+		Short: "Show the activity timeline of a project",
+		Long: `Show when a project changed stage, switched detection method,
+was hibernated or activated, and when its agent started or stopped waiting.
+
+Events are listed oldest first. Use --type to narrow the timeline
+(added, stage, method, hibernated, activated, agent).
+
+Examples:
+  vdash history client-alpha                 # Last 50 events
+  vdash history client-alpha --type stage    # Stage transitions only
+  vdash history client-alpha --limit 0       # Full history
+  vdash history client-alpha --json          # JSON output`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: projectCompletionFunc,
+		RunE:              runHistory,
+	}
+
+	cmd.Flags().BoolVar(&historyJSON, "json", false, "Output as JSON")
+	cmd.Flags().IntVar(&historyLimit, "limit", defaultHistoryLimit, "Show the most recent N events (0 = all)")
+	cmd.Flags().StringSliceVar(&historyTypes, "type", nil, "Only show these event types (repeatable)")
+
+	return cmd
+}
+
+// RegisterHistoryCommand registers the history command with the given parent command.
+// Used for testing to create fresh command trees.
+func RegisterHistoryCommand(parent *cobra.Command) {
+	parent.AddCommand(newHistoryCmd())
+}
+
+func init() {
+	RootCmd.AddCommand(newHistoryCmd())
+}
+
+// runHistory implements the history command logic.
+func runHistory(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if eventRepository == nil {
+		return fmt.Errorf("event history not initialized")
+	}
+	if historyLimit < 0 {
+		return fmt.Errorf("--limit must be >= 0, got %d", historyLimit)
+	}
+
+	filter := ports.EventFilter{Limit: historyLimit}
+	for _, t := range historyTypes {
+		eventType, err := domain.ParseEventType(t)
+		if err != nil {
+			return fmt.Errorf("%w: %q", err, t)
+		}
+		filter.Types = append(filter.Types, eventType)
+	}
+
+	identifier := args[0]
+	proj, err := findProjectByIdentifier(ctx, identifier)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			fmt.Fprintf(cmd.OutOrStdout(), "✗ Project not found: %s\n", identifier)
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+		}
+		return err
+	}
+
+	events, err := eventRepository.FindEvents(ctx, proj.ID, filter)
+	if err != nil {
+		return fmt.Errorf("failed to load history: %w", err)
+	}
+
+	if historyJSON {
+		return formatHistoryJSON(cmd, proj, events)
+	}
+	formatHistoryPlainText(cmd, proj, events)
+	return nil
+}
+
+// formatHistoryPlainText prints one line per event:
+// "  2026-01-11 14:22  stage       Plan → Implement  (plan.md exists)"
+func formatHistoryPlainText(cmd *cobra.Command, p *domain.Project, events []domain.ProjectEvent) {
+	out := cmd.OutOrStdout()
+	name := project.EffectiveName(p)
+
+	if len(events) == 0 {
+		fmt.Fprintf(out, "No history recorded for %s\n", name)
+		return
+	}
+
+	fmt.Fprintf(out, "%s (%d events)\n", name, len(events))
+	for _, e := range events {
+		label, ok := historyLabels[e.Type]
+		if !ok {
+			label = string(e.Type)
+		}
+
+		var change string
+		switch {
+		case e.From != "" && e.To != "":
+			change = e.From + " → " + e.To
+		case e.To != "":
+			change = e.To
+		default:
+			change = e.From
+		}
+
+		line := fmt.Sprintf("  %s  %-10s  %s", e.OccurredAt.Local().Format("2006-01-02 15:04"), label, change)
+		if e.Detail != "" {
+			line += fmt.Sprintf("  (%s)", e.Detail)
+		}
+		fmt.Fprintln(out, line)
+	}
+}
+
+// formatHistoryJSON writes the timeline as JSON.
+func formatHistoryJSON(cmd *cobra.Command, p *domain.Project, events []domain.ProjectEvent) error {
+	response := HistoryResponse{
+		APIVersion: "v1",
+		Project:    project.EffectiveName(p),
+		Events:     make([]HistoryEvent, 0, len(events)), // [] not null when empty
+	}
+	for _, e := range events {
+		response.Events = append(response.Events, HistoryEvent{
+			Type:       string(e.Type),
+			From:       optionalString(e.From),
+			To:         optionalString(e.To),
+			Detail:     optionalString(e.Detail),
+			OccurredAt: e.OccurredAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(response)
+}
+
+// optionalString returns nil for empty strings so JSON renders null.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
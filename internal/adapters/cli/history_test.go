@@ -0,0 +1,178 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// mockEventRepository implements ports.ProjectEventRepository for CLI tests.
+type mockEventRepository struct {
+	events     []domain.ProjectEvent
+	lastFilter ports.EventFilter
+}
+
+func (m *mockEventRepository) AppendEvent(_ context.Context, event domain.ProjectEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockEventRepository) FindEvents(_ context.Context, projectID string, filter ports.EventFilter) ([]domain.ProjectEvent, error) {
+	m.lastFilter = filter
+	result := make([]domain.ProjectEvent, 0)
+	for _, e := range m.events {
+		if e.ProjectID == projectID {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockEventRepository) LastEvent(_ context.Context, _ string, _ domain.EventType) (*domain.ProjectEvent, error) {
+	return nil, nil
+}
+
+func executeHistoryCommand(args []string) (string, error) {
+	cli.ResetHistoryFlags()
+	cmd := cli.NewRootCmd()
+	cli.RegisterHistoryCommand(cmd)
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	cmd.SetArgs(append([]string{"history"}, args...))
+
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func setupHistoryTest(t *testing.T) *mockEventRepository {
+	t.Helper()
+	projects := []*domain.Project{
+		{ID: "p1", Path: "/test/alpha", Name: "alpha"},
+	}
+	at := time.Date(2026, 1, 11, 14, 22, 0, 0, time.UTC)
+	events := &mockEventRepository{events: []domain.ProjectEvent{
+		domain.NewProjectEvent("p1", domain.EventProjectAdded, "", "Specify", "speckit", at),
+		domain.NewProjectEvent("p1", domain.EventStageChanged, "Specify", "Plan", "plan.md exists", at.Add(time.Hour)),
+		domain.NewProjectEvent("p1", domain.EventAgentStateChanged, "Working", "Waiting", "Claude Code", at.Add(2*time.Hour)),
+		domain.NewProjectEvent("other", domain.EventHibernated, "Active", "Hibernated", "", at),
+	}}
+
+	cli.SetRepository(newHibernateMockRepository().withProjects(projects))
+	cli.SetEventRepository(events)
+	t.Cleanup(func() {
+		cli.SetRepository(nil)
+		cli.SetEventRepository(nil)
+	})
+	return events
+}
+
+func TestHistoryCmd_PlainText(t *testing.T) {
+	setupHistoryTest(t)
+
+	output, err := executeHistoryCommand([]string{"alpha"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"alpha (3 events)", "Specify → Plan", "(plan.md exists)", "Working → Waiting", "added"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "hibernated") {
+		t.Errorf("output should not include other projects' events:\n%s", output)
+	}
+}
+
+func TestHistoryCmd_JSON(t *testing.T) {
+	setupHistoryTest(t)
+
+	output, err := executeHistoryCommand([]string{"alpha", "--json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var resp cli.HistoryResponse
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if resp.APIVersion != "v1" || resp.Project != "alpha" {
+		t.Errorf("unexpected header: %+v", resp)
+	}
+	if len(resp.Events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(resp.Events))
+	}
+	if resp.Events[0].From != nil {
+		t.Errorf("project_added should have null from, got %q", *resp.Events[0].From)
+	}
+	if resp.Events[1].Type != "stage_changed" || *resp.Events[1].To != "Plan" {
+		t.Errorf("unexpected stage event: %+v", resp.Events[1])
+	}
+	if resp.Events[1].OccurredAt != "2026-01-11T15:22:00Z" {
+		t.Errorf("OccurredAt = %q", resp.Events[1].OccurredAt)
+	}
+}
+
+func TestHistoryCmd_TypeAndLimitFlags(t *testing.T) {
+	events := setupHistoryTest(t)
+
+	if _, err := executeHistoryCommand([]string{"alpha", "--type", "stage,agent", "--limit", "10"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []domain.EventType{domain.EventStageChanged, domain.EventAgentStateChanged}
+	if len(events.lastFilter.Types) != len(want) {
+		t.Fatalf("filter types = %v, want %v", events.lastFilter.Types, want)
+	}
+	for i := range want {
+		if events.lastFilter.Types[i] != want[i] {
+			t.Errorf("filter types = %v, want %v", events.lastFilter.Types, want)
+		}
+	}
+	if events.lastFilter.Limit != 10 {
+		t.Errorf("filter limit = %d, want 10", events.lastFilter.Limit)
+	}
+}
+
+func TestHistoryCmd_InvalidType(t *testing.T) {
+	setupHistoryTest(t)
+
+	_, err := executeHistoryCommand([]string{"alpha", "--type", "bogus"})
+	if err == nil || !strings.Contains(err.Error(), "invalid event type") {
+		t.Errorf("expected invalid event type error, got %v", err)
+	}
+}
+
+func TestHistoryCmd_ProjectNotFound(t *testing.T) {
+	setupHistoryTest(t)
+
+	output, err := executeHistoryCommand([]string{"missing"})
+	if err == nil {
+		t.Fatal("expected error for missing project")
+	}
+	if !strings.Contains(output, "✗ Project not found: missing") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestHistoryCmd_NoEvents(t *testing.T) {
+	events := setupHistoryTest(t)
+	events.events = nil
+
+	output, err := executeHistoryCommand([]string{"alpha"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "No history recorded for alpha") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
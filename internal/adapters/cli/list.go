@@ -4,14 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/apitypes"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/stageformat"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/timeformat"
 )
 
@@ -21,11 +22,15 @@ var listJSON bool
 // listAPIVersion holds the --api-version flag value
 var listAPIVersion string
 
+// listSort holds the --sort flag value ("" = config sort_mode)
+var listSort string
+
 // ResetListFlags resets list command flags for testing.
 // Call this before each test to ensure clean state.
 func ResetListFlags() {
 	listJSON = false
 	listAPIVersion = "v1"
+	listSort = ""
 }
 
 // newListCmd creates the list command.
@@ -38,15 +43,20 @@ func newListCmd() *cobra.Command {
 Shows project name, workflow stage, and time since last activity.
 Use --json for machine-readable output.
 
+Projects are ordered by the config sort_mode (default: name) unless --sort
+is given. Sort modes: name, waiting, recent, stage, method, waiting-duration.
+
 Examples:
-  vdash list           # Plain text output
-  vdash list --json    # JSON output for scripting`,
+  vdash list                  # Plain text output
+  vdash list --json           # JSON output for scripting
+  vdash list --sort recent    # Most recently active first`,
 		Args: cobra.NoArgs,
 		RunE: runList,
 	}
 
 	cmd.Flags().BoolVar(&listJSON, "json", false, "Output as JSON")
 	cmd.Flags().StringVar(&listAPIVersion, "api-version", "v1", "API version for JSON output (currently only v1)")
+	cmd.Flags().StringVar(&listSort, "sort", "", "Sort order: name, waiting, recent, stage, method, waiting-duration (default: config sort_mode)")
 
 	return cmd
 }
@@ -74,13 +84,22 @@ func runList(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("unsupported API version: %s", listAPIVersion)
 	}
 
+	sortValue := listSort
+	if sortValue == "" {
+		sortValue = GetConfig().SortMode
+	}
+	sortMode, err := domain.ParseSortMode(sortValue)
+	if err != nil {
+		return err
+	}
+
 	projects, err := repository.FindAll(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to list projects: %w", err)
 	}
 
-	// Sort alphabetically by effective name
-	project.SortByName(projects)
+	// Same ordering as the dashboard (favorites first for name)
+	project.Sort(projects, sortMode, listWaitingState(ctx))
 
 	if listJSON {
 		return formatJSON(ctx, cmd, projects)
@@ -96,10 +115,25 @@ func runList(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// listWaitingState adapts the waiting detector for project.Sort.
+// Returns nil when no detector is set (nothing is waiting).
+func listWaitingState(ctx context.Context) project.WaitingState {
+	if waitingDetector == nil {
+		return nil
+	}
+	return func(p *domain.Project) (bool, time.Duration) {
+		if !waitingDetector.IsWaiting(ctx, p) {
+			return false, 0
+		}
+		return true, waitingDetector.WaitingDuration(ctx, p)
+	}
+}
+
 // formatPlainText formats projects as a plain text table.
 func formatPlainText(cmd *cobra.Command, projects []*domain.Project) {
 	// Header
-	fmt.Fprintf(cmd.OutOrStdout(), "%-40s %-10s %12s\n", "PROJECT", "STAGE", "LAST ACTIVE")
+	// Styled with the active theme; plain when piped or with NO_COLOR/--no-color
+	fmt.Fprintln(cmd.OutOrStdout(), styles.TitleStyle.Render(fmt.Sprintf("%-40s %-10s %12s", "PROJECT", "STAGE", "LAST ACTIVE")))
 
 	for _, p := range projects {
 		name := project.EffectiveName(p)
@@ -114,30 +148,6 @@ func formatPlainText(cmd *cobra.Command, projects []*domain.Project) {
 	}
 }
 
-// ListResponse represents the JSON output structure
-type ListResponse struct {
-	APIVersion    string           `json:"api_version"`
-	Projects      []ProjectSummary `json:"projects"`
-	ConfigWarning *string          `json:"config_warning,omitempty"` // Story 7.2: Config error message (null if no error)
-}
-
-// ProjectSummary represents a single project in JSON output
-type ProjectSummary struct {
-	Name                   string  `json:"name"`
-	DisplayName            *string `json:"display_name"` // null if not set
-	Path                   string  `json:"path"`
-	Method                 string  `json:"method"`
-	Stage                  string  `json:"stage"`      // lowercase per Architecture spec
-	Confidence             string  `json:"confidence"` // lowercase: "certain", "likely", "uncertain"
-	State                  string  `json:"state"`      // lowercase: "active" or "hibernated"
-	IsFavorite             bool    `json:"is_favorite"`
-	IsWaiting              bool    `json:"is_waiting"`               // Agent waiting detection status
-	WaitingDurationMinutes *int    `json:"waiting_duration_minutes"` // Minutes waiting, null if not waiting
-	Notes                  *string `json:"notes"`                    // User notes, null if not set
-	DetectionReasoning     *string `json:"detection_reasoning"`      // Detection explanation, null if empty
-	LastActivityAt         string  `json:"last_activity_at"`         // ISO 8601 UTC (RFC3339)
-}
-
 // formatJSON formats projects as JSON output.
 func formatJSON(ctx context.Context, cmd *cobra.Command, projects []*domain.Project) error {
 	// Story 7.2: Include config warning if present (AC7)
@@ -146,57 +156,14 @@ func formatJSON(ctx context.Context, cmd *cobra.Command, projects []*domain.Proj
 		cfgWarning = &configWarning
 	}
 
-	response := ListResponse{
+	response := apitypes.ListResponse{
 		APIVersion:    listAPIVersion,
-		Projects:      make([]ProjectSummary, 0, len(projects)),
+		Projects:      make([]apitypes.ProjectSummary, 0, len(projects)),
 		ConfigWarning: cfgWarning,
 	}
 
 	for _, p := range projects {
-		// Nullable display_name (null if not set)
-		var displayName *string
-		if p.DisplayName != "" {
-			displayName = &p.DisplayName
-		}
-
-		// Nullable notes (null if not set)
-		var notes *string
-		if p.Notes != "" {
-			notes = &p.Notes
-		}
-
-		// Nullable detection_reasoning (null if not set)
-		var detectionReasoning *string
-		if p.DetectionReasoning != "" {
-			detectionReasoning = &p.DetectionReasoning
-		}
-
-		// Waiting detection (AC4: is_waiting and waiting_duration_minutes)
-		isWaiting := false
-		var waitingMinutes *int
-		if waitingDetector != nil {
-			isWaiting = waitingDetector.IsWaiting(ctx, p)
-			if isWaiting {
-				mins := int(waitingDetector.WaitingDuration(ctx, p).Minutes())
-				waitingMinutes = &mins
-			}
-		}
-
-		response.Projects = append(response.Projects, ProjectSummary{
-			Name:                   p.Name,
-			DisplayName:            displayName,
-			Path:                   p.Path,
-			Method:                 p.DetectedMethod,
-			Stage:                  strings.ToLower(p.CurrentStage.String()),
-			Confidence:             strings.ToLower(p.Confidence.String()),
-			State:                  strings.ToLower(p.State.String()),
-			IsFavorite:             p.IsFavorite,
-			IsWaiting:              isWaiting,
-			WaitingDurationMinutes: waitingMinutes,
-			Notes:                  notes,
-			DetectionReasoning:     detectionReasoning,
-			LastActivityAt:         p.LastActivityAt.UTC().Format(time.RFC3339),
-		})
+		response.Projects = append(response.Projects, apitypes.NewProjectSummary(ctx, p, waitingDetector, gitInspector))
 	}
 
 	encoder := json.NewEncoder(cmd.OutOrStdout())
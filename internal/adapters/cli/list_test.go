@@ -10,6 +10,7 @@ import (
 
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
 )
 
 // executeListCommand runs the list command with given args and returns output/error
@@ -689,6 +690,9 @@ func TestList_JSON_NullableFieldsWhenEmpty(t *testing.T) {
 			WaitingDurationMinutes *int    `json:"waiting_duration_minutes"`
 			Notes                  *string `json:"notes"`
 			IsWaiting              bool    `json:"is_waiting"`
+			TasksDone              *int    `json:"tasks_done"`
+			TasksTotal             *int    `json:"tasks_total"`
+			NextTask               *string `json:"next_task"`
 		} `json:"projects"`
 	}
 	if err := json.Unmarshal([]byte(output), &response); err != nil {
@@ -710,6 +714,9 @@ func TestList_JSON_NullableFieldsWhenEmpty(t *testing.T) {
 	if proj.WaitingDurationMinutes != nil {
 		t.Errorf("expected waiting_duration_minutes to be null, got %v", proj.WaitingDurationMinutes)
 	}
+	if proj.TasksDone != nil || proj.TasksTotal != nil || proj.NextTask != nil {
+		t.Errorf("expected task fields to be null without a task list, got %v/%v %v", proj.TasksDone, proj.TasksTotal, proj.NextTask)
+	}
 }
 
 func TestList_JSON_APIVersionValidation(t *testing.T) {
@@ -867,3 +874,69 @@ func TestList_JSON_ConfigWarningOmittedWhenEmpty(t *testing.T) {
 		t.Errorf("expected config_warning to be omitted when empty, got: %s", output)
 	}
 }
+
+// =============================================================================
+// --sort flag
+// =============================================================================
+
+func TestList_SortRecent(t *testing.T) {
+	mock := NewMockRepository()
+	now := time.Now()
+	for name, age := range map[string]time.Duration{"old": 3 * time.Hour, "fresh": time.Minute, "mid": time.Hour} {
+		p, _ := domain.NewProject("/path/to/"+name, "")
+		p.LastActivityAt = now.Add(-age)
+		mock.Projects[p.Path] = p
+	}
+	cli.SetRepository(mock)
+
+	output, err := executeListCommand([]string{"--sort", "recent"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	freshIdx, midIdx, oldIdx := strings.Index(output, "fresh"), strings.Index(output, "mid"), strings.Index(output, "old")
+	if freshIdx > midIdx || midIdx > oldIdx {
+		t.Errorf("expected fresh, mid, old order, got:\n%s", output)
+	}
+}
+
+func TestList_SortDefaultsToConfig(t *testing.T) {
+	mock := NewMockRepository()
+	pa, _ := domain.NewProject("/path/to/alpha", "")
+	pa.CurrentStage = domain.StagePlan
+	mock.Projects[pa.Path] = pa
+	pz, _ := domain.NewProject("/path/to/zulu", "")
+	pz.CurrentStage = domain.StageImplement
+	mock.Projects[pz.Path] = pz
+	cli.SetRepository(mock)
+
+	cfg := ports.NewConfig()
+	cfg.SortMode = "stage"
+	cli.SetConfig(cfg)
+	defer cli.SetConfig(nil)
+
+	output, err := executeListCommand([]string{})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if strings.Index(output, "zulu") > strings.Index(output, "alpha") {
+		t.Errorf("expected config sort_mode=stage to list zulu (implement) first, got:\n%s", output)
+	}
+
+	// --sort overrides config
+	output, err = executeListCommand([]string{"--sort", "name"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if strings.Index(output, "alpha") > strings.Index(output, "zulu") {
+		t.Errorf("expected --sort name to list alpha first, got:\n%s", output)
+	}
+}
+
+func TestList_SortInvalid(t *testing.T) {
+	cli.SetRepository(NewMockRepository())
+
+	_, err := executeListCommand([]string{"--sort", "size"})
+	if !errors.Is(err, domain.ErrInvalidSortMode) {
+		t.Errorf("expected ErrInvalidSortMode, got: %v", err)
+	}
+}
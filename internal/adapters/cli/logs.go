@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
+)
+
+// Package-level flags (same pattern as history.go)
+var logsSearchJSON bool
+var logsSearchLimit int
+
+// defaultLogsSearchLimit keeps plain-text output to a screenful by default
+const defaultLogsSearchLimit = 50
+
+// logsSessionIDWidth is the number of session ID characters shown in plain text
+const logsSessionIDWidth = 8
+
+// ResetLogsFlags resets logs command flags for testing.
+// Call this before each test to ensure clean state.
+func ResetLogsFlags() {
+	logsSearchJSON = false
+	logsSearchLimit = defaultLogsSearchLimit
+}
+
+// LogSearchResponse represents the JSON output structure for log search.
+type LogSearchResponse struct {
+	APIVersion string         `json:"api_version"` // Schema version (currently "v1")
+	Project    string         `json:"project"`     // Effective project name
+	Query      string         `json:"query"`
+	Hits       []LogSearchHit `json:"hits"` // Newest session first
+}
+
+// LogSearchHit is one matching log entry in JSON output.
+type LogSearchHit struct {
+	Tool        string  `json:"tool"`
+	SessionID   string  `json:"session_id"`
+	SessionPath string  `json:"session_path"`
+	Line        int     `json:"line"`      // 1-based line in the session file
+	Timestamp   *string `json:"timestamp"` // RFC3339, UTC; null if unknown
+	Type        string  `json:"type"`
+	Snippet     string  `json:"snippet"`
+}
+
+// newLogsCmd creates the logs command and its subcommands.
+func newLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Work with agent session logs",
+		Long: `Work with the session logs of agentic tools (Claude Code, Codex, Aider).
+
+Use 'logs search' to find text across all sessions of a project.`,
+	}
+	cmd.AddCommand(newLogsSearchCmd())
+	return cmd
+}
+
+// newLogsSearchCmd creates the 'logs search' command.
+func newLogsSearchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <project-name> <query>",
+		Short: "Search all session logs of a project",
+		Long: `Search every session log of a project for text (case-insensitive).
+
+Sessions are searched newest first and each match is listed with its
+time, tool, session, entry type and a snippet of the matching text.
+Log files are streamed, so large sessions are searched without loading
+them into memory.
+
+Examples:
+  vdash logs search client-alpha "permission denied"
+  vdash logs search client-alpha migration --limit 0   # All matches
+  vdash logs search client-alpha migration --json      # JSON output`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: projectCompletionFunc,
+		RunE:              runLogsSearch,
+	}
+
+	cmd.Flags().BoolVar(&logsSearchJSON, "json", false, "Output as JSON")
+	cmd.Flags().IntVar(&logsSearchLimit, "limit", defaultLogsSearchLimit, "Show at most N matches (0 = all)")
+
+	return cmd
+}
+
+// RegisterLogsCommand registers the logs command with the given parent command.
+// Used for testing to create fresh command trees.
+func RegisterLogsCommand(parent *cobra.Command) {
+	parent.AddCommand(newLogsCmd())
+}
+
+func init() {
+	RootCmd.AddCommand(newLogsCmd())
+}
+
+// runLogsSearch implements the 'logs search' command logic.
+func runLogsSearch(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if logReaderRegistry == nil {
+		return fmt.Errorf("log readers not initialized")
+	}
+	if logsSearchLimit < 0 {
+		return fmt.Errorf("--limit must be >= 0, got %d", logsSearchLimit)
+	}
+	query := strings.TrimSpace(args[1])
+	if query == "" {
+		return fmt.Errorf("search query must not be empty")
+	}
+
+	identifier := args[0]
+	proj, err := findProjectByIdentifier(ctx, identifier)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			fmt.Fprintf(cmd.OutOrStdout(), "✗ Project not found: %s\n", identifier)
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+		}
+		return err
+	}
+
+	hits, err := logReaderRegistry.Search(ctx, proj.Path, query, logsSearchLimit)
+	if err != nil {
+		return fmt.Errorf("failed to search logs: %w", err)
+	}
+
+	if logsSearchJSON {
+		return formatLogSearchJSON(cmd, proj, query, hits)
+	}
+	formatLogSearchPlainText(cmd, proj, query, hits)
+	return nil
+}
+
+// formatLogSearchPlainText prints one line per match:
+// "  2026-01-12 10:01  Claude Code  3f2a9c1e  user       Thanks! Please also update the changelog."
+func formatLogSearchPlainText(cmd *cobra.Command, p *domain.Project, query string, hits []domain.LogSearchHit) {
+	out := cmd.OutOrStdout()
+	name := project.EffectiveName(p)
+
+	if len(hits) == 0 {
+		fmt.Fprintf(out, "No matches for %q in %s logs\n", query, name)
+		return
+	}
+
+	fmt.Fprintf(out, "%s (%d matches for %q)\n", name, len(hits), query)
+	for _, h := range hits {
+		when := strings.Repeat(" ", len("2006-01-02 15:04"))
+		if !h.Timestamp.IsZero() {
+			when = h.Timestamp.Local().Format("2006-01-02 15:04")
+		}
+		sessionID := h.Session.ID
+		if len(sessionID) > logsSessionIDWidth {
+			sessionID = sessionID[:logsSessionIDWidth]
+		}
+		fmt.Fprintf(out, "  %s  %-11s  %-8s  %-9s  %s\n", when, h.Session.Tool, sessionID, h.Type, h.Snippet)
+	}
+}
+
+// formatLogSearchJSON writes the matches as JSON.
+func formatLogSearchJSON(cmd *cobra.Command, p *domain.Project, query string, hits []domain.LogSearchHit) error {
+	response := LogSearchResponse{
+		APIVersion: "v1",
+		Project:    project.EffectiveName(p),
+		Query:      query,
+		Hits:       make([]LogSearchHit, 0, len(hits)), // [] not null when empty
+	}
+	for _, h := range hits {
+		var ts string
+		if !h.Timestamp.IsZero() {
+			ts = h.Timestamp.UTC().Format(time.RFC3339)
+		}
+		response.Hits = append(response.Hits, LogSearchHit{
+			Tool:        h.Session.Tool,
+			SessionID:   h.Session.ID,
+			SessionPath: h.Session.Path,
+			Line:        h.Line,
+			Timestamp:   optionalString(ts),
+			Type:        h.Type,
+			Snippet:     h.Snippet,
+		})
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(response)
+}
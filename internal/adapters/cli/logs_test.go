@@ -0,0 +1,177 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// mockLogReaderRegistry implements ports.LogReaderRegistry with canned search hits.
+type mockLogReaderRegistry struct {
+	hits      []domain.LogSearchHit
+	lastPath  string
+	lastQuery string
+	lastLimit int
+}
+
+func (m *mockLogReaderRegistry) Register(_ ports.LogReader) {}
+
+func (m *mockLogReaderRegistry) GetReader(_ context.Context, _ string) ports.LogReader {
+	return nil
+}
+
+func (m *mockLogReaderRegistry) GetReaders(_ context.Context, _ string) []ports.LogReader {
+	return nil
+}
+
+func (m *mockLogReaderRegistry) Readers() []ports.LogReader {
+	return nil
+}
+
+func (m *mockLogReaderRegistry) Search(_ context.Context, projectPath, query string, limit int) ([]domain.LogSearchHit, error) {
+	m.lastPath, m.lastQuery, m.lastLimit = projectPath, query, limit
+	return m.hits, nil
+}
+
+func executeLogsCommand(args []string) (string, error) {
+	cli.ResetLogsFlags()
+	cmd := cli.NewRootCmd()
+	cli.RegisterLogsCommand(cmd)
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	cmd.SetArgs(append([]string{"logs"}, args...))
+
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func setupLogsTest(t *testing.T) *mockLogReaderRegistry {
+	t.Helper()
+	projects := []*domain.Project{
+		{ID: "p1", Path: "/test/alpha", Name: "alpha"},
+	}
+	at := time.Date(2026, 1, 12, 10, 1, 0, 0, time.UTC)
+	registry := &mockLogReaderRegistry{hits: []domain.LogSearchHit{
+		{
+			Session:   domain.LogSession{ID: "3f2a9c1e-77b0-4c1d", Path: "/logs/3f2a9c1e.jsonl", Tool: "Claude Code"},
+			Line:      11,
+			Timestamp: at,
+			Type:      "user",
+			Snippet:   "Please also update the changelog.",
+		},
+		{
+			Session: domain.LogSession{ID: "input-history", Path: "/test/alpha/.aider.input.history", Tool: "Aider"},
+			Line:    4,
+			Type:    "user",
+			Snippet: "Rewrite the changelog",
+		},
+	}}
+
+	cli.SetRepository(newHibernateMockRepository().withProjects(projects))
+	cli.SetLogReaderRegistry(registry)
+	t.Cleanup(func() {
+		cli.SetRepository(nil)
+		cli.SetLogReaderRegistry(nil)
+	})
+	return registry
+}
+
+func TestLogsSearchCmd_PlainText(t *testing.T) {
+	registry := setupLogsTest(t)
+
+	output, err := executeLogsCommand([]string{"search", "alpha", "changelog"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{`alpha (2 matches for "changelog")`, "Claude Code", "3f2a9c1e ", "user", "Please also update the changelog.", "input-hi"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "77b0") {
+		t.Errorf("session IDs should be shortened:\n%s", output)
+	}
+	if registry.lastPath != "/test/alpha" || registry.lastQuery != "changelog" || registry.lastLimit != 50 {
+		t.Errorf("Search(%q, %q, %d), want project path, query and default limit", registry.lastPath, registry.lastQuery, registry.lastLimit)
+	}
+}
+
+func TestLogsSearchCmd_JSON(t *testing.T) {
+	setupLogsTest(t)
+
+	output, err := executeLogsCommand([]string{"search", "alpha", "changelog", "--json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var resp cli.LogSearchResponse
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if resp.APIVersion != "v1" || resp.Project != "alpha" || resp.Query != "changelog" {
+		t.Errorf("unexpected header: %+v", resp)
+	}
+	if len(resp.Hits) != 2 {
+		t.Fatalf("expected 2 hits, got %d", len(resp.Hits))
+	}
+	first := resp.Hits[0]
+	if first.SessionID != "3f2a9c1e-77b0-4c1d" || first.Line != 11 || first.Tool != "Claude Code" {
+		t.Errorf("unexpected hit: %+v", first)
+	}
+	if first.Timestamp == nil || *first.Timestamp != "2026-01-12T10:01:00Z" {
+		t.Errorf("Timestamp = %v", first.Timestamp)
+	}
+	if resp.Hits[1].Timestamp != nil {
+		t.Errorf("unknown timestamp should be null, got %q", *resp.Hits[1].Timestamp)
+	}
+}
+
+func TestLogsSearchCmd_LimitFlag(t *testing.T) {
+	registry := setupLogsTest(t)
+
+	if _, err := executeLogsCommand([]string{"search", "alpha", "changelog", "--limit", "0"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if registry.lastLimit != 0 {
+		t.Errorf("limit = %d, want 0", registry.lastLimit)
+	}
+
+	if _, err := executeLogsCommand([]string{"search", "alpha", "changelog", "--limit", "-1"}); err == nil {
+		t.Error("expected error for negative limit")
+	}
+}
+
+func TestLogsSearchCmd_NoMatches(t *testing.T) {
+	registry := setupLogsTest(t)
+	registry.hits = nil
+
+	output, err := executeLogsCommand([]string{"search", "alpha", "nothing"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, `No matches for "nothing" in alpha logs`) {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestLogsSearchCmd_ProjectNotFound(t *testing.T) {
+	setupLogsTest(t)
+
+	output, err := executeLogsCommand([]string{"search", "missing", "query"})
+	if err == nil {
+		t.Fatal("expected error for missing project")
+	}
+	if !strings.Contains(output, "✗ Project not found: missing") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
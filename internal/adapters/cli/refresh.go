@@ -16,7 +16,8 @@ func newRefreshCmd() *cobra.Command {
 		Long: `Re-scan all tracked projects to update their methodology stage.
 
 This command runs the detection service against all projects and updates
-their stage based on current artifacts.`,
+their stage based on current artifacts. When a daemon is running
+('vdash daemon'), the daemon performs the refresh instead.`,
 		RunE: runRefresh,
 	}
 }
@@ -44,6 +45,15 @@ func runRefresh(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("detection service not initialized")
 	}
 
+	// A running daemon is the single writer; let it do the scan
+	if d := attachedDaemon(ctx); d != nil {
+		result, err := d.Refresh(ctx)
+		if err != nil {
+			return fmt.Errorf("daemon refresh failed: %w", err)
+		}
+		return reportRefresh(cmd, result.Refreshed, result.Failed)
+	}
+
 	// Get all projects
 	projects, err := repository.FindAll(ctx)
 	if err != nil {
@@ -64,10 +74,7 @@ func runRefresh(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
-		project.DetectedMethod = result.Method
-		project.CurrentStage = result.Stage
-		project.Confidence = result.Confidence
-		project.DetectionReasoning = result.Reasoning
+		project.ApplyDetection(result, nil)
 		project.UpdatedAt = time.Now()
 
 		if err := repository.Save(ctx, project); err != nil {
@@ -79,7 +86,12 @@ func runRefresh(cmd *cobra.Command, args []string) error {
 		refreshedCount++
 	}
 
-	// AC3: Only return error if ALL projects fail
+	return reportRefresh(cmd, refreshedCount, failedCount)
+}
+
+// reportRefresh prints the refresh summary.
+// AC3: Only returns an error if ALL projects fail.
+func reportRefresh(cmd *cobra.Command, refreshedCount, failedCount int) error {
 	if refreshedCount == 0 && failedCount > 0 {
 		return fmt.Errorf("all %d projects failed to refresh", failedCount)
 	}
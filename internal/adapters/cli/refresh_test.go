@@ -197,12 +197,13 @@ func TestRefreshCmd_UpdatesProjectFields(t *testing.T) {
 	_ = mock.Save(context.Background(), project)
 
 	cli.SetRepository(mock)
-	cli.SetDetectionService(newMockDetectorWithResult(&domain.DetectionResult{
+	result := domain.DetectionResult{
 		Method:     "bmad",
 		Stage:      domain.StagePlan,
 		Confidence: domain.ConfidenceCertain,
 		Reasoning:  "Found BMAD artifacts",
-	}))
+	}.WithTasks(2, 5, "T003 Wire handler").WithActiveChange("add-2fa")
+	cli.SetDetectionService(newMockDetectorWithResult(&result))
 
 	_, err := executeRefreshCommand()
 	if err != nil {
@@ -220,6 +221,12 @@ func TestRefreshCmd_UpdatesProjectFields(t *testing.T) {
 	if updated.Confidence != domain.ConfidenceCertain {
 		t.Errorf("expected Confidence to be Certain, got: %v", updated.Confidence)
 	}
+	if updated.TasksDone != 2 || updated.TasksTotal != 5 || updated.NextTask != "T003 Wire handler" {
+		t.Errorf("tasks = %d/%d next %q, want 2/5 next T003", updated.TasksDone, updated.TasksTotal, updated.NextTask)
+	}
+	if updated.ActiveChange != "add-2fa" {
+		t.Errorf("ActiveChange = %q, want add-2fa", updated.ActiveChange)
+	}
 }
 
 // CallCountDetector is a detector that returns different results based on call count.
@@ -132,6 +132,13 @@ func runRemove(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to remove project: %w", err)
 	}
 
+	// Drop the project's progress metrics (non-fatal if fails)
+	if metricsCollector != nil {
+		if err := metricsCollector.DeleteProjectStats(ctx, project.ID); err != nil {
+			slog.Warn("failed to delete project metrics", "project_id", project.ID, "error", err)
+		}
+	}
+
 	// Delete project directory using DirectoryManager (non-fatal if fails)
 	if directoryManager != nil {
 		if err := directoryManager.DeleteProjectDir(ctx, projectPath); err != nil {
@@ -442,6 +442,24 @@ func TestRemove_CallsDeleteProjectDir(t *testing.T) {
 	}
 }
 
+func TestRemove_DeletesProjectMetrics(t *testing.T) {
+	mock := NewMockRepository()
+	p, _ := domain.NewProject("/path/to/test-project", "")
+	mock.Projects[p.Path] = p
+	cli.SetRepository(mock)
+
+	metrics := &mockMetricsCollector{}
+	cli.SetMetricsCollector(metrics)
+	defer cli.SetMetricsCollector(nil)
+
+	if _, err := executeRemoveCommand(t, []string{"test-project", "--force"}, ""); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(metrics.deleted) != 1 || metrics.deleted[0] != p.ID {
+		t.Errorf("metrics deleted for %v, want [%s]", metrics.deleted, p.ID)
+	}
+}
+
 func TestRemove_DirectoryDeletionErrorIsNonFatal(t *testing.T) {
 	// AC2: Verify directory deletion failure doesn't fail the remove command
 	mock := NewMockRepository()
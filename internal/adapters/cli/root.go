@@ -36,10 +36,27 @@ Exit Codes:
 			return
 		}
 
-		// Pass detection service, waiting detector, file watcher, layout, config, hibernation service, state service, and log reader registry to TUI
-		// (Story 3.6, 4.5, 4.6, 8.6, 8.7, 11.2, 11.3, 12.1)
+		// Hand the TUI every service it can use (Story 3.6, 4.5, 4.6, 8.6, 8.7, 11.2, 11.3, 12.1)
 		// Uses existing package variables from add.go and deps.go
-		if err := tui.Run(cmd.Context(), repository, detectionService, waitingDetector, fileWatcher, detailLayout, appConfig, hibernationService, stateService, logReaderRegistry); err != nil {
+		deps := tui.Deps{
+			Repo:                repository,
+			Refresher:           projectRefresher,
+			WaitingDetector:     waitingDetector,
+			FileWatcher:         fileWatcher,
+			DetailLayout:        detailLayout,
+			Config:              appConfig,
+			HibernationService:  hibernationService,
+			StateService:        stateService,
+			LogReaderRegistry:   logReaderRegistry,
+			NotificationService: notificationService,
+			ProjectScanner:      projectScanner,
+			GitInspector:        gitInspector,
+			ConfigLoader:        configLoader,
+			MetricsCollector:    metricsCollector,
+			UsageReader:         usageReader,
+			Daemon:              attachedDaemon(cmd.Context()),
+		}
+		if err := tui.Run(cmd.Context(), deps); err != nil {
 			slog.Error("TUI error", "error", err)
 		}
 	},
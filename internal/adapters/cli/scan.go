@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// Package-level flags (same pattern as add.go)
+var (
+	scanDepth  int
+	scanDryRun bool
+)
+
+// ResetScanFlags resets scan command flags for testing.
+// Call this before each test to ensure clean state.
+func ResetScanFlags() {
+	scanDepth = ports.DefaultScanDepth
+	scanDryRun = false
+}
+
+// newScanCmd creates the scan command.
+func newScanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scan [root...]",
+		Short: "Find and add projects under workspace directories",
+		Long: `Walk one or more directories and add every project found.
+
+A directory is a project if it is a git repository, contains BMAD or Speckit
+artifacts (.bmad, _bmad, specs, .specify, ...), or has Claude Code logs under
+~/.claude/projects. Directories inside a project are not searched further.
+Already tracked projects are skipped; name collisions are resolved
+automatically, as with 'vdash add --force'.
+
+Without arguments, the workspace_roots from the config file are scanned.
+
+Examples:
+  vdash scan ~/work ~/oss          # Add all projects under both roots
+  vdash scan ~/work --depth 1      # Only direct children of ~/work
+  vdash scan --dry-run             # Preview what workspace_roots would add`,
+		RunE: runScan,
+	}
+
+	cmd.Flags().IntVar(&scanDepth, "depth", ports.DefaultScanDepth, "Directory levels to search below each root")
+	cmd.Flags().BoolVar(&scanDryRun, "dry-run", false, "Show what would be added without saving")
+
+	return cmd
+}
+
+// RegisterScanCommand registers the scan command with the given parent command.
+// Used for testing to create fresh command trees.
+func RegisterScanCommand(parent *cobra.Command) {
+	parent.AddCommand(newScanCmd())
+}
+
+func init() {
+	RootCmd.AddCommand(newScanCmd())
+}
+
+// runScan implements the scan command logic.
+func runScan(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if projectScanner == nil {
+		return fmt.Errorf("scanner not initialized")
+	}
+	if scanDepth < 0 {
+		return fmt.Errorf("--depth must be >= 0, got %d", scanDepth)
+	}
+
+	roots := args
+	if len(roots) == 0 {
+		roots = GetConfig().WorkspaceRoots
+	}
+	if len(roots) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "✗ No roots given and workspace_roots is not configured")
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		return fmt.Errorf("no workspace roots")
+	}
+
+	report, err := projectScanner.Scan(ctx, roots, ports.ScanOptions{MaxDepth: scanDepth, DryRun: scanDryRun})
+	if err != nil {
+		return err
+	}
+
+	if IsQuiet() {
+		return nil
+	}
+
+	out := cmd.OutOrStdout()
+	for _, o := range report.Outcomes {
+		switch o.Status {
+		case ports.ScanAdded:
+			fmt.Fprintf(out, "✓ Added: %s%s\n", effectiveName(o.Project), formatScanMethods(o.Methods))
+			fmt.Fprintf(out, "  Path: %s\n", o.Candidate.Path)
+		case ports.ScanWouldAdd:
+			fmt.Fprintf(out, "+ Would add: %s%s\n", effectiveName(o.Project), formatScanMethods(o.Methods))
+			fmt.Fprintf(out, "  Path: %s\n", o.Candidate.Path)
+		case ports.ScanFailed:
+			fmt.Fprintf(out, "✗ Failed: %s: %v\n", o.Candidate.Path, o.Err)
+		}
+	}
+
+	found := len(report.Outcomes)
+	tracked := report.Count(ports.ScanAlreadyTracked)
+	failed := report.Count(ports.ScanFailed)
+	if scanDryRun {
+		fmt.Fprintf(out, "Found %d projects: %d new, %d already tracked (dry run, nothing saved)\n",
+			found, report.Count(ports.ScanWouldAdd), tracked)
+		return nil
+	}
+	fmt.Fprintf(out, "Found %d projects: %d added, %d already tracked", found, report.Count(ports.ScanAdded), tracked)
+	if failed > 0 {
+		fmt.Fprintf(out, ", %d failed", failed)
+	}
+	fmt.Fprintln(out)
+	return nil
+}
+
+// effectiveName returns the display name if set, otherwise the project name.
+func effectiveName(p *domain.Project) string {
+	if p.DisplayName != "" {
+		return p.DisplayName
+	}
+	return p.Name
+}
+
+// formatScanMethods renders detected methodologies, e.g. " (bmad, speckit)".
+func formatScanMethods(methods []string) string {
+	if len(methods) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(methods, ", ") + ")"
+}
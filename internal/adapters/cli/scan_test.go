@@ -0,0 +1,160 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// fakeScanner returns a canned report and records its inputs.
+type fakeScanner struct {
+	report ports.ScanReport
+	err    error
+	roots  []string
+	opts   ports.ScanOptions
+}
+
+func (f *fakeScanner) Scan(_ context.Context, roots []string, opts ports.ScanOptions) (ports.ScanReport, error) {
+	f.roots, f.opts = roots, opts
+	return f.report, f.err
+}
+
+func scanOutcome(path, name, displayName string, status ports.ScanStatus, methods ...string) ports.ScanOutcome {
+	return ports.ScanOutcome{
+		Candidate: ports.ProjectCandidate{Path: path, Markers: []string{".git"}},
+		Status:    status,
+		Project:   &domain.Project{Path: path, Name: name, DisplayName: displayName},
+		Methods:   methods,
+	}
+}
+
+// executeScanCommand runs the scan command and returns output/error.
+func executeScanCommand(args ...string) (string, error) {
+	cli.ResetScanFlags()
+	cmd := cli.NewRootCmd()
+	cli.RegisterScanCommand(cmd)
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	cmd.SetArgs(append([]string{"scan"}, args...))
+
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func setScanner(t *testing.T, s ports.ProjectScanner) {
+	t.Helper()
+	cli.SetProjectScanner(s)
+	t.Cleanup(func() {
+		cli.SetProjectScanner(nil)
+		cli.SetConfig(nil)
+	})
+}
+
+func TestScanCmd_AddsProjects(t *testing.T) {
+	scanner := &fakeScanner{report: ports.ScanReport{Outcomes: []ports.ScanOutcome{
+		scanOutcome("/work/api", "api", "client-api", ports.ScanAdded, "bmad", "speckit"),
+		scanOutcome("/work/web", "web", "", ports.ScanAdded),
+		scanOutcome("/work/old", "old", "", ports.ScanAlreadyTracked),
+		{Candidate: ports.ProjectCandidate{Path: "/work/bad"}, Status: ports.ScanFailed, Err: errors.New("disk full")},
+	}}}
+	setScanner(t, scanner)
+
+	output, err := executeScanCommand("/work", "/oss", "--depth", "2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(scanner.roots) != 2 || scanner.roots[1] != "/oss" {
+		t.Errorf("roots = %v", scanner.roots)
+	}
+	if scanner.opts.MaxDepth != 2 || scanner.opts.DryRun {
+		t.Errorf("opts = %+v", scanner.opts)
+	}
+	for _, want := range []string{
+		"✓ Added: client-api (bmad, speckit)",
+		"  Path: /work/api",
+		"✓ Added: web\n",
+		"✗ Failed: /work/bad: disk full",
+		"Found 4 projects: 2 added, 1 already tracked, 1 failed",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "/work/old") {
+		t.Errorf("already tracked projects should not be listed:\n%s", output)
+	}
+}
+
+func TestScanCmd_DryRun(t *testing.T) {
+	scanner := &fakeScanner{report: ports.ScanReport{Outcomes: []ports.ScanOutcome{
+		scanOutcome("/work/api", "api", "", ports.ScanWouldAdd),
+	}}}
+	setScanner(t, scanner)
+
+	output, err := executeScanCommand("/work", "--dry-run")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !scanner.opts.DryRun {
+		t.Error("expected DryRun option")
+	}
+	if scanner.opts.MaxDepth != ports.DefaultScanDepth {
+		t.Errorf("MaxDepth = %d, want default %d", scanner.opts.MaxDepth, ports.DefaultScanDepth)
+	}
+	if !strings.Contains(output, "+ Would add: api") || !strings.Contains(output, "dry run, nothing saved") {
+		t.Errorf("unexpected output:\n%s", output)
+	}
+}
+
+func TestScanCmd_UsesWorkspaceRoots(t *testing.T) {
+	scanner := &fakeScanner{}
+	setScanner(t, scanner)
+	cfg := ports.NewConfig()
+	cfg.WorkspaceRoots = []string{"~/work", "~/oss"}
+	cli.SetConfig(cfg)
+
+	if _, err := executeScanCommand(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(scanner.roots) != 2 || scanner.roots[0] != "~/work" {
+		t.Errorf("roots = %v, want workspace_roots", scanner.roots)
+	}
+}
+
+func TestScanCmd_NoRoots(t *testing.T) {
+	setScanner(t, &fakeScanner{})
+	cli.SetConfig(nil)
+
+	output, err := executeScanCommand()
+	if err == nil {
+		t.Fatal("expected error without roots")
+	}
+	if !strings.Contains(output, "workspace_roots is not configured") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestScanCmd_InvalidDepth(t *testing.T) {
+	setScanner(t, &fakeScanner{})
+
+	if _, err := executeScanCommand("/work", "--depth", "-1"); err == nil {
+		t.Error("expected error for negative depth")
+	}
+}
+
+func TestScanCmd_ScannerError(t *testing.T) {
+	setScanner(t, &fakeScanner{err: errors.New("discovery failed")})
+
+	if _, err := executeScanCommand("/work"); err == nil || !strings.Contains(err.Error(), "discovery failed") {
+		t.Errorf("expected scanner error, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	httpapi "github.com/JeiKeiLim/vibe-dash/internal/adapters/api/http"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// Package-level flags (same pattern as status.go)
+var serveListen string
+var servePollInterval time.Duration
+
+// ResetServeFlags resets serve command flags for testing.
+// Call this before each test to ensure clean state.
+func ResetServeFlags() {
+	serveListen = httpapi.DefaultListenAddr
+	servePollInterval = httpapi.DefaultPollInterval
+}
+
+// newServeCmd creates the serve command.
+func newServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve project state over a local HTTP/JSON API",
+		Long: `Run a local HTTP server exposing tracked projects as JSON, plus a
+Server-Sent Events stream of live changes for status bars, editor plugins
+and wall displays.
+
+Endpoints (JSON matches 'list --json' / 'status --json'):
+  GET    /v1/projects                    All projects
+  GET    /v1/projects/{name}             One project (name, display name or escaped path)
+  PUT    /v1/projects/{name}/favorite    Mark favorite
+  DELETE /v1/projects/{name}/favorite    Remove favorite
+  PUT    /v1/projects/{name}/note        Set note: {"note": "..."}
+  DELETE /v1/projects/{name}/note        Clear note
+  POST   /v1/projects/{name}/hibernate   Hibernate
+  POST   /v1/projects/{name}/activate    Activate
+  GET    /v1/events                      SSE stream: snapshot, project_updated,
+                                         project_removed, activity
+
+When 'vdash daemon' is running, file activity on the stream is relayed from
+the daemon, which also sends waiting notifications, instead of the server
+watching files itself.
+
+The API has no authentication. It binds to loopback by default and only
+accepts requests whose Host (and Origin, if sent) is a loopback address or
+the IP it listens on, so web pages cannot reach it through DNS rebinding or
+cross-site requests. PUT, DELETE and POST require
+"Content-Type: application/json". Only listen on other interfaces on
+trusted networks.
+
+Examples:
+  vdash serve                              # http://127.0.0.1:7878
+  vdash serve --listen 127.0.0.1:9000      # Custom port
+  curl -N localhost:7878/v1/events         # Follow live changes
+  curl -X POST -H 'Content-Type: application/json' localhost:7878/v1/projects/app/hibernate`,
+		Args: cobra.NoArgs,
+		RunE: runServe,
+	}
+
+	cmd.Flags().StringVar(&serveListen, "listen", httpapi.DefaultListenAddr, "Address to listen on (host:port)")
+	cmd.Flags().DurationVar(&servePollInterval, "poll-interval", httpapi.DefaultPollInterval, "How often to re-check agent and project state")
+
+	return cmd
+}
+
+// RegisterServeCommand registers the serve command with the given parent command.
+// Used for testing to create fresh command trees.
+func RegisterServeCommand(parent *cobra.Command) {
+	parent.AddCommand(newServeCmd())
+}
+
+func init() {
+	RootCmd.AddCommand(newServeCmd())
+}
+
+// runServe implements the serve command logic. Blocks until the command
+// context is cancelled (SIGINT/SIGTERM via main.go).
+func runServe(cmd *cobra.Command, _ []string) error {
+	if repository == nil {
+		return fmt.Errorf("repository not initialized")
+	}
+	if servePollInterval <= 0 {
+		return fmt.Errorf("--poll-interval must be positive, got %s", servePollInterval)
+	}
+
+	ln, err := net.Listen("tcp", serveListen)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", serveListen, err)
+	}
+
+	opts := []httpapi.ServerOption{
+		httpapi.WithWaitingDetector(waitingDetector),
+		httpapi.WithGitInspector(gitInspector),
+		httpapi.WithStateService(stateService),
+		httpapi.WithPollInterval(servePollInterval),
+		httpapi.WithConfigWarning(configWarning),
+	}
+
+	// A running daemon already watches files, records activity and sends
+	// waiting notifications; relay its activity instead of duplicating them
+	relaying := false
+	if d := attachedDaemon(cmd.Context()); d != nil {
+		if feed, ok := d.(ports.ActivityFeed); ok {
+			opts = append(opts, httpapi.WithActivityFeed(feed))
+			relaying = true
+		}
+	}
+	if !relaying {
+		opts = append(opts,
+			httpapi.WithFileWatcher(fileWatcher),
+			httpapi.WithNotificationService(notificationService),
+		)
+	}
+
+	server := httpapi.NewServer(repository, opts...)
+
+	if !IsQuiet() {
+		fmt.Fprintf(cmd.OutOrStdout(), "Serving vibe-dash API on http://%s (Ctrl+C to stop)\n", ln.Addr())
+		if relaying {
+			fmt.Fprintln(cmd.OutOrStdout(), "Relaying file activity from the running daemon")
+		}
+	}
+
+	return server.Serve(cmd.Context(), ln)
+}
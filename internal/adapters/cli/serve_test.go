@@ -0,0 +1,124 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/apitypes"
+)
+
+// syncBuffer is a goroutine-safe output buffer for long-running commands.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func executeServeCommand(ctx context.Context, out *syncBuffer, args []string) error {
+	cli.ResetServeFlags()
+	cmd := cli.NewRootCmd()
+	cli.RegisterServeCommand(cmd)
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs(append([]string{"serve"}, args...))
+	return cmd.ExecuteContext(ctx)
+}
+
+func TestServeCmd_ServesProjectsUntilCancelled(t *testing.T) {
+	cli.SetRepository(newHibernateMockRepository().withProjects([]*domain.Project{
+		{ID: "p1", Path: "/test/alpha", Name: "alpha"},
+	}))
+	t.Cleanup(func() { cli.SetRepository(nil) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var out syncBuffer
+	done := make(chan error, 1)
+	go func() { done <- executeServeCommand(ctx, &out, []string{"--listen", "127.0.0.1:0"}) }()
+
+	// Wait for the banner to learn the chosen port
+	var baseURL string
+	deadline := time.Now().Add(5 * time.Second)
+	for baseURL == "" && time.Now().Before(deadline) {
+		if i := strings.Index(out.String(), "http://"); i >= 0 {
+			baseURL = strings.Fields(out.String()[i:])[0]
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if baseURL == "" {
+		t.Fatalf("server did not start, output: %q", out.String())
+	}
+
+	resp, err := http.Get(baseURL + "/v1/projects")
+	if err != nil {
+		t.Fatalf("GET /v1/projects failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var body apitypes.ListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(body.Projects) != 1 || body.Projects[0].Name != "alpha" {
+		t.Errorf("unexpected projects: %+v", body.Projects)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("serve returned error on shutdown: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve did not stop after cancel")
+	}
+}
+
+func TestServeCmd_InvalidPollInterval(t *testing.T) {
+	cli.SetRepository(newHibernateMockRepository())
+	t.Cleanup(func() { cli.SetRepository(nil) })
+
+	var out syncBuffer
+	err := executeServeCommand(context.Background(), &out, []string{"--poll-interval", "0s"})
+	if err == nil || !strings.Contains(err.Error(), "--poll-interval") {
+		t.Errorf("expected poll interval error, got %v", err)
+	}
+}
+
+func TestServeCmd_AddressInUse(t *testing.T) {
+	cli.SetRepository(newHibernateMockRepository())
+	t.Cleanup(func() { cli.SetRepository(nil) })
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	defer ln.Close()
+
+	var out syncBuffer
+	err = executeServeCommand(context.Background(), &out, []string{"--listen", ln.Addr().String()})
+	if err == nil || !strings.Contains(err.Error(), "failed to listen") {
+		t.Errorf("expected listen error, got %v", err)
+	}
+}
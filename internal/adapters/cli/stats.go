@@ -0,0 +1,318 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/charts"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/timeformat"
+)
+
+// Package-level flags (same pattern as history.go)
+var statsJSON bool
+var statsDays int
+
+// defaultStatsDays is the default reporting window
+const defaultStatsDays = 7
+
+// statsBarWidth is the width of plain-text duration bars
+const statsBarWidth = 20
+
+// statsSparkWidth is the width of the plain-text burndown sparkline
+const statsSparkWidth = 30
+
+// ResetStatsFlags resets stats command flags for testing.
+// Call this before each test to ensure clean state.
+func ResetStatsFlags() {
+	statsJSON = false
+	statsDays = defaultStatsDays
+}
+
+// StatsResponse represents the JSON output structure for progress metrics.
+type StatsResponse struct {
+	APIVersion string         `json:"api_version"` // Schema version (currently "v1")
+	Since      *string        `json:"since"`       // RFC3339 UTC, null for all time
+	Until      string         `json:"until"`       // RFC3339 UTC
+	Projects   []ProjectStats `json:"projects"`
+}
+
+// ProjectStats is one project's aggregated metrics in JSON output.
+// Durations are whole seconds.
+type ProjectStats struct {
+	Name           string          `json:"name"`
+	Samples        int             `json:"samples"`
+	Progress       StatsProgress   `json:"progress"`
+	Burndown       []StatsBurndown `json:"burndown"`
+	TimeInStage    []StatsStage    `json:"time_in_stage"`
+	AgentTime      []StatsAgent    `json:"agent_time"`
+	WaitingByDay   []StatsDay      `json:"waiting_by_day"`
+	WaitingSeconds int64           `json:"waiting_seconds"`
+}
+
+// StatsProgress is the latest sampled work progress.
+type StatsProgress struct {
+	StoriesDone  int `json:"stories_done"`
+	StoriesTotal int `json:"stories_total"`
+	TasksDone    int `json:"tasks_done"`
+	TasksTotal   int `json:"tasks_total"`
+}
+
+// StatsBurndown is one burndown point.
+type StatsBurndown struct {
+	At        string `json:"at"` // RFC3339 UTC
+	Done      int    `json:"done"`
+	Total     int    `json:"total"`
+	Remaining int    `json:"remaining"`
+}
+
+// StatsStage is the time spent in one workflow stage.
+type StatsStage struct {
+	Stage   string `json:"stage"` // lowercase
+	Seconds int64  `json:"seconds"`
+}
+
+// StatsAgent is the time the agent spent in one status.
+type StatsAgent struct {
+	Status  string `json:"status"` // lowercase
+	Seconds int64  `json:"seconds"`
+}
+
+// StatsDay is the agent waiting time on one local day.
+type StatsDay struct {
+	Day     string `json:"day"` // YYYY-MM-DD, local time
+	Seconds int64  `json:"seconds"`
+}
+
+// newStatsCmd creates the stats command.
+func newStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats [project-name]",
+		Short: "Show progress metrics for projects",
+		Long: `Show progress metrics sampled while the dashboard or daemon runs:
+story/task burndown, time spent in each stage, and agent waiting time.
+
+Without a project name, shows every active project. Metrics are stored in
+metrics.db next to the project database.
+
+Examples:
+  vdash stats                       # All active projects, last 7 days
+  vdash stats client-alpha          # One project
+  vdash stats client-alpha --days 0 # All recorded history
+  vdash stats --json                # JSON output`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: projectCompletionFunc,
+		RunE:              runStats,
+	}
+
+	cmd.Flags().BoolVar(&statsJSON, "json", false, "Output as JSON")
+	cmd.Flags().IntVar(&statsDays, "days", defaultStatsDays, "Report the last N days (0 = all time)")
+
+	return cmd
+}
+
+// RegisterStatsCommand registers the stats command with the given parent command.
+// Used for testing to create fresh command trees.
+func RegisterStatsCommand(parent *cobra.Command) {
+	parent.AddCommand(newStatsCmd())
+}
+
+func init() {
+	RootCmd.AddCommand(newStatsCmd())
+}
+
+// runStats implements the stats command logic.
+func runStats(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if metricsCollector == nil {
+		return fmt.Errorf("metrics not initialized")
+	}
+	if repository == nil {
+		return fmt.Errorf("repository not initialized")
+	}
+	if statsDays < 0 {
+		return fmt.Errorf("--days must be >= 0, got %d", statsDays)
+	}
+
+	var projects []*domain.Project
+	if len(args) == 1 {
+		proj, err := findProjectByIdentifier(ctx, args[0])
+		if err != nil {
+			if errors.Is(err, domain.ErrProjectNotFound) {
+				fmt.Fprintf(cmd.OutOrStdout(), "✗ Project not found: %s\n", args[0])
+				cmd.SilenceErrors = true
+				cmd.SilenceUsage = true
+			}
+			return err
+		}
+		projects = []*domain.Project{proj}
+	} else {
+		active, err := repository.FindActive(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list projects: %w", err)
+		}
+		project.SortByName(active)
+		projects = active
+	}
+
+	var since time.Time
+	if statsDays > 0 {
+		since = time.Now().AddDate(0, 0, -statsDays)
+	}
+
+	all := make([]*domain.ProjectStats, 0, len(projects))
+	for _, p := range projects {
+		stats, err := metricsCollector.ProjectStats(ctx, p.ID, since)
+		if err != nil {
+			return fmt.Errorf("failed to load stats for %s: %w", project.EffectiveName(p), err)
+		}
+		all = append(all, stats)
+	}
+
+	if statsJSON {
+		return formatStatsJSON(cmd, projects, all, since)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(projects) == 0 {
+		fmt.Fprintln(out, "No active projects.")
+		return nil
+	}
+	for i, p := range projects {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		formatStatsPlainText(out, p, all[i])
+	}
+	return nil
+}
+
+// formatStatsPlainText prints one project's stats block.
+func formatStatsPlainText(out io.Writer, p *domain.Project, s *domain.ProjectStats) {
+	name := project.EffectiveName(p)
+	if s.Samples == 0 {
+		fmt.Fprintf(out, "%s: no metrics recorded yet\n", name)
+		return
+	}
+
+	fmt.Fprintf(out, "%s (%d samples)\n", styles.TitleStyle.Render(name), s.Samples)
+
+	if s.Progress.Total() > 0 {
+		fmt.Fprintf(out, "  Progress  %d/%d done, %d remaining\n", s.Progress.Done(), s.Progress.Total(), s.Progress.Remaining())
+	}
+	if len(s.Burndown) > 0 {
+		remaining := make([]int, len(s.Burndown))
+		for i, b := range s.Burndown {
+			remaining[i] = b.Remaining
+		}
+		fmt.Fprintf(out, "  Burndown  %s\n", charts.Sparkline(remaining, statsSparkWidth))
+	}
+
+	if len(s.TimeInStage) > 0 {
+		rows := make([]statsRow, len(s.TimeInStage))
+		for i, d := range s.TimeInStage {
+			rows[i] = statsRow{d.Stage.String(), d.Duration}
+		}
+		printStatsRows(out, "Stage", rows)
+	}
+	if len(s.AgentTime) > 0 {
+		rows := make([]statsRow, len(s.AgentTime))
+		for i, d := range s.AgentTime {
+			rows[i] = statsRow{d.Status.String(), d.Duration}
+		}
+		printStatsRows(out, "Agent", rows)
+	}
+	if len(s.WaitingByDay) > 0 {
+		rows := make([]statsRow, len(s.WaitingByDay))
+		for i, d := range s.WaitingByDay {
+			rows[i] = statsRow{d.Day.Format("2006-01-02"), d.Duration}
+		}
+		printStatsRows(out, "Waiting", rows)
+	}
+}
+
+// statsRow is one labelled duration bar.
+type statsRow struct {
+	label    string
+	duration time.Duration
+}
+
+// printStatsRows prints a titled group of bars scaled to the longest row:
+// "  Stage     Plan        ████▌                 2h 15m"
+func printStatsRows(out io.Writer, title string, rows []statsRow) {
+	var longest time.Duration
+	for _, r := range rows {
+		longest = max(longest, r.duration)
+	}
+	for i, r := range rows {
+		heading := ""
+		if i == 0 {
+			heading = title
+		}
+		bar := charts.Bar(float64(r.duration), float64(longest), statsBarWidth)
+		pad := strings.Repeat(" ", statsBarWidth-len([]rune(bar)))
+		fmt.Fprintf(out, "  %-8s  %-10s  %s%s  %s\n", heading, r.label, bar, pad, timeformat.FormatWaitingDuration(r.duration, true))
+	}
+}
+
+// formatStatsJSON writes stats as JSON.
+func formatStatsJSON(cmd *cobra.Command, projects []*domain.Project, all []*domain.ProjectStats, since time.Time) error {
+	response := StatsResponse{
+		APIVersion: "v1",
+		Until:      time.Now().UTC().Format(time.RFC3339),
+		Projects:   make([]ProjectStats, 0, len(projects)), // [] not null when empty
+	}
+	if !since.IsZero() {
+		response.Since = optionalString(since.UTC().Format(time.RFC3339))
+	}
+
+	for i, p := range projects {
+		s := all[i]
+		ps := ProjectStats{
+			Name:    project.EffectiveName(p),
+			Samples: s.Samples,
+			Progress: StatsProgress{
+				StoriesDone:  s.Progress.StoriesDone,
+				StoriesTotal: s.Progress.StoriesTotal,
+				TasksDone:    s.Progress.TasksDone,
+				TasksTotal:   s.Progress.TasksTotal,
+			},
+			Burndown:       make([]StatsBurndown, 0, len(s.Burndown)),
+			TimeInStage:    make([]StatsStage, 0, len(s.TimeInStage)),
+			AgentTime:      make([]StatsAgent, 0, len(s.AgentTime)),
+			WaitingByDay:   make([]StatsDay, 0, len(s.WaitingByDay)),
+			WaitingSeconds: int64(s.WaitingTime().Seconds()),
+		}
+		for _, b := range s.Burndown {
+			ps.Burndown = append(ps.Burndown, StatsBurndown{
+				At:        b.At.UTC().Format(time.RFC3339),
+				Done:      b.Done,
+				Total:     b.Total,
+				Remaining: b.Remaining,
+			})
+		}
+		for _, d := range s.TimeInStage {
+			ps.TimeInStage = append(ps.TimeInStage, StatsStage{strings.ToLower(d.Stage.String()), int64(d.Duration.Seconds())})
+		}
+		for _, d := range s.AgentTime {
+			ps.AgentTime = append(ps.AgentTime, StatsAgent{strings.ToLower(d.Status.String()), int64(d.Duration.Seconds())})
+		}
+		for _, d := range s.WaitingByDay {
+			ps.WaitingByDay = append(ps.WaitingByDay, StatsDay{d.Day.Format("2006-01-02"), int64(d.Duration.Seconds())})
+		}
+		response.Projects = append(response.Projects, ps)
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(response)
+}
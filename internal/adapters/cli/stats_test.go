@@ -0,0 +1,194 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// mockMetricsCollector implements ports.MetricsCollector for CLI tests.
+type mockMetricsCollector struct {
+	stats     map[string]domain.ProjectStats
+	lastSince time.Time
+	deleted   []string
+}
+
+func (m *mockMetricsCollector) Collect(context.Context) (int, error) { return 0, nil }
+
+func (m *mockMetricsCollector) ProjectStats(_ context.Context, projectID string, since time.Time) (*domain.ProjectStats, error) {
+	m.lastSince = since
+	s := m.stats[projectID]
+	s.ProjectID = projectID
+	return &s, nil
+}
+
+func (m *mockMetricsCollector) DeleteProjectStats(_ context.Context, projectID string) error {
+	m.deleted = append(m.deleted, projectID)
+	return nil
+}
+
+func executeStatsCommand(args []string) (string, error) {
+	cli.ResetStatsFlags()
+	cmd := cli.NewRootCmd()
+	cli.RegisterStatsCommand(cmd)
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	cmd.SetArgs(append([]string{"stats"}, args...))
+
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func setupStatsTest(t *testing.T) *mockMetricsCollector {
+	t.Helper()
+	projects := []*domain.Project{
+		{ID: "p1", Path: "/test/alpha", Name: "alpha", State: domain.StateActive},
+		{ID: "p2", Path: "/test/beta", Name: "beta", State: domain.StateActive},
+		{ID: "p3", Path: "/test/gamma", Name: "gamma", State: domain.StateHibernated},
+	}
+	at := time.Date(2026, 1, 11, 14, 0, 0, 0, time.UTC)
+	day := time.Date(2026, 1, 11, 0, 0, 0, 0, time.Local)
+	metrics := &mockMetricsCollector{stats: map[string]domain.ProjectStats{
+		"p1": {
+			Samples:  12,
+			Progress: domain.WorkProgress{StoriesDone: 3, StoriesTotal: 5},
+			Burndown: []domain.BurndownPoint{
+				{At: at, Done: 1, Total: 5, Remaining: 4},
+				{At: at.Add(time.Hour), Done: 3, Total: 5, Remaining: 2},
+			},
+			TimeInStage: []domain.StageDuration{
+				{Stage: domain.StagePlan, Duration: 30 * time.Minute},
+				{Stage: domain.StageImplement, Duration: 2 * time.Hour},
+			},
+			AgentTime: []domain.AgentDuration{
+				{Status: domain.AgentWorking, Duration: 2 * time.Hour},
+				{Status: domain.AgentWaitingForUser, Duration: 30 * time.Minute},
+			},
+			WaitingByDay: []domain.DailyDuration{{Day: day, Duration: 30 * time.Minute}},
+		},
+	}}
+
+	cli.SetRepository(newHibernateMockRepository().withProjects(projects))
+	cli.SetMetricsCollector(metrics)
+	t.Cleanup(func() {
+		cli.SetRepository(nil)
+		cli.SetMetricsCollector(nil)
+	})
+	return metrics
+}
+
+func TestStatsCmd_PlainText(t *testing.T) {
+	setupStatsTest(t)
+
+	output, err := executeStatsCommand(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"alpha (12 samples)",
+		"Progress  3/5 done, 2 remaining",
+		"Burndown",
+		"Implement",
+		"2h 0m",
+		"Waiting",
+		"2026-01-11",
+		"beta: no metrics recorded yet",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "gamma") {
+		t.Errorf("hibernated projects should be skipped:\n%s", output)
+	}
+}
+
+func TestStatsCmd_JSON(t *testing.T) {
+	setupStatsTest(t)
+
+	output, err := executeStatsCommand([]string{"alpha", "--json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var resp cli.StatsResponse
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if resp.APIVersion != "v1" || resp.Since == nil || len(resp.Projects) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	p := resp.Projects[0]
+	if p.Name != "alpha" || p.Samples != 12 || p.Progress.StoriesDone != 3 {
+		t.Errorf("unexpected project: %+v", p)
+	}
+	if len(p.Burndown) != 2 || p.Burndown[1].Remaining != 2 || p.Burndown[0].At != "2026-01-11T14:00:00Z" {
+		t.Errorf("Burndown = %+v", p.Burndown)
+	}
+	if len(p.TimeInStage) != 2 || p.TimeInStage[1].Stage != "implement" || p.TimeInStage[1].Seconds != 7200 {
+		t.Errorf("TimeInStage = %+v", p.TimeInStage)
+	}
+	if len(p.AgentTime) != 2 || p.AgentTime[1].Status != "waiting" {
+		t.Errorf("AgentTime = %+v", p.AgentTime)
+	}
+	if p.WaitingSeconds != 1800 || len(p.WaitingByDay) != 1 || p.WaitingByDay[0].Day != "2026-01-11" {
+		t.Errorf("waiting = %d, %+v", p.WaitingSeconds, p.WaitingByDay)
+	}
+}
+
+func TestStatsCmd_JSONEmptyArrays(t *testing.T) {
+	setupStatsTest(t)
+
+	output, err := executeStatsCommand([]string{"beta", "--json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, `"burndown": []`) || strings.Contains(output, "null,") {
+		t.Errorf("empty stats should render [] not null:\n%s", output)
+	}
+}
+
+func TestStatsCmd_Days(t *testing.T) {
+	metrics := setupStatsTest(t)
+
+	if _, err := executeStatsCommand([]string{"alpha", "--days", "0"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !metrics.lastSince.IsZero() {
+		t.Errorf("--days 0 should query all time, got since %v", metrics.lastSince)
+	}
+
+	if _, err := executeStatsCommand([]string{"alpha", "--days", "-1"}); err == nil {
+		t.Error("negative --days should fail")
+	}
+}
+
+func TestStatsCmd_ProjectNotFound(t *testing.T) {
+	setupStatsTest(t)
+
+	output, err := executeStatsCommand([]string{"missing"})
+	if err == nil {
+		t.Fatal("expected error for unknown project")
+	}
+	if !strings.Contains(output, "✗ Project not found: missing") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestStatsCmd_NotInitialized(t *testing.T) {
+	cli.SetMetricsCollector(nil)
+
+	_, err := executeStatsCommand(nil)
+	if err == nil || !strings.Contains(err.Error(), "metrics not initialized") {
+		t.Errorf("expected 'metrics not initialized' error, got %v", err)
+	}
+}
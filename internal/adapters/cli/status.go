@@ -6,12 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/filesystem"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/apitypes"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/timeformat"
 )
@@ -29,14 +29,6 @@ func ResetStatusFlags() {
 	statusAll = false
 }
 
-// StatusResponse represents the JSON output structure for single project.
-// Different from ListResponse which uses "projects" array.
-type StatusResponse struct {
-	APIVersion    string         `json:"api_version"`              // Schema version (currently "v1")
-	Project       ProjectSummary `json:"project"`                  // Single project object (NOT array)
-	ConfigWarning *string        `json:"config_warning,omitempty"` // Story 7.2: Config error message (null if no error)
-}
-
 // newStatusCmd creates the status command.
 func newStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -173,12 +165,12 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	if statusJSON {
 		return formatStatusJSON(ctx, cmd, proj)
 	}
-	formatStatusPlainText(cmd, proj)
+	formatStatusPlainText(ctx, cmd, proj)
 	return nil
 }
 
 // formatStatusPlainText formats a single project as indented key-value pairs.
-func formatStatusPlainText(cmd *cobra.Command, p *domain.Project) {
+func formatStatusPlainText(ctx context.Context, cmd *cobra.Command, p *domain.Project) {
 	// First line: effective name (DisplayName if set, else Name)
 	name := p.Name
 	if p.DisplayName != "" {
@@ -194,7 +186,23 @@ func formatStatusPlainText(cmd *cobra.Command, p *domain.Project) {
 		method = strings.ToUpper(method[:1]) + method[1:]
 	}
 	fmt.Fprintf(cmd.OutOrStdout(), "  Method:      %s\n", method)
-	fmt.Fprintf(cmd.OutOrStdout(), "  Stage:       %s\n", p.CurrentStage.String())
+	stage := p.StageLabel()
+	if !p.MethodStage.IsZero() && p.MethodStage.Label != p.CurrentStage.String() {
+		stage += " (" + p.CurrentStage.String() + ")"
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "  Stage:       %s\n", stage)
+	if p.TasksTotal > 0 {
+		tasks := fmt.Sprintf("%d/%d done", p.TasksDone, p.TasksTotal)
+		if p.NextTask != "" {
+			tasks += ", " + nextTaskLabel(p) + ": " + p.NextTask
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "  Tasks:       %s\n", tasks)
+	}
+	if p.Sprint != nil {
+		epicsDone, epicsTotal := p.Sprint.EpicCounts()
+		storiesDone, storiesTotal := p.Sprint.StoryCounts()
+		fmt.Fprintf(cmd.OutOrStdout(), "  Sprint:      %d/%d epics, %d/%d stories done\n", epicsDone, epicsTotal, storiesDone, storiesTotal)
+	}
 	fmt.Fprintf(cmd.OutOrStdout(), "  Confidence:  %s\n", p.Confidence.String())
 	fmt.Fprintf(cmd.OutOrStdout(), "  State:       %s\n", p.State.String())
 
@@ -209,63 +217,53 @@ func formatStatusPlainText(cmd *cobra.Command, p *domain.Project) {
 	}
 
 	fmt.Fprintf(cmd.OutOrStdout(), "  Last Active: %s\n", timeformat.FormatRelativeTime(p.LastActivityAt))
-}
 
-// formatStatusJSON formats a single project as JSON output.
-func formatStatusJSON(ctx context.Context, cmd *cobra.Command, p *domain.Project) error {
-	// Build ProjectSummary using same nullable patterns as list.go:147-189
-	var displayName *string
-	if p.DisplayName != "" {
-		displayName = &p.DisplayName
+	if gitInspector != nil {
+		if status, err := gitInspector.Inspect(ctx, p.Path); err == nil && status != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "  Git:         %s\n", formatGitLine(*status))
+		}
 	}
+}
 
-	var notes *string
-	if p.Notes != "" {
-		notes = &p.Notes
+// formatGitLine renders a one-line repository summary:
+// "main ↑2 ↓1 origin/main, 3 modified, 1 untracked".
+func formatGitLine(s domain.GitStatus) string {
+	parts := []string{s.BranchLabel()}
+	if s.Ahead > 0 {
+		parts = append(parts, fmt.Sprintf("↑%d", s.Ahead))
 	}
-
-	var detectionReasoning *string
-	if p.DetectionReasoning != "" {
-		detectionReasoning = &p.DetectionReasoning
+	if s.Behind > 0 {
+		parts = append(parts, fmt.Sprintf("↓%d", s.Behind))
 	}
-
-	isWaiting := false
-	var waitingMinutes *int
-	if waitingDetector != nil {
-		isWaiting = waitingDetector.IsWaiting(ctx, p)
-		if isWaiting {
-			mins := int(waitingDetector.WaitingDuration(ctx, p).Minutes())
-			waitingMinutes = &mins
-		}
+	if s.Upstream != "" {
+		parts = append(parts, s.Upstream)
 	}
+	return strings.Join(parts, " ") + ", " + s.ChangeSummary()
+}
 
+// formatStatusJSON formats a single project as JSON output.
+func formatStatusJSON(ctx context.Context, cmd *cobra.Command, p *domain.Project) error {
 	// Story 7.2: Include config warning if present (AC7)
 	var cfgWarning *string
 	if configWarning != "" {
 		cfgWarning = &configWarning
 	}
 
-	response := StatusResponse{
+	response := apitypes.StatusResponse{
 		APIVersion:    statusAPIVersion,
 		ConfigWarning: cfgWarning,
-		Project: ProjectSummary{
-			Name:                   p.Name,
-			DisplayName:            displayName,
-			Path:                   p.Path,
-			Method:                 p.DetectedMethod,
-			Stage:                  strings.ToLower(p.CurrentStage.String()),
-			Confidence:             strings.ToLower(p.Confidence.String()),
-			State:                  strings.ToLower(p.State.String()),
-			IsFavorite:             p.IsFavorite,
-			IsWaiting:              isWaiting,
-			WaitingDurationMinutes: waitingMinutes,
-			Notes:                  notes,
-			DetectionReasoning:     detectionReasoning,
-			LastActivityAt:         p.LastActivityAt.UTC().Format(time.RFC3339),
-		},
+		Project:       apitypes.NewProjectSummary(ctx, p, waitingDetector, gitInspector),
 	}
 
 	encoder := json.NewEncoder(cmd.OutOrStdout())
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(response)
 }
+
+// nextTaskLabel names the next task with its phase: "next in Phase 2".
+func nextTaskLabel(p *domain.Project) string {
+	if p.NextTaskPhase == "" {
+		return "next"
+	}
+	return "next in " + domain.ShortPhaseName(p.NextTaskPhase)
+}
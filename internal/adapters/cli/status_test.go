@@ -2,6 +2,7 @@ package cli_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"os"
 	"path/filepath"
@@ -43,6 +44,9 @@ func TestStatus_PlainText_SingleProject(t *testing.T) {
 	p1.State = domain.StateActive
 	p1.IsFavorite = false
 	p1.Notes = "Waiting on API specs"
+	p1.TasksDone = 2
+	p1.TasksTotal = 40
+	p1.NextTask = "T003 Create User model"
 	p1.LastActivityAt = time.Now().Add(-2 * time.Hour)
 	mock.Projects[p1.Path] = p1
 	cli.SetRepository(mock)
@@ -68,6 +72,9 @@ func TestStatus_PlainText_SingleProject(t *testing.T) {
 	if !strings.Contains(output, "  Stage:") {
 		t.Errorf("expected Stage in output, got: %s", output)
 	}
+	if !strings.Contains(output, "  Tasks:       2/40 done, next: T003 Create User model") {
+		t.Errorf("expected Tasks in output, got: %s", output)
+	}
 	if !strings.Contains(output, "  Confidence:") {
 		t.Errorf("expected Confidence in output, got: %s", output)
 	}
@@ -143,6 +150,11 @@ func TestStatus_JSON_SingleProject(t *testing.T) {
 	p1.IsFavorite = false
 	p1.Notes = "Waiting on API specs"
 	p1.DetectionReasoning = "plan.md exists"
+	p1.TasksDone = 3
+	p1.TasksTotal = 8
+	p1.NextTask = "T004 Implement password hashing"
+	p1.NextTaskPhase = "Phase 2: Foundational"
+	p1.TaskPhases = []domain.TaskPhase{{Name: "Phase 1: Setup", Done: 3, Total: 3}, {Name: "Phase 2: Foundational", Total: 5, Parallel: 2}}
 	p1.LastActivityAt = time.Now().Add(-45 * time.Minute)
 	mock.Projects[p1.Path] = p1
 	cli.SetRepository(mock)
@@ -176,7 +188,17 @@ func TestStatus_JSON_SingleProject(t *testing.T) {
 			WaitingDurationMinutes *int    `json:"waiting_duration_minutes"`
 			Notes                  *string `json:"notes"`
 			DetectionReasoning     *string `json:"detection_reasoning"`
-			LastActivityAt         string  `json:"last_activity_at"`
+			TasksDone              *int    `json:"tasks_done"`
+			TasksTotal             *int    `json:"tasks_total"`
+			NextTask               *string `json:"next_task"`
+			NextTaskPhase          *string `json:"next_task_phase"`
+			TaskPhases             []struct {
+				Name     string `json:"name"`
+				Done     int    `json:"done"`
+				Total    int    `json:"total"`
+				Parallel int    `json:"parallel"`
+			} `json:"task_phases"`
+			LastActivityAt string `json:"last_activity_at"`
 		} `json:"project"`
 		Projects interface{} `json:"projects"` // Should be null/missing
 	}
@@ -229,6 +251,18 @@ func TestStatus_JSON_SingleProject(t *testing.T) {
 	if proj.DetectionReasoning == nil || *proj.DetectionReasoning != "plan.md exists" {
 		t.Errorf("expected detection_reasoning 'plan.md exists', got %v", proj.DetectionReasoning)
 	}
+	if proj.TasksDone == nil || *proj.TasksDone != 3 || proj.TasksTotal == nil || *proj.TasksTotal != 8 {
+		t.Errorf("expected tasks 3/8, got %v/%v", proj.TasksDone, proj.TasksTotal)
+	}
+	if proj.NextTask == nil || *proj.NextTask != "T004 Implement password hashing" {
+		t.Errorf("expected next_task T004, got %v", proj.NextTask)
+	}
+	if proj.NextTaskPhase == nil || *proj.NextTaskPhase != "Phase 2: Foundational" {
+		t.Errorf("expected next_task_phase Phase 2, got %v", proj.NextTaskPhase)
+	}
+	if len(proj.TaskPhases) != 2 || proj.TaskPhases[1].Total != 5 || proj.TaskPhases[1].Parallel != 2 {
+		t.Errorf("expected 2 task_phases with Phase 2 at 0/5 and 2 parallel, got %+v", proj.TaskPhases)
+	}
 	if proj.DisplayName != nil {
 		t.Errorf("expected display_name to be null, got %v", proj.DisplayName)
 	}
@@ -244,6 +278,179 @@ func TestStatus_JSON_SingleProject(t *testing.T) {
 // Task 5.3: Test project not found returns exit code 2
 // ============================================================================
 
+func TestStatus_Sprint(t *testing.T) {
+	mock := NewMockRepository()
+	p1, _ := domain.NewProject("/home/user/projects/bmad-app", "")
+	p1.DetectedMethod = "bmad"
+	p1.CurrentStage = domain.StageTasks
+	p1.Confidence = domain.ConfidenceCertain
+	p1.Sprint = &domain.SprintProgress{
+		Epics: []domain.SprintEpic{
+			{Key: "epic-1", ID: "1", Status: domain.WorkDone, Retrospective: domain.WorkDone,
+				Stories: []domain.SprintStory{{Key: "1-1-setup", ID: "1.1", Status: domain.WorkDone, RawStatus: "done"}}},
+			{Key: "epic-2", ID: "2", Status: domain.WorkInProgress,
+				Stories: []domain.SprintStory{
+					{Key: "2-1-login", ID: "2.1", Status: domain.WorkDone, RawStatus: "completed"},
+					{Key: "2-2-logout", ID: "2.2", Status: domain.WorkReview, RawStatus: "review"},
+				}},
+			{Key: "epic-3", ID: "3", Status: domain.WorkDeferred},
+		},
+		Focus:    &domain.SprintFocus{Kind: domain.FocusStory, Epic: "2", Story: "2.2", Status: domain.WorkReview},
+		Warnings: []domain.SprintWarning{{Kind: domain.WarningOrphanStory, Key: "9-1-x", Detail: "9.1"}},
+	}
+	p1.LastActivityAt = time.Now()
+	mock.Projects[p1.Path] = p1
+	cli.SetRepository(mock)
+
+	output, err := executeStatusCommand([]string{"bmad-app"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.Contains(output, "  Sprint:      1/2 epics, 2/3 stories done") {
+		t.Errorf("expected Sprint line in output, got: %s", output)
+	}
+
+	output, err = executeStatusCommand([]string{"bmad-app", "--json"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var resp struct {
+		Project struct {
+			Sprint *struct {
+				EpicsDone    int `json:"epics_done"`
+				EpicsTotal   int `json:"epics_total"`
+				StoriesDone  int `json:"stories_done"`
+				StoriesTotal int `json:"stories_total"`
+				Focus        *struct {
+					Kind   string  `json:"kind"`
+					Epic   *string `json:"epic"`
+					Story  *string `json:"story"`
+					Status string  `json:"status"`
+				} `json:"focus"`
+				Epics []struct {
+					ID            string  `json:"id"`
+					Status        string  `json:"status"`
+					Retrospective *string `json:"retrospective"`
+					Stories       []struct {
+						ID        string `json:"id"`
+						Status    string `json:"status"`
+						RawStatus string `json:"raw_status"`
+					} `json:"stories"`
+				} `json:"epics"`
+				Warnings []struct {
+					Kind    string `json:"kind"`
+					Key     string `json:"key"`
+					Message string `json:"message"`
+				} `json:"warnings"`
+			} `json:"sprint"`
+		} `json:"project"`
+	}
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		t.Fatalf("failed to parse JSON: %v\nOutput: %s", err, output)
+	}
+
+	sprint := resp.Project.Sprint
+	if sprint == nil {
+		t.Fatalf("expected sprint object, got null: %s", output)
+	}
+	if sprint.EpicsDone != 1 || sprint.EpicsTotal != 2 || sprint.StoriesDone != 2 || sprint.StoriesTotal != 3 {
+		t.Errorf("counts = %d/%d epics %d/%d stories, want 1/2 and 2/3",
+			sprint.EpicsDone, sprint.EpicsTotal, sprint.StoriesDone, sprint.StoriesTotal)
+	}
+	if sprint.Focus == nil || sprint.Focus.Kind != "story" || sprint.Focus.Story == nil || *sprint.Focus.Story != "2.2" || sprint.Focus.Status != "review" {
+		t.Errorf("unexpected focus: %+v", sprint.Focus)
+	}
+	if len(sprint.Epics) != 3 || sprint.Epics[2].Status != "deferred" || len(sprint.Epics[2].Stories) != 0 {
+		t.Fatalf("unexpected epics: %+v", sprint.Epics)
+	}
+	if sprint.Epics[0].Retrospective == nil || *sprint.Epics[0].Retrospective != "done" || sprint.Epics[1].Retrospective != nil {
+		t.Errorf("unexpected retrospectives: %+v", sprint.Epics)
+	}
+	if story := sprint.Epics[1].Stories[0]; story.ID != "2.1" || story.Status != "done" || story.RawStatus != "completed" {
+		t.Errorf("unexpected story: %+v", story)
+	}
+	if len(sprint.Warnings) != 1 || sprint.Warnings[0].Kind != "orphan-story" || sprint.Warnings[0].Message != "orphan story 9.1" {
+		t.Errorf("unexpected warnings: %+v", sprint.Warnings)
+	}
+}
+
+func TestStatus_Sprint_NullForOtherMethods(t *testing.T) {
+	mock := NewMockRepository()
+	p1, _ := domain.NewProject("/home/user/projects/speckit-app", "")
+	p1.DetectedMethod = "speckit"
+	p1.LastActivityAt = time.Now()
+	mock.Projects[p1.Path] = p1
+	cli.SetRepository(mock)
+
+	output, err := executeStatusCommand([]string{"speckit-app", "--json"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.Contains(output, `"sprint": null`) {
+		t.Errorf("expected \"sprint\": null, got: %s", output)
+	}
+	if strings.Contains(output, "Sprint:") {
+		t.Errorf("unexpected Sprint line: %s", output)
+	}
+}
+
+func TestStatus_MethodStage(t *testing.T) {
+	mock := NewMockRepository()
+	p1, _ := domain.NewProject("/home/user/projects/bmad-app", "")
+	p1.DetectedMethod = "bmad"
+	p1.CurrentStage = domain.StageTasks
+	p1.MethodStage = domain.MethodStage{ID: "code-review", Label: "Code review", Ordinal: 6, Coarse: domain.StageTasks}
+	p1.LastActivityAt = time.Now()
+	p2, _ := domain.NewProject("/home/user/projects/plain-app", "")
+	p2.DetectedMethod = "speckit"
+	p2.CurrentStage = domain.StagePlan
+	p2.LastActivityAt = time.Now()
+	mock.Projects[p1.Path] = p1
+	mock.Projects[p2.Path] = p2
+	cli.SetRepository(mock)
+
+	output, err := executeStatusCommand([]string{"bmad-app"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.Contains(output, "  Stage:       Code review (Tasks)") {
+		t.Errorf("expected method stage in Stage line, got: %s", output)
+	}
+
+	codeReview := "code-review"
+	for _, tt := range []struct {
+		name  string
+		id    *string
+		label string
+	}{
+		{"bmad-app", &codeReview, "Code review"},
+		{"plain-app", nil, "Plan"},
+	} {
+		output, err := executeStatusCommand([]string{tt.name, "--json"})
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		var resp struct {
+			Project struct {
+				Stage      string  `json:"stage"`
+				StageID    *string `json:"stage_id"`
+				StageLabel string  `json:"stage_label"`
+				StageDone  bool    `json:"stage_done"`
+			} `json:"project"`
+		}
+		if err := json.Unmarshal([]byte(output), &resp); err != nil {
+			t.Fatalf("failed to parse JSON: %v\nOutput: %s", err, output)
+		}
+		got := resp.Project
+		if (got.StageID == nil) != (tt.id == nil) || (got.StageID != nil && *got.StageID != *tt.id) {
+			t.Errorf("%s: stage_id = %v, want %v", tt.name, got.StageID, tt.id)
+		}
+		if got.StageLabel != tt.label || got.StageDone {
+			t.Errorf("%s: stage_label = %q done %v, want %q", tt.name, got.StageLabel, got.StageDone, tt.label)
+		}
+	}
+}
+
 func TestStatus_ProjectNotFound(t *testing.T) {
 	mock := NewMockRepository()
 	cli.SetRepository(mock)
@@ -545,3 +752,87 @@ func TestStatus_LookupPriority_NameBeforeDisplayName(t *testing.T) {
 
 // Note: MockWaitingDetector is reused from list_test.go (same package)
 // No need to duplicate it here.
+
+// fakeGitInspector returns a fixed status for every path.
+type fakeGitInspector struct {
+	status *domain.GitStatus
+}
+
+func (f *fakeGitInspector) Inspect(context.Context, string) (*domain.GitStatus, error) {
+	return f.status, nil
+}
+
+func TestStatus_GitInfo(t *testing.T) {
+	mock := NewMockRepository()
+	p, _ := domain.NewProject("/home/user/git-app", "")
+	mock.Projects[p.Path] = p
+	cli.SetRepository(mock)
+	cli.SetGitInspector(&fakeGitInspector{status: &domain.GitStatus{
+		Branch: "main", HeadSHA: "0123456789abcdef0123456789abcdef01234567",
+		Upstream: "origin/main", Ahead: 2, Behind: 1, Dirty: 3,
+		LastCommitSubject: "Fix parser", LastCommitAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}})
+	defer cli.SetGitInspector(nil)
+
+	output, err := executeStatusCommand([]string{"git-app"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.Contains(output, "  Git:         main ↑2 ↓1 origin/main, 3 modified") {
+		t.Errorf("plain text missing git line:\n%s", output)
+	}
+
+	output, err = executeStatusCommand([]string{"git-app", "--json"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var response struct {
+		Project struct {
+			Git *struct {
+				Branch     string `json:"branch"`
+				Upstream   string `json:"upstream"`
+				Ahead      int    `json:"ahead"`
+				Behind     int    `json:"behind"`
+				Dirty      int    `json:"dirty"`
+				LastCommit struct {
+					Subject     string `json:"subject"`
+					CommittedAt string `json:"committed_at"`
+				} `json:"last_commit"`
+				Worktrees []any `json:"worktrees"`
+			} `json:"git"`
+		} `json:"project"`
+	}
+	if err := json.Unmarshal([]byte(output), &response); err != nil {
+		t.Fatalf("invalid JSON: %v\nOutput: %s", err, output)
+	}
+	g := response.Project.Git
+	if g == nil {
+		t.Fatalf("expected git object, got null:\n%s", output)
+	}
+	if g.Branch != "main" || g.Upstream != "origin/main" || g.Ahead != 2 || g.Behind != 1 || g.Dirty != 3 {
+		t.Errorf("unexpected git summary: %+v", *g)
+	}
+	if g.LastCommit.Subject != "Fix parser" || g.LastCommit.CommittedAt != "2026-01-02T03:04:05Z" {
+		t.Errorf("unexpected last_commit: %+v", g.LastCommit)
+	}
+	if g.Worktrees == nil {
+		t.Error("worktrees should be an empty array, not null")
+	}
+}
+
+func TestStatus_GitInfo_NotARepository(t *testing.T) {
+	mock := NewMockRepository()
+	p, _ := domain.NewProject("/home/user/plain", "")
+	mock.Projects[p.Path] = p
+	cli.SetRepository(mock)
+	cli.SetGitInspector(&fakeGitInspector{})
+	defer cli.SetGitInspector(nil)
+
+	output, err := executeStatusCommand([]string{"plain", "--json"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.Contains(output, `"git": null`) {
+		t.Errorf("expected git to be null:\n%s", output)
+	}
+}
@@ -13,12 +13,14 @@ func resetTestState() {
 	quiet = false
 	configFile = ""
 	waitingThreshold = -1
+	noColor = false
 	// Reset persistent flags to their defaults (ignore errors as flags always exist)
 	_ = RootCmd.PersistentFlags().Set("verbose", "false")
 	_ = RootCmd.PersistentFlags().Set("debug", "false")
 	_ = RootCmd.PersistentFlags().Set("quiet", "false")
 	_ = RootCmd.PersistentFlags().Set("config", "")
 	_ = RootCmd.PersistentFlags().Set("waiting-threshold", "-1")
+	_ = RootCmd.PersistentFlags().Set("no-color", "false")
 	// Clear any previous args
 	RootCmd.SetArgs(nil)
 }
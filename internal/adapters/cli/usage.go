@@ -0,0 +1,419 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/usageformat"
+)
+
+// Package-level flags (same pattern as history.go)
+var usageJSON bool
+var usageSince string
+
+// defaultUsageSince is the default reporting window
+const defaultUsageSince = "30d"
+
+// usageDayLayout formats local calendar days
+const usageDayLayout = "2006-01-02"
+
+// ResetUsageFlags resets usage command flags for testing.
+// Call this before each test to ensure clean state.
+func ResetUsageFlags() {
+	usageJSON = false
+	usageSince = defaultUsageSince
+}
+
+// UsageResponse represents the JSON output structure for token usage.
+// Costs are estimates in USD from the price table.
+type UsageResponse struct {
+	APIVersion string         `json:"api_version"` // Schema version (currently "v1")
+	Since      *string        `json:"since"`       // YYYY-MM-DD local, null for all time
+	Projects   []UsageProject `json:"projects"`
+	Tokens     UsageTokens    `json:"tokens"` // All projects
+	CostUSD    float64        `json:"cost_usd"`
+}
+
+// UsageProject is one project's usage in JSON output.
+type UsageProject struct {
+	Name           string         `json:"name"`
+	Tokens         UsageTokens    `json:"tokens"`
+	CostUSD        float64        `json:"cost_usd"`
+	Sessions       []UsageSession `json:"sessions"` // Newest first
+	Days           []UsageDay     `json:"days"`     // Oldest first
+	Models         []UsageModel   `json:"models"`   // Most expensive first
+	UnpricedModels []string       `json:"unpriced_models"`
+}
+
+// UsageTokens are token counts by kind.
+type UsageTokens struct {
+	Input      int64 `json:"input"`
+	Output     int64 `json:"output"`
+	CacheRead  int64 `json:"cache_read"`
+	CacheWrite int64 `json:"cache_write"`
+	Total      int64 `json:"total"`
+}
+
+// UsageSession is one session log's usage.
+type UsageSession struct {
+	SessionID string      `json:"session_id"`
+	Path      string      `json:"path"`
+	Start     *string     `json:"start"` // RFC3339 UTC of the first reply
+	End       *string     `json:"end"`   // RFC3339 UTC of the last reply
+	Messages  int         `json:"messages"`
+	Tokens    UsageTokens `json:"tokens"`
+	CostUSD   float64     `json:"cost_usd"`
+}
+
+// UsageDay is the usage on one local calendar day.
+type UsageDay struct {
+	Day     string      `json:"day"` // YYYY-MM-DD, local time
+	Tokens  UsageTokens `json:"tokens"`
+	CostUSD float64     `json:"cost_usd"`
+}
+
+// UsageModel is the usage of one model.
+type UsageModel struct {
+	Model   string      `json:"model"`
+	Tokens  UsageTokens `json:"tokens"`
+	CostUSD float64     `json:"cost_usd"`
+	Priced  bool        `json:"priced"` // false = missing from the price table, cost 0
+}
+
+// newUsageCmd creates the usage command.
+func newUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage [project-name]",
+		Short: "Show token usage and estimated cost of agent sessions",
+		Long: `Show token usage and estimated cost from Claude Code session logs.
+
+Without a project name, shows a summary per active project and per day.
+With a project, also lists its sessions and models.
+
+Costs are estimates from the price table in prices.yaml in the vibe-dash
+directory (created with Anthropic list prices on first use; edit it to
+change prices or add models). Parsed logs are cached in usage-cache.json,
+so later runs only read what was appended since.
+
+--since takes a number of days or hours (7d, 24h), a date (2026-01-15) or
+"all". Usage is counted by whole local days.
+
+Examples:
+  vdash usage                        # All active projects, last 30 days
+  vdash usage client-alpha           # Sessions, days and models of one project
+  vdash usage --since 7d             # Last 7 days
+  vdash usage --since all --json     # All time, JSON output`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: projectCompletionFunc,
+		RunE:              runUsage,
+	}
+
+	cmd.Flags().BoolVar(&usageJSON, "json", false, "Output as JSON")
+	cmd.Flags().StringVar(&usageSince, "since", defaultUsageSince, "Count usage since N days/hours ago (7d, 24h), a date (YYYY-MM-DD) or \"all\"")
+
+	return cmd
+}
+
+// RegisterUsageCommand registers the usage command with the given parent command.
+// Used for testing to create fresh command trees.
+func RegisterUsageCommand(parent *cobra.Command) {
+	parent.AddCommand(newUsageCmd())
+}
+
+func init() {
+	RootCmd.AddCommand(newUsageCmd())
+}
+
+// parseUsageSince parses the --since flag relative to now.
+// "all" (or "0") returns the zero time.
+func parseUsageSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(strings.ToLower(value))
+	switch {
+	case value == "all" || value == "0":
+		return time.Time{}, nil
+	case strings.HasSuffix(value, "d"):
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err == nil && days > 0 {
+			return now.AddDate(0, 0, -days), nil
+		}
+	case strings.HasSuffix(value, "h"):
+		hours, err := strconv.Atoi(strings.TrimSuffix(value, "h"))
+		if err == nil && hours > 0 {
+			return now.Add(-time.Duration(hours) * time.Hour), nil
+		}
+	default:
+		if day, err := time.ParseInLocation(usageDayLayout, value, time.Local); err == nil {
+			return day, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid --since %q (use 7d, 24h, YYYY-MM-DD or all)", value)
+}
+
+// runUsage implements the usage command logic.
+func runUsage(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if usageReader == nil {
+		return fmt.Errorf("usage reader not initialized")
+	}
+	if repository == nil {
+		return fmt.Errorf("repository not initialized")
+	}
+	since, err := parseUsageSince(usageSince, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var projects []*domain.Project
+	if len(args) == 1 {
+		proj, err := findProjectByIdentifier(ctx, args[0])
+		if err != nil {
+			if errors.Is(err, domain.ErrProjectNotFound) {
+				fmt.Fprintf(cmd.OutOrStdout(), "✗ Project not found: %s\n", args[0])
+				cmd.SilenceErrors = true
+				cmd.SilenceUsage = true
+			}
+			return err
+		}
+		projects = []*domain.Project{proj}
+	} else {
+		active, err := repository.FindActive(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list projects: %w", err)
+		}
+		project.SortByName(active)
+		projects = active
+	}
+
+	all := make([]*domain.ProjectUsage, 0, len(projects))
+	for _, p := range projects {
+		u, err := usageReader.ProjectUsage(ctx, p, since)
+		if err != nil {
+			return fmt.Errorf("failed to read usage for %s: %w", project.EffectiveName(p), err)
+		}
+		all = append(all, u)
+	}
+
+	if usageJSON {
+		return formatUsageJSON(cmd, projects, all, since)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(args) == 1 {
+		formatProjectUsagePlainText(out, projects[0], all[0], since)
+		return nil
+	}
+	formatUsageSummaryPlainText(out, projects, all, since)
+	return nil
+}
+
+// usageSinceLabel describes the reporting window.
+func usageSinceLabel(since time.Time) string {
+	if since.IsZero() {
+		return "all time"
+	}
+	return "since " + since.Local().Format(usageDayLayout)
+}
+
+// usageAmount formats tokens and cost: "4.1M tokens  $3.42".
+func usageAmount(tokens domain.TokenUsage, cost float64) string {
+	return fmt.Sprintf("%7s tokens  %7s", usageformat.FormatTokens(tokens.Total()), usageformat.FormatCost(cost))
+}
+
+// formatUsageSummaryPlainText prints one line per project with usage,
+// the total and the per-day usage across projects:
+// "  client-alpha    12 sessions     4.1M tokens    $3.42"
+func formatUsageSummaryPlainText(out io.Writer, projects []*domain.Project, all []*domain.ProjectUsage, since time.Time) {
+	nameWidth := len("Total")
+	var sessions int
+	var tokens domain.TokenUsage
+	var cost float64
+	byDay := make(map[string]*domain.DailyUsage)
+	var days []string
+	for i, u := range all {
+		if len(u.Sessions) == 0 {
+			continue
+		}
+		nameWidth = max(nameWidth, len(project.EffectiveName(projects[i])))
+		sessions += len(u.Sessions)
+		tokens = tokens.Add(u.Tokens)
+		cost += u.Cost
+		for _, d := range u.ByDay {
+			key := d.Day.Format(usageDayLayout)
+			total, ok := byDay[key]
+			if !ok {
+				total = &domain.DailyUsage{Day: d.Day}
+				byDay[key] = total
+				days = append(days, key)
+			}
+			total.Tokens = total.Tokens.Add(d.Tokens)
+			total.Cost += d.Cost
+		}
+	}
+
+	if sessions == 0 {
+		fmt.Fprintf(out, "No token usage recorded %s.\n", usageSinceLabel(since))
+		return
+	}
+
+	fmt.Fprintf(out, "%s (%s, estimated cost)\n", styles.TitleStyle.Render("Token usage"), usageSinceLabel(since))
+	for i, u := range all {
+		if len(u.Sessions) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "  %-*s  %4d sessions  %s\n", nameWidth, project.EffectiveName(projects[i]), len(u.Sessions), usageAmount(u.Tokens, u.Cost))
+	}
+	fmt.Fprintf(out, "  %-*s  %4d sessions  %s\n", nameWidth, "Total", sessions, usageAmount(tokens, cost))
+
+	sort.Strings(days)
+	fmt.Fprintln(out)
+	for i, key := range days {
+		heading := ""
+		if i == 0 {
+			heading = "Per day"
+		}
+		fmt.Fprintf(out, "  %-8s  %s  %s\n", heading, key, usageAmount(byDay[key].Tokens, byDay[key].Cost))
+	}
+	printUnpriced(out, all)
+}
+
+// formatProjectUsagePlainText prints one project's sessions, days and models.
+func formatProjectUsagePlainText(out io.Writer, p *domain.Project, u *domain.ProjectUsage, since time.Time) {
+	name := project.EffectiveName(p)
+	if len(u.Sessions) == 0 {
+		fmt.Fprintf(out, "%s: no token usage recorded %s\n", name, usageSinceLabel(since))
+		return
+	}
+
+	fmt.Fprintf(out, "%s (%s, estimated cost)\n", styles.TitleStyle.Render(name), usageSinceLabel(since))
+	fmt.Fprintf(out, "  %-8s  %s\n", "Total", usageAmount(u.Tokens, u.Cost))
+
+	for i, s := range u.Sessions {
+		heading := ""
+		if i == 0 {
+			heading = "Sessions"
+		}
+		sessionID := s.SessionID
+		if len(sessionID) > logsSessionIDWidth {
+			sessionID = sessionID[:logsSessionIDWidth]
+		}
+		fmt.Fprintf(out, "  %-8s  %s  %-8s  %4d replies  %s\n", heading, s.EndTime.Local().Format("2006-01-02 15:04"), sessionID, s.Messages, usageAmount(s.Tokens, s.Cost))
+	}
+	for i, d := range u.ByDay {
+		heading := ""
+		if i == 0 {
+			heading = "Days"
+		}
+		fmt.Fprintf(out, "  %-8s  %s  %s\n", heading, d.Day.Format(usageDayLayout), usageAmount(d.Tokens, d.Cost))
+	}
+	for i, m := range u.ByModel {
+		heading := ""
+		if i == 0 {
+			heading = "Models"
+		}
+		fmt.Fprintf(out, "  %-8s  %-28s  %s\n", heading, m.Model, usageAmount(m.Tokens, m.Cost))
+	}
+	printUnpriced(out, []*domain.ProjectUsage{u})
+}
+
+// printUnpriced lists models missing from the price table, whose usage is
+// counted in tokens but not in cost.
+func printUnpriced(out io.Writer, all []*domain.ProjectUsage) {
+	seen := make(map[string]bool)
+	var models []string
+	for _, u := range all {
+		for _, m := range u.Unpriced {
+			if !seen[m] {
+				seen[m] = true
+				models = append(models, m)
+			}
+		}
+	}
+	if len(models) == 0 {
+		return
+	}
+	sort.Strings(models)
+	fmt.Fprintf(out, "\n%s No price for %s (add it to prices.yaml)\n",
+		styles.WarningStyle.Render("!"), strings.Join(models, ", "))
+}
+
+// usageTokens converts domain token counts for JSON output.
+func usageTokens(t domain.TokenUsage) UsageTokens {
+	return UsageTokens{
+		Input:      t.Input,
+		Output:     t.Output,
+		CacheRead:  t.CacheRead,
+		CacheWrite: t.CacheWrite,
+		Total:      t.Total(),
+	}
+}
+
+// usageTime formats a timestamp as RFC3339 UTC, or nil if unknown.
+func usageTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	return optionalString(t.UTC().Format(time.RFC3339))
+}
+
+// formatUsageJSON writes usage as JSON.
+func formatUsageJSON(cmd *cobra.Command, projects []*domain.Project, all []*domain.ProjectUsage, since time.Time) error {
+	response := UsageResponse{
+		APIVersion: "v1",
+		Projects:   make([]UsageProject, 0, len(projects)), // [] not null when empty
+	}
+	if !since.IsZero() {
+		response.Since = optionalString(since.Local().Format(usageDayLayout))
+	}
+
+	var total domain.TokenUsage
+	for i, p := range projects {
+		u := all[i]
+		total = total.Add(u.Tokens)
+		response.CostUSD += u.Cost
+
+		up := UsageProject{
+			Name:           project.EffectiveName(p),
+			Tokens:         usageTokens(u.Tokens),
+			CostUSD:        u.Cost,
+			Sessions:       make([]UsageSession, 0, len(u.Sessions)),
+			Days:           make([]UsageDay, 0, len(u.ByDay)),
+			Models:         make([]UsageModel, 0, len(u.ByModel)),
+			UnpricedModels: append(make([]string, 0, len(u.Unpriced)), u.Unpriced...),
+		}
+		for _, s := range u.Sessions {
+			up.Sessions = append(up.Sessions, UsageSession{
+				SessionID: s.SessionID,
+				Path:      s.Path,
+				Start:     usageTime(s.StartTime),
+				End:       usageTime(s.EndTime),
+				Messages:  s.Messages,
+				Tokens:    usageTokens(s.Tokens),
+				CostUSD:   s.Cost,
+			})
+		}
+		for _, d := range u.ByDay {
+			up.Days = append(up.Days, UsageDay{d.Day.Format(usageDayLayout), usageTokens(d.Tokens), d.Cost})
+		}
+		for _, m := range u.ByModel {
+			up.Models = append(up.Models, UsageModel{m.Model, usageTokens(m.Tokens), m.Cost, m.Priced})
+		}
+		response.Projects = append(response.Projects, up)
+	}
+	response.Tokens = usageTokens(total)
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(response)
+}
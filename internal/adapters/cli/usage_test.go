@@ -0,0 +1,204 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/cli"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// mockUsageReader implements ports.UsageReader with canned usage per project.
+type mockUsageReader struct {
+	usage     map[string]domain.ProjectUsage
+	lastSince time.Time
+}
+
+func (m *mockUsageReader) ProjectUsage(_ context.Context, p *domain.Project, since time.Time) (*domain.ProjectUsage, error) {
+	m.lastSince = since
+	u := m.usage[p.ID]
+	u.ProjectID = p.ID
+	return &u, nil
+}
+
+func executeUsageCommand(args []string) (string, error) {
+	cli.ResetUsageFlags()
+	cmd := cli.NewRootCmd()
+	cli.RegisterUsageCommand(cmd)
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	cmd.SetArgs(append([]string{"usage"}, args...))
+
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func setupUsageTest(t *testing.T) *mockUsageReader {
+	t.Helper()
+	projects := []*domain.Project{
+		{ID: "p1", Path: "/test/alpha", Name: "alpha", State: domain.StateActive},
+		{ID: "p2", Path: "/test/beta", Name: "beta", State: domain.StateActive},
+		{ID: "p3", Path: "/test/gamma", Name: "gamma", State: domain.StateActive},
+	}
+	day := time.Date(2026, 1, 11, 0, 0, 0, 0, time.Local)
+	alphaTokens := domain.TokenUsage{Input: 1200, Output: 45_000, CacheRead: 2_000_000, CacheWrite: 100_000}
+	betaTokens := domain.TokenUsage{Input: 500, Output: 2000}
+	reader := &mockUsageReader{usage: map[string]domain.ProjectUsage{
+		"p1": {
+			Sessions: []domain.SessionUsage{{
+				SessionID: "3f2a9c1e-77b0-4c1d",
+				Path:      "/logs/3f2a9c1e-77b0-4c1d.jsonl",
+				StartTime: time.Date(2026, 1, 11, 9, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2026, 1, 11, 10, 30, 0, 0, time.UTC),
+				Tokens:    alphaTokens,
+				Cost:      1.65,
+				Messages:  42,
+			}},
+			ByDay:   []domain.DailyUsage{{Day: day, Tokens: alphaTokens, Cost: 1.65}},
+			ByModel: []domain.ModelUsage{{Model: "claude-sonnet-4-5-20250929", Tokens: alphaTokens, Cost: 1.65, Priced: true}},
+			Tokens:  alphaTokens,
+			Cost:    1.65,
+		},
+		"p2": {
+			Sessions: []domain.SessionUsage{{SessionID: "b1", Tokens: betaTokens, Messages: 1}},
+			ByDay:    []domain.DailyUsage{{Day: day, Tokens: betaTokens}},
+			ByModel:  []domain.ModelUsage{{Model: "mystery-model", Tokens: betaTokens}},
+			Tokens:   betaTokens,
+			Unpriced: []string{"mystery-model"},
+		},
+	}}
+
+	cli.SetRepository(newHibernateMockRepository().withProjects(projects))
+	cli.SetUsageReader(reader)
+	t.Cleanup(func() {
+		cli.SetRepository(nil)
+		cli.SetUsageReader(nil)
+	})
+	return reader
+}
+
+func TestUsageCmd_Summary(t *testing.T) {
+	reader := setupUsageTest(t)
+
+	output, err := executeUsageCommand(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"Token usage", "alpha", "2.1M tokens    $1.65", "beta", "Total", "2 sessions", "Per day", "2026-01-11", "No price for mystery-model"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "gamma") {
+		t.Errorf("projects without usage should be omitted:\n%s", output)
+	}
+
+	// Default window is the last 30 days
+	if want := time.Now().AddDate(0, 0, -30); reader.lastSince.Sub(want).Abs() > time.Minute {
+		t.Errorf("since = %v, want about %v", reader.lastSince, want)
+	}
+}
+
+func TestUsageCmd_Project(t *testing.T) {
+	setupUsageTest(t)
+
+	output, err := executeUsageCommand([]string{"alpha"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"Sessions", "3f2a9c1e ", "42 replies", "Days", "Models", "claude-sonnet-4-5-20250929"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	output, _ = executeUsageCommand([]string{"gamma"})
+	if !strings.Contains(output, "gamma: no token usage recorded since") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestUsageCmd_SinceFlag(t *testing.T) {
+	reader := setupUsageTest(t)
+
+	if _, err := executeUsageCommand([]string{"--since", "all"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reader.lastSince.IsZero() {
+		t.Errorf("--since all: since = %v, want zero", reader.lastSince)
+	}
+
+	if _, err := executeUsageCommand([]string{"--since", "2026-01-05"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 1, 5, 0, 0, 0, 0, time.Local); !reader.lastSince.Equal(want) {
+		t.Errorf("--since date: since = %v, want %v", reader.lastSince, want)
+	}
+
+	if _, err := executeUsageCommand([]string{"--since", "24h"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Now().Add(-24 * time.Hour); reader.lastSince.Sub(want).Abs() > time.Minute {
+		t.Errorf("--since 24h: since = %v, want about %v", reader.lastSince, want)
+	}
+
+	for _, bad := range []string{"-3d", "week", "2026-13-01"} {
+		if _, err := executeUsageCommand([]string{"--since", bad}); err == nil {
+			t.Errorf("--since %q: expected error", bad)
+		}
+	}
+}
+
+func TestUsageCmd_JSON(t *testing.T) {
+	setupUsageTest(t)
+
+	output, err := executeUsageCommand([]string{"--json", "--since", "2026-01-05"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var resp cli.UsageResponse
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if resp.APIVersion != "v1" || resp.Since == nil || *resp.Since != "2026-01-05" {
+		t.Errorf("unexpected header: %+v", resp)
+	}
+	if len(resp.Projects) != 3 || resp.CostUSD != 1.65 || resp.Tokens.Total != 2_148_700 {
+		t.Fatalf("projects = %d, cost = %v, tokens = %d", len(resp.Projects), resp.CostUSD, resp.Tokens.Total)
+	}
+
+	alpha := resp.Projects[0]
+	if alpha.Name != "alpha" || len(alpha.Sessions) != 1 || alpha.Tokens.CacheRead != 2_000_000 {
+		t.Errorf("unexpected alpha usage: %+v", alpha)
+	}
+	s := alpha.Sessions[0]
+	if s.SessionID != "3f2a9c1e-77b0-4c1d" || s.Messages != 42 || s.End == nil || *s.End != "2026-01-11T10:30:00Z" {
+		t.Errorf("unexpected session: %+v", s)
+	}
+	if beta := resp.Projects[1]; len(beta.UnpricedModels) != 1 || beta.Models[0].Priced {
+		t.Errorf("unexpected beta models: %+v", beta)
+	}
+	if gamma := resp.Projects[2]; gamma.Sessions == nil || gamma.UnpricedModels == nil {
+		t.Error("empty lists should be [] not null")
+	}
+}
+
+func TestUsageCmd_ProjectNotFound(t *testing.T) {
+	setupUsageTest(t)
+
+	output, err := executeUsageCommand([]string{"missing"})
+	if err == nil {
+		t.Fatal("expected error for missing project")
+	}
+	if !strings.Contains(output, "✗ Project not found: missing") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
@@ -0,0 +1,152 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// dialTimeout keeps "is a daemon running?" checks fast when none is.
+const dialTimeout = 500 * time.Millisecond
+
+// ErrNotRunning is returned when no daemon is listening on the socket.
+var ErrNotRunning = errors.New("daemon not running")
+
+// Client talks to a running daemon over its Unix socket.
+type Client struct {
+	socketPath string
+}
+
+// Compile-time interface compliance checks
+var (
+	_ ports.DaemonController = (*Client)(nil)
+	_ ports.ActivityFeed     = (*Client)(nil)
+)
+
+// activityBuffer is the client-side activity buffer.
+const activityBuffer = 32
+
+// NewClient creates a client for the daemon socket at socketPath.
+func NewClient(socketPath string) *Client {
+	return &Client{socketPath: socketPath}
+}
+
+// Status returns the daemon's status, or ErrNotRunning.
+func (c *Client) Status(ctx context.Context) (ports.DaemonStatus, error) {
+	resp, err := c.do(ctx, commandStatus)
+	if err != nil {
+		return ports.DaemonStatus{}, err
+	}
+	if resp.Status == nil {
+		return ports.DaemonStatus{}, fmt.Errorf("daemon returned no status")
+	}
+	return resp.Status.toStatus(), nil
+}
+
+// Refresh asks the daemon to re-detect all active projects and waits for it.
+func (c *Client) Refresh(ctx context.Context) (ports.RefreshResult, error) {
+	resp, err := c.do(ctx, commandRefresh)
+	if err != nil {
+		return ports.RefreshResult{}, err
+	}
+	if resp.Refresh == nil {
+		return ports.RefreshResult{}, fmt.Errorf("daemon returned no refresh result")
+	}
+	return ports.RefreshResult{Refreshed: resp.Refresh.Refreshed, Failed: resp.Refresh.Failed}, nil
+}
+
+// Stop asks the daemon to exit. Returns once the request is acknowledged.
+func (c *Client) Stop(ctx context.Context) error {
+	_, err := c.do(ctx, commandStop)
+	return err
+}
+
+// Activity streams the file activity the daemon attributes to projects
+// until ctx is cancelled or the daemon exits, then closes the channel.
+func (c *Client) Activity(ctx context.Context) (<-chan ports.FileEvent, error) {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "unix", c.socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotRunning, err)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+
+	dec := json.NewDecoder(conn)
+	var resp response
+	if err := json.NewEncoder(conn).Encode(request{Command: commandActivity}); err != nil {
+		stop()
+		conn.Close()
+		return nil, fmt.Errorf("failed to send daemon request: %w", err)
+	}
+	if err := dec.Decode(&resp); err != nil || !resp.OK {
+		stop()
+		conn.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read daemon response: %w", err)
+		}
+		return nil, fmt.Errorf("daemon error: %s", resp.Error)
+	}
+
+	events := make(chan ports.FileEvent, activityBuffer)
+	go func() {
+		defer close(events)
+		defer conn.Close()
+		defer stop()
+		for {
+			var msg activityMessage
+			if err := dec.Decode(&msg); err != nil {
+				return
+			}
+			select {
+			case events <- msg.toEvent():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// IsRunning reports whether a daemon answers on the socket.
+func (c *Client) IsRunning(ctx context.Context) bool {
+	_, err := c.Status(ctx)
+	return err == nil
+}
+
+// do sends one request and decodes the response.
+func (c *Client) do(ctx context.Context, command string) (*response, error) {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "unix", c.socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotRunning, err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	// Unblock reads if ctx is cancelled without a deadline
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if err := json.NewEncoder(conn).Encode(request{Command: command}); err != nil {
+		return nil, fmt.Errorf("failed to send daemon request: %w", err)
+	}
+
+	var resp response
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("failed to read daemon response: %w", err)
+	}
+	if !resp.OK {
+		return nil, fmt.Errorf("daemon error: %s", resp.Error)
+	}
+	return &resp, nil
+}
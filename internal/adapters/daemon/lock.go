@@ -0,0 +1,105 @@
+// Package daemon provides the single-instance lock and the Unix socket
+// control channel used by `vdash daemon` and the clients that attach to it.
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const (
+	// PIDFileName is the pidfile (and lock) under the vibe-dash home directory.
+	PIDFileName = "daemon.pid"
+
+	// SocketFileName is the control socket under the vibe-dash home directory.
+	SocketFileName = "daemon.sock"
+)
+
+// ErrAlreadyRunning is returned by AcquireLock when another daemon holds the lock.
+var ErrAlreadyRunning = errors.New("daemon already running")
+
+// PIDFilePath returns the pidfile path for a vibe-dash home directory.
+func PIDFilePath(basePath string) string {
+	return filepath.Join(basePath, PIDFileName)
+}
+
+// SocketPath returns the control socket path for a vibe-dash home directory.
+func SocketPath(basePath string) string {
+	return filepath.Join(basePath, SocketFileName)
+}
+
+// Lock is an exclusive flock on the pidfile, held for the daemon's lifetime.
+// The kernel releases it if the process dies, so a stale pidfile never
+// blocks a new daemon.
+type Lock struct {
+	file *os.File
+	path string
+}
+
+// AcquireLock takes the pidfile lock and writes the current PID into it.
+// Returns an error wrapping ErrAlreadyRunning if another process holds it.
+func AcquireLock(path string) (*Lock, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create pidfile directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pidfile: %w", err)
+	}
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		f.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			if pid, readErr := ReadPID(path); readErr == nil {
+				return nil, fmt.Errorf("%w (pid %d)", ErrAlreadyRunning, pid)
+			}
+			return nil, ErrAlreadyRunning
+		}
+		return nil, fmt.Errorf("failed to lock pidfile: %w", err)
+	}
+
+	if err := f.Truncate(0); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to write pidfile: %w", err)
+	}
+	if _, err := f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to write pidfile: %w", err)
+	}
+
+	return &Lock{file: f, path: path}, nil
+}
+
+// Release removes the pidfile and drops the lock. Safe to call more than once.
+func (l *Lock) Release() error {
+	if l == nil || l.file == nil {
+		return nil
+	}
+	// Remove while still holding the lock so a new daemon never sees our PID
+	removeErr := os.Remove(l.path)
+	closeErr := l.file.Close() // Closing releases the flock
+	l.file = nil
+	if removeErr != nil && !os.IsNotExist(removeErr) {
+		return removeErr
+	}
+	return closeErr
+}
+
+// ReadPID returns the PID recorded in a pidfile.
+func ReadPID(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid pidfile %s: %w", path, err)
+	}
+	return pid, nil
+}
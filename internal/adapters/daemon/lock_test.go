@@ -0,0 +1,75 @@
+package daemon
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAcquireLock_SingleInstance(t *testing.T) {
+	path := PIDFilePath(t.TempDir())
+
+	lock, err := AcquireLock(path)
+	if err != nil {
+		t.Fatalf("AcquireLock returned error: %v", err)
+	}
+
+	pid, err := ReadPID(path)
+	if err != nil {
+		t.Fatalf("ReadPID returned error: %v", err)
+	}
+	if pid != os.Getpid() {
+		t.Errorf("pidfile contains %d, want %d", pid, os.Getpid())
+	}
+
+	if _, err := AcquireLock(path); !errors.Is(err, ErrAlreadyRunning) {
+		t.Fatalf("second AcquireLock error = %v, want ErrAlreadyRunning", err)
+	}
+
+	if err := lock.Release(); err != nil {
+		t.Fatalf("Release returned error: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("pidfile still exists after Release: %v", err)
+	}
+	// Release is idempotent
+	if err := lock.Release(); err != nil {
+		t.Errorf("second Release returned error: %v", err)
+	}
+
+	// Lock can be taken again once released
+	again, err := AcquireLock(path)
+	if err != nil {
+		t.Fatalf("AcquireLock after Release returned error: %v", err)
+	}
+	again.Release()
+}
+
+func TestAcquireLock_StalePIDFile(t *testing.T) {
+	// A pidfile left by a crashed daemon holds no lock and must not block
+	path := filepath.Join(t.TempDir(), PIDFileName)
+	if err := os.WriteFile(path, []byte("999999\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	lock, err := AcquireLock(path)
+	if err != nil {
+		t.Fatalf("AcquireLock returned error: %v", err)
+	}
+	defer lock.Release()
+
+	if pid, _ := ReadPID(path); pid != os.Getpid() {
+		t.Errorf("pidfile contains %d, want %d", pid, os.Getpid())
+	}
+}
+
+func TestReadPID_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PIDFileName)
+	if err := os.WriteFile(path, []byte("not-a-pid"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadPID(path); err == nil {
+		t.Error("expected error for invalid pidfile")
+	}
+}
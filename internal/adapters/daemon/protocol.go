@@ -0,0 +1,93 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// Control commands accepted on the socket. Each connection carries one
+// JSON request line and one JSON response line; an activity response is
+// followed by one activityMessage line per event until either side closes.
+const (
+	commandStatus   = "status"
+	commandRefresh  = "refresh"
+	commandStop     = "stop"
+	commandActivity = "activity"
+)
+
+// request is a control message sent by a client.
+type request struct {
+	Command string `json:"command"`
+}
+
+// response is the daemon's reply to a request.
+type response struct {
+	OK      bool           `json:"ok"`
+	Error   string         `json:"error,omitempty"`
+	Status  *statusMessage `json:"status,omitempty"`
+	Refresh *refreshResult `json:"refresh,omitempty"`
+}
+
+// statusMessage is the wire form of ports.DaemonStatus.
+type statusMessage struct {
+	PID                    int       `json:"pid"`
+	StartedAt              time.Time `json:"started_at"`
+	Projects               int       `json:"projects"`
+	WatchedPaths           int       `json:"watched_paths"`
+	LastRefreshAt          time.Time `json:"last_refresh_at"`
+	LastHibernationCheckAt time.Time `json:"last_hibernation_check_at"`
+	LastActivityAt         time.Time `json:"last_activity_at"`
+}
+
+// refreshResult is the wire form of ports.RefreshResult.
+type refreshResult struct {
+	Refreshed int `json:"refreshed"`
+	Failed    int `json:"failed"`
+}
+
+func toStatusMessage(s ports.DaemonStatus) *statusMessage {
+	return &statusMessage{
+		PID:                    s.PID,
+		StartedAt:              s.StartedAt,
+		Projects:               s.Projects,
+		WatchedPaths:           s.WatchedPaths,
+		LastRefreshAt:          s.LastRefreshAt,
+		LastHibernationCheckAt: s.LastHibernationCheckAt,
+		LastActivityAt:         s.LastActivityAt,
+	}
+}
+
+func (m *statusMessage) toStatus() ports.DaemonStatus {
+	return ports.DaemonStatus{
+		PID:                    m.PID,
+		StartedAt:              m.StartedAt,
+		Projects:               m.Projects,
+		WatchedPaths:           m.WatchedPaths,
+		LastRefreshAt:          m.LastRefreshAt,
+		LastHibernationCheckAt: m.LastHibernationCheckAt,
+		LastActivityAt:         m.LastActivityAt,
+	}
+}
+
+// activityMessage is the wire form of a ports.FileEvent.
+type activityMessage struct {
+	Path      string    `json:"path"`
+	Operation string    `json:"operation"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func toActivityMessage(ev ports.FileEvent) activityMessage {
+	return activityMessage{Path: ev.Path, Operation: ev.Operation.String(), Timestamp: ev.Timestamp}
+}
+
+// toEvent converts the message back. Unknown operations read as modify.
+func (m activityMessage) toEvent() ports.FileEvent {
+	op := ports.FileOpModify
+	for _, candidate := range []ports.FileOperation{ports.FileOpCreate, ports.FileOpModify, ports.FileOpDelete} {
+		if candidate.String() == m.Operation {
+			op = candidate
+		}
+	}
+	return ports.FileEvent{Path: m.Path, Operation: op, Timestamp: m.Timestamp}
+}
@@ -0,0 +1,194 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// requestTimeout bounds one control request, including a full refresh.
+const requestTimeout = 5 * time.Minute
+
+// Server answers control requests on a Unix socket for a running daemon.
+type Server struct {
+	controller ports.DaemonController
+	stop       func() // Called on a "stop" request
+}
+
+// NewServer creates a control server. stop is called when a client asks the
+// daemon to exit (typically the cancel func of the daemon's context).
+func NewServer(controller ports.DaemonController, stop func()) *Server {
+	return &Server{controller: controller, stop: stop}
+}
+
+// Serve listens on socketPath until ctx is cancelled, then removes the socket.
+// The caller must hold the daemon Lock: any existing socket file is assumed
+// stale and replaced.
+func (s *Server) Serve(ctx context.Context, socketPath string) error {
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove stale socket: %w", err)
+	}
+
+	ln, err := listenPrivate(socketPath)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	var wg sync.WaitGroup
+	defer func() {
+		wg.Wait()
+		os.Remove(socketPath)
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			slog.Debug("daemon accept failed", "error", err)
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.handleConn(ctx, conn)
+		}()
+	}
+}
+
+// listenPrivate listens on socketPath without ever exposing a socket other
+// users could connect to: it binds inside a fresh 0700 directory, restricts
+// the socket to the owner, then moves it into place.
+func listenPrivate(socketPath string) (net.Listener, error) {
+	dir, err := os.MkdirTemp(filepath.Dir(socketPath), ".daemon-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create socket directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := filepath.Join(dir, filepath.Base(socketPath))
+	ln, err := net.Listen("unix", tmpPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", socketPath, err)
+	}
+	// Serve removes the socket at its final path
+	ln.(*net.UnixListener).SetUnlinkOnClose(false)
+
+	// Only the owner may control the daemon
+	if err := os.Chmod(tmpPath, 0600); err != nil {
+		ln.Close()
+		return nil, fmt.Errorf("failed to secure socket: %w", err)
+	}
+	if err := os.Rename(tmpPath, socketPath); err != nil {
+		ln.Close()
+		return nil, fmt.Errorf("failed to move socket into place: %w", err)
+	}
+	return ln, nil
+}
+
+// handleConn reads one request and writes one response.
+func (s *Server) handleConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(requestTimeout))
+
+	var req request
+	if err := json.NewDecoder(conn).Decode(&req); err != nil {
+		writeResponse(conn, response{Error: "invalid request"})
+		return
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	var resp response
+	switch req.Command {
+	case commandStatus:
+		status, err := s.controller.Status(reqCtx)
+		if err != nil {
+			resp.Error = err.Error()
+			break
+		}
+		resp.OK, resp.Status = true, toStatusMessage(status)
+	case commandRefresh:
+		result, err := s.controller.Refresh(reqCtx)
+		if err != nil {
+			resp.Error = err.Error()
+			break
+		}
+		resp.OK, resp.Refresh = true, &refreshResult{Refreshed: result.Refreshed, Failed: result.Failed}
+	case commandStop:
+		resp.OK = true
+		writeResponse(conn, resp)
+		if s.stop != nil {
+			s.stop()
+		}
+		return
+	case commandActivity:
+		s.streamActivity(ctx, conn)
+		return
+	default:
+		resp.Error = fmt.Sprintf("unknown command %q", req.Command)
+	}
+	writeResponse(conn, resp)
+}
+
+// streamActivity writes the daemon's file activity to conn until the
+// client disconnects or the daemon stops.
+func (s *Server) streamActivity(ctx context.Context, conn net.Conn) {
+	feed, ok := s.controller.(ports.ActivityFeed)
+	if !ok {
+		writeResponse(conn, response{Error: "activity not available"})
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := feed.Activity(ctx)
+	if err != nil {
+		writeResponse(conn, response{Error: err.Error()})
+		return
+	}
+
+	// The stream has no request timeout; a read returning means the client left
+	_ = conn.SetDeadline(time.Time{})
+	go func() {
+		_, _ = io.Copy(io.Discard, conn)
+		cancel()
+	}()
+
+	enc := json.NewEncoder(conn)
+	if err := enc.Encode(response{OK: true}); err != nil {
+		return
+	}
+	for ev := range events {
+		if err := enc.Encode(toActivityMessage(ev)); err != nil {
+			return
+		}
+	}
+}
+
+func writeResponse(conn net.Conn, resp response) {
+	if err := json.NewEncoder(conn).Encode(resp); err != nil {
+		slog.Debug("daemon response write failed", "error", err)
+	}
+}
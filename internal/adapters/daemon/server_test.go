@@ -0,0 +1,236 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// fakeController returns canned status and refresh results.
+type fakeController struct {
+	status     ports.DaemonStatus
+	refresh    ports.RefreshResult
+	refreshErr error
+}
+
+func (f *fakeController) Status(context.Context) (ports.DaemonStatus, error) {
+	return f.status, nil
+}
+
+func (f *fakeController) Refresh(context.Context) (ports.RefreshResult, error) {
+	return f.refresh, f.refreshErr
+}
+
+// feedController also streams activity; each subscription is handed to the
+// test, which closes it when the subscriber leaves.
+type feedController struct {
+	fakeController
+	subscribed chan chan ports.FileEvent
+}
+
+func (f *feedController) Activity(ctx context.Context) (<-chan ports.FileEvent, error) {
+	ch := make(chan ports.FileEvent, 1)
+	f.subscribed <- ch
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// startServer serves controller on a temp socket and returns a client for it.
+// The returned channel is closed when the server receives "stop".
+func startServer(t *testing.T, controller ports.DaemonController) (*Client, <-chan struct{}) {
+	t.Helper()
+	socketPath := SocketPath(t.TempDir())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	server := NewServer(controller, func() {
+		close(stopped)
+		cancel()
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- server.Serve(ctx, socketPath) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Serve returned error: %v", err)
+		}
+	})
+
+	// Wait for the socket to appear
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if _, err := os.Stat(socketPath); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("socket was not created")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return NewClient(socketPath), stopped
+}
+
+func TestClientServer_StatusAndRefresh(t *testing.T) {
+	started := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	controller := &fakeController{
+		status:  ports.DaemonStatus{PID: 4242, StartedAt: started, Projects: 3, WatchedPaths: 2},
+		refresh: ports.RefreshResult{Refreshed: 2, Failed: 1},
+	}
+	client, _ := startServer(t, controller)
+	ctx := context.Background()
+
+	if !client.IsRunning(ctx) {
+		t.Fatal("IsRunning = false, want true")
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status returned error: %v", err)
+	}
+	if status.PID != 4242 || !status.StartedAt.Equal(started) || status.Projects != 3 || status.WatchedPaths != 2 {
+		t.Errorf("Status = %+v", status)
+	}
+	if !status.LastRefreshAt.IsZero() {
+		t.Errorf("LastRefreshAt = %v, want zero", status.LastRefreshAt)
+	}
+
+	result, err := client.Refresh(ctx)
+	if err != nil {
+		t.Fatalf("Refresh returned error: %v", err)
+	}
+	if result != controller.refresh {
+		t.Errorf("Refresh = %+v, want %+v", result, controller.refresh)
+	}
+}
+
+func TestClientServer_RefreshError(t *testing.T) {
+	client, _ := startServer(t, &fakeController{refreshErr: errors.New("boom")})
+
+	if _, err := client.Refresh(context.Background()); err == nil {
+		t.Fatal("expected error from failing refresh")
+	}
+}
+
+func TestClientServer_Stop(t *testing.T) {
+	client, stopped := startServer(t, &fakeController{})
+
+	if err := client.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop returned error: %v", err)
+	}
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("server stop callback not called")
+	}
+}
+
+func TestClientServer_Activity(t *testing.T) {
+	controller := &feedController{subscribed: make(chan chan ports.FileEvent, 1)}
+	client, _ := startServer(t, controller)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := client.Activity(ctx)
+	if err != nil {
+		t.Fatalf("Activity returned error: %v", err)
+	}
+	want := ports.FileEvent{Path: "/p/main.go", Operation: ports.FileOpCreate, Timestamp: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)}
+	(<-controller.subscribed) <- want
+
+	select {
+	case got := <-events:
+		if got.Path != want.Path || got.Operation != want.Operation || !got.Timestamp.Equal(want.Timestamp) {
+			t.Errorf("event = %+v, want %+v", got, want)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event relayed")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("expected the stream to close after cancel")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("stream not closed after cancel")
+	}
+}
+
+func TestClientServer_ActivityNotAvailable(t *testing.T) {
+	client, _ := startServer(t, &fakeController{})
+
+	if _, err := client.Activity(context.Background()); err == nil {
+		t.Error("expected error from a controller without activity")
+	}
+}
+
+func TestClient_NotRunning(t *testing.T) {
+	client := NewClient(SocketPath(t.TempDir()))
+
+	if client.IsRunning(context.Background()) {
+		t.Error("IsRunning = true with no server")
+	}
+	if _, err := client.Status(context.Background()); !errors.Is(err, ErrNotRunning) {
+		t.Errorf("Status error = %v, want ErrNotRunning", err)
+	}
+}
+
+func TestServer_RemovesSocketOnExit(t *testing.T) {
+	socketPath := SocketPath(t.TempDir())
+	// A stale socket file from a crashed daemon is replaced
+	if err := os.WriteFile(socketPath, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- NewServer(&fakeController{}, nil).Serve(ctx, socketPath) }()
+
+	client := NewClient(socketPath)
+	deadline := time.Now().Add(2 * time.Second)
+	for !client.IsRunning(context.Background()) {
+		if time.Now().After(deadline) {
+			t.Fatal("server did not start")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Serve returned error: %v", err)
+	}
+	if _, err := os.Stat(socketPath); !os.IsNotExist(err) {
+		t.Errorf("socket still exists after shutdown: %v", err)
+	}
+}
+
+func TestServer_SocketOwnerOnly(t *testing.T) {
+	client, _ := startServer(t, &fakeController{})
+
+	info, err := os.Stat(client.socketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode()&os.ModeSocket == 0 || info.Mode().Perm() != 0600 {
+		t.Errorf("socket mode = %v, want owner-only socket", info.Mode())
+	}
+
+	// The private directory the socket was bound in is gone
+	entries, err := os.ReadDir(filepath.Dir(client.socketPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != SocketFileName {
+		t.Errorf("home directory entries = %v, want only %s", entries, SocketFileName)
+	}
+}
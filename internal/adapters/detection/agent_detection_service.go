@@ -17,14 +17,15 @@ const serviceName = "Agent Detection Service"
 const detectionTimeout = 1 * time.Second
 
 // AgentDetectionService orchestrates multiple agent detectors with fallback.
-// It tries Claude Code detection first (high confidence), then falls back
-// to generic file-activity detection (low confidence).
+// It tries log-based detectors (Claude Code, Codex; high confidence) first,
+// then falls back to generic file-activity detection (low confidence).
 //
 // Located in adapters layer (not services) because it directly composes
 // adapter-layer detectors. Per hexagonal architecture, core/services
 // MUST NOT import adapters.
 type AgentDetectionService struct {
 	claudeDetector  ports.AgentActivityDetector
+	codexDetector   ports.AgentActivityDetector
 	genericDetector ports.AgentActivityDetector
 }
 
@@ -38,6 +39,13 @@ func WithClaudeDetector(d ports.AgentActivityDetector) ServiceOption {
 	}
 }
 
+// WithCodexDetector sets a custom Codex detector (for testing).
+func WithCodexDetector(d ports.AgentActivityDetector) ServiceOption {
+	return func(s *AgentDetectionService) {
+		s.codexDetector = d
+	}
+}
+
 // WithGenericDetector sets a custom generic detector (for testing).
 func WithGenericDetector(d ports.AgentActivityDetector) ServiceOption {
 	return func(s *AgentDetectionService) {
@@ -54,6 +62,9 @@ func NewAgentDetectionService(opts ...ServiceOption) *AgentDetectionService {
 	if s.claudeDetector == nil {
 		s.claudeDetector = agentdetectors.NewClaudeCodeDetector()
 	}
+	if s.codexDetector == nil {
+		s.codexDetector = agentdetectors.NewCodexDetector()
+	}
 	if s.genericDetector == nil {
 		s.genericDetector = agentdetectors.NewGenericDetector()
 	}
@@ -69,7 +80,7 @@ func (s *AgentDetectionService) Name() string {
 }
 
 // Detect determines the agent activity state for a project.
-// Uses Claude Code detection with fallback to generic file-activity detection.
+// Uses Claude Code and Codex log detection with fallback to generic file-activity detection.
 func (s *AgentDetectionService) Detect(ctx context.Context, projectPath string) (domain.AgentState, error) {
 	// Apply timeout per NFR-P2-1 (< 1 second)
 	ctx, cancel := context.WithTimeout(ctx, detectionTimeout)
@@ -82,17 +93,33 @@ func (s *AgentDetectionService) Detect(ctx context.Context, projectPath string)
 	default:
 	}
 
-	// Step 1: Try Claude Code detection (high confidence)
-	claudeState, err := s.claudeDetector.Detect(ctx, projectPath)
-	if err != nil {
-		slog.Debug("Claude Code detection error, falling back to generic",
-			"path", projectPath, "error", err)
-		// Fall through to generic detection
-	} else if !claudeState.IsUnknown() {
-		// Claude detection succeeded with known state
-		slog.Debug("Agent detection via Claude Code",
-			"path", projectPath, "status", claudeState.Status.String())
-		return claudeState, nil
+	// Step 1: Try log-based detectors (high confidence).
+	// When several tools have sessions for the project, the most recent activity wins.
+	var best *domain.AgentState
+	for _, detector := range []ports.AgentActivityDetector{s.claudeDetector, s.codexDetector} {
+		select {
+		case <-ctx.Done():
+			return domain.NewAgentState(serviceName, domain.AgentUnknown, 0, domain.ConfidenceUncertain), nil
+		default:
+		}
+
+		state, err := detector.Detect(ctx, projectPath)
+		if err != nil {
+			slog.Debug("log-based detection error, trying next detector",
+				"detector", detector.Name(), "path", projectPath, "error", err)
+			continue
+		}
+		if state.IsUnknown() {
+			continue
+		}
+		if best == nil || preferState(state, *best) {
+			best = &state
+		}
+	}
+	if best != nil {
+		slog.Debug("Agent detection via tool logs",
+			"path", projectPath, "tool", best.Tool, "status", best.Status.String())
+		return *best, nil
 	}
 
 	// Check context between detector calls (Story 15.4 learning)
@@ -114,3 +141,14 @@ func (s *AgentDetectionService) Detect(ctx context.Context, projectPath string)
 		"path", projectPath, "status", genericState.Status.String())
 	return genericState, nil
 }
+
+// preferState reports whether candidate should replace current when two
+// log-based detectors both know the agent state. Active states (Working,
+// WaitingForUser) beat Inactive; among active states the most recent
+// activity (shortest duration) wins.
+func preferState(candidate, current domain.AgentState) bool {
+	if candidate.IsInactive() != current.IsInactive() {
+		return current.IsInactive()
+	}
+	return candidate.Duration < current.Duration
+}
@@ -194,6 +194,64 @@ func TestAgentDetectionService_Detect(t *testing.T) {
 	}
 }
 
+func TestAgentDetectionService_Detect_Codex(t *testing.T) {
+	tests := []struct {
+		name        string
+		claudeState domain.AgentState
+		codexState  domain.AgentState
+		wantTool    string
+		wantStatus  domain.AgentStatus
+	}{
+		{
+			name:        "claude unknown - codex waiting wins",
+			claudeState: domain.NewAgentState("Claude Code", domain.AgentUnknown, 0, domain.ConfidenceUncertain),
+			codexState:  domain.NewAgentState("Codex", domain.AgentWaitingForUser, 20*time.Minute, domain.ConfidenceCertain),
+			wantTool:    "Codex",
+			wantStatus:  domain.AgentWaitingForUser,
+		},
+		{
+			name:        "both active - most recent wins",
+			claudeState: domain.NewAgentState("Claude Code", domain.AgentWaitingForUser, 3*time.Hour, domain.ConfidenceCertain),
+			codexState:  domain.NewAgentState("Codex", domain.AgentWorking, time.Minute, domain.ConfidenceCertain),
+			wantTool:    "Codex",
+			wantStatus:  domain.AgentWorking,
+		},
+		{
+			name:        "claude inactive - codex active wins",
+			claudeState: domain.NewAgentState("Claude Code", domain.AgentInactive, 0, domain.ConfidenceCertain),
+			codexState:  domain.NewAgentState("Codex", domain.AgentWaitingForUser, 2*time.Hour, domain.ConfidenceCertain),
+			wantTool:    "Codex",
+			wantStatus:  domain.AgentWaitingForUser,
+		},
+		{
+			name:        "codex unknown - claude kept",
+			claudeState: domain.NewAgentState("Claude Code", domain.AgentWorking, time.Minute, domain.ConfidenceCertain),
+			codexState:  domain.NewAgentState("Codex", domain.AgentUnknown, 0, domain.ConfidenceUncertain),
+			wantTool:    "Claude Code",
+			wantStatus:  domain.AgentWorking,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genericMock := &mockDetector{name: "Generic"}
+			svc := NewAgentDetectionService(
+				WithClaudeDetector(&mockDetector{name: "Claude Code", state: tt.claudeState}),
+				WithCodexDetector(&mockDetector{name: "Codex", state: tt.codexState}),
+				WithGenericDetector(genericMock),
+			)
+
+			state, _ := svc.Detect(context.Background(), "/test/path")
+			if state.Tool != tt.wantTool || state.Status != tt.wantStatus {
+				t.Errorf("Detect() = %s, want %s/%v", state.Summary(), tt.wantTool, tt.wantStatus)
+			}
+			if genericMock.called {
+				t.Error("generic detector should not be called when a log-based detector knows the state")
+			}
+		})
+	}
+}
+
 func TestAgentDetectionService_Detect_Timeout(t *testing.T) {
 	// Claude detector takes 2 seconds (longer than 1 second timeout)
 	claudeMock := &mockDetector{
@@ -162,7 +162,7 @@ func (d *BMADDetector) Detect(ctx context.Context, path string) (*domain.Detecti
 
 	// Detect stage from sprint-status.yaml or artifacts
 	// Pass bmadDir so we can read config for artifact paths
-	stage, stageConfidence, stageReasoning, artifactMtime := d.detectStage(ctx, path, bmadDir)
+	stage, stageConfidence, stageReasoning, artifactMtime, sprint := d.detectStage(ctx, path, bmadDir)
 
 	// Build combined reasoning
 	var fullReasoning string
@@ -184,7 +184,9 @@ func (d *BMADDetector) Detect(ctx context.Context, path string) (*domain.Detecti
 		stage,
 		finalConfidence,
 		fullReasoning,
-	).WithTimestamp(artifactMtime)
+	).WithTimestamp(artifactMtime).
+		WithSprint(sprint).
+		WithMethodStage(methodStage(stage, sprint))
 	return &result, nil
 }
 
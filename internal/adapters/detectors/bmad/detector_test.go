@@ -443,6 +443,7 @@ func TestBMADDetector_FixtureBased(t *testing.T) {
 		expectedStage  domain.Stage
 		expectedConf   domain.Confidence
 		expectedMethod string
+		expectedID     string // Method stage ID ("" when stage is unknown)
 	}{
 		// bmad-v6-complete: Full .bmad structure with sprint-status.yaml showing epic in-progress
 		{
@@ -451,6 +452,7 @@ func TestBMADDetector_FixtureBased(t *testing.T) {
 			expectedStage:  domain.StageImplement, // Has epic-1: in-progress
 			expectedConf:   domain.ConfidenceCertain,
 			expectedMethod: "bmad",
+			expectedID:     "development",
 		},
 		// bmad-v6-minimal: Just .bmad/bmm/config.yaml - no sprint-status or artifacts
 		{
@@ -475,6 +477,7 @@ func TestBMADDetector_FixtureBased(t *testing.T) {
 			expectedStage:  domain.StageImplement, // Has epic-2: in-progress
 			expectedConf:   domain.ConfidenceCertain,
 			expectedMethod: "bmad",
+			expectedID:     "development",
 		},
 		// bmad-v6-all-done: All epics marked done
 		{
@@ -483,6 +486,7 @@ func TestBMADDetector_FixtureBased(t *testing.T) {
 			expectedStage:  domain.StageImplement, // All done = still Implement stage
 			expectedConf:   domain.ConfidenceCertain,
 			expectedMethod: "bmad",
+			expectedID:     "done",
 		},
 		// bmad-v6-artifacts-only: has epics.md but no sprint-status - falls back to artifact detection
 		// Returns Certain confidence because artifact detection returns Likely (not Uncertain)
@@ -493,6 +497,7 @@ func TestBMADDetector_FixtureBased(t *testing.T) {
 			expectedStage:  domain.StageImplement, // Epic artifacts detected
 			expectedConf:   domain.ConfidenceCertain,
 			expectedMethod: "bmad",
+			expectedID:     "sprint-planning",
 		},
 		// bmad-v4-not-supported: .bmad-core folder (v4 structure)
 		{
@@ -568,6 +573,9 @@ func TestBMADDetector_FixtureBased(t *testing.T) {
 				if result.Reasoning == "" {
 					t.Error("Reasoning should not be empty")
 				}
+				if result.MethodStage.ID != tc.expectedID {
+					t.Errorf("MethodStage.ID = %q, want %q", result.MethodStage.ID, tc.expectedID)
+				}
 			} else {
 				if canDetect {
 					t.Errorf("CanDetect() = true, want false for fixture %s", tc.fixture)
@@ -0,0 +1,45 @@
+package bmad
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+)
+
+// StoryProgress counts stories in a project's sprint-status.yaml: keys like
+// "1-2-some-story", excluding deferred ones. Returns ok=false when no
+// sprint-status.yaml is found. Used for burndown metrics.
+func StoryProgress(ctx context.Context, projectPath string) (done, total int, ok bool, err error) {
+	var cfg *BMADConfig
+	for _, marker := range markerDirs {
+		markerPath := filepath.Join(projectPath, marker)
+		if info, statErr := os.Stat(markerPath); statErr == nil && info.IsDir() {
+			cfg, _ = findBMADConfigWithMtime(markerPath)
+			break
+		}
+	}
+
+	statusPath, _ := findSprintStatusPath(projectPath, cfg)
+	if statusPath == "" {
+		return 0, 0, false, nil
+	}
+	status, err := parseSprintStatus(ctx, statusPath)
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	for key, value := range status.DevelopmentStatus {
+		if !storyKeyRegex.MatchString(key) {
+			continue
+		}
+		normalized := normalizeStatus(value)
+		if isDeferred(normalized) {
+			continue
+		}
+		total++
+		if normalized == "done" {
+			done++
+		}
+	}
+	return done, total, true, nil
+}
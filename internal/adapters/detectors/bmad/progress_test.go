@@ -0,0 +1,48 @@
+package bmad
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStoryProgress_Fixture(t *testing.T) {
+	done, total, ok, err := StoryProgress(context.Background(), filepath.Join(fixturesDir(), "bmad-v6-mid-sprint"))
+	if err != nil || !ok {
+		t.Fatalf("StoryProgress() ok = %v, err = %v", ok, err)
+	}
+	if done != 2 || total != 4 {
+		t.Errorf("StoryProgress() = %d/%d, want 2/4", done, total)
+	}
+}
+
+func TestStoryProgress_SkipsDeferredAndNormalizes(t *testing.T) {
+	dir := t.TempDir()
+	statusDir := filepath.Join(dir, "docs", "sprint-artifacts")
+	if err := os.MkdirAll(statusDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := `development_status:
+  epic-1: in-progress
+  1-1-a: Completed
+  1-2-b: review
+  1-3-c: deferred
+  epic-1-retrospective: optional
+`
+	if err := os.WriteFile(filepath.Join(statusDir, "sprint-status.yaml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	done, total, ok, err := StoryProgress(context.Background(), dir)
+	if err != nil || !ok || done != 1 || total != 2 {
+		t.Errorf("StoryProgress() = %d/%d ok=%v err=%v, want 1/2", done, total, ok, err)
+	}
+}
+
+func TestStoryProgress_NoSprintStatus(t *testing.T) {
+	_, _, ok, err := StoryProgress(context.Background(), t.TempDir())
+	if ok || err != nil {
+		t.Errorf("StoryProgress() ok = %v, err = %v; want false, nil", ok, err)
+	}
+}
@@ -121,11 +121,13 @@ func parseSprintStatus(ctx context.Context, path string) (*SprintStatus, error)
 }
 
 // determineStageFromStatus analyzes the sprint status and returns the current stage.
-// Returns (stage, confidence, reasoning).
-func determineStageFromStatus(status *SprintStatus) (domain.Stage, domain.Confidence, string) {
+// Returns (stage, confidence, reasoning, sprint) where sprint is the structured
+// epic/story tree with the item the stage was derived from (nil if the file is empty).
+func determineStageFromStatus(status *SprintStatus) (domain.Stage, domain.Confidence, string, *domain.SprintProgress) {
 	if status == nil || status.DevelopmentStatus == nil || len(status.DevelopmentStatus) == 0 {
-		return domain.StageUnknown, domain.ConfidenceUncertain, "sprint-status.yaml is empty"
+		return domain.StageUnknown, domain.ConfidenceUncertain, "sprint-status.yaml is empty", nil
 	}
+	sprint := &domain.SprintProgress{Epics: buildSprintEpics(status)}
 
 	// G23: Track retrospectives for all-done case
 	type retroInfo struct {
@@ -191,11 +193,11 @@ func determineStageFromStatus(status *SprintStatus) (domain.Stage, domain.Confid
 	// G24: Check if all epics are deferred (no active epics found)
 	if len(epics) == 0 {
 		return domain.StageUnknown, domain.ConfidenceUncertain,
-			"All epics deferred - no active development"
+			"All epics deferred - no active development", sprint
 	}
 
 	// G14/G22: Track data quality warnings
-	var warnings []string
+	var warnings []domain.SprintWarning
 
 	// Second pass: associate stories with epics
 	for key, value := range status.DevelopmentStatus {
@@ -206,7 +208,7 @@ func determineStageFromStatus(status *SprintStatus) (domain.Stage, domain.Confid
 
 		// G22: Check for empty status values
 		if strings.TrimSpace(value) == "" {
-			warnings = append(warnings, "empty status for "+key)
+			warnings = append(warnings, domain.SprintWarning{Kind: domain.WarningEmptyStatus, Key: key})
 			continue
 		}
 
@@ -231,18 +233,26 @@ func determineStageFromStatus(status *SprintStatus) (domain.Stage, domain.Confid
 				})
 			} else {
 				// G14: Orphan story (no matching epic)
-				warnings = append(warnings, "orphan story "+formatStoryKey(key))
+				warnings = append(warnings, domain.SprintWarning{Kind: domain.WarningOrphanStory, Key: key, Detail: formatStoryKey(key)})
 			}
 		}
 	}
 
-	// Helper to append warnings to reasoning
-	appendWarnings := func(reasoning string) string {
+	// finish records the focus and warnings on the sprint and appends the
+	// warnings to reasoning
+	finish := func(stage domain.Stage, confidence domain.Confidence, reasoning string, focus *domain.SprintFocus) (domain.Stage, domain.Confidence, string, *domain.SprintProgress) {
+		sprint.Focus = focus
 		if len(warnings) > 0 {
-			sort.Strings(warnings) // Deterministic warning order
-			return reasoning + " [Warning: " + strings.Join(warnings, "; ") + "]"
+			// Deterministic warning order
+			sort.Slice(warnings, func(i, j int) bool { return warnings[i].String() < warnings[j].String() })
+			texts := make([]string, len(warnings))
+			for i, w := range warnings {
+				texts[i] = w.String()
+			}
+			sprint.Warnings = warnings
+			reasoning += " [Warning: " + strings.Join(texts, "; ") + "]"
 		}
-		return reasoning
+		return stage, confidence, reasoning, sprint
 	}
 
 	// Count epics by status
@@ -281,12 +291,12 @@ func determineStageFromStatus(status *SprintStatus) (domain.Stage, domain.Confid
 		if epic.status == "done" {
 			for _, story := range epic.stories {
 				if story.status == "review" {
-					return domain.StageTasks, domain.ConfidenceLikely,
-						appendWarnings("Epic done but Story " + formatStoryKey(story.key) + " in review")
+					return finish(domain.StageTasks, domain.ConfidenceLikely,
+						"Epic done but Story "+formatStoryKey(story.key)+" in review", storyFocus(epic, story.key, story.status))
 				}
 				if story.status == "in-progress" {
-					return domain.StageImplement, domain.ConfidenceLikely,
-						appendWarnings("Epic done but Story " + formatStoryKey(story.key) + " in-progress")
+					return finish(domain.StageImplement, domain.ConfidenceLikely,
+						"Epic done but Story "+formatStoryKey(story.key)+" in-progress", storyFocus(epic, story.key, story.status))
 				}
 			}
 		}
@@ -296,11 +306,13 @@ func determineStageFromStatus(status *SprintStatus) (domain.Stage, domain.Confid
 	if len(epics) > 0 && doneCount == len(epics) {
 		for _, retro := range retrospectives {
 			if isActiveStatus(retro.status) {
-				return domain.StageImplement, domain.ConfidenceCertain,
-					appendWarnings("Retrospective for Epic " + retro.epicNum + " in progress")
+				return finish(domain.StageImplement, domain.ConfidenceCertain,
+					"Retrospective for Epic "+retro.epicNum+" in progress",
+					&domain.SprintFocus{Kind: domain.FocusRetrospective, Epic: dotted(retro.epicNum), Status: toWorkStatus(retro.status)})
 			}
 		}
-		return domain.StageImplement, domain.ConfidenceCertain, appendWarnings("All epics complete - project done")
+		return finish(domain.StageImplement, domain.ConfidenceCertain, "All epics complete - project done",
+			&domain.SprintFocus{Kind: domain.FocusComplete, Status: domain.WorkDone})
 	}
 
 	// G8: Check for backlog epics with active stories
@@ -309,8 +321,8 @@ func determineStageFromStatus(status *SprintStatus) (domain.Stage, domain.Confid
 		if epic.status == "backlog" {
 			for _, story := range epic.stories {
 				if story.status == "in-progress" || story.status == "done" || story.status == "review" {
-					return domain.StageSpecify, domain.ConfidenceLikely,
-						appendWarnings("Epic backlog but Story " + formatStoryKey(story.key) + " active")
+					return finish(domain.StageSpecify, domain.ConfidenceLikely,
+						"Epic backlog but Story "+formatStoryKey(story.key)+" active", storyFocus(epic, story.key, story.status))
 				}
 			}
 		}
@@ -318,37 +330,126 @@ func determineStageFromStatus(status *SprintStatus) (domain.Stage, domain.Confid
 
 	// All epics backlog
 	if len(epics) > 0 && backlogCount == len(epics) {
-		return domain.StageSpecify, domain.ConfidenceCertain, appendWarnings("No epics in progress - planning phase")
+		return finish(domain.StageSpecify, domain.ConfidenceCertain, "No epics in progress - planning phase",
+			&domain.SprintFocus{Kind: domain.FocusPlanning, Status: domain.WorkBacklog})
 	}
 
 	// G25/G27: Check for backlog epic when no in-progress epic exists
 	// This handles the case where some epics are done and some are backlog
 	if firstInProgressEpic == nil && firstBacklogEpic != nil {
 		// Analyze backlog epic's stories using same priority logic as in-progress
-		stage, confidence, reasoning, found := analyzeEpicStories(firstBacklogEpic, &warnings, appendWarnings)
+		stage, confidence, reasoning, focus, found := analyzeEpicStories(firstBacklogEpic, &warnings)
 		if found {
-			return stage, confidence, reasoning
+			return finish(stage, confidence, reasoning, focus)
 		}
 
 		// G26: Backlog epic has no stories - needs story planning
-		return domain.StagePlan, domain.ConfidenceCertain,
-			appendWarnings(formatEpicKey(firstBacklogEpic.key) + " in backlog, needs story planning")
+		return finish(domain.StagePlan, domain.ConfidenceCertain,
+			formatEpicKey(firstBacklogEpic.key)+" in backlog, needs story planning", epicFocus(firstBacklogEpic, domain.WorkBacklog))
 	}
 
 	// Has in-progress epic - analyze its stories using shared helper
 	if firstInProgressEpic != nil {
-		stage, confidence, reasoning, found := analyzeEpicStories(firstInProgressEpic, &warnings, appendWarnings)
+		stage, confidence, reasoning, focus, found := analyzeEpicStories(firstInProgressEpic, &warnings)
 		if found {
-			return stage, confidence, reasoning
+			return finish(stage, confidence, reasoning, focus)
 		}
 
 		// Epic in-progress but no actionable stories found
-		return domain.StagePlan, domain.ConfidenceCertain,
-			appendWarnings(formatEpicKey(firstInProgressEpic.key) + " started, preparing stories")
+		return finish(domain.StagePlan, domain.ConfidenceCertain,
+			formatEpicKey(firstInProgressEpic.key)+" started, preparing stories", epicFocus(firstInProgressEpic, domain.WorkInProgress))
 	}
 
 	// Fallback for unexpected states
-	return domain.StageUnknown, domain.ConfidenceUncertain, appendWarnings("Unable to determine stage from sprint status")
+	return finish(domain.StageUnknown, domain.ConfidenceUncertain, "Unable to determine stage from sprint status", nil)
+}
+
+// buildSprintEpics builds the epic/story tree of a sprint status in natural
+// order. Deferred epics are kept (with WorkDeferred) so the tree is complete;
+// stories without a matching epic are left out (they are reported as
+// orphan warnings).
+func buildSprintEpics(status *SprintStatus) []domain.SprintEpic {
+	byKey := make(map[string]*domain.SprintEpic)
+	var keys []string
+	for key, value := range status.DevelopmentStatus {
+		if epicKeyRegex.MatchString(key) {
+			byKey[key] = &domain.SprintEpic{
+				Key:    key,
+				ID:     dotted(strings.TrimPrefix(key, "epic-")),
+				Status: toWorkStatus(normalizeStatus(value)),
+			}
+			keys = append(keys, key)
+		}
+	}
+
+	for key, value := range status.DevelopmentStatus {
+		if matches := retroKeyRegex.FindStringSubmatch(key); matches != nil {
+			if epic, ok := byKey["epic-"+matches[1]]; ok {
+				epic.Retrospective = toWorkStatus(normalizeStatus(value))
+			}
+			continue
+		}
+		if !storyKeyRegex.MatchString(key) {
+			continue
+		}
+		if epic, ok := byKey["epic-"+extractStoryPrefix(key)]; ok {
+			epic.Stories = append(epic.Stories, domain.SprintStory{
+				Key:       key,
+				ID:        formatStoryKey(key),
+				Status:    toWorkStatus(normalizeStatus(value)),
+				RawStatus: value,
+			})
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return naturalCompare(keys[i], keys[j]) })
+	epics := make([]domain.SprintEpic, 0, len(keys))
+	for _, key := range keys {
+		epic := byKey[key]
+		sort.Slice(epic.Stories, func(i, j int) bool { return naturalCompare(epic.Stories[i].Key, epic.Stories[j].Key) })
+		epics = append(epics, *epic)
+	}
+	return epics
+}
+
+// toWorkStatus maps a normalized status to a WorkStatus. "contexted" and
+// "started" count as in progress; anything unrecognized is WorkUnknown.
+func toWorkStatus(normalized string) domain.WorkStatus {
+	switch {
+	case isDeferred(normalized):
+		return domain.WorkDeferred
+	case normalized == "contexted" || isActiveStatus(normalized):
+		return domain.WorkInProgress
+	}
+	switch s := domain.WorkStatus(normalized); s {
+	case domain.WorkBacklog, domain.WorkDrafted, domain.WorkReady, domain.WorkInProgress, domain.WorkReview, domain.WorkDone:
+		return s
+	}
+	return domain.WorkUnknown
+}
+
+// storyFocus returns a focus on one story of an epic.
+func storyFocus(epic *epicInfo, storyKey, status string) *domain.SprintFocus {
+	return &domain.SprintFocus{
+		Kind:   domain.FocusStory,
+		Epic:   dotted(strings.TrimPrefix(epic.key, "epic-")),
+		Story:  formatStoryKey(storyKey),
+		Status: toWorkStatus(status),
+	}
+}
+
+// epicFocus returns a focus on an epic itself.
+func epicFocus(epic *epicInfo, status domain.WorkStatus) *domain.SprintFocus {
+	return &domain.SprintFocus{
+		Kind:   domain.FocusEpic,
+		Epic:   dotted(strings.TrimPrefix(epic.key, "epic-")),
+		Status: status,
+	}
+}
+
+// dotted converts a dashed number to dotted form: "4-5" -> "4.5".
+func dotted(num string) string {
+	return strings.ReplaceAll(num, "-", ".")
 }
 
 // extractStoryPrefix extracts the epic prefix from a story key.
@@ -518,11 +619,11 @@ func formatEpicKey(key string) string {
 
 // analyzeEpicStories analyzes an epic's stories and returns stage info.
 // G25: Extracted helper to share story analysis logic between in-progress and backlog epics.
-// Returns (stage, confidence, reasoning, found) where found indicates if a result was produced.
-// The warnings pointer allows adding warnings that get formatted by appendWarnings.
-func analyzeEpicStories(epic *epicInfo, warnings *[]string, appendWarnings func(string) string) (domain.Stage, domain.Confidence, string, bool) {
+// Returns (stage, confidence, reasoning, focus, found) where found indicates if a result was produced.
+// The warnings pointer allows adding warnings that the caller appends to reasoning.
+func analyzeEpicStories(epic *epicInfo, warnings *[]domain.SprintWarning) (domain.Stage, domain.Confidence, string, *domain.SprintFocus, bool) {
 	if len(epic.stories) == 0 {
-		return domain.StageUnknown, domain.ConfidenceUncertain, "", false
+		return domain.StageUnknown, domain.ConfidenceUncertain, "", nil, false
 	}
 
 	// G19: Sort stories for deterministic ordering
@@ -572,22 +673,23 @@ func analyzeEpicStories(epic *epicInfo, warnings *[]string, appendWarnings func(
 	}
 
 	// Return based on selected story status
+	focus := storyFocus(epic, selectedStory, selectedStatus)
 	switch selectedStatus {
 	case "review":
 		return domain.StageTasks, domain.ConfidenceCertain,
-			appendWarnings("Story " + formatStoryKey(selectedStory) + " in code review"), true
+			"Story " + formatStoryKey(selectedStory) + " in code review", focus, true
 	case "in-progress":
 		return domain.StageImplement, domain.ConfidenceCertain,
-			appendWarnings("Story " + formatStoryKey(selectedStory) + " being implemented"), true
+			"Story " + formatStoryKey(selectedStory) + " being implemented", focus, true
 	case "ready-for-dev":
 		return domain.StagePlan, domain.ConfidenceCertain,
-			appendWarnings("Story " + formatStoryKey(selectedStory) + " ready for development"), true
+			"Story " + formatStoryKey(selectedStory) + " ready for development", focus, true
 	case "drafted":
 		return domain.StagePlan, domain.ConfidenceCertain,
-			appendWarnings("Story " + formatStoryKey(selectedStory) + " drafted, needs review"), true
+			"Story " + formatStoryKey(selectedStory) + " drafted, needs review", focus, true
 	case "backlog":
 		return domain.StagePlan, domain.ConfidenceCertain,
-			appendWarnings("Story " + formatStoryKey(selectedStory) + " in backlog, needs drafting"), true
+			"Story " + formatStoryKey(selectedStory) + " in backlog, needs drafting", focus, true
 	}
 
 	// G1: Check if ALL stories in this epic are done
@@ -600,18 +702,18 @@ func analyzeEpicStories(epic *epicInfo, warnings *[]string, appendWarnings func(
 	}
 	if allDone {
 		return domain.StageImplement, domain.ConfidenceCertain,
-			appendWarnings(formatEpicKey(epic.key) + " stories complete, update epic status"), true
+			formatEpicKey(epic.key) + " stories complete, update epic status", epicFocus(epic, domain.WorkDone), true
 	}
 
 	// If there are stories with unknown status, show the first one
 	if len(unknownStatusStories) > 0 {
 		first := unknownStatusStories[0]
-		*warnings = append(*warnings, "unknown status '"+first.status+"' for "+first.key)
+		*warnings = append(*warnings, domain.SprintWarning{Kind: domain.WarningUnknownStatus, Key: first.key, Detail: first.status})
 		return domain.StagePlan, domain.ConfidenceLikely,
-			appendWarnings("Story " + formatStoryKey(first.key) + " has unknown status '" + first.status + "'"), true
+			"Story " + formatStoryKey(first.key) + " has unknown status '" + first.status + "'", storyFocus(epic, first.key, first.status), true
 	}
 
-	return domain.StageUnknown, domain.ConfidenceUncertain, "", false
+	return domain.StageUnknown, domain.ConfidenceUncertain, "", nil, false
 }
 
 // detectStageFromArtifacts checks for BMAD artifact files as a fallback.
@@ -751,16 +853,17 @@ func findSprintStatusPath(projectPath string, cfg *BMADConfig) (string, time.Tim
 
 // detectStage performs stage detection for a BMAD v6 project.
 // It first tries to parse sprint-status.yaml (using config for path), then falls back to artifact detection.
-// Returns stage, confidence, reasoning, and artifact timestamp.
+// Returns stage, confidence, reasoning, artifact timestamp and the sprint
+// progress (nil unless sprint-status.yaml was parsed).
 // Parameters:
 //   - ctx: context for cancellation
 //   - path: project root path
 //   - bmadDir: the BMAD marker directory (.bmad or _bmad) for reading config
-func (d *BMADDetector) detectStage(ctx context.Context, path string, bmadDir string) (domain.Stage, domain.Confidence, string, time.Time) {
+func (d *BMADDetector) detectStage(ctx context.Context, path string, bmadDir string) (domain.Stage, domain.Confidence, string, time.Time, *domain.SprintProgress) {
 	// Check context first
 	select {
 	case <-ctx.Done():
-		return domain.StageUnknown, domain.ConfidenceUncertain, "", time.Time{}
+		return domain.StageUnknown, domain.ConfidenceUncertain, "", time.Time{}, nil
 	default:
 	}
 
@@ -777,27 +880,27 @@ func (d *BMADDetector) detectStage(ctx context.Context, path string, bmadDir str
 		status, err := parseSprintStatus(ctx, statusPath)
 		if err != nil {
 			// Parse error - return unknown with reason, use config mtime as fallback
-			return domain.StageUnknown, domain.ConfidenceUncertain, "sprint-status.yaml parse error", configMtime
+			return domain.StageUnknown, domain.ConfidenceUncertain, "sprint-status.yaml parse error", configMtime, nil
 		}
 
-		stage, confidence, reasoning := determineStageFromStatus(status)
+		stage, confidence, reasoning, sprint := determineStageFromStatus(status)
 		// Use max of sprint-status mtime and config mtime
 		artifactMtime := statusMtime
 		if configMtime.After(artifactMtime) {
 			artifactMtime = configMtime
 		}
-		return stage, confidence, reasoning, artifactMtime
+		return stage, confidence, reasoning, artifactMtime, sprint
 	}
 
 	// Fallback to artifact detection
 	stage, confidence, reasoning, err := detectStageFromArtifacts(ctx, path)
 	if err != nil {
 		// Context cancellation
-		return domain.StageUnknown, domain.ConfidenceUncertain, "", time.Time{}
+		return domain.StageUnknown, domain.ConfidenceUncertain, "", time.Time{}, nil
 	}
 
 	// For artifact-based detection, use config mtime if available
-	return stage, confidence, reasoning, configMtime
+	return stage, confidence, reasoning, configMtime, nil
 }
 
 // findBMADConfigWithMtime searches for and parses BMAD config from the marker directory.
@@ -859,7 +859,7 @@ func TestDetermineStageFromStatus(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stage, confidence, reasoning := determineStageFromStatus(tt.status)
+			stage, confidence, reasoning, _ := determineStageFromStatus(tt.status)
 
 			if stage != tt.wantStage {
 				t.Errorf("determineStageFromStatus() stage = %v, want %v", stage, tt.wantStage)
@@ -1251,7 +1251,7 @@ func TestDetermineStageFromStatus_DoubleDigitStories(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stage, _, reasoning := determineStageFromStatus(tt.status)
+			stage, _, reasoning, _ := determineStageFromStatus(tt.status)
 			if stage != tt.wantStage {
 				t.Errorf("stage = %v, want %v", stage, tt.wantStage)
 			}
@@ -1316,7 +1316,7 @@ func TestDetermineStageFromStatus_DoubleDigitEpics(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stage, _, reasoning := determineStageFromStatus(tt.status)
+			stage, _, reasoning, _ := determineStageFromStatus(tt.status)
 			if stage != tt.wantStage {
 				t.Errorf("stage = %v, want %v", stage, tt.wantStage)
 			}
@@ -1372,7 +1372,7 @@ func TestDetermineStageFromStatus_DoubleDigitRetrospectives(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stage, _, reasoning := determineStageFromStatus(tt.status)
+			stage, _, reasoning, _ := determineStageFromStatus(tt.status)
 			if stage != tt.wantStage {
 				t.Errorf("stage = %v, want %v", stage, tt.wantStage)
 			}
@@ -1409,7 +1409,7 @@ func TestDetermineStageFromStatus_MixedDoubleDigitScenario(t *testing.T) {
 		},
 	}
 
-	stage, confidence, reasoning := determineStageFromStatus(status)
+	stage, confidence, reasoning, _ := determineStageFromStatus(status)
 
 	if stage != domain.StageImplement {
 		t.Errorf("stage = %v, want StageImplement", stage)
@@ -1480,7 +1480,7 @@ func TestDetermineStageFromStatus_G25_BacklogEpicWithStories(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stage, _, reasoning := determineStageFromStatus(tt.status)
+			stage, _, reasoning, _ := determineStageFromStatus(tt.status)
 			if stage != tt.wantStage {
 				t.Errorf("stage = %v, want %v", stage, tt.wantStage)
 			}
@@ -1529,7 +1529,7 @@ func TestDetermineStageFromStatus_G26_BacklogEpicNoStories(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stage, _, reasoning := determineStageFromStatus(tt.status)
+			stage, _, reasoning, _ := determineStageFromStatus(tt.status)
 			if stage != tt.wantStage {
 				t.Errorf("stage = %v, want %v", stage, tt.wantStage)
 			}
@@ -1587,7 +1587,7 @@ func TestDetermineStageFromStatus_G27_MixedDoneBacklog(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stage, _, reasoning := determineStageFromStatus(tt.status)
+			stage, _, reasoning, _ := determineStageFromStatus(tt.status)
 			if stage != tt.wantStage {
 				t.Errorf("stage = %v, want %v", stage, tt.wantStage)
 			}
@@ -1598,6 +1598,182 @@ func TestDetermineStageFromStatus_G27_MixedDoneBacklog(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Structured Sprint Progress Tests
+// =============================================================================
+
+func TestDetermineStageFromStatus_SprintTree(t *testing.T) {
+	status := &SprintStatus{
+		DevelopmentStatus: map[string]string{
+			"epic-1":               "done",
+			"1-1-setup":            "done",
+			"epic-1-retrospective": "completed",
+			"epic-4-5":             "in-progress",
+			"4-5-10-feature":       "backlog",
+			"4-5-2-feature":        "In Progress",
+			"4-5-1-feature":        "done",
+			"4-5-3-feature":        "blocked",
+			"epic-2":               "deferred",
+			"2-1-later":            "backlog",
+			"9-1-orphan":           "done",
+			"epic-3":               "backlog",
+			"3-1-story":            "",
+		},
+	}
+
+	_, _, _, sprint := determineStageFromStatus(status)
+	if sprint == nil {
+		t.Fatal("sprint = nil, want progress")
+	}
+
+	var ids []string
+	for _, e := range sprint.Epics {
+		ids = append(ids, e.ID+":"+string(e.Status))
+	}
+	want := []string{"1:done", "2:deferred", "3:backlog", "4.5:in-progress"}
+	if len(ids) != len(want) {
+		t.Fatalf("epics = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("epics[%d] = %s, want %s", i, ids[i], want[i])
+		}
+	}
+
+	if got := sprint.Epics[0].Retrospective; got != domain.WorkDone {
+		t.Errorf("epic 1 retrospective = %q, want done", got)
+	}
+	if got := sprint.Epics[2].Stories; len(got) != 1 || got[0].Status != domain.WorkUnknown {
+		t.Errorf("epic 3 stories = %+v, want one unknown story", got)
+	}
+
+	epic := sprint.Epic("4.5")
+	if epic == nil {
+		t.Fatal("Epic(4.5) = nil")
+	}
+	wantStories := []domain.SprintStory{
+		{Key: "4-5-1-feature", ID: "4.5.1", Status: domain.WorkDone, RawStatus: "done"},
+		{Key: "4-5-2-feature", ID: "4.5.2", Status: domain.WorkInProgress, RawStatus: "In Progress"},
+		{Key: "4-5-3-feature", ID: "4.5.3", Status: domain.WorkUnknown, RawStatus: "blocked"},
+		{Key: "4-5-10-feature", ID: "4.5.10", Status: domain.WorkBacklog, RawStatus: "backlog"},
+	}
+	if len(epic.Stories) != len(wantStories) {
+		t.Fatalf("epic 4.5 stories = %+v", epic.Stories)
+	}
+	for i, w := range wantStories {
+		if epic.Stories[i] != w {
+			t.Errorf("stories[%d] = %+v, want %+v", i, epic.Stories[i], w)
+		}
+	}
+
+	wantFocus := domain.SprintFocus{Kind: domain.FocusStory, Epic: "4.5", Story: "4.5.2", Status: domain.WorkInProgress}
+	if sprint.Focus == nil || *sprint.Focus != wantFocus {
+		t.Errorf("focus = %+v, want %+v", sprint.Focus, wantFocus)
+	}
+
+	wantWarnings := []domain.SprintWarning{
+		{Kind: domain.WarningEmptyStatus, Key: "3-1-story"},
+		{Kind: domain.WarningOrphanStory, Key: "9-1-orphan", Detail: "9.1"},
+	}
+	if len(sprint.Warnings) != len(wantWarnings) {
+		t.Fatalf("warnings = %+v, want %+v", sprint.Warnings, wantWarnings)
+	}
+	for i, w := range wantWarnings {
+		if sprint.Warnings[i] != w {
+			t.Errorf("warnings[%d] = %+v, want %+v", i, sprint.Warnings[i], w)
+		}
+	}
+}
+
+func TestDetermineStageFromStatus_SprintFocus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status map[string]string
+		want   *domain.SprintFocus
+	}{
+		{
+			name:   "story in review",
+			status: map[string]string{"epic-8": "in-progress", "8-3-display": "review"},
+			want:   &domain.SprintFocus{Kind: domain.FocusStory, Epic: "8", Story: "8.3", Status: domain.WorkReview},
+		},
+		{
+			name:   "epic without stories",
+			status: map[string]string{"epic-1": "done", "epic-4-5": "in-progress"},
+			want:   &domain.SprintFocus{Kind: domain.FocusEpic, Epic: "4.5", Status: domain.WorkInProgress},
+		},
+		{
+			name:   "backlog epic without stories",
+			status: map[string]string{"epic-1": "done", "epic-10": "backlog", "epic-11": "backlog"},
+			want:   &domain.SprintFocus{Kind: domain.FocusEpic, Epic: "10", Status: domain.WorkBacklog},
+		},
+		{
+			name:   "stories done, epic not updated",
+			status: map[string]string{"epic-2": "in-progress", "2-1-a": "done"},
+			want:   &domain.SprintFocus{Kind: domain.FocusEpic, Epic: "2", Status: domain.WorkDone},
+		},
+		{
+			name:   "retrospective running",
+			status: map[string]string{"epic-7": "done", "epic-7-retrospective": "in-progress"},
+			want:   &domain.SprintFocus{Kind: domain.FocusRetrospective, Epic: "7", Status: domain.WorkInProgress},
+		},
+		{
+			name:   "all complete",
+			status: map[string]string{"epic-1": "done", "epic-2": "done"},
+			want:   &domain.SprintFocus{Kind: domain.FocusComplete, Status: domain.WorkDone},
+		},
+		{
+			name:   "all backlog",
+			status: map[string]string{"epic-1": "backlog", "epic-2": "backlog"},
+			want:   &domain.SprintFocus{Kind: domain.FocusPlanning, Status: domain.WorkBacklog},
+		},
+		{
+			name:   "all deferred",
+			status: map[string]string{"epic-1": "deferred"},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, sprint := determineStageFromStatus(&SprintStatus{DevelopmentStatus: tt.status})
+			if sprint == nil {
+				t.Fatal("sprint = nil, want progress")
+			}
+			if (sprint.Focus == nil) != (tt.want == nil) || (tt.want != nil && *sprint.Focus != *tt.want) {
+				t.Errorf("focus = %+v, want %+v", sprint.Focus, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetermineStageFromStatus_SprintEmpty(t *testing.T) {
+	if _, _, _, sprint := determineStageFromStatus(&SprintStatus{}); sprint != nil {
+		t.Errorf("sprint = %+v, want nil for empty status", sprint)
+	}
+}
+
+func TestToWorkStatus(t *testing.T) {
+	tests := map[string]domain.WorkStatus{
+		"backlog":           domain.WorkBacklog,
+		"drafted":           domain.WorkDrafted,
+		"ready-for-dev":     domain.WorkReady,
+		"in-progress":       domain.WorkInProgress,
+		"started":           domain.WorkInProgress,
+		"contexted":         domain.WorkInProgress,
+		"review":            domain.WorkReview,
+		"done":              domain.WorkDone,
+		"deferred-post-mvp": domain.WorkDeferred,
+		"post-mvp":          domain.WorkDeferred,
+		"blocked":           domain.WorkUnknown,
+		"":                  domain.WorkUnknown,
+	}
+	for in, want := range tests {
+		if got := toWorkStatus(in); got != want {
+			t.Errorf("toWorkStatus(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
 // =============================================================================
 // findSprintStatusPath Tests
 // =============================================================================
@@ -1950,7 +2126,7 @@ func TestDetectStage_Integration(t *testing.T) {
 			d := NewBMADDetector()
 			// Pass bmadDir for config-based path resolution
 			bmadDir := filepath.Join(dir, ".bmad")
-			stage, confidence, reasoning, _ := d.detectStage(context.Background(), dir, bmadDir)
+			stage, confidence, reasoning, _, _ := d.detectStage(context.Background(), dir, bmadDir)
 
 			if stage != tt.wantStage {
 				t.Errorf("detectStage() stage = %v, want %v", stage, tt.wantStage)
@@ -1974,7 +2150,7 @@ func TestDetectStage_ContextCancellation(t *testing.T) {
 	cancel() // Cancel immediately
 
 	bmadDir := filepath.Join(dir, ".bmad")
-	stage, confidence, reasoning, artifactMtime := d.detectStage(ctx, dir, bmadDir)
+	stage, confidence, reasoning, artifactMtime, sprint := d.detectStage(ctx, dir, bmadDir)
 
 	if stage != domain.StageUnknown {
 		t.Errorf("detectStage() with cancelled context stage = %v, want StageUnknown", stage)
@@ -1988,6 +2164,9 @@ func TestDetectStage_ContextCancellation(t *testing.T) {
 	if !artifactMtime.IsZero() {
 		t.Errorf("detectStage() with cancelled context artifactMtime = %v, want zero", artifactMtime)
 	}
+	if sprint != nil {
+		t.Errorf("detectStage() with cancelled context sprint = %+v, want nil", sprint)
+	}
 }
 
 // =============================================================================
@@ -2096,6 +2275,9 @@ func TestDetect_WithStageDetection(t *testing.T) {
 			if !tt.checkReasoning(result.Reasoning) {
 				t.Errorf("Detect() reasoning = %q, check failed", result.Reasoning)
 			}
+			if result.Sprint == nil || result.Sprint.Focus == nil {
+				t.Errorf("Detect() sprint = %+v, want progress with focus", result.Sprint)
+			}
 		})
 	}
 }
@@ -0,0 +1,92 @@
+package bmad
+
+import "github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+
+// BMAD stage IDs, in workflow order.
+const (
+	stagePlanning       = "planning"
+	stageSolutioning    = "solutioning"
+	stageSprintPlanning = "sprint-planning"
+	stageStoryPrep      = "story-prep"
+	stageDevelopment    = "development"
+	stageCodeReview     = "code-review"
+	stageRetrospective  = "retrospective"
+	stageDone           = "done"
+)
+
+// stages is the BMAD workflow: analysis and PRD, architecture, sprint
+// planning, then the per-story cycle (create, develop, review) closed by
+// epic retrospectives. Coarse stages match what detection reported before
+// the taxonomy existed, so sorting and filtering are unchanged.
+var stages = domain.NewMethodStages(
+	domain.MethodStage{ID: stagePlanning, Label: "Planning", Coarse: domain.StageSpecify},
+	domain.MethodStage{ID: stageSolutioning, Label: "Solutioning", Coarse: domain.StagePlan},
+	domain.MethodStage{ID: stageSprintPlanning, Label: "Sprint planning", Coarse: domain.StageImplement},
+	domain.MethodStage{ID: stageStoryPrep, Label: "Story prep", Coarse: domain.StagePlan},
+	domain.MethodStage{ID: stageDevelopment, Label: "Development", Coarse: domain.StageImplement},
+	domain.MethodStage{ID: stageCodeReview, Label: "Code review", Coarse: domain.StageTasks},
+	domain.MethodStage{ID: stageRetrospective, Label: "Retrospective", Coarse: domain.StageImplement},
+	domain.MethodStage{ID: stageDone, Label: "Done", Done: true, Coarse: domain.StageImplement},
+)
+
+// Stages returns the BMAD stage list.
+func (d *BMADDetector) Stages() []domain.MethodStage {
+	list := make([]domain.MethodStage, len(stages))
+	copy(list, stages)
+	return list
+}
+
+// methodStage maps a detected coarse stage onto the BMAD taxonomy. With a
+// sprint, the focus distinguishes retrospectives and completion from
+// development; without one, the stage came from planning artifacts
+// (PRD, architecture, epics). Returns the zero MethodStage for StageUnknown.
+func methodStage(stage domain.Stage, sprint *domain.SprintProgress) domain.MethodStage {
+	if stage == domain.StageUnknown {
+		return domain.MethodStage{}
+	}
+
+	var id string
+	if sprint != nil {
+		id = sprintStageID(stage, sprint.Focus)
+	} else {
+		id = artifactStageID(stage)
+	}
+
+	s, _ := domain.FindMethodStage(stages, id)
+	return s
+}
+
+// sprintStageID returns the stage ID for a stage derived from sprint-status.yaml.
+func sprintStageID(stage domain.Stage, focus *domain.SprintFocus) string {
+	if focus != nil {
+		switch focus.Kind {
+		case domain.FocusRetrospective:
+			return stageRetrospective
+		case domain.FocusComplete:
+			return stageDone
+		}
+	}
+
+	switch stage {
+	case domain.StageSpecify:
+		return stagePlanning
+	case domain.StagePlan:
+		return stageStoryPrep
+	case domain.StageTasks:
+		return stageCodeReview
+	default:
+		return stageDevelopment
+	}
+}
+
+// artifactStageID returns the stage ID for a stage derived from planning artifacts.
+func artifactStageID(stage domain.Stage) string {
+	switch stage {
+	case domain.StageSpecify:
+		return stagePlanning
+	case domain.StagePlan:
+		return stageSolutioning
+	default:
+		return stageSprintPlanning
+	}
+}
@@ -0,0 +1,72 @@
+package bmad
+
+import (
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func TestBMADDetector_Stages(t *testing.T) {
+	got := NewBMADDetector().Stages()
+	wantIDs := []string{
+		stagePlanning, stageSolutioning, stageSprintPlanning, stageStoryPrep,
+		stageDevelopment, stageCodeReview, stageRetrospective, stageDone,
+	}
+
+	if len(got) != len(wantIDs) {
+		t.Fatalf("len(Stages()) = %d, want %d", len(got), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if got[i].ID != id || got[i].Ordinal != i+1 {
+			t.Errorf("Stages()[%d] = %s/%d, want %s/%d", i, got[i].ID, got[i].Ordinal, id, i+1)
+		}
+		if got[i].Done != (id == stageDone) {
+			t.Errorf("Stages()[%d].Done = %v", i, got[i].Done)
+		}
+	}
+
+	// Returned list is a copy
+	got[0].Label = "changed"
+	if stages[0].Label == "changed" {
+		t.Error("Stages() must not expose the package stage list")
+	}
+}
+
+func TestMethodStage(t *testing.T) {
+	sprint := func(kind domain.SprintFocusKind) *domain.SprintProgress {
+		return &domain.SprintProgress{Focus: &domain.SprintFocus{Kind: kind}}
+	}
+
+	tests := []struct {
+		name   string
+		stage  domain.Stage
+		sprint *domain.SprintProgress
+		wantID string
+	}{
+		{"unknown", domain.StageUnknown, nil, ""},
+		{"unknown with sprint", domain.StageUnknown, &domain.SprintProgress{}, ""},
+		{"prd only", domain.StageSpecify, nil, stagePlanning},
+		{"architecture", domain.StagePlan, nil, stageSolutioning},
+		{"epics without sprint", domain.StageImplement, nil, stageSprintPlanning},
+		{"all epics backlog", domain.StageSpecify, sprint(domain.FocusPlanning), stagePlanning},
+		{"story ready", domain.StagePlan, sprint(domain.FocusStory), stageStoryPrep},
+		{"epic preparing stories", domain.StagePlan, sprint(domain.FocusEpic), stageStoryPrep},
+		{"story in progress", domain.StageImplement, sprint(domain.FocusStory), stageDevelopment},
+		{"story in review", domain.StageTasks, sprint(domain.FocusStory), stageCodeReview},
+		{"retrospective", domain.StageImplement, sprint(domain.FocusRetrospective), stageRetrospective},
+		{"all done", domain.StageImplement, sprint(domain.FocusComplete), stageDone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := methodStage(tt.stage, tt.sprint)
+			if got.ID != tt.wantID {
+				t.Errorf("methodStage(%v) = %q, want %q", tt.stage, got.ID, tt.wantID)
+			}
+			// The coarse stage must be the one detection already reported
+			if tt.wantID != "" && got.Coarse != tt.stage {
+				t.Errorf("methodStage(%v).Coarse = %v, want unchanged", tt.stage, got.Coarse)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+// Package declarative implements config-driven methodology detectors.
+// Definitions are YAML files (typically under ~/.vibe-dash/detectors/) that
+// describe marker directories, artifact globs and ordered stage rules, so
+// teams can add in-house workflows without writing Go code.
+//
+// Example definition:
+//
+//	name: rfc-flow
+//	markers: [rfcs]
+//	artifacts: ["rfcs/*/*.md"]
+//	stages:
+//	  - stage: implement
+//	    reasoning: "build notes exist"
+//	    when:
+//	      exists: ["rfcs/*/build.md"]
+//	  - stage: tasks
+//	    confidence: likely
+//	    reasoning: "design approved ({match})"
+//	    when:
+//	      front_matter:
+//	        - file: "rfcs/*/design.md"
+//	          field: status
+//	          equals: approved
+//	  - stage: specify
+//	    when:
+//	      exists: ["rfcs/*/rfc.md"]
+//
+// Stage rules are evaluated top to bottom and the first matching rule wins,
+// so list the most advanced stage first. Patterns use filepath.Match syntax
+// relative to the project root.
+//
+// Without a workflow, rule stages name the coarse stages (specify, plan,
+// tasks, implement). A workflow declares the methodology's own ordered stage
+// list instead; rules then name workflow IDs, and each workflow stage maps
+// onto a coarse stage for sorting and filtering:
+//
+//	workflow:
+//	  - id: draft
+//	    stage: specify
+//	  - id: design-review
+//	    label: Design review
+//	    stage: plan
+//	  - id: build
+//	    stage: implement
+//	  - id: shipped
+//	    stage: implement
+//	    done: true
+//	stages:
+//	  - stage: shipped
+//	    when:
+//	      exists: ["rfcs/*/release.md"]
+//	  - stage: design-review
+//	    when:
+//	      exists: ["rfcs/*/design.md"]
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// namePattern restricts detector names to lowercase identifiers (e.g., "rfc-flow").
+// Names are persisted as detected_method and shown in the TUI, so keep them short and stable.
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// ErrInvalidDefinition indicates a detector definition failed validation.
+var ErrInvalidDefinition = errors.New("invalid detector definition")
+
+// Definition is the YAML schema for a declarative detector.
+type Definition struct {
+	Name        string          `yaml:"name"`
+	Description string          `yaml:"description"`
+	Markers     []string        `yaml:"markers"`
+	Artifacts   []string        `yaml:"artifacts"`
+	Workflow    []WorkflowStage `yaml:"workflow"`
+	Stages      []StageRule     `yaml:"stages"`
+	Default     *StageRule      `yaml:"default"`
+
+	// Source is the file the definition was loaded from (empty for inline definitions).
+	Source string `yaml:"-"`
+
+	methodStages []domain.MethodStage
+}
+
+// WorkflowStage declares one stage of the methodology's own stage list.
+type WorkflowStage struct {
+	ID    string `yaml:"id"`    // Referenced by stage rules and persisted, "design-review"
+	Label string `yaml:"label"` // Display name (default derived from ID, "Design review")
+	Stage string `yaml:"stage"` // Coarse stage: specify, plan, tasks or implement
+	Done  bool   `yaml:"done"`  // Reaching this stage means the workflow is finished
+}
+
+// StageRule maps a set of conditions to a stage result.
+type StageRule struct {
+	Stage      string    `yaml:"stage"`
+	Confidence string    `yaml:"confidence"` // "certain" (default), "likely", "uncertain"
+	Reasoning  string    `yaml:"reasoning"`  // "{match}" is replaced by the first matched path
+	When       Condition `yaml:"when"`
+
+	stage      domain.MethodStage
+	confidence domain.Confidence
+}
+
+// Condition describes what must hold for a StageRule to match.
+// All populated fields must be satisfied (logical AND).
+type Condition struct {
+	// Exists lists patterns that must each match at least one file.
+	Exists []string `yaml:"exists"`
+	// AnyExists lists patterns where at least one must match a file.
+	AnyExists []string `yaml:"any_exists"`
+	// Missing lists patterns that must not match any file.
+	Missing []string `yaml:"missing"`
+	// FrontMatter lists front-matter field checks on matched files.
+	FrontMatter []FrontMatterCheck `yaml:"front_matter"`
+}
+
+// FrontMatterCheck matches a YAML front-matter field in files matching File.
+// The check passes if ANY matching file satisfies it.
+type FrontMatterCheck struct {
+	File   string   `yaml:"file"`
+	Field  string   `yaml:"field"`
+	Equals string   `yaml:"equals"`
+	In     []string `yaml:"in"`
+}
+
+// isEmpty reports whether the condition has no checks (always matches).
+func (c Condition) isEmpty() bool {
+	return len(c.Exists) == 0 && len(c.AnyExists) == 0 && len(c.Missing) == 0 && len(c.FrontMatter) == 0
+}
+
+// Parse decodes and validates a definition from YAML bytes.
+func Parse(data []byte) (*Definition, error) {
+	var def Definition
+	dec := yaml.NewDecoder(strings.NewReader(string(data)))
+	dec.KnownFields(true)
+	if err := dec.Decode(&def); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDefinition, err)
+	}
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// LoadFile reads and parses a definition file.
+func LoadFile(path string) (*Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read detector definition: %w", err)
+	}
+	def, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	def.Source = path
+	return def, nil
+}
+
+// Validate checks the definition and resolves stage/confidence names.
+// Must be called before the definition is used by a Detector (Parse does this).
+func (d *Definition) Validate() error {
+	if !namePattern.MatchString(d.Name) {
+		return fmt.Errorf("%w: name must match %s, got %q", ErrInvalidDefinition, namePattern.String(), d.Name)
+	}
+	if d.Name == "unknown" {
+		return fmt.Errorf("%w: name %q is reserved", ErrInvalidDefinition, d.Name)
+	}
+	if len(d.Markers) == 0 {
+		return fmt.Errorf("%w: %s: at least one marker is required", ErrInvalidDefinition, d.Name)
+	}
+	for _, m := range d.Markers {
+		if err := validatePattern(m); err != nil {
+			return fmt.Errorf("%w: %s: marker %q: %v", ErrInvalidDefinition, d.Name, m, err)
+		}
+	}
+	for _, a := range d.Artifacts {
+		if err := validatePattern(a); err != nil {
+			return fmt.Errorf("%w: %s: artifact %q: %v", ErrInvalidDefinition, d.Name, a, err)
+		}
+	}
+	if len(d.Stages) == 0 {
+		return fmt.Errorf("%w: %s: at least one stage rule is required", ErrInvalidDefinition, d.Name)
+	}
+	stages, err := resolveWorkflow(d.Workflow)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidDefinition, d.Name, err)
+	}
+	d.methodStages = stages
+	for i := range d.Stages {
+		if err := d.Stages[i].resolve(stages); err != nil {
+			return fmt.Errorf("%w: %s: stages[%d]: %v", ErrInvalidDefinition, d.Name, i, err)
+		}
+	}
+	if d.Default != nil {
+		if err := d.Default.resolve(stages); err != nil {
+			return fmt.Errorf("%w: %s: default: %v", ErrInvalidDefinition, d.Name, err)
+		}
+	}
+	return nil
+}
+
+// MethodStages returns the resolved stage list: the workflow when declared,
+// otherwise the coarse stages. Valid after Validate.
+func (d *Definition) MethodStages() []domain.MethodStage {
+	stages := make([]domain.MethodStage, len(d.methodStages))
+	copy(stages, d.methodStages)
+	return stages
+}
+
+// resolveWorkflow builds the stage list from the workflow section.
+// Returns the coarse stages when no workflow is declared.
+func resolveWorkflow(workflow []WorkflowStage) ([]domain.MethodStage, error) {
+	if len(workflow) == 0 {
+		return domain.DefaultMethodStages(), nil
+	}
+
+	stages := make([]domain.MethodStage, 0, len(workflow))
+	seen := make(map[string]bool, len(workflow))
+	for i, w := range workflow {
+		if !namePattern.MatchString(w.ID) || w.ID == "unknown" {
+			return nil, fmt.Errorf("workflow[%d]: id must match %s, got %q", i, namePattern.String(), w.ID)
+		}
+		if seen[w.ID] {
+			return nil, fmt.Errorf("workflow[%d]: duplicate id %q", i, w.ID)
+		}
+		seen[w.ID] = true
+
+		coarse, err := domain.ParseStage(w.Stage)
+		if err != nil || coarse == domain.StageUnknown {
+			return nil, fmt.Errorf("workflow %s: stage must be specify, plan, tasks or implement, got %q", w.ID, w.Stage)
+		}
+
+		label := w.Label
+		if label == "" {
+			label = labelFromID(w.ID)
+		}
+		stages = append(stages, domain.MethodStage{ID: w.ID, Label: label, Done: w.Done, Coarse: coarse})
+	}
+	return domain.NewMethodStages(stages...), nil
+}
+
+// labelFromID derives a display name from a stage ID: "design-review" -> "Design review".
+func labelFromID(id string) string {
+	label := strings.NewReplacer("-", " ", "_", " ").Replace(id)
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// resolve looks up the rule's stage in stages, parses the confidence string
+// and validates patterns. "unknown" (or an empty stage) is always accepted.
+func (r *StageRule) resolve(stages []domain.MethodStage) error {
+	if coarse, err := domain.ParseStage(r.Stage); err == nil && coarse == domain.StageUnknown {
+		r.stage = domain.MethodStage{}
+	} else if stage, ok := domain.FindMethodStage(stages, r.Stage); ok {
+		r.stage = stage
+	} else {
+		return fmt.Errorf("unknown stage %q", r.Stage)
+	}
+
+	confidence := domain.ConfidenceCertain
+	if r.Confidence != "" {
+		var err error
+		confidence, err = domain.ParseConfidence(r.Confidence)
+		if err != nil {
+			return fmt.Errorf("unknown confidence %q", r.Confidence)
+		}
+	}
+	r.confidence = confidence
+
+	patterns := append(append(append([]string{}, r.When.Exists...), r.When.AnyExists...), r.When.Missing...)
+	for _, fm := range r.When.FrontMatter {
+		if fm.File == "" || fm.Field == "" {
+			return fmt.Errorf("front_matter requires file and field")
+		}
+		if fm.Equals == "" && len(fm.In) == 0 {
+			return fmt.Errorf("front_matter %s requires equals or in", fm.Field)
+		}
+		patterns = append(patterns, fm.File)
+	}
+	for _, p := range patterns {
+		if err := validatePattern(p); err != nil {
+			return fmt.Errorf("pattern %q: %v", p, err)
+		}
+	}
+	return nil
+}
+
+// validatePattern rejects absolute paths, parent traversal and malformed globs.
+func validatePattern(p string) error {
+	if p == "" {
+		return errors.New("empty pattern")
+	}
+	if filepath.IsAbs(p) {
+		return errors.New("must be relative to project root")
+	}
+	for _, part := range strings.Split(filepath.ToSlash(p), "/") {
+		if part == ".." {
+			return errors.New("must not reference parent directories")
+		}
+	}
+	if _, err := filepath.Match(p, ""); err != nil {
+		return err
+	}
+	return nil
+}
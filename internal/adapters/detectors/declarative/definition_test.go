@@ -0,0 +1,167 @@
+package declarative_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/declarative"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func TestParse_Valid(t *testing.T) {
+	def, err := declarative.Parse([]byte(`
+name: rfc-flow
+markers: [rfcs]
+stages:
+  - stage: implement
+    when:
+      exists: ["rfcs/*/build.md"]
+  - stage: specify
+    confidence: likely
+`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if def.Name != "rfc-flow" {
+		t.Errorf("Name = %q, want %q", def.Name, "rfc-flow")
+	}
+	if len(def.Stages) != 2 {
+		t.Errorf("len(Stages) = %d, want 2", len(def.Stages))
+	}
+}
+
+func TestParse_Workflow(t *testing.T) {
+	def, err := declarative.Parse([]byte(`
+name: rfc-flow
+markers: [rfcs]
+workflow:
+  - id: draft
+    stage: specify
+  - id: design-review
+    stage: plan
+  - id: shipped
+    label: Released
+    stage: implement
+    done: true
+stages:
+  - stage: shipped
+    when:
+      exists: ["rfcs/*/release.md"]
+  - stage: Design-Review
+default:
+  stage: unknown
+`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	stages := def.MethodStages()
+	want := []domain.MethodStage{
+		{ID: "draft", Label: "Draft", Ordinal: 1, Coarse: domain.StageSpecify},
+		{ID: "design-review", Label: "Design review", Ordinal: 2, Coarse: domain.StagePlan},
+		{ID: "shipped", Label: "Released", Ordinal: 3, Done: true, Coarse: domain.StageImplement},
+	}
+	if len(stages) != len(want) {
+		t.Fatalf("len(MethodStages()) = %d, want %d", len(stages), len(want))
+	}
+	for i := range want {
+		if stages[i] != want[i] {
+			t.Errorf("MethodStages()[%d] = %+v, want %+v", i, stages[i], want[i])
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantMsg string
+	}{
+		{"missing name", "markers: [x]\nstages: [{stage: plan}]", "name must match"},
+		{"uppercase name", "name: RFC\nmarkers: [x]\nstages: [{stage: plan}]", "name must match"},
+		{"reserved name", "name: unknown\nmarkers: [x]\nstages: [{stage: plan}]", "reserved"},
+		{"no markers", "name: a\nstages: [{stage: plan}]", "marker"},
+		{"no stages", "name: a\nmarkers: [x]", "stage rule"},
+		{"bad stage", "name: a\nmarkers: [x]\nstages: [{stage: deploy}]", "unknown stage"},
+		{"bad confidence", "name: a\nmarkers: [x]\nstages: [{stage: plan, confidence: maybe}]", "unknown confidence"},
+		{"absolute marker", "name: a\nmarkers: [/etc]\nstages: [{stage: plan}]", "relative"},
+		{"parent traversal", "name: a\nmarkers: [x]\nstages: [{stage: plan, when: {exists: [../secret]}}]", "parent"},
+		{"malformed glob", "name: a\nmarkers: [x]\nstages: [{stage: plan, when: {exists: [\"[\"]}}]", "syntax error"},
+		{"front matter without value", "name: a\nmarkers: [x]\nstages: [{stage: plan, when: {front_matter: [{file: a.md, field: status}]}}]", "equals or in"},
+		{"unknown field", "name: a\nmarkers: [x]\nstage: [{stage: plan}]", "field stage not found"},
+		{"workflow bad id", "name: a\nmarkers: [x]\nworkflow: [{id: Draft, stage: plan}]\nstages: [{stage: plan}]", "id must match"},
+		{"workflow duplicate id", "name: a\nmarkers: [x]\nworkflow: [{id: d, stage: plan}, {id: d, stage: tasks}]\nstages: [{stage: d}]", "duplicate id"},
+		{"workflow bad stage", "name: a\nmarkers: [x]\nworkflow: [{id: d, stage: deploy}]\nstages: [{stage: d}]", "stage must be"},
+		{"workflow missing stage", "name: a\nmarkers: [x]\nworkflow: [{id: d}]\nstages: [{stage: d}]", "stage must be"},
+		{"rule outside workflow", "name: a\nmarkers: [x]\nworkflow: [{id: d, stage: plan}]\nstages: [{stage: plan}]", "unknown stage"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := declarative.Parse([]byte(tt.yaml))
+			if err == nil {
+				t.Fatal("Parse() expected error, got nil")
+			}
+			if !errors.Is(err, declarative.ErrInvalidDefinition) {
+				t.Errorf("error should wrap ErrInvalidDefinition, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("error = %q, want to contain %q", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("a.yaml", "name: alpha\nmarkers: [x]\nstages: [{stage: plan}]")
+	write("b.yml", "name: beta\nmarkers: [y]\nstages: [{stage: tasks}]")
+	write("c.yaml", "name: alpha\nmarkers: [z]\nstages: [{stage: plan}]") // duplicate name
+	write("d.yaml", "name: Bad\n")                                        // invalid
+	write("notes.txt", "ignored")
+
+	detectors, err := declarative.LoadDir(dir)
+	if len(detectors) != 2 {
+		t.Fatalf("LoadDir() loaded %d detectors, want 2", len(detectors))
+	}
+	if detectors[0].Name() != "alpha" || detectors[1].Name() != "beta" {
+		t.Errorf("LoadDir() order = [%s %s], want [alpha beta]", detectors[0].Name(), detectors[1].Name())
+	}
+	if err == nil {
+		t.Fatal("LoadDir() expected error for invalid files")
+	}
+	for _, want := range []string{"c.yaml", "d.yaml"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error should mention %s, got %v", want, err)
+		}
+	}
+}
+
+func TestLoadDir_Missing(t *testing.T) {
+	detectors, err := declarative.LoadDir(filepath.Join(t.TempDir(), "nope"))
+	if err != nil {
+		t.Errorf("LoadDir() on missing dir error = %v, want nil", err)
+	}
+	if len(detectors) != 0 {
+		t.Errorf("LoadDir() on missing dir returned %d detectors", len(detectors))
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
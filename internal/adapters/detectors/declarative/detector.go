@@ -0,0 +1,313 @@
+package declarative
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// Compile-time interface compliance check
+var _ ports.MethodDetector = (*Detector)(nil)
+
+// maxFrontMatterBytes caps how much of a file is scanned for front matter.
+const maxFrontMatterBytes = 64 * 1024
+
+// Detector implements ports.MethodDetector from a validated Definition.
+type Detector struct {
+	def *Definition
+}
+
+// NewDetector creates a detector from a definition.
+// Returns an error if the definition is invalid.
+func NewDetector(def *Definition) (*Detector, error) {
+	if def == nil {
+		return nil, fmt.Errorf("%w: nil definition", ErrInvalidDefinition)
+	}
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	return &Detector{def: def}, nil
+}
+
+// Name returns the detector identifier from the definition.
+func (d *Detector) Name() string {
+	return d.def.Name
+}
+
+// Stages returns the definition's workflow, or the coarse stages when the
+// definition declares none.
+func (d *Detector) Stages() []domain.MethodStage {
+	return d.def.MethodStages()
+}
+
+// Definition returns the underlying definition (read-only use).
+func (d *Detector) Definition() *Definition {
+	return d.def
+}
+
+// CanDetect checks if any marker from the definition exists at the given path.
+func (d *Detector) CanDetect(ctx context.Context, path string) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	default:
+	}
+
+	return d.findMarker(path) != ""
+}
+
+// Detect evaluates stage rules in order and returns the first match.
+func (d *Detector) Detect(ctx context.Context, path string) (*domain.DetectionResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	marker := d.findMarker(path)
+	if marker == "" {
+		return nil, fmt.Errorf("no %s markers found at %s", d.def.Name, path)
+	}
+
+	artifactMtime := d.artifactTimestamp(path, marker)
+
+	for i := range d.def.Stages {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		rule := &d.def.Stages[i]
+		matched, ok := evaluate(path, rule.When)
+		if !ok {
+			continue
+		}
+		slog.Debug("declarative stage rule matched",
+			"detector", d.def.Name, "rule", i, "stage", rule.stage.ID, "match", matched)
+		result := domain.NewDetectionResult(
+			d.def.Name,
+			rule.stage.Coarse,
+			rule.confidence,
+			formatReasoning(rule, matched, fmt.Sprintf("stage rule %d matched", i+1)),
+		).WithTimestamp(artifactMtime).WithMethodStage(rule.stage)
+		return &result, nil
+	}
+
+	if d.def.Default != nil {
+		result := domain.NewDetectionResult(
+			d.def.Name,
+			d.def.Default.stage.Coarse,
+			d.def.Default.confidence,
+			formatReasoning(d.def.Default, "", "no stage rule matched"),
+		).WithTimestamp(artifactMtime).WithMethodStage(d.def.Default.stage)
+		return &result, nil
+	}
+
+	result := domain.NewDetectionResult(
+		d.def.Name,
+		domain.StageUnknown,
+		domain.ConfidenceUncertain,
+		fmt.Sprintf("%s marker %s found, no stage rule matched", d.def.Name, marker),
+	).WithTimestamp(artifactMtime)
+	return &result, nil
+}
+
+// findMarker returns the first marker (relative) that exists at path, or "".
+func (d *Detector) findMarker(path string) string {
+	for _, marker := range d.def.Markers {
+		matches, err := filepath.Glob(filepath.Join(path, marker))
+		if err == nil && len(matches) > 0 {
+			rel, _ := filepath.Rel(path, matches[0])
+			return rel
+		}
+	}
+	return ""
+}
+
+// artifactTimestamp returns the newest mtime among artifact matches.
+// Falls back to the marker's own mtime when no artifacts are configured or found,
+// so coexistence tie-breaking still has a signal.
+func (d *Detector) artifactTimestamp(path, marker string) time.Time {
+	var maxMtime time.Time
+	for _, pattern := range d.def.Artifacts {
+		for _, m := range globFiles(path, pattern) {
+			if info, err := os.Stat(m); err == nil && info.ModTime().After(maxMtime) {
+				maxMtime = info.ModTime()
+			}
+		}
+	}
+	if maxMtime.IsZero() {
+		if info, err := os.Stat(filepath.Join(path, marker)); err == nil {
+			maxMtime = info.ModTime()
+		}
+	}
+	return maxMtime
+}
+
+// evaluate checks every populated condition.
+// Returns the first matched path (relative, for reasoning) and whether the condition holds.
+func evaluate(root string, c Condition) (string, bool) {
+	if c.isEmpty() {
+		return "", true
+	}
+
+	var firstMatch string
+	note := func(abs string) {
+		if firstMatch == "" {
+			firstMatch, _ = filepath.Rel(root, abs)
+		}
+	}
+
+	for _, pattern := range c.Exists {
+		matches := globFiles(root, pattern)
+		if len(matches) == 0 {
+			return "", false
+		}
+		note(matches[0])
+	}
+
+	if len(c.AnyExists) > 0 {
+		found := false
+		for _, pattern := range c.AnyExists {
+			if matches := globFiles(root, pattern); len(matches) > 0 {
+				note(matches[0])
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", false
+		}
+	}
+
+	for _, pattern := range c.Missing {
+		if len(globFiles(root, pattern)) > 0 {
+			return "", false
+		}
+	}
+
+	for _, fm := range c.FrontMatter {
+		match := ""
+		for _, file := range globFiles(root, fm.File) {
+			if fm.matches(readFrontMatter(file)) {
+				match = file
+				break
+			}
+		}
+		if match == "" {
+			return "", false
+		}
+		note(match)
+	}
+
+	return firstMatch, true
+}
+
+// matches reports whether the front-matter field satisfies the check.
+// Comparison is case-insensitive on the string form of the value.
+func (fm FrontMatterCheck) matches(front map[string]interface{}) bool {
+	if front == nil {
+		return false
+	}
+	raw, ok := front[fm.Field]
+	if !ok || raw == nil {
+		return false
+	}
+	value := strings.TrimSpace(fmt.Sprint(raw))
+	if fm.Equals != "" && strings.EqualFold(value, fm.Equals) {
+		return true
+	}
+	for _, candidate := range fm.In {
+		if strings.EqualFold(value, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+// globFiles returns regular files matching pattern under root, sorted descending
+// so higher-numbered directories (005-*) come first, matching Speckit's tiebreak.
+// Hidden files are ignored unless the pattern explicitly names them.
+func globFiles(root, pattern string) []string {
+	matches, err := filepath.Glob(filepath.Join(root, pattern))
+	if err != nil {
+		return nil
+	}
+	files := make([]string, 0, len(matches))
+	explicitHidden := strings.HasPrefix(filepath.Base(pattern), ".")
+	for _, m := range matches {
+		if !explicitHidden && strings.HasPrefix(filepath.Base(m), ".") {
+			continue
+		}
+		if info, err := os.Stat(m); err == nil && !info.IsDir() {
+			files = append(files, m)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(files)))
+	return files
+}
+
+// readFrontMatter parses a leading "---" delimited YAML block.
+// Returns nil if the file has no front matter or cannot be parsed.
+func readFrontMatter(path string) map[string]interface{} {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 4096), maxFrontMatterBytes)
+	if !scanner.Scan() || strings.TrimSpace(scanner.Text()) != "---" {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	closed := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "---" {
+			closed = true
+			break
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+		if buf.Len() > maxFrontMatterBytes {
+			return nil
+		}
+	}
+	if !closed {
+		return nil
+	}
+
+	var front map[string]interface{}
+	if err := yaml.Unmarshal(buf.Bytes(), &front); err != nil {
+		return nil
+	}
+	return front
+}
+
+// formatReasoning builds the reasoning string for a matched rule.
+func formatReasoning(rule *StageRule, matched, fallback string) string {
+	reasoning := rule.Reasoning
+	if reasoning == "" {
+		reasoning = fallback
+		if matched != "" {
+			reasoning += " ({match})"
+		}
+	}
+	return strings.ReplaceAll(reasoning, "{match}", filepath.ToSlash(matched))
+}
@@ -0,0 +1,213 @@
+package declarative_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/declarative"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/speckit"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// fixturesDir returns the path to the test fixtures directory
+func fixturesDir() string {
+	return filepath.Join("..", "..", "..", "..", "test", "fixtures")
+}
+
+func loadFixtureDetector(t *testing.T, name string) *declarative.Detector {
+	t.Helper()
+	def, err := declarative.LoadFile(filepath.Join(fixturesDir(), "detectors", name))
+	if err != nil {
+		t.Fatalf("LoadFile(%s) error = %v", name, err)
+	}
+	d, err := declarative.NewDetector(def)
+	if err != nil {
+		t.Fatalf("NewDetector() error = %v", err)
+	}
+	return d
+}
+
+func TestDetector_ImplementsInterface(t *testing.T) {
+	var _ ports.MethodDetector = (*declarative.Detector)(nil)
+}
+
+func TestNewDetector_NilDefinition(t *testing.T) {
+	if _, err := declarative.NewDetector(nil); err == nil {
+		t.Error("NewDetector(nil) expected error")
+	}
+}
+
+// TestDetector_SpeckitParity validates that a user-written definition reproduces
+// the built-in Speckit detector on the shared golden fixtures.
+func TestDetector_SpeckitParity(t *testing.T) {
+	fixtures := []string{
+		"speckit-stage-specify",
+		"speckit-stage-plan",
+		"speckit-stage-tasks",
+		"speckit-stage-implement",
+		"speckit-uncertain",
+		"speckit-dotspecify-marker",
+		"speckit-dotspeckit-marker",
+		"speckit-stage-plan-with-drafts",
+		"speckit-stage-tasks-partial",
+		"speckit-stage-implement-complete",
+		"speckit-non-standard-names",
+		"speckit-readme-only",
+		"speckit-hidden-files",
+		"speckit-empty-spec-dir",
+		"no-method-detected",
+		"empty-project",
+	}
+
+	d := loadFixtureDetector(t, "speckit-declarative.yaml")
+	builtin := speckit.NewSpeckitDetector()
+	ctx := context.Background()
+
+	for _, fixture := range fixtures {
+		t.Run(fixture, func(t *testing.T) {
+			path := filepath.Join(fixturesDir(), fixture)
+
+			want := builtin.CanDetect(ctx, path)
+			if got := d.CanDetect(ctx, path); got != want {
+				t.Fatalf("CanDetect() = %v, builtin = %v", got, want)
+			}
+			if !want {
+				return
+			}
+
+			wantResult, err := builtin.Detect(ctx, path)
+			if err != nil {
+				t.Fatalf("builtin Detect() error = %v", err)
+			}
+			got, err := d.Detect(ctx, path)
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if got.Stage != wantResult.Stage {
+				t.Errorf("Stage = %v, builtin = %v", got.Stage, wantResult.Stage)
+			}
+			if got.Method != "speckit-yaml" {
+				t.Errorf("Method = %q, want %q", got.Method, "speckit-yaml")
+			}
+		})
+	}
+}
+
+func TestDetector_FrontMatter(t *testing.T) {
+	d := loadFixtureDetector(t, "rfc-flow.yaml")
+	path := filepath.Join(fixturesDir(), "declarative-rfc-flow")
+
+	result, err := d.Detect(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.Stage != domain.StagePlan {
+		t.Errorf("Stage = %v, want Plan", result.Stage)
+	}
+	if result.MethodStage.ID != "design-review" || result.MethodStage.Label != "Design review" || result.MethodStage.Ordinal != 2 {
+		t.Errorf("MethodStage = %+v, want design-review (2)", result.MethodStage)
+	}
+	if result.Confidence != domain.ConfidenceCertain {
+		t.Errorf("Confidence = %v, want Certain", result.Confidence)
+	}
+	if !strings.Contains(result.Reasoning, "rfcs/001-auth/design.md") {
+		t.Errorf("Reasoning = %q, want matched design.md path", result.Reasoning)
+	}
+	if !result.HasTimestamp() {
+		t.Error("expected ArtifactTimestamp from artifact globs")
+	}
+}
+
+func TestDetector_RuleOrderAndDefault(t *testing.T) {
+	def, err := declarative.Parse([]byte(`
+name: flow
+markers: [docs]
+stages:
+  - stage: implement
+    when:
+      exists: ["docs/build.md"]
+      missing: ["docs/blocked.md"]
+  - stage: specify
+    confidence: likely
+    reasoning: "rfc only"
+    when:
+      exists: ["docs/rfc.md"]
+default:
+  stage: unknown
+  confidence: uncertain
+  reasoning: "docs folder without artifacts"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, _ := declarative.NewDetector(def)
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		files      []string
+		stage      domain.Stage
+		confidence domain.Confidence
+		reasoning  string
+	}{
+		{"build wins", []string{"docs/rfc.md", "docs/build.md"}, domain.StageImplement, domain.ConfidenceCertain, "stage rule 1 matched (docs/build.md)"},
+		{"missing blocks", []string{"docs/rfc.md", "docs/build.md", "docs/blocked.md"}, domain.StageSpecify, domain.ConfidenceLikely, "rfc only"},
+		{"default", []string{"docs/other.md"}, domain.StageUnknown, domain.ConfidenceUncertain, "docs folder without artifacts"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, f := range tt.files {
+				writeFile(t, filepath.Join(dir, f), "x")
+			}
+			result, err := d.Detect(ctx, dir)
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if result.Stage != tt.stage || result.Confidence != tt.confidence {
+				t.Errorf("got %s, want stage %v confidence %v", result.Summary(), tt.stage, tt.confidence)
+			}
+			if result.Reasoning != tt.reasoning {
+				t.Errorf("Reasoning = %q, want %q", result.Reasoning, tt.reasoning)
+			}
+			if result.MethodStage.Coarse != tt.stage {
+				t.Errorf("MethodStage = %+v, want coarse %v", result.MethodStage, tt.stage)
+			}
+		})
+	}
+}
+
+func TestDetector_Stages(t *testing.T) {
+	rfc := loadFixtureDetector(t, "rfc-flow.yaml").Stages()
+	var ids []string
+	for _, s := range rfc {
+		ids = append(ids, s.ID)
+	}
+	if got := strings.Join(ids, ","); got != "rfc,design-review,breakdown,build" {
+		t.Errorf("Stages() = %s, want workflow order", got)
+	}
+
+	// Without a workflow, the coarse stages are the taxonomy
+	plain := loadFixtureDetector(t, "speckit-declarative.yaml").Stages()
+	if len(plain) != 4 || plain[0].ID != "specify" || plain[3].ID != "implement" {
+		t.Errorf("Stages() = %+v, want default stages", plain)
+	}
+}
+
+func TestDetector_ContextCancellation(t *testing.T) {
+	d := loadFixtureDetector(t, "rfc-flow.yaml")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	path := filepath.Join(fixturesDir(), "declarative-rfc-flow")
+	if d.CanDetect(ctx, path) {
+		t.Error("CanDetect() should return false on cancelled context")
+	}
+	if _, err := d.Detect(ctx, path); err == nil {
+		t.Error("Detect() should return error on cancelled context")
+	}
+}
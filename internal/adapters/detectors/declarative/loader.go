@@ -0,0 +1,61 @@
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LoadDir loads every *.yaml / *.yml definition in dir (non-recursive).
+// Valid definitions are returned as detectors in filename order; invalid files
+// are reported in the joined error so one bad file does not hide the rest.
+// A missing directory is not an error (no user definitions).
+func LoadDir(dir string) ([]*Detector, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read detector directory: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if ext == ".yaml" || ext == ".yml" {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var detectors []*Detector
+	var errs []error
+	seen := make(map[string]string)
+	for _, name := range names {
+		def, err := LoadFile(filepath.Join(dir, name))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if prev, dup := seen[def.Name]; dup {
+			errs = append(errs, fmt.Errorf("%s: %w: name %q already defined in %s", name, ErrInvalidDefinition, def.Name, prev))
+			continue
+		}
+		seen[def.Name] = name
+
+		d, err := NewDetector(def)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		detectors = append(detectors, d)
+	}
+
+	return detectors, errors.Join(errs...)
+}
@@ -0,0 +1,147 @@
+// Package openspec implements detection for the OpenSpec workflow methodology.
+// It scans the openspec/ directory (project.md, specs/, changes/<id>/ and
+// changes/archive/) and derives the stage from the most recently modified
+// active change proposal and the completion of its tasks.md.
+package openspec
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// Compile-time interface compliance check
+var _ ports.MethodDetector = (*OpenSpecDetector)(nil)
+
+// markerDir is the directory that indicates an OpenSpec project.
+const markerDir = "openspec"
+
+// maxListedChanges caps how many active change IDs the reasoning names.
+const maxListedChanges = 3
+
+// OpenSpecDetector implements ports.MethodDetector for OpenSpec methodology.
+type OpenSpecDetector struct{}
+
+// NewOpenSpecDetector creates a new OpenSpec detector.
+func NewOpenSpecDetector() *OpenSpecDetector {
+	return &OpenSpecDetector{}
+}
+
+// Name returns the detector identifier.
+func (d *OpenSpecDetector) Name() string {
+	return "openspec"
+}
+
+// Stages returns the OpenSpec stage list.
+func (d *OpenSpecDetector) Stages() []domain.MethodStage {
+	list := make([]domain.MethodStage, len(stages))
+	copy(list, stages)
+	return list
+}
+
+// CanDetect checks if the openspec/ directory exists at the given path.
+func (d *OpenSpecDetector) CanDetect(ctx context.Context, path string) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	default:
+	}
+
+	info, err := os.Stat(filepath.Join(path, markerDir))
+	return err == nil && info.IsDir()
+}
+
+// Detect performs full OpenSpec methodology detection on the given path.
+// The stage comes from the most recent active change; with none active, the
+// project is done when changes were archived, and in project setup otherwise.
+// ArtifactTimestamp is the newest mtime across project.md, specs and changes.
+func (d *OpenSpecDetector) Detect(ctx context.Context, path string) (*domain.DetectionResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	dir := filepath.Join(path, markerDir)
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("no openspec directory found at %s", path)
+	}
+
+	w, err := ScanWorkspace(dir)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("openspec workspace scanned",
+		"path", path, "active", len(w.Active), "archived", len(w.Archived), "specs", w.Specs)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if latest := w.Latest(); latest != nil {
+		tasks := latest.Tasks()
+		stage, confidence, reasoning := changeStage(latest, tasks)
+		reasoning += " (" + changeSummary(w, latest) + ")"
+
+		result := domain.NewDetectionResult(d.Name(), stage.Coarse, confidence, reasoning).
+			WithTimestamp(w.ModTime).
+			WithMethodStage(stage).
+			WithActiveChange(latest.ID)
+		if tasks != nil && tasks.Total() > 0 {
+			next := ""
+			if t := tasks.Next(); t != nil {
+				next = t.String()
+			}
+			result = result.WithTasks(tasks.Done(), tasks.Total(), next)
+		}
+		return &result, nil
+	}
+
+	var result domain.DetectionResult
+	switch {
+	case len(w.Archived) > 0:
+		done := stage(stageArchived)
+		result = domain.NewDetectionResult(d.Name(), done.Coarse, domain.ConfidenceCertain,
+			fmt.Sprintf("no active changes, %d archived (latest: %s)", len(w.Archived), w.Archived[0].ID)).
+			WithMethodStage(done)
+	case !w.IsEmpty():
+		project := stage(stageProject)
+		result = domain.NewDetectionResult(d.Name(), project.Coarse, domain.ConfidenceLikely,
+			fmt.Sprintf("project context defined (%d specs), no change proposals", w.Specs)).
+			WithMethodStage(project)
+	default:
+		result = domain.NewDetectionResult(d.Name(), domain.StageUnknown, domain.ConfidenceUncertain,
+			"openspec directory exists but has no project.md, specs or changes")
+	}
+	result = result.WithTimestamp(w.ModTime)
+	return &result, nil
+}
+
+// changeSummary names the change the stage came from and lists active and
+// archived changes: "change: add-2fa; 2 active: add-2fa, fix-login; 5 archived".
+func changeSummary(w *Workspace, latest *Change) string {
+	parts := []string{"change: " + latest.ID}
+	if len(w.Active) > 1 {
+		ids := make([]string, 0, maxListedChanges)
+		for i, c := range w.Active {
+			if i == maxListedChanges {
+				ids = append(ids, fmt.Sprintf("+%d more", len(w.Active)-maxListedChanges))
+				break
+			}
+			ids = append(ids, c.ID)
+		}
+		parts = append(parts, fmt.Sprintf("%d active: %s", len(w.Active), strings.Join(ids, ", ")))
+	}
+	if len(w.Archived) > 0 {
+		parts = append(parts, fmt.Sprintf("%d archived", len(w.Archived)))
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,260 @@
+package openspec_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/openspec"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// fixturesDir returns the path to the test fixtures directory
+func fixturesDir() string {
+	return filepath.Join("..", "..", "..", "..", "test", "fixtures")
+}
+
+func TestOpenSpecDetector_ImplementsInterface(t *testing.T) {
+	var _ ports.MethodDetector = openspec.NewOpenSpecDetector()
+}
+
+func TestOpenSpecDetector_Name(t *testing.T) {
+	d := openspec.NewOpenSpecDetector()
+	if got := d.Name(); got != "openspec" {
+		t.Errorf("Name() = %q, want %q", got, "openspec")
+	}
+}
+
+func TestOpenSpecDetector_Stages(t *testing.T) {
+	stages := openspec.NewOpenSpecDetector().Stages()
+	var ids []string
+	for i, s := range stages {
+		ids = append(ids, s.ID)
+		if s.Ordinal != i+1 {
+			t.Errorf("Stages()[%d].Ordinal = %d, want %d", i, s.Ordinal, i+1)
+		}
+		if s.Coarse == domain.StageUnknown {
+			t.Errorf("Stages()[%d] (%s) has no coarse stage", i, s.ID)
+		}
+	}
+	want := "project,proposal,design,tasks,apply,archive,archived"
+	if got := strings.Join(ids, ","); got != want {
+		t.Errorf("Stages() IDs = %s, want %s", got, want)
+	}
+	if !stages[len(stages)-1].Done {
+		t.Error("last stage should be Done")
+	}
+
+	// Callers get a copy
+	stages[0].Label = "changed"
+	if openspec.NewOpenSpecDetector().Stages()[0].Label == "changed" {
+		t.Error("Stages() should return a copy")
+	}
+}
+
+func TestOpenSpecDetector_CanDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected bool
+	}{
+		{"proposal stage", "openspec-stage-proposal", true},
+		{"archived only", "openspec-archived", true},
+		{"no artifacts", "openspec-no-artifacts", true},
+		{"speckit project", "speckit-stage-specify", false},
+		{"no markers present", "no-method-detected", false},
+	}
+
+	d := openspec.NewOpenSpecDetector()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.CanDetect(context.Background(), filepath.Join(fixturesDir(), tt.fixture)); got != tt.expected {
+				t.Errorf("CanDetect() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOpenSpecDetector_CanDetect_MarkerIsFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "openspec"), []byte("not a dir"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if openspec.NewOpenSpecDetector().CanDetect(context.Background(), tmpDir) {
+		t.Error("CanDetect() should return false when openspec is a file")
+	}
+}
+
+func TestOpenSpecDetector_CanDetect_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	d := openspec.NewOpenSpecDetector()
+	if d.CanDetect(ctx, filepath.Join(fixturesDir(), "openspec-stage-proposal")) {
+		t.Error("CanDetect() should return false for cancelled context")
+	}
+}
+
+func TestOpenSpecDetector_Detect(t *testing.T) {
+	tests := []struct {
+		name          string
+		fixture       string
+		expectStageID string
+		expectStage   domain.Stage
+		expectConf    domain.Confidence
+		checkReason   string
+	}{
+		{"project only", "openspec-project-only", "project", domain.StageSpecify, domain.ConfidenceLikely, "project context defined (1 specs)"},
+		{"proposal", "openspec-stage-proposal", "proposal", domain.StageSpecify, domain.ConfidenceCertain, "proposal.md exists, no tasks.md (change: add-2fa)"},
+		{"design", "openspec-stage-design", "design", domain.StagePlan, domain.ConfidenceCertain, "design.md exists"},
+		{"tasks", "openspec-stage-tasks", "tasks", domain.StageTasks, domain.ConfidenceCertain, "tasks.md with 5 open tasks"},
+		{"apply", "openspec-stage-apply", "apply", domain.StageImplement, domain.ConfidenceCertain, "2/5 tasks done (change: add-2fa; 1 archived)"},
+		{"ready to archive", "openspec-stage-archive", "archive", domain.StageImplement, domain.ConfidenceCertain, "all 5 tasks done, ready to archive"},
+		{"archived", "openspec-archived", "archived", domain.StageImplement, domain.ConfidenceCertain, "no active changes, 2 archived (latest: add-2fa)"},
+		{"no artifacts", "openspec-no-artifacts", "", domain.StageUnknown, domain.ConfidenceUncertain, "no project.md, specs or changes"},
+	}
+
+	d := openspec.NewOpenSpecDetector()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := d.Detect(context.Background(), filepath.Join(fixturesDir(), tt.fixture))
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if result.Method != "openspec" {
+				t.Errorf("Detect().Method = %q, want %q", result.Method, "openspec")
+			}
+			if result.MethodStage.ID != tt.expectStageID {
+				t.Errorf("Detect().MethodStage.ID = %q, want %q", result.MethodStage.ID, tt.expectStageID)
+			}
+			if result.Stage != tt.expectStage {
+				t.Errorf("Detect().Stage = %v, want %v", result.Stage, tt.expectStage)
+			}
+			if result.Confidence != tt.expectConf {
+				t.Errorf("Detect().Confidence = %v, want %v", result.Confidence, tt.expectConf)
+			}
+			if !strings.Contains(result.Reasoning, tt.checkReason) {
+				t.Errorf("Detect().Reasoning = %q, want to contain %q", result.Reasoning, tt.checkReason)
+			}
+			if result.ArtifactTimestamp.IsZero() {
+				t.Error("Detect().ArtifactTimestamp should be set")
+			}
+		})
+	}
+}
+
+func TestOpenSpecDetector_Detect_TaskProgress(t *testing.T) {
+	d := openspec.NewOpenSpecDetector()
+
+	result, err := d.Detect(context.Background(), filepath.Join(fixturesDir(), "openspec-stage-apply"))
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.TasksDone != 2 || result.TasksTotal != 5 {
+		t.Errorf("Detect() tasks = %d/%d, want 2/5", result.TasksDone, result.TasksTotal)
+	}
+	if result.NextTask != "1.3 Add verification endpoint" {
+		t.Errorf("Detect().NextTask = %q, want %q", result.NextTask, "1.3 Add verification endpoint")
+	}
+
+	// No tasks.md: no task progress
+	result, err = d.Detect(context.Background(), filepath.Join(fixturesDir(), "openspec-stage-proposal"))
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.HasTasks() {
+		t.Errorf("Detect() tasks = %d/%d, want none", result.TasksDone, result.TasksTotal)
+	}
+}
+
+func TestOpenSpecDetector_Detect_MultipleChanges(t *testing.T) {
+	// Equal mtimes after checkout: the highest change ID wins
+	d := openspec.NewOpenSpecDetector()
+	result, err := d.Detect(context.Background(), filepath.Join(fixturesDir(), "openspec-multiple-changes"))
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.MethodStage.ID != "apply" || result.ActiveChange != "update-billing" {
+		t.Errorf("Detect() = %q at %q, want update-billing at apply", result.ActiveChange, result.MethodStage.ID)
+	}
+	if !strings.Contains(result.Reasoning, "2 active: add-2fa, update-billing") {
+		t.Errorf("Detect().Reasoning = %q, want active change list", result.Reasoning)
+	}
+}
+
+func TestOpenSpecDetector_Detect_MostRecentChangeWins(t *testing.T) {
+	tmpDir := t.TempDir()
+	changes := filepath.Join(tmpDir, "openspec", "changes")
+	older := filepath.Join(changes, "zz-older")
+	newer := filepath.Join(changes, "aa-newer")
+	for _, dir := range []string{older, newer} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(older, "tasks.md"), []byte("- [x] 1.1 Done\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(newer, "proposal.md"), []byte("## Why\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	oldTime := time.Now().Add(-2 * time.Hour)
+	for _, p := range []string{older, filepath.Join(older, "tasks.md")} {
+		if err := os.Chtimes(p, oldTime, oldTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := openspec.NewOpenSpecDetector().Detect(context.Background(), tmpDir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.MethodStage.ID != "proposal" {
+		t.Errorf("Detect().MethodStage.ID = %q, want %q", result.MethodStage.ID, "proposal")
+	}
+	if !strings.Contains(result.Reasoning, "change: aa-newer") {
+		t.Errorf("Detect().Reasoning = %q, want change aa-newer", result.Reasoning)
+	}
+}
+
+func TestOpenSpecDetector_Detect_ManyActiveChanges(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		if err := os.MkdirAll(filepath.Join(tmpDir, "openspec", "changes", id), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := openspec.NewOpenSpecDetector().Detect(context.Background(), tmpDir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if !strings.Contains(result.Reasoning, "5 active: a, b, c, +2 more") {
+		t.Errorf("Detect().Reasoning = %q, want truncated change list", result.Reasoning)
+	}
+	if result.Confidence != domain.ConfidenceLikely {
+		t.Errorf("Detect().Confidence = %v, want Likely for change without proposal", result.Confidence)
+	}
+}
+
+func TestOpenSpecDetector_Detect_NoDirectory(t *testing.T) {
+	_, err := openspec.NewOpenSpecDetector().Detect(context.Background(), t.TempDir())
+	if err == nil {
+		t.Error("Detect() should return error without openspec directory")
+	}
+}
+
+func TestOpenSpecDetector_Detect_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := openspec.NewOpenSpecDetector().Detect(ctx, filepath.Join(fixturesDir(), "openspec-stage-apply"))
+	if err != context.Canceled {
+		t.Errorf("Detect() error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,32 @@
+package openspec
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+)
+
+// TaskProgress counts checklist items in the tasks.md of every active
+// change; archived changes are finished work and not counted. Returns
+// ok=false when no active change has a tasks.md. Used for burndown metrics.
+func TaskProgress(ctx context.Context, projectPath string) (done, total int, ok bool, err error) {
+	dir := filepath.Join(projectPath, markerDir)
+	if _, statErr := os.Stat(dir); statErr != nil {
+		return 0, 0, false, nil
+	}
+	w, err := ScanWorkspace(dir)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	for i := range w.Active {
+		select {
+		case <-ctx.Done():
+			return 0, 0, false, ctx.Err()
+		default:
+		}
+		if tasks := w.Active[i].Tasks(); tasks != nil {
+			done, total, ok = done+tasks.Done(), total+tasks.Total(), true
+		}
+	}
+	return done, total, ok, nil
+}
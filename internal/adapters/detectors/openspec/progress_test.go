@@ -0,0 +1,46 @@
+package openspec_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/openspec"
+)
+
+func TestTaskProgress(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		wantDone  int
+		wantTotal int
+		wantOK    bool
+	}{
+		{"active change with tasks", "openspec-stage-apply", 2, 5, true},
+		{"tasks across changes", "openspec-multiple-changes", 1, 2, true},
+		{"archived changes not counted", "openspec-archived", 0, 0, false},
+		{"no openspec directory", "empty-project", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			done, total, ok, err := openspec.TaskProgress(context.Background(), filepath.Join(fixturesDir(), tt.fixture))
+			if err != nil {
+				t.Fatalf("TaskProgress() error = %v", err)
+			}
+			if done != tt.wantDone || total != tt.wantTotal || ok != tt.wantOK {
+				t.Errorf("TaskProgress() = %d/%d ok=%v, want %d/%d ok=%v", done, total, ok, tt.wantDone, tt.wantTotal, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTaskProgress_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, _, err := openspec.TaskProgress(ctx, filepath.Join(fixturesDir(), "openspec-stage-apply"))
+	if err != context.Canceled {
+		t.Errorf("TaskProgress() error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,63 @@
+package openspec
+
+import (
+	"fmt"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/speckit"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// OpenSpec stage IDs, in workflow order.
+const (
+	stageProject  = "project"
+	stageProposal = "proposal"
+	stageDesign   = "design"
+	stageTasks    = "tasks"
+	stageApply    = "apply"
+	stageArchive  = "archive"
+	stageArchived = "archived"
+)
+
+// stages is the OpenSpec workflow: project context, then per change a
+// proposal, optional design, task list and implementation ("apply") until the
+// change is archived into the specs.
+var stages = domain.NewMethodStages(
+	domain.MethodStage{ID: stageProject, Label: "Project context", Coarse: domain.StageSpecify},
+	domain.MethodStage{ID: stageProposal, Label: "Proposal", Coarse: domain.StageSpecify},
+	domain.MethodStage{ID: stageDesign, Label: "Design", Coarse: domain.StagePlan},
+	domain.MethodStage{ID: stageTasks, Label: "Tasks", Coarse: domain.StageTasks},
+	domain.MethodStage{ID: stageApply, Label: "Apply", Coarse: domain.StageImplement},
+	domain.MethodStage{ID: stageArchive, Label: "Ready to archive", Coarse: domain.StageImplement},
+	domain.MethodStage{ID: stageArchived, Label: "Archived", Done: true, Coarse: domain.StageImplement},
+)
+
+// stage returns the workflow stage with the given ID.
+func stage(id string) domain.MethodStage {
+	s, _ := domain.FindMethodStage(stages, id)
+	return s
+}
+
+// changeStage derives the stage of an active change from its artifacts and
+// task completion. tasks may be nil when the change has no readable tasks.md.
+func changeStage(c *Change, tasks *speckit.TaskList) (domain.MethodStage, domain.Confidence, string) {
+	switch {
+	case tasks != nil && tasks.Total() > 0:
+		done, total := tasks.Done(), tasks.Total()
+		switch {
+		case done == total:
+			return stage(stageArchive), domain.ConfidenceCertain, fmt.Sprintf("all %d tasks done, ready to archive", total)
+		case done > 0:
+			return stage(stageApply), domain.ConfidenceCertain, fmt.Sprintf("%d/%d tasks done", done, total)
+		default:
+			return stage(stageTasks), domain.ConfidenceCertain, fmt.Sprintf("tasks.md with %d open tasks", total)
+		}
+	case c.HasTasks:
+		return stage(stageTasks), domain.ConfidenceLikely, "tasks.md has no checklist items"
+	case c.HasDesign:
+		return stage(stageDesign), domain.ConfidenceCertain, "design.md exists, no tasks.md"
+	case c.HasProposal:
+		return stage(stageProposal), domain.ConfidenceCertain, "proposal.md exists, no tasks.md"
+	default:
+		return stage(stageProposal), domain.ConfidenceLikely, "change has no proposal.md yet"
+	}
+}
@@ -0,0 +1,58 @@
+package openspec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/speckit"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func TestChangeStage(t *testing.T) {
+	parse := func(s string) *speckit.TaskList {
+		list, err := speckit.ParseTasks(strings.NewReader(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return list
+	}
+
+	tests := []struct {
+		name       string
+		change     Change
+		tasks      *speckit.TaskList
+		wantID     string
+		wantConf   domain.Confidence
+		wantReason string
+	}{
+		{"empty change", Change{}, nil, stageProposal, domain.ConfidenceLikely, "no proposal.md"},
+		{"proposal", Change{HasProposal: true}, nil, stageProposal, domain.ConfidenceCertain, "proposal.md exists"},
+		{"design", Change{HasProposal: true, HasDesign: true}, nil, stageDesign, domain.ConfidenceCertain, "design.md exists"},
+		{"tasks without checklist", Change{HasTasks: true}, parse("## 1. Implementation\n"), stageTasks, domain.ConfidenceLikely, "no checklist items"},
+		{"unreadable tasks", Change{HasTasks: true, HasDesign: true}, nil, stageTasks, domain.ConfidenceLikely, "no checklist items"},
+		{"open tasks", Change{HasTasks: true}, parse("- [ ] 1.1 A\n- [ ] 1.2 B\n"), stageTasks, domain.ConfidenceCertain, "2 open tasks"},
+		{"partial tasks", Change{HasTasks: true}, parse("- [x] 1.1 A\n- [ ] 1.2 B\n"), stageApply, domain.ConfidenceCertain, "1/2 tasks done"},
+		{"all tasks done", Change{HasTasks: true}, parse("- [x] 1.1 A\n- [x] 1.2 B\n"), stageArchive, domain.ConfidenceCertain, "ready to archive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conf, reason := changeStage(&tt.change, tt.tasks)
+			if got.ID != tt.wantID {
+				t.Errorf("changeStage() stage = %q, want %q", got.ID, tt.wantID)
+			}
+			if conf != tt.wantConf {
+				t.Errorf("changeStage() confidence = %v, want %v", conf, tt.wantConf)
+			}
+			if !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("changeStage() reasoning = %q, want to contain %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestStage_UnknownID(t *testing.T) {
+	if s := stage("nope"); !s.IsZero() {
+		t.Errorf("stage(%q) = %+v, want zero", "nope", s)
+	}
+}
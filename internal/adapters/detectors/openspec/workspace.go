@@ -0,0 +1,189 @@
+package openspec
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/speckit"
+)
+
+// Layout of an OpenSpec workspace, relative to the openspec/ directory.
+const (
+	projectFile  = "project.md"
+	specsDir     = "specs"
+	changesDir   = "changes"
+	archiveDir   = "archive" // Under changes/
+	proposalFile = "proposal.md"
+	designFile   = "design.md"
+	tasksFile    = "tasks.md"
+)
+
+// archivedNameRegex splits an archived change directory into its archive date
+// and change ID: "2025-01-15-add-2fa" -> "2025-01-15", "add-2fa".
+var archivedNameRegex = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})-(.+)$`)
+
+// Change is one change proposal under openspec/changes/.
+type Change struct {
+	ID          string // Directory name without the archive date: "add-2fa"
+	Dir         string // Absolute path of the change directory
+	Archived    bool   // Moved to changes/archive/
+	ArchivedOn  string // Archive date from the directory name, "2025-01-15" (empty if absent)
+	HasProposal bool
+	HasDesign   bool
+	HasTasks    bool
+	ModTime     time.Time // Newest of the directory and its files
+}
+
+// Workspace is the scanned content of an openspec/ directory.
+type Workspace struct {
+	Dir        string // Absolute path of openspec/
+	HasProject bool   // project.md exists
+	Specs      int    // Capability directories under specs/
+	Active     []Change
+	Archived   []Change // Most recently archived first
+	ModTime    time.Time
+}
+
+// IsEmpty reports whether the workspace has no project context, specs or changes.
+func (w *Workspace) IsEmpty() bool {
+	return !w.HasProject && w.Specs == 0 && len(w.Active) == 0 && len(w.Archived) == 0
+}
+
+// Latest returns the most recently modified active change, or nil when there
+// is none. Equal mtimes (common after git clone) fall back to the highest
+// change ID, like Speckit's feature directories.
+func (w *Workspace) Latest() *Change {
+	if len(w.Active) == 0 {
+		return nil
+	}
+	latest := &w.Active[0]
+	for i := range w.Active[1:] {
+		c := &w.Active[i+1]
+		if c.ModTime.After(latest.ModTime) || (c.ModTime.Equal(latest.ModTime) && c.ID > latest.ID) {
+			latest = c
+		}
+	}
+	return latest
+}
+
+// ScanWorkspace reads the openspec/ directory at dir. Unreadable entries are
+// skipped; only an unreadable dir itself is an error.
+func ScanWorkspace(dir string) (*Workspace, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read openspec directory: %w", err)
+	}
+
+	w := &Workspace{Dir: dir, ModTime: info.ModTime()}
+
+	if info, err := os.Stat(filepath.Join(dir, projectFile)); err == nil && !info.IsDir() {
+		w.HasProject = true
+		w.noteModTime(info.ModTime())
+	}
+
+	for _, entry := range readDirs(filepath.Join(dir, specsDir)) {
+		w.Specs++
+		if info, err := entry.Info(); err == nil {
+			w.noteModTime(info.ModTime())
+		}
+	}
+
+	changes := filepath.Join(dir, changesDir)
+	for _, entry := range readDirs(changes) {
+		if entry.Name() == archiveDir {
+			continue
+		}
+		c := scanChange(filepath.Join(changes, entry.Name()), entry.Name())
+		w.Active = append(w.Active, c)
+		w.noteModTime(c.ModTime)
+	}
+
+	archive := filepath.Join(changes, archiveDir)
+	for _, entry := range readDirs(archive) {
+		c := scanChange(filepath.Join(archive, entry.Name()), entry.Name())
+		c.Archived = true
+		if m := archivedNameRegex.FindStringSubmatch(entry.Name()); m != nil {
+			c.ArchivedOn, c.ID = m[1], m[2]
+		}
+		w.Archived = append(w.Archived, c)
+		w.noteModTime(c.ModTime)
+	}
+	sort.Slice(w.Archived, func(i, j int) bool {
+		a, b := w.Archived[i], w.Archived[j]
+		if a.ArchivedOn != b.ArchivedOn {
+			return a.ArchivedOn > b.ArchivedOn
+		}
+		return a.ID > b.ID
+	})
+
+	return w, nil
+}
+
+// scanChange records which artifacts a change directory holds.
+func scanChange(dir, name string) Change {
+	c := Change{ID: name, Dir: dir}
+	if info, err := os.Stat(dir); err == nil {
+		c.ModTime = info.ModTime()
+	}
+	for _, file := range []string{proposalFile, designFile, tasksFile} {
+		info, err := os.Stat(filepath.Join(dir, file))
+		if err != nil || info.IsDir() {
+			continue
+		}
+		switch file {
+		case proposalFile:
+			c.HasProposal = true
+		case designFile:
+			c.HasDesign = true
+		case tasksFile:
+			c.HasTasks = true
+		}
+		if info.ModTime().After(c.ModTime) {
+			c.ModTime = info.ModTime()
+		}
+	}
+	return c
+}
+
+// Tasks parses the change's tasks.md. Returns nil when the change has no
+// tasks.md or it cannot be read; the stage then ignores task completion.
+func (c *Change) Tasks() *speckit.TaskList {
+	if !c.HasTasks {
+		return nil
+	}
+	tasks, err := speckit.ParseTasksFile(filepath.Join(c.Dir, tasksFile))
+	if err != nil {
+		slog.Debug("failed to parse openspec tasks.md", "change", c.ID, "error", err)
+		return nil
+	}
+	return tasks
+}
+
+// noteModTime keeps the newest artifact time for coexistence tie-breaking.
+func (w *Workspace) noteModTime(t time.Time) {
+	if t.After(w.ModTime) {
+		w.ModTime = t
+	}
+}
+
+// readDirs returns the visible subdirectories of dir, sorted by name.
+// Returns nil if dir does not exist or cannot be read.
+func readDirs(dir string) []os.DirEntry {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var dirs []os.DirEntry
+	for _, entry := range entries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			dirs = append(dirs, entry)
+		}
+	}
+	return dirs
+}
@@ -0,0 +1,122 @@
+package openspec_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/openspec"
+)
+
+func TestScanWorkspace(t *testing.T) {
+	w, err := openspec.ScanWorkspace(filepath.Join(fixturesDir(), "openspec-stage-apply", "openspec"))
+	if err != nil {
+		t.Fatalf("ScanWorkspace() error = %v", err)
+	}
+	if !w.HasProject {
+		t.Error("HasProject = false, want true")
+	}
+	if w.Specs != 1 {
+		t.Errorf("Specs = %d, want 1", w.Specs)
+	}
+	if len(w.Active) != 1 {
+		t.Fatalf("Active = %d changes, want 1", len(w.Active))
+	}
+	c := w.Active[0]
+	if c.ID != "add-2fa" || c.Archived || !c.HasProposal || !c.HasDesign || !c.HasTasks {
+		t.Errorf("Active[0] = %+v, want add-2fa with proposal, design and tasks", c)
+	}
+	if len(w.Archived) != 1 {
+		t.Fatalf("Archived = %d changes, want 1", len(w.Archived))
+	}
+	a := w.Archived[0]
+	if a.ID != "add-password-reset" || a.ArchivedOn != "2025-01-10" || !a.Archived {
+		t.Errorf("Archived[0] = %+v, want add-password-reset archived on 2025-01-10", a)
+	}
+	if w.IsEmpty() {
+		t.Error("IsEmpty() = true, want false")
+	}
+}
+
+func TestScanWorkspace_ArchivedNewestFirst(t *testing.T) {
+	w, err := openspec.ScanWorkspace(filepath.Join(fixturesDir(), "openspec-archived", "openspec"))
+	if err != nil {
+		t.Fatalf("ScanWorkspace() error = %v", err)
+	}
+	var ids []string
+	for _, c := range w.Archived {
+		ids = append(ids, c.ArchivedOn+" "+c.ID)
+	}
+	if len(ids) != 2 || ids[0] != "2025-02-03 add-2fa" || ids[1] != "2025-01-10 add-password-reset" {
+		t.Errorf("Archived = %v, want newest first", ids)
+	}
+	if w.Latest() != nil {
+		t.Error("Latest() should be nil without active changes")
+	}
+}
+
+func TestScanWorkspace_UndatedArchive(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "changes", "archive", "legacy-change"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := openspec.ScanWorkspace(dir)
+	if err != nil {
+		t.Fatalf("ScanWorkspace() error = %v", err)
+	}
+	if len(w.Archived) != 1 || w.Archived[0].ID != "legacy-change" || w.Archived[0].ArchivedOn != "" {
+		t.Errorf("Archived = %+v, want legacy-change without date", w.Archived)
+	}
+}
+
+func TestScanWorkspace_Empty(t *testing.T) {
+	w, err := openspec.ScanWorkspace(filepath.Join(fixturesDir(), "openspec-no-artifacts", "openspec"))
+	if err != nil {
+		t.Fatalf("ScanWorkspace() error = %v", err)
+	}
+	if !w.IsEmpty() {
+		t.Errorf("IsEmpty() = false for %+v", w)
+	}
+}
+
+func TestScanWorkspace_Missing(t *testing.T) {
+	if _, err := openspec.ScanWorkspace(filepath.Join(t.TempDir(), "openspec")); err == nil {
+		t.Error("ScanWorkspace() should return error for missing directory")
+	}
+}
+
+func TestScanWorkspace_ModTime(t *testing.T) {
+	dir := t.TempDir()
+	change := filepath.Join(dir, "changes", "add-2fa")
+	if err := os.MkdirAll(change, 0755); err != nil {
+		t.Fatal(err)
+	}
+	proposal := filepath.Join(change, "proposal.md")
+	if err := os.WriteFile(proposal, []byte("## Why\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now().Add(time.Hour)
+	for _, p := range []string{dir, filepath.Join(dir, "changes"), change} {
+		if err := os.Chtimes(p, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Chtimes(proposal, recent, recent); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := openspec.ScanWorkspace(dir)
+	if err != nil {
+		t.Fatalf("ScanWorkspace() error = %v", err)
+	}
+	if !w.ModTime.Equal(recent) {
+		t.Errorf("ModTime = %v, want newest artifact %v", w.ModTime, recent)
+	}
+	if !w.Active[0].ModTime.Equal(recent) {
+		t.Errorf("Active[0].ModTime = %v, want %v", w.Active[0].ModTime, recent)
+	}
+}
@@ -10,10 +10,12 @@ package detectors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
 
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/declarative"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
 )
@@ -41,6 +43,44 @@ func (r *Registry) Register(detector ports.MethodDetector) {
 	r.detectors = append(r.detectors, detector)
 }
 
+// LoadDefinitions registers declarative detectors from YAML files in dir
+// (e.g., ~/.vibe-dash/detectors/). Definitions whose name collides with an
+// already-registered detector are skipped so built-ins cannot be shadowed.
+// Returns the number of detectors registered; invalid files are reported in
+// the error but do not prevent valid ones from loading.
+func (r *Registry) LoadDefinitions(dir string) (int, error) {
+	loaded, err := declarative.LoadDir(dir)
+
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	count := 0
+	for _, d := range loaded {
+		if r.has(d.Name()) {
+			errs = append(errs, fmt.Errorf("%w: %s: name %q conflicts with registered detector",
+				declarative.ErrInvalidDefinition, d.Definition().Source, d.Name()))
+			continue
+		}
+		r.Register(d)
+		count++
+		slog.Debug("declarative detector registered", "name", d.Name(), "source", d.Definition().Source)
+	}
+
+	return count, errors.Join(errs...)
+}
+
+// has reports whether a detector with the given name is registered.
+func (r *Registry) has(name string) bool {
+	for _, d := range r.detectors {
+		if d.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
 // Detectors returns the list of registered detectors.
 func (r *Registry) Detectors() []ports.MethodDetector {
 	return r.detectors
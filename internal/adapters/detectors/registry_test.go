@@ -3,12 +3,19 @@ package detectors_test
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/openspec"
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/speckit"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/taskmaster"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/services"
 )
 
 // mockDetector is a test double for ports.MethodDetector
@@ -24,6 +31,10 @@ func (m *mockDetector) Name() string {
 	return m.name
 }
 
+func (m *mockDetector) Stages() []domain.MethodStage {
+	return nil
+}
+
 func (m *mockDetector) CanDetect(ctx context.Context, path string) bool {
 	return m.canDetect
 }
@@ -312,6 +323,66 @@ func TestRegistry_DetectWithCoexistence_MultipleMethods(t *testing.T) {
 	}
 }
 
+func TestRegistry_DetectWithCoexistence_OpenSpecAndSpeckit(t *testing.T) {
+	// A repo using both: the methodology with the newer artifacts wins
+	root := t.TempDir()
+	files := map[string]string{
+		"specs/001-auth/spec.md":               "# Auth\n",
+		"openspec/project.md":                  "# Project\n",
+		"openspec/changes/add-2fa/proposal.md": "## Why\n",
+		"openspec/changes/add-2fa/tasks.md":    "- [x] 1.1 Secrets\n- [ ] 1.2 Login\n",
+	}
+	for rel, content := range files {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-48 * time.Hour)
+	for _, rel := range []string{"specs", "specs/001-auth", "specs/001-auth/spec.md"} {
+		if err := os.Chtimes(filepath.Join(root, rel), old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := detectors.NewRegistry()
+	r.Register(speckit.NewSpeckitDetector())
+	r.Register(openspec.NewOpenSpecDetector())
+
+	winner, results, err := services.NewDetectionService(r).DetectWithCoexistenceSelection(context.Background(), root)
+	if err != nil {
+		t.Fatalf("DetectWithCoexistenceSelection() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("DetectWithCoexistenceSelection() returned %d results, want 2", len(results))
+	}
+	if winner == nil {
+		t.Fatal("DetectWithCoexistenceSelection() returned a tie, want openspec to win")
+	}
+	if winner.Method != "openspec" || winner.MethodStage.ID != "apply" {
+		t.Errorf("winner = %s at %q, want openspec at apply", winner.Method, winner.MethodStage.ID)
+	}
+}
+
+func TestRegistry_DetectAll_TaskMaster(t *testing.T) {
+	r := detectors.NewRegistry()
+	r.Register(speckit.NewSpeckitDetector())
+	r.Register(openspec.NewOpenSpecDetector())
+	r.Register(taskmaster.NewTaskMasterDetector())
+
+	fixture := filepath.Join("..", "..", "..", "test", "fixtures", "taskmaster-stage-implement")
+	result, err := r.DetectAll(context.Background(), fixture)
+	if err != nil {
+		t.Fatalf("DetectAll() error = %v", err)
+	}
+	if result.Method != "taskmaster" || result.MethodStage.ID != "in-progress" {
+		t.Errorf("DetectAll() = %s at %q, want taskmaster at in-progress", result.Method, result.MethodStage.ID)
+	}
+}
+
 func TestRegistry_DetectWithCoexistence_SingleMatch(t *testing.T) {
 	r := detectors.NewRegistry()
 	ctx := context.Background()
@@ -454,3 +525,47 @@ func TestRegistry_DetectWithCoexistence_EmptyRegistry(t *testing.T) {
 		t.Errorf("DetectWithCoexistence() returned %d results, want 0", len(results))
 	}
 }
+
+func TestRegistry_LoadDefinitions(t *testing.T) {
+	dir := t.TempDir()
+	defs := map[string]string{
+		"rfc.yaml":     "name: rfc-flow\nmarkers: [rfcs]\nstages: [{stage: plan}]",
+		"clash.yaml":   "name: speckit\nmarkers: [specs]\nstages: [{stage: plan}]",
+		"invalid.yaml": "name: [\n",
+	}
+	for name, content := range defs {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := detectors.NewRegistry()
+	r.Register(speckit.NewSpeckitDetector())
+
+	n, err := r.LoadDefinitions(dir)
+	if n != 1 {
+		t.Errorf("LoadDefinitions() loaded %d, want 1", n)
+	}
+	if err == nil {
+		t.Fatal("LoadDefinitions() expected error for invalid and clashing definitions")
+	}
+	if !strings.Contains(err.Error(), "conflicts with registered detector") {
+		t.Errorf("error should report name conflict, got %v", err)
+	}
+
+	names := make([]string, 0, len(r.Detectors()))
+	for _, d := range r.Detectors() {
+		names = append(names, d.Name())
+	}
+	if strings.Join(names, ",") != "speckit,rfc-flow" {
+		t.Errorf("Detectors() = %v, want [speckit rfc-flow]", names)
+	}
+}
+
+func TestRegistry_LoadDefinitions_MissingDir(t *testing.T) {
+	r := detectors.NewRegistry()
+	n, err := r.LoadDefinitions(filepath.Join(t.TempDir(), "missing"))
+	if err != nil || n != 0 {
+		t.Errorf("LoadDefinitions() = (%d, %v), want (0, nil)", n, err)
+	}
+}
@@ -35,6 +35,12 @@ func (d *SpeckitDetector) Name() string {
 	return "speckit"
 }
 
+// Stages returns Speckit's stage list, which follows the coarse stages
+// directly: specify, plan, tasks, implement.
+func (d *SpeckitDetector) Stages() []domain.MethodStage {
+	return domain.DefaultMethodStages()
+}
+
 // CanDetect checks if any Speckit marker directory exists at the given path.
 func (d *SpeckitDetector) CanDetect(ctx context.Context, path string) bool {
 	select {
@@ -186,7 +192,8 @@ func (d *SpeckitDetector) findMostRecentDir(baseDir string, dirs []os.DirEntry)
 // Order matters: highest stage first (implement > tasks > plan > spec).
 var speckitArtifacts = []string{"implement.md", "tasks.md", "plan.md", "spec.md"}
 
-// analyzeSpecDir determines the stage based on artifact files.
+// analyzeSpecDir determines the stage based on artifact files and counts the
+// tasks of the feature's tasks.md.
 // dirMtime is the directory modification time from findMostRecentDir.
 // Returns DetectionResult with ArtifactTimestamp set to max(dirMtime, file mtimes).
 func (d *SpeckitDetector) analyzeSpecDir(dirPath string, extraReasoning string, dirMtime time.Time) (*domain.DetectionResult, error) {
@@ -236,11 +243,34 @@ func (d *SpeckitDetector) analyzeSpecDir(dirPath string, extraReasoning string,
 		reasoning = "no standard Speckit artifacts found"
 	}
 
+	// Task completion of this feature. An unreadable tasks.md only loses
+	// the counts, not the stage.
+	var tasks *TaskList
+	if hasTasks {
+		var err error
+		if tasks, err = ParseTasksFile(filepath.Join(dirPath, "tasks.md")); err != nil {
+			slog.Debug("failed to parse tasks.md", "dir", dirPath, "error", err)
+		}
+	}
+	if tasks != nil && tasks.Total() > 0 {
+		reasoning += fmt.Sprintf(", %d/%d tasks done", tasks.Done(), tasks.Total())
+	}
+
 	// Append extra reasoning if present
 	if extraReasoning != "" {
 		reasoning = reasoning + " (" + extraReasoning + ")"
 	}
 
-	result := domain.NewDetectionResult(d.Name(), stage, confidence, reasoning).WithTimestamp(maxMtime)
+	result := domain.NewDetectionResult(d.Name(), stage, confidence, reasoning).
+		WithTimestamp(maxMtime).
+		WithMethodStage(domain.MethodStageFor(d.Stages(), stage))
+	if tasks != nil && tasks.Total() > 0 {
+		next, nextPhase := "", ""
+		if t := tasks.Next(); t != nil {
+			next, nextPhase = t.String(), t.Phase
+		}
+		result = result.WithTasks(tasks.Done(), tasks.Total(), next).
+			WithTaskPhases(tasks.Phases, nextPhase)
+	}
 	return &result, nil
 }
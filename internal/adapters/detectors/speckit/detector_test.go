@@ -185,10 +185,70 @@ func TestSpeckitDetector_Detect(t *testing.T) {
 			if result.Reasoning == "" {
 				t.Error("Detect().Reasoning should not be empty")
 			}
+			if result.MethodStage.Coarse != tt.expectStage {
+				t.Errorf("Detect().MethodStage = %+v, want coarse %v", result.MethodStage, tt.expectStage)
+			}
 		})
 	}
 }
 
+func TestSpeckitDetector_Stages(t *testing.T) {
+	stages := speckit.NewSpeckitDetector().Stages()
+	var ids []string
+	for _, s := range stages {
+		ids = append(ids, s.ID)
+	}
+	if got := strings.Join(ids, ","); got != "specify,plan,tasks,implement" {
+		t.Errorf("Stages() = %s, want specify,plan,tasks,implement", got)
+	}
+}
+
+func TestSpeckitDetector_Detect_TaskProgress(t *testing.T) {
+	d := speckit.NewSpeckitDetector()
+	ctx := context.Background()
+
+	result, err := d.Detect(ctx, filepath.Join(fixturesDir(), "speckit-tasks-phases"))
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.Stage != domain.StageTasks || !result.HasTasks() {
+		t.Fatalf("Detect() = %s with %d tasks, want Tasks with counts", result.Stage, result.TasksTotal)
+	}
+	if result.TasksDone != 3 || result.TasksTotal != 8 {
+		t.Errorf("tasks = %d/%d, want 3/8", result.TasksDone, result.TasksTotal)
+	}
+	if result.NextTask != "T004 [P] Implement password hashing in internal/auth/hash.go" {
+		t.Errorf("NextTask = %q", result.NextTask)
+	}
+	if result.NextTaskPhase != "Phase 2: Foundational" {
+		t.Errorf("NextTaskPhase = %q, want Phase 2: Foundational", result.NextTaskPhase)
+	}
+	if len(result.TaskPhases) != 3 || result.TaskPhases[1] != (domain.TaskPhase{Name: "Phase 2: Foundational", Done: 1, Total: 3, Parallel: 2}) {
+		t.Errorf("TaskPhases = %+v, want 3 phases with Phase 2 at 1/3 and 2 open [P]", result.TaskPhases)
+	}
+	if !strings.Contains(result.Reasoning, "tasks.md exists, 3/8 tasks done (spec: 001-user-auth") {
+		t.Errorf("Reasoning = %q, want task counts and spec", result.Reasoning)
+	}
+
+	// All tasks done: counts without a next task
+	result, err = d.Detect(ctx, filepath.Join(fixturesDir(), "speckit-stage-implement-complete"))
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.TasksDone != 2 || result.TasksTotal != 2 || result.NextTask != "" {
+		t.Errorf("complete = %d/%d next %q, want 2/2 and no next task", result.TasksDone, result.TasksTotal, result.NextTask)
+	}
+
+	// Before tasks.md there is nothing to count
+	result, err = d.Detect(ctx, filepath.Join(fixturesDir(), "speckit-stage-plan"))
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.HasTasks() {
+		t.Errorf("plan stage should have no tasks, got %d", result.TasksTotal)
+	}
+}
+
 func TestSpeckitDetector_Detect_ContextCancellation(t *testing.T) {
 	d := speckit.NewSpeckitDetector()
 
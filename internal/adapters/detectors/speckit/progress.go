@@ -0,0 +1,47 @@
+package speckit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+)
+
+// TaskProgress counts checklist items in tasks.md across all spec
+// directories. Returns ok=false when no tasks.md exists. Used for burndown
+// metrics.
+func TaskProgress(ctx context.Context, projectPath string) (done, total int, ok bool, err error) {
+	for _, marker := range markerDirs {
+		entries, readErr := os.ReadDir(filepath.Join(projectPath, marker))
+		if readErr != nil {
+			continue
+		}
+		for _, entry := range entries {
+			select {
+			case <-ctx.Done():
+				return 0, 0, false, ctx.Err()
+			default:
+			}
+			if !entry.IsDir() {
+				continue
+			}
+			d, t, found, err := countTasks(filepath.Join(projectPath, marker, entry.Name(), "tasks.md"))
+			if err != nil {
+				return 0, 0, false, err
+			}
+			if found {
+				done, total, ok = done+d, total+t, true
+			}
+		}
+	}
+	return done, total, ok, nil
+}
+
+// countTasks counts checked and total checklist items in one tasks.md.
+// Returns found=false if the file does not exist.
+func countTasks(path string) (done, total int, found bool, err error) {
+	list, err := ParseTasksFile(path)
+	if err != nil || list == nil {
+		return 0, 0, false, err
+	}
+	return list.Done(), list.Total(), true, nil
+}
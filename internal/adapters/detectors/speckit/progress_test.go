@@ -0,0 +1,51 @@
+package speckit_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/speckit"
+)
+
+func TestTaskProgress_Fixture(t *testing.T) {
+	done, total, ok, err := speckit.TaskProgress(context.Background(), filepath.Join(fixturesDir(), "speckit-stage-tasks-partial"))
+	if err != nil || !ok {
+		t.Fatalf("TaskProgress() ok = %v, err = %v", ok, err)
+	}
+	if done != 0 || total != 2 {
+		t.Errorf("TaskProgress() = %d/%d, want 0/2", done, total)
+	}
+}
+
+func TestTaskProgress_SumsSpecDirs(t *testing.T) {
+	dir := t.TempDir()
+	write := func(spec, content string) {
+		t.Helper()
+		specDir := filepath.Join(dir, "specs", spec)
+		if err := os.MkdirAll(specDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(specDir, "tasks.md"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("001-auth", "# Tasks\n- [x] T001 Setup\n- [X] T002 Model\n  - [ ] T003 Nested\nNot a task [x]\n")
+	write("002-api", "* [ ] T010 Endpoint\n+ [x] T011 Docs\n")
+	if err := os.MkdirAll(filepath.Join(dir, "specs", "003-draft"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	done, total, ok, err := speckit.TaskProgress(context.Background(), dir)
+	if err != nil || !ok || done != 3 || total != 5 {
+		t.Errorf("TaskProgress() = %d/%d ok=%v err=%v, want 3/5", done, total, ok, err)
+	}
+}
+
+func TestTaskProgress_NoTasks(t *testing.T) {
+	_, _, ok, err := speckit.TaskProgress(context.Background(), filepath.Join(fixturesDir(), "speckit-stage-plan"))
+	if ok || err != nil {
+		t.Errorf("TaskProgress() ok = %v, err = %v; want false, nil", ok, err)
+	}
+}
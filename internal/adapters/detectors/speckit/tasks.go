@@ -0,0 +1,146 @@
+package speckit
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+var (
+	// taskItemRegex captures the check mark and text of a checklist item.
+	taskItemRegex = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s*(.*)$`)
+	// taskIDRegex matches a leading Speckit task ID: "T001".
+	taskIDRegex = regexp.MustCompile(`^(T\d+)\b\s*`)
+	// phaseHeadingRegex matches the headings that group tasks: "## Phase 1: Setup".
+	phaseHeadingRegex = regexp.MustCompile(`^#{2,3}\s+(.+?)\s*#*\s*$`)
+)
+
+// parallelMarker tags a task that can run in parallel with its neighbours.
+const parallelMarker = "[P]"
+
+// Task is one checklist item of a tasks.md:
+// "- [ ] T012 [P] [US1] Create User model in src/models/user.py".
+type Task struct {
+	ID          string // "T012", empty when the item has no task ID
+	Description string // Text after the ID and [P] marker
+	Done        bool
+	Parallel    bool   // Marked [P]
+	Phase       string // Heading the task is listed under, empty before the first heading
+}
+
+// String returns the ID, [P] marker and description as written:
+// "T012 [P] [US1] Create User model".
+func (t Task) String() string {
+	parts := make([]string, 0, 3)
+	if t.ID != "" {
+		parts = append(parts, t.ID)
+	}
+	if t.Parallel {
+		parts = append(parts, parallelMarker)
+	}
+	if t.Description != "" {
+		parts = append(parts, t.Description)
+	}
+	return strings.Join(parts, " ")
+}
+
+// TaskList is a parsed tasks.md.
+type TaskList struct {
+	Tasks  []Task
+	Phases []domain.TaskPhase // Headings with at least one task, in file order
+}
+
+// Done returns the number of checked tasks.
+func (l *TaskList) Done() int {
+	done := 0
+	for _, t := range l.Tasks {
+		if t.Done {
+			done++
+		}
+	}
+	return done
+}
+
+// Total returns the number of tasks.
+func (l *TaskList) Total() int {
+	return len(l.Tasks)
+}
+
+// Next returns the first unchecked task, or nil when all are done.
+func (l *TaskList) Next() *Task {
+	for i := range l.Tasks {
+		if !l.Tasks[i].Done {
+			return &l.Tasks[i]
+		}
+	}
+	return nil
+}
+
+// ParseTasks reads markdown checklist items and the headings they are
+// listed under. Nested items count as tasks of their own.
+func ParseTasks(r io.Reader) (*TaskList, error) {
+	list := &TaskList{}
+	phase := ""
+	phaseIdx := -1 // Index into list.Phases of the current heading, -1 until it has a task
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := phaseHeadingRegex.FindStringSubmatch(line); m != nil {
+			phase, phaseIdx = m[1], -1
+			continue
+		}
+		m := taskItemRegex.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		task := Task{Done: m[1] != " ", Phase: phase}
+		text := m[2]
+		if id := taskIDRegex.FindStringSubmatch(text); id != nil {
+			task.ID = id[1]
+			text = text[len(id[0]):]
+		}
+		if rest, ok := strings.CutPrefix(text, parallelMarker); ok {
+			task.Parallel = true
+			text = rest
+		}
+		task.Description = strings.TrimSpace(text)
+		list.Tasks = append(list.Tasks, task)
+
+		if phase != "" {
+			if phaseIdx < 0 {
+				list.Phases = append(list.Phases, domain.TaskPhase{Name: phase})
+				phaseIdx = len(list.Phases) - 1
+			}
+			list.Phases[phaseIdx].Total++
+			if task.Done {
+				list.Phases[phaseIdx].Done++
+			} else if task.Parallel {
+				list.Phases[phaseIdx].Parallel++
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// ParseTasksFile parses one tasks.md. Returns nil, nil if the file does not
+// exist.
+func ParseTasksFile(path string) (*TaskList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	return ParseTasks(f)
+}
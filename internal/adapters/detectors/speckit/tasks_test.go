@@ -0,0 +1,100 @@
+package speckit_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/speckit"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func TestParseTasks(t *testing.T) {
+	input := `# Tasks
+- [x] T001 Setup
+- [ ] untracked item
+
+## Phase 1: Core ##
+
+- [X] T002 [P] Model in src/model.go
+  - [ ] T003 Nested
+* [ ] T004 [P] [US1] Endpoint
+Not a task [x]
+
+## Empty phase
+`
+	list, err := speckit.ParseTasks(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseTasks() error = %v", err)
+	}
+	if list.Done() != 2 || list.Total() != 5 {
+		t.Errorf("ParseTasks() = %d/%d, want 2/5", list.Done(), list.Total())
+	}
+
+	want := []speckit.Task{
+		{ID: "T001", Description: "Setup", Done: true},
+		{Description: "untracked item"},
+		{ID: "T002", Description: "Model in src/model.go", Done: true, Parallel: true, Phase: "Phase 1: Core"},
+		{ID: "T003", Description: "Nested", Phase: "Phase 1: Core"},
+		{ID: "T004", Description: "[US1] Endpoint", Parallel: true, Phase: "Phase 1: Core"},
+	}
+	for i, w := range want {
+		if list.Tasks[i] != w {
+			t.Errorf("Tasks[%d] = %+v, want %+v", i, list.Tasks[i], w)
+		}
+	}
+
+	if len(list.Phases) != 1 || list.Phases[0] != (domain.TaskPhase{Name: "Phase 1: Core", Done: 1, Total: 3, Parallel: 1}) {
+		t.Errorf("Phases = %+v, want only Phase 1 with 1/3 done and 1 open [P]", list.Phases)
+	}
+	if next := list.Next(); next == nil || next.String() != "untracked item" {
+		t.Errorf("Next() = %v, want untracked item", next)
+	}
+}
+
+func TestTaskList_NextAllDone(t *testing.T) {
+	list, err := speckit.ParseTasks(strings.NewReader("- [x] T001 Done\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next := list.Next(); next != nil {
+		t.Errorf("Next() = %+v, want nil", next)
+	}
+}
+
+func TestTask_String(t *testing.T) {
+	tests := []struct {
+		task speckit.Task
+		want string
+	}{
+		{speckit.Task{ID: "T012", Description: "[US1] Create User model"}, "T012 [US1] Create User model"},
+		{speckit.Task{ID: "T012"}, "T012"},
+		{speckit.Task{ID: "T013", Description: "Add index", Parallel: true}, "T013 [P] Add index"},
+		{speckit.Task{Description: "Write docs"}, "Write docs"},
+	}
+	for _, tt := range tests {
+		if got := tt.task.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestParseTasksFile_Fixture(t *testing.T) {
+	list, err := speckit.ParseTasksFile(filepath.Join(fixturesDir(), "speckit-tasks-phases", "specs", "001-user-auth", "tasks.md"))
+	if err != nil || list == nil {
+		t.Fatalf("ParseTasksFile() = %v, %v", list, err)
+	}
+	if list.Done() != 3 || list.Total() != 8 {
+		t.Errorf("ParseTasksFile() = %d/%d, want 3/8", list.Done(), list.Total())
+	}
+	if len(list.Phases) != 3 || list.Phases[2].Name != "Phase 3: User Story 1 - Sign in (Priority: P1)" {
+		t.Errorf("Phases = %+v", list.Phases)
+	}
+}
+
+func TestParseTasksFile_Missing(t *testing.T) {
+	list, err := speckit.ParseTasksFile(filepath.Join(t.TempDir(), "tasks.md"))
+	if list != nil || err != nil {
+		t.Errorf("ParseTasksFile() = %v, %v; want nil, nil", list, err)
+	}
+}
@@ -0,0 +1,200 @@
+// Package taskmaster implements detection for the Task Master workflow.
+// It reads .taskmaster/tasks/tasks.json (legacy or tagged task lists, with
+// the active tag from .taskmaster/state.json) and the PRDs under
+// .taskmaster/docs/, and derives the stage from task statuses.
+package taskmaster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// Compile-time interface compliance check
+var _ ports.MethodDetector = (*TaskMasterDetector)(nil)
+
+// Layout of a Task Master project, relative to the project root.
+const (
+	markerDir = ".taskmaster"
+	tasksPath = ".taskmaster/tasks/tasks.json"
+	statePath = ".taskmaster/state.json"
+	docsDir   = ".taskmaster/docs"
+)
+
+// TaskMasterDetector implements ports.MethodDetector for Task Master.
+type TaskMasterDetector struct{}
+
+// NewTaskMasterDetector creates a new Task Master detector.
+func NewTaskMasterDetector() *TaskMasterDetector {
+	return &TaskMasterDetector{}
+}
+
+// Name returns the detector identifier.
+func (d *TaskMasterDetector) Name() string {
+	return "taskmaster"
+}
+
+// CanDetect checks if the .taskmaster/ directory exists at the given path.
+func (d *TaskMasterDetector) CanDetect(ctx context.Context, path string) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	default:
+	}
+
+	info, err := os.Stat(filepath.Join(path, markerDir))
+	return err == nil && info.IsDir()
+}
+
+// Detect performs full Task Master detection on the given path. Tasks of the
+// active tag decide the stage; without tasks, a PRD means the project is
+// being specified. ArtifactTimestamp is the newest mtime of tasks.json and
+// the PRDs.
+func (d *TaskMasterDetector) Detect(ctx context.Context, path string) (*domain.DetectionResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	dir := filepath.Join(path, markerDir)
+	info, err := os.Stat(dir)
+	if err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("no .taskmaster directory found at %s", path)
+	}
+
+	prds, docsTime := listPRDs(filepath.Join(path, docsDir))
+	timestamp := latest(info.ModTime(), docsTime)
+	if tasksInfo, err := os.Stat(filepath.Join(path, tasksPath)); err == nil {
+		timestamp = latest(timestamp, tasksInfo.ModTime())
+	}
+
+	file, err := ParseTasksFile(filepath.Join(path, tasksPath))
+	if err != nil {
+		slog.Debug("failed to parse tasks.json", "path", path, "error", err)
+		result := domain.NewDetectionResult(d.Name(), domain.StageUnknown, domain.ConfidenceUncertain,
+			"tasks.json parse error").WithTimestamp(timestamp)
+		return &result, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var tag string
+	var tasks TaskList
+	if file != nil {
+		tag, tasks = file.Select(readCurrentTag(filepath.Join(path, statePath)))
+	}
+
+	var result domain.DetectionResult
+	switch {
+	case len(tasks) > 0:
+		s := listStage(tasks)
+		result = domain.NewDetectionResult(d.Name(), s.Coarse, domain.ConfidenceCertain,
+			taskReasoning(tasks, tag, file.TagNames())).
+			WithMethodStage(s)
+		if tasks.Total() > 0 {
+			next := ""
+			if t := tasks.Next(); t != nil {
+				next = t.String()
+			}
+			result = result.WithTasks(tasks.Done(), tasks.Total(), next)
+		}
+	case len(prds) > 0:
+		s := stage(stagePRD)
+		result = domain.NewDetectionResult(d.Name(), s.Coarse, domain.ConfidenceCertain,
+			fmt.Sprintf("PRD found (%s), no tasks generated", listNames(prds))).
+			WithMethodStage(s)
+	default:
+		result = domain.NewDetectionResult(d.Name(), domain.StageUnknown, domain.ConfidenceUncertain,
+			"no PRD or tasks found in .taskmaster")
+	}
+	result = result.WithTimestamp(timestamp)
+	return &result, nil
+}
+
+// taskReasoning summarizes a task list: counts by status, the next unblocked
+// task and the tag when it is not the only, default one.
+// "12 tasks: 5 done, 2 in-progress, 5 pending; next: #8 Add auth; tag: api (2 tags)"
+func taskReasoning(tasks TaskList, tag string, tags []string) string {
+	counts := tasks.Counts()
+	parts := []string{fmt.Sprintf("%d tasks: %s", len(tasks), FormatCounts(counts))}
+
+	if next := tasks.Next(); next != nil {
+		parts = append(parts, "next: "+next.String())
+	} else if counts[StatusPending]+counts[StatusInProgress] > 0 {
+		parts = append(parts, "no unblocked task")
+	}
+
+	if len(tags) > 1 {
+		parts = append(parts, fmt.Sprintf("tag: %s (%d tags)", tag, len(tags)))
+	} else if tag != defaultTag {
+		parts = append(parts, "tag: "+tag)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// readCurrentTag returns the active tag from state.json, or "" when the file
+// is missing or unreadable (the default tag is used then).
+func readCurrentTag(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	var state struct {
+		CurrentTag string `json:"currentTag"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		slog.Debug("failed to parse state.json", "path", path, "error", err)
+		return ""
+	}
+	return strings.TrimSpace(state.CurrentTag)
+}
+
+// listPRDs returns the visible files in the docs directory, sorted by name,
+// and their newest mtime.
+func listPRDs(dir string) ([]string, time.Time) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, time.Time{}
+	}
+	var names []string
+	var newest time.Time
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		names = append(names, entry.Name())
+		if info, err := entry.Info(); err == nil {
+			newest = latest(newest, info.ModTime())
+		}
+	}
+	return names, newest
+}
+
+// listNames names the first file and how many follow: "prd.txt, +2 more".
+func listNames(names []string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return fmt.Sprintf("%s, +%d more", names[0], len(names)-1)
+}
+
+// latest returns the later of two times.
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
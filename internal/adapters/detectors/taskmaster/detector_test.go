@@ -0,0 +1,257 @@
+package taskmaster_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/taskmaster"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// fixturesDir returns the path to the test fixtures directory
+func fixturesDir() string {
+	return filepath.Join("..", "..", "..", "..", "test", "fixtures")
+}
+
+func TestTaskMasterDetector_ImplementsInterface(t *testing.T) {
+	var _ ports.MethodDetector = taskmaster.NewTaskMasterDetector()
+}
+
+func TestTaskMasterDetector_Name(t *testing.T) {
+	d := taskmaster.NewTaskMasterDetector()
+	if got := d.Name(); got != "taskmaster" {
+		t.Errorf("Name() = %q, want %q", got, "taskmaster")
+	}
+}
+
+func TestTaskMasterDetector_CanDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected bool
+	}{
+		{"prd only", "taskmaster-prd-only", true},
+		{"tasks generated", "taskmaster-stage-tasks", true},
+		{"init only", "taskmaster-no-artifacts", true},
+		{"speckit project", "speckit-stage-specify", false},
+		{"no markers present", "no-method-detected", false},
+	}
+
+	d := taskmaster.NewTaskMasterDetector()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.CanDetect(context.Background(), filepath.Join(fixturesDir(), tt.fixture)); got != tt.expected {
+				t.Errorf("CanDetect() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTaskMasterDetector_CanDetect_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	d := taskmaster.NewTaskMasterDetector()
+	if d.CanDetect(ctx, filepath.Join(fixturesDir(), "taskmaster-stage-tasks")) {
+		t.Error("CanDetect() should return false for cancelled context")
+	}
+}
+
+func TestTaskMasterDetector_Detect(t *testing.T) {
+	tests := []struct {
+		name          string
+		fixture       string
+		expectStageID string
+		expectStage   domain.Stage
+		expectConf    domain.Confidence
+		expectReason  string
+	}{
+		{
+			"prd only", "taskmaster-prd-only", "prd", domain.StageSpecify, domain.ConfidenceCertain,
+			"PRD found (prd.txt), no tasks generated",
+		},
+		{
+			"tasks generated", "taskmaster-stage-tasks", "tasks", domain.StageTasks, domain.ConfidenceCertain,
+			"4 tasks: 4 pending; next: #1 Set up project skeleton",
+		},
+		{
+			"implementation", "taskmaster-stage-implement", "in-progress", domain.StageImplement, domain.ConfidenceCertain,
+			"6 tasks: 2 done, 1 in-progress, 2 pending, 1 deferred; next: #3.2 Add create endpoint",
+		},
+		{
+			"active tag", "taskmaster-tagged", "tasks", domain.StageTasks, domain.ConfidenceCertain,
+			"3 tasks: 2 pending, 1 blocked; next: #1 Add sync protocol; tag: feature-sync (2 tags)",
+		},
+		{
+			"all done", "taskmaster-all-done", "done", domain.StageImplement, domain.ConfidenceCertain,
+			"3 tasks: 2 done, 1 cancelled",
+		},
+		{
+			"no artifacts", "taskmaster-no-artifacts", "", domain.StageUnknown, domain.ConfidenceUncertain,
+			"no PRD or tasks found in .taskmaster",
+		},
+		{
+			"invalid tasks.json", "taskmaster-invalid-json", "", domain.StageUnknown, domain.ConfidenceUncertain,
+			"tasks.json parse error",
+		},
+	}
+
+	d := taskmaster.NewTaskMasterDetector()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := d.Detect(context.Background(), filepath.Join(fixturesDir(), tt.fixture))
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if result.Method != "taskmaster" {
+				t.Errorf("Detect().Method = %q, want %q", result.Method, "taskmaster")
+			}
+			if result.MethodStage.ID != tt.expectStageID {
+				t.Errorf("Detect().MethodStage.ID = %q, want %q", result.MethodStage.ID, tt.expectStageID)
+			}
+			if result.Stage != tt.expectStage {
+				t.Errorf("Detect().Stage = %v, want %v", result.Stage, tt.expectStage)
+			}
+			if result.Confidence != tt.expectConf {
+				t.Errorf("Detect().Confidence = %v, want %v", result.Confidence, tt.expectConf)
+			}
+			if result.Reasoning != tt.expectReason {
+				t.Errorf("Detect().Reasoning = %q, want %q", result.Reasoning, tt.expectReason)
+			}
+			if result.ArtifactTimestamp.IsZero() {
+				t.Error("Detect().ArtifactTimestamp should be set")
+			}
+		})
+	}
+}
+
+func TestTaskMasterDetector_Detect_TaskProgress(t *testing.T) {
+	d := taskmaster.NewTaskMasterDetector()
+
+	result, err := d.Detect(context.Background(), filepath.Join(fixturesDir(), "taskmaster-stage-implement"))
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.TasksDone != 2 || result.TasksTotal != 6 {
+		t.Errorf("Detect() tasks = %d/%d, want 2/6", result.TasksDone, result.TasksTotal)
+	}
+	if result.NextTask != "#3.2 Add create endpoint" {
+		t.Errorf("Detect().NextTask = %q, want %q", result.NextTask, "#3.2 Add create endpoint")
+	}
+
+	// Cancelled tasks are left out of the total
+	result, err = d.Detect(context.Background(), filepath.Join(fixturesDir(), "taskmaster-all-done"))
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.TasksDone != 2 || result.TasksTotal != 2 || result.NextTask != "" {
+		t.Errorf("Detect() tasks = %d/%d next %q, want 2/2 without next", result.TasksDone, result.TasksTotal, result.NextTask)
+	}
+}
+
+func TestTaskMasterDetector_Detect_AllBlocked(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeFile(t, filepath.Join(tmpDir, ".taskmaster", "tasks", "tasks.json"),
+		`{"tasks": [{"id": 1, "title": "A", "status": "pending", "dependencies": [2]},
+		            {"id": 2, "title": "B", "status": "blocked"}]}`)
+
+	result, err := taskmaster.NewTaskMasterDetector().Detect(context.Background(), tmpDir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if want := "2 tasks: 1 pending, 1 blocked; no unblocked task"; result.Reasoning != want {
+		t.Errorf("Detect().Reasoning = %q, want %q", result.Reasoning, want)
+	}
+}
+
+func TestTaskMasterDetector_Detect_EmptyActiveTag(t *testing.T) {
+	// A tag without tasks falls back to the PRD
+	tmpDir := t.TempDir()
+	writeFile(t, filepath.Join(tmpDir, ".taskmaster", "tasks", "tasks.json"),
+		`{"master": {"tasks": [{"id": 1, "title": "A", "status": "done"}]}, "next-release": {"tasks": []}}`)
+	writeFile(t, filepath.Join(tmpDir, ".taskmaster", "state.json"), `{"currentTag": "next-release"}`)
+	writeFile(t, filepath.Join(tmpDir, ".taskmaster", "docs", "prd.txt"), "PRD")
+	writeFile(t, filepath.Join(tmpDir, ".taskmaster", "docs", "release.md"), "PRD")
+
+	result, err := taskmaster.NewTaskMasterDetector().Detect(context.Background(), tmpDir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.MethodStage.ID != "prd" || result.Reasoning != "PRD found (prd.txt, +1 more), no tasks generated" {
+		t.Errorf("Detect() = %q (%s), want prd stage", result.MethodStage.ID, result.Reasoning)
+	}
+}
+
+func TestTaskMasterDetector_Detect_Timestamp(t *testing.T) {
+	tmpDir := t.TempDir()
+	tasksFile := filepath.Join(tmpDir, ".taskmaster", "tasks", "tasks.json")
+	prdFile := filepath.Join(tmpDir, ".taskmaster", "docs", "prd.txt")
+	writeFile(t, tasksFile, `{"tasks": [{"id": 1, "title": "A", "status": "pending"}]}`)
+	writeFile(t, prdFile, "PRD")
+
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now().Add(time.Hour)
+	for _, p := range []string{filepath.Join(tmpDir, ".taskmaster"), prdFile} {
+		if err := os.Chtimes(p, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Chtimes(tasksFile, recent, recent); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := taskmaster.NewTaskMasterDetector().Detect(context.Background(), tmpDir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if !result.ArtifactTimestamp.Equal(recent) {
+		t.Errorf("Detect().ArtifactTimestamp = %v, want tasks.json mtime %v", result.ArtifactTimestamp, recent)
+	}
+}
+
+func TestTaskMasterDetector_Detect_NoDirectory(t *testing.T) {
+	_, err := taskmaster.NewTaskMasterDetector().Detect(context.Background(), t.TempDir())
+	if err == nil {
+		t.Error("Detect() should return error without .taskmaster directory")
+	}
+}
+
+func TestTaskMasterDetector_Detect_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := taskmaster.NewTaskMasterDetector().Detect(ctx, filepath.Join(fixturesDir(), "taskmaster-stage-tasks"))
+	if err != context.Canceled {
+		t.Errorf("Detect() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestTaskMasterDetector_Stages(t *testing.T) {
+	stages := taskmaster.NewTaskMasterDetector().Stages()
+	var ids []string
+	for _, s := range stages {
+		ids = append(ids, s.ID)
+	}
+	if got, want := strings.Join(ids, ","), "prd,tasks,in-progress,done"; got != want {
+		t.Errorf("Stages() IDs = %s, want %s", got, want)
+	}
+	if !stages[len(stages)-1].Done {
+		t.Error("last stage should be Done")
+	}
+}
+
+// writeFile creates path with its parent directories.
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
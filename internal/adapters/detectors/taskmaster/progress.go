@@ -0,0 +1,27 @@
+package taskmaster
+
+import (
+	"context"
+	"path/filepath"
+)
+
+// TaskProgress counts done and non-cancelled top-level tasks of the active
+// tag. Returns ok=false when tasks.json does not exist or has no tasks. Used
+// for burndown metrics.
+func TaskProgress(ctx context.Context, projectPath string) (done, total int, ok bool, err error) {
+	select {
+	case <-ctx.Done():
+		return 0, 0, false, ctx.Err()
+	default:
+	}
+
+	file, err := ParseTasksFile(filepath.Join(projectPath, tasksPath))
+	if err != nil || file == nil {
+		return 0, 0, false, err
+	}
+	_, tasks := file.Select(readCurrentTag(filepath.Join(projectPath, statePath)))
+	if len(tasks) == 0 {
+		return 0, 0, false, nil
+	}
+	return tasks.Done(), tasks.Total(), true, nil
+}
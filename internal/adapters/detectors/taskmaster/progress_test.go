@@ -0,0 +1,44 @@
+package taskmaster_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/taskmaster"
+)
+
+func TestTaskProgress(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		wantDone  int
+		wantTotal int
+		wantOK    bool
+	}{
+		{"tasks in progress", "taskmaster-stage-implement", 2, 6, true},
+		{"active tag", "taskmaster-tagged", 0, 3, true},
+		{"cancelled not counted", "taskmaster-all-done", 2, 2, true},
+		{"prd only", "taskmaster-prd-only", 0, 0, false},
+		{"no .taskmaster directory", "empty-project", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			done, total, ok, err := taskmaster.TaskProgress(context.Background(), filepath.Join(fixturesDir(), tt.fixture))
+			if err != nil {
+				t.Fatalf("TaskProgress() error = %v", err)
+			}
+			if done != tt.wantDone || total != tt.wantTotal || ok != tt.wantOK {
+				t.Errorf("TaskProgress() = %d/%d ok=%v, want %d/%d ok=%v", done, total, ok, tt.wantDone, tt.wantTotal, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTaskProgress_InvalidJSON(t *testing.T) {
+	_, _, _, err := taskmaster.TaskProgress(context.Background(), filepath.Join(fixturesDir(), "taskmaster-invalid-json"))
+	if err == nil {
+		t.Error("TaskProgress() should return error for invalid tasks.json")
+	}
+}
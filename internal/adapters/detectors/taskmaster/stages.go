@@ -0,0 +1,47 @@
+package taskmaster
+
+import "github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+
+// Task Master stage IDs, in workflow order.
+const (
+	stagePRD        = "prd"
+	stageTasks      = "tasks"
+	stageInProgress = "in-progress"
+	stageDone       = "done"
+)
+
+// stages is the Task Master workflow: a PRD is parsed into tasks, which are
+// then implemented until every task is done.
+var stages = domain.NewMethodStages(
+	domain.MethodStage{ID: stagePRD, Label: "PRD", Coarse: domain.StageSpecify},
+	domain.MethodStage{ID: stageTasks, Label: "Task breakdown", Coarse: domain.StageTasks},
+	domain.MethodStage{ID: stageInProgress, Label: "Implementation", Coarse: domain.StageImplement},
+	domain.MethodStage{ID: stageDone, Label: "Done", Done: true, Coarse: domain.StageImplement},
+)
+
+// Stages returns the Task Master stage list.
+func (d *TaskMasterDetector) Stages() []domain.MethodStage {
+	list := make([]domain.MethodStage, len(stages))
+	copy(list, stages)
+	return list
+}
+
+// stage returns the workflow stage with the given ID.
+func stage(id string) domain.MethodStage {
+	s, _ := domain.FindMethodStage(stages, id)
+	return s
+}
+
+// listStage derives the stage from a non-empty task list: done when every
+// task is finished, in progress once any task or subtask was started, and
+// the task breakdown otherwise.
+func listStage(tasks TaskList) domain.MethodStage {
+	switch {
+	case tasks.Finished():
+		return stage(stageDone)
+	case tasks.Started():
+		return stage(stageInProgress)
+	default:
+		return stage(stageTasks)
+	}
+}
@@ -0,0 +1,45 @@
+package taskmaster
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func TestListStage(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"nothing started", `{"tasks": [{"id": 1}, {"id": 2, "status": "blocked"}]}`, stageTasks},
+		{"task in review", `{"tasks": [{"id": 1, "status": "review"}, {"id": 2}]}`, stageInProgress},
+		{"subtask done", `{"tasks": [{"id": 1, "subtasks": [{"id": 1, "status": "done"}]}]}`, stageInProgress},
+		{"all done", `{"tasks": [{"id": 1, "status": "done"}]}`, stageDone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := ParseTasks(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, tasks := file.Select("")
+			if got := listStage(tasks); got.ID != tt.want {
+				t.Errorf("listStage() = %q, want %q", got.ID, tt.want)
+			}
+		})
+	}
+}
+
+func TestStages_CoarseMapping(t *testing.T) {
+	for _, s := range stages {
+		if s.Coarse == domain.StageUnknown {
+			t.Errorf("stage %q has no coarse stage", s.ID)
+		}
+	}
+	if s := stage(stagePRD); s.Ordinal != 1 || s.Label != "PRD" {
+		t.Errorf("stage(prd) = %+v", s)
+	}
+}
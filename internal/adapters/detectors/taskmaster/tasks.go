@@ -0,0 +1,391 @@
+package taskmaster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// defaultTag is the tag Task Master uses for untagged (legacy) task lists
+// and when no tag has been selected.
+const defaultTag = "master"
+
+// Task Master statuses, normalized to lower case.
+const (
+	StatusPending    = "pending"
+	StatusInProgress = "in-progress"
+	StatusReview     = "review"
+	StatusDone       = "done"
+	StatusBlocked    = "blocked"
+	StatusDeferred   = "deferred"
+	StatusCancelled  = "cancelled"
+)
+
+// statusOrder is the order statuses are listed in reasoning; statuses not
+// listed follow alphabetically.
+var statusOrder = []string{
+	StatusDone, StatusInProgress, StatusReview, StatusPending,
+	StatusBlocked, StatusDeferred, StatusCancelled,
+}
+
+// priorityRank orders priorities for picking the next task; missing or
+// unknown priorities count as medium, as in Task Master.
+var priorityRank = map[string]int{"high": 3, "medium": 2, "low": 1}
+
+// TaskID is a task or subtask ID. tasks.json stores numbers ("id": 3) and
+// dotted subtask references ("3.2"); both are kept as strings.
+type TaskID string
+
+// UnmarshalJSON accepts a JSON number or string.
+func (id *TaskID) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*id = TaskID(strings.TrimSpace(s))
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("task id must be a number or string: %s", data)
+	}
+	*id = TaskID(n.String())
+	return nil
+}
+
+// Task is one task of a tasks.json, with its subtasks. Subtask IDs are
+// qualified with the parent ID when parsed ("2" under task 3 -> "3.2").
+type Task struct {
+	ID           TaskID   `json:"id"`
+	Title        string   `json:"title"`
+	Status       string   `json:"status"`
+	Priority     string   `json:"priority"`
+	Dependencies []TaskID `json:"dependencies"`
+	Subtasks     []Task   `json:"subtasks"`
+}
+
+// String returns the ID and title: "#3.2 Add login endpoint".
+func (t Task) String() string {
+	return "#" + string(t.ID) + " " + t.Title
+}
+
+// TaskList is the task list of one tag.
+type TaskList []Task
+
+// TaskFile is a parsed tasks.json: the task list of every tag. A legacy file
+// with a top-level "tasks" array has the single tag "master".
+type TaskFile struct {
+	Tags map[string]TaskList
+}
+
+// TagNames returns the tags in alphabetical order.
+func (f *TaskFile) TagNames() []string {
+	names := make([]string, 0, len(f.Tags))
+	for name := range f.Tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Select returns the task list of tag. When tag is empty or missing, it falls
+// back to "master" and then to the first tag alphabetically. Returns "", nil
+// when the file has no tags.
+func (f *TaskFile) Select(tag string) (string, TaskList) {
+	for _, name := range []string{tag, defaultTag} {
+		if tasks, ok := f.Tags[name]; ok && name != "" {
+			return name, tasks
+		}
+	}
+	if names := f.TagNames(); len(names) > 0 {
+		return names[0], f.Tags[names[0]]
+	}
+	return "", nil
+}
+
+// Counts returns the number of top-level tasks per normalized status.
+func (l TaskList) Counts() map[string]int {
+	counts := make(map[string]int)
+	for _, t := range l {
+		counts[normalizeStatus(t.Status)]++
+	}
+	return counts
+}
+
+// Done returns the number of done top-level tasks.
+func (l TaskList) Done() int {
+	return l.Counts()[StatusDone]
+}
+
+// Total returns the number of top-level tasks that are not cancelled.
+func (l TaskList) Total() int {
+	return len(l) - l.Counts()[StatusCancelled]
+}
+
+// Started reports whether any task or subtask is in progress, in review or done.
+func (l TaskList) Started() bool {
+	for _, t := range l {
+		if isStarted(t.Status) {
+			return true
+		}
+		for _, s := range t.Subtasks {
+			if isStarted(s.Status) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Finished reports whether every task is done or cancelled, with at least
+// one done.
+func (l TaskList) Finished() bool {
+	counts := l.Counts()
+	return counts[StatusDone] > 0 && counts[StatusDone]+counts[StatusCancelled] == len(l)
+}
+
+// Next returns the task to work on next, following Task Master's "next"
+// command: an open subtask of an in-progress task first, otherwise a pending
+// or in-progress task. Either must have all dependencies done; ties go to
+// higher priority, fewer dependencies, then lower ID. Returns nil when every
+// open task is blocked by dependencies or no task is open.
+func (l TaskList) Next() *Task {
+	done := make(map[TaskID]bool)
+	for _, t := range l {
+		if normalizeStatus(t.Status) == StatusDone {
+			done[t.ID] = true
+		}
+		for _, s := range t.Subtasks {
+			if normalizeStatus(s.Status) == StatusDone {
+				done[s.ID] = true
+			}
+		}
+	}
+	ready := func(t *Task) bool {
+		if !isOpen(t.Status) {
+			return false
+		}
+		for _, dep := range t.Dependencies {
+			if !done[dep] {
+				return false
+			}
+		}
+		return true
+	}
+
+	var subtasks []*Task
+	for i := range l {
+		if normalizeStatus(l[i].Status) != StatusInProgress {
+			continue
+		}
+		for j := range l[i].Subtasks {
+			if s := &l[i].Subtasks[j]; ready(s) {
+				subtasks = append(subtasks, s)
+			}
+		}
+	}
+	if next := bestTask(subtasks); next != nil {
+		return next
+	}
+
+	var tasks []*Task
+	for i := range l {
+		if ready(&l[i]) {
+			tasks = append(tasks, &l[i])
+		}
+	}
+	return bestTask(tasks)
+}
+
+// bestTask returns the candidate with the highest priority, then the fewest
+// dependencies, then the lowest ID. Returns nil for no candidates.
+func bestTask(candidates []*Task) *Task {
+	var best *Task
+	for _, t := range candidates {
+		if best == nil || betterTask(t, best) {
+			best = t
+		}
+	}
+	return best
+}
+
+// betterTask reports whether a should be picked before b.
+func betterTask(a, b *Task) bool {
+	if pa, pb := priority(a.Priority), priority(b.Priority); pa != pb {
+		return pa > pb
+	}
+	if len(a.Dependencies) != len(b.Dependencies) {
+		return len(a.Dependencies) < len(b.Dependencies)
+	}
+	return compareIDs(a.ID, b.ID) < 0
+}
+
+// compareIDs orders dotted IDs numerically part by part ("2" < "10",
+// "3.2" < "3.10"), falling back to string order for non-numeric parts.
+func compareIDs(a, b TaskID) int {
+	pa, pb := strings.Split(string(a), "."), strings.Split(string(b), ".")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, errA := strconv.Atoi(pa[i])
+		nb, errB := strconv.Atoi(pb[i])
+		switch {
+		case errA == nil && errB == nil && na != nb:
+			if na < nb {
+				return -1
+			}
+			return 1
+		case (errA != nil || errB != nil) && pa[i] != pb[i]:
+			return strings.Compare(pa[i], pb[i])
+		}
+	}
+	return len(pa) - len(pb)
+}
+
+// priority ranks a priority name; unknown and missing rank as medium.
+func priority(p string) int {
+	if rank, ok := priorityRank[strings.ToLower(strings.TrimSpace(p))]; ok {
+		return rank
+	}
+	return priorityRank["medium"]
+}
+
+// normalizeStatus lower-cases a status and maps aliases: "completed" is
+// done, "in_progress" is in-progress, and a missing status is pending.
+func normalizeStatus(status string) string {
+	s := strings.ToLower(strings.TrimSpace(status))
+	s = strings.ReplaceAll(s, "_", "-")
+	switch s {
+	case "":
+		return StatusPending
+	case "completed":
+		return StatusDone
+	case "canceled":
+		return StatusCancelled
+	default:
+		return s
+	}
+}
+
+// isStarted reports whether work on a task has begun.
+func isStarted(status string) bool {
+	switch normalizeStatus(status) {
+	case StatusInProgress, StatusReview, StatusDone:
+		return true
+	default:
+		return false
+	}
+}
+
+// isOpen reports whether a task can be picked as the next one.
+func isOpen(status string) bool {
+	switch normalizeStatus(status) {
+	case StatusPending, StatusInProgress:
+		return true
+	default:
+		return false
+	}
+}
+
+// FormatCounts lists status counts in workflow order:
+// "5 done, 2 in-progress, 5 pending".
+func FormatCounts(counts map[string]int) string {
+	var parts []string
+	listed := make(map[string]bool)
+	for _, status := range statusOrder {
+		listed[status] = true
+		if counts[status] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[status], status))
+		}
+	}
+	var other []string
+	for status := range counts {
+		if !listed[status] && counts[status] > 0 {
+			other = append(other, status)
+		}
+	}
+	sort.Strings(other)
+	for _, status := range other {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[status], status))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// taggedList is the shape of one tag in tagged files and of legacy files.
+type taggedList struct {
+	Tasks *[]Task `json:"tasks"`
+}
+
+// ParseTasks reads a tasks.json in the tagged format
+// ({"master": {"tasks": [...]}, "feature-x": {...}}) or the legacy format
+// ({"tasks": [...]}). Keys that hold no task list are ignored.
+func ParseTasks(r io.Reader) (*TaskFile, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	file := &TaskFile{Tags: make(map[string]TaskList)}
+	if _, legacy := raw["tasks"]; legacy {
+		raw = map[string]json.RawMessage{defaultTag: data}
+	}
+
+	for tag, value := range raw {
+		// Skip scalar and array values, e.g. a top-level version field
+		if trimmed := bytes.TrimSpace(value); len(trimmed) == 0 || trimmed[0] != '{' {
+			continue
+		}
+		var list taggedList
+		if err := json.Unmarshal(value, &list); err != nil {
+			return nil, fmt.Errorf("tag %q: %w", tag, err)
+		}
+		if list.Tasks == nil {
+			continue
+		}
+		file.Tags[tag] = qualify(*list.Tasks)
+	}
+	return file, nil
+}
+
+// ParseTasksFile parses one tasks.json. Returns nil, nil if the file does not
+// exist.
+func ParseTasksFile(path string) (*TaskFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	return ParseTasks(f)
+}
+
+// qualify prefixes subtask IDs and sibling dependencies with the parent ID,
+// so subtasks can be looked up across the list ("2" under task 3 -> "3.2").
+func qualify(tasks []Task) TaskList {
+	for i := range tasks {
+		parent := tasks[i].ID
+		for j := range tasks[i].Subtasks {
+			s := &tasks[i].Subtasks[j]
+			if !strings.Contains(string(s.ID), ".") {
+				s.ID = parent + "." + s.ID
+			}
+			for k, dep := range s.Dependencies {
+				if !strings.Contains(string(dep), ".") {
+					s.Dependencies[k] = parent + "." + dep
+				}
+			}
+		}
+	}
+	return TaskList(tasks)
+}
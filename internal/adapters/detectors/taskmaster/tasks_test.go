@@ -0,0 +1,206 @@
+package taskmaster_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/taskmaster"
+)
+
+func parse(t *testing.T, input string) *taskmaster.TaskFile {
+	t.Helper()
+	file, err := taskmaster.ParseTasks(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseTasks() error = %v", err)
+	}
+	return file
+}
+
+func TestParseTasks_Legacy(t *testing.T) {
+	file := parse(t, `{
+		"tasks": [
+			{"id": 1, "title": "Setup", "status": "done", "dependencies": []},
+			{"id": 2, "title": "API", "status": "in-progress", "dependencies": [1],
+			 "subtasks": [{"id": 1, "title": "Model", "status": "done"},
+			              {"id": 2, "title": "Handler", "status": "pending", "dependencies": [1, "1.1"]}]}
+		],
+		"metadata": {"projectName": "demo"}
+	}`)
+
+	if got := file.TagNames(); len(got) != 1 || got[0] != "master" {
+		t.Fatalf("TagNames() = %v, want [master]", got)
+	}
+	tag, tasks := file.Select("")
+	if tag != "master" || len(tasks) != 2 {
+		t.Fatalf("Select() = %q with %d tasks, want master with 2", tag, len(tasks))
+	}
+	sub := tasks[1].Subtasks[1]
+	if sub.ID != "2.2" {
+		t.Errorf("subtask ID = %q, want %q", sub.ID, "2.2")
+	}
+	if len(sub.Dependencies) != 2 || sub.Dependencies[0] != "2.1" || sub.Dependencies[1] != "1.1" {
+		t.Errorf("subtask dependencies = %v, want [2.1 1.1]", sub.Dependencies)
+	}
+}
+
+func TestParseTasks_Tagged(t *testing.T) {
+	file := parse(t, `{
+		"master": {"tasks": [{"id": 1, "title": "Setup", "status": "done"}], "metadata": {}},
+		"feature-x": {"tasks": [{"id": "1", "title": "Spike", "status": "pending"}]},
+		"notes": {"text": "not a tag"},
+		"version": "1"
+	}`)
+
+	if got := strings.Join(file.TagNames(), ","); got != "feature-x,master" {
+		t.Errorf("TagNames() = %s, want feature-x,master", got)
+	}
+	if tag, tasks := file.Select("feature-x"); tag != "feature-x" || tasks[0].Title != "Spike" {
+		t.Errorf("Select(feature-x) = %q %v", tag, tasks)
+	}
+	if tag, _ := file.Select("missing"); tag != "master" {
+		t.Errorf("Select(missing) = %q, want master fallback", tag)
+	}
+}
+
+func TestParseTasks_SelectWithoutMaster(t *testing.T) {
+	file := parse(t, `{"b": {"tasks": []}, "a": {"tasks": []}}`)
+	if tag, _ := file.Select(""); tag != "a" {
+		t.Errorf("Select() = %q, want first tag alphabetically", tag)
+	}
+	if tag, tasks := parse(t, `{}`).Select(""); tag != "" || tasks != nil {
+		t.Errorf("Select() on empty file = %q %v, want none", tag, tasks)
+	}
+}
+
+func TestParseTasks_Invalid(t *testing.T) {
+	for _, input := range []string{
+		`{"tasks": [`,
+		`[]`,
+		`{"master": {"tasks": "none"}}`,
+		`{"tasks": [{"id": true}]}`,
+	} {
+		if _, err := taskmaster.ParseTasks(strings.NewReader(input)); err == nil {
+			t.Errorf("ParseTasks(%s) should return error", input)
+		}
+	}
+}
+
+func TestParseTasksFile_Missing(t *testing.T) {
+	file, err := taskmaster.ParseTasksFile(filepath.Join(t.TempDir(), "tasks.json"))
+	if err != nil || file != nil {
+		t.Errorf("ParseTasksFile() = %v, %v, want nil, nil", file, err)
+	}
+}
+
+func TestTaskList_Counts(t *testing.T) {
+	_, tasks := parse(t, `{"tasks": [
+		{"id": 1, "status": "done"}, {"id": 2, "status": "Completed"},
+		{"id": 3, "status": "in_progress"}, {"id": 4},
+		{"id": 5, "status": "cancelled"}, {"id": 6, "status": "on-hold"}
+	]}`).Select("")
+
+	want := "2 done, 1 in-progress, 1 pending, 1 cancelled, 1 on-hold"
+	if got := taskmaster.FormatCounts(tasks.Counts()); got != want {
+		t.Errorf("FormatCounts() = %q, want %q", got, want)
+	}
+	if tasks.Done() != 2 || tasks.Total() != 5 {
+		t.Errorf("Done()/Total() = %d/%d, want 2/5", tasks.Done(), tasks.Total())
+	}
+	if !tasks.Started() || tasks.Finished() {
+		t.Errorf("Started() = %v, Finished() = %v, want true, false", tasks.Started(), tasks.Finished())
+	}
+}
+
+func TestTaskList_StartedBySubtask(t *testing.T) {
+	_, tasks := parse(t, `{"tasks": [{"id": 1, "status": "pending",
+		"subtasks": [{"id": 1, "status": "done"}]}]}`).Select("")
+	if !tasks.Started() {
+		t.Error("Started() = false, want true for a done subtask")
+	}
+}
+
+func TestTaskList_Finished(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{`{"tasks": [{"id": 1, "status": "done"}, {"id": 2, "status": "cancelled"}]}`, true},
+		{`{"tasks": [{"id": 1, "status": "cancelled"}]}`, false},
+		{`{"tasks": [{"id": 1, "status": "done"}, {"id": 2, "status": "deferred"}]}`, false},
+	}
+	for _, tt := range tests {
+		_, tasks := parse(t, tt.input).Select("")
+		if got := tasks.Finished(); got != tt.want {
+			t.Errorf("Finished() for %s = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestTaskList_Next(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			"lowest ID among equals",
+			`{"tasks": [{"id": 10, "title": "J"}, {"id": 2, "title": "B"}]}`,
+			"#2 B",
+		},
+		{
+			"dependencies must be done",
+			`{"tasks": [{"id": 1, "title": "A", "status": "in-progress"},
+			            {"id": 2, "title": "B", "dependencies": [1]},
+			            {"id": 3, "title": "C", "dependencies": []}]}`,
+			"#1 A",
+		},
+		{
+			"priority before ID",
+			`{"tasks": [{"id": 1, "title": "A", "priority": "low"},
+			            {"id": 2, "title": "B", "priority": "high"}]}`,
+			"#2 B",
+		},
+		{
+			"fewer dependencies first",
+			`{"tasks": [{"id": 1, "title": "A", "status": "done"},
+			            {"id": 2, "title": "B", "dependencies": [1]},
+			            {"id": 3, "title": "C"}]}`,
+			"#3 C",
+		},
+		{
+			"subtask of in-progress task first",
+			`{"tasks": [{"id": 1, "title": "A", "priority": "high"},
+			            {"id": 2, "title": "B", "status": "in-progress", "priority": "low",
+			             "subtasks": [{"id": 1, "title": "B1", "status": "done"},
+			                          {"id": 2, "title": "B2", "dependencies": [1]}]}]}`,
+			"#2.2 B2",
+		},
+		{
+			"blocked subtasks fall back to tasks",
+			`{"tasks": [{"id": 1, "title": "A"},
+			            {"id": 2, "title": "B", "status": "in-progress",
+			             "subtasks": [{"id": 1, "title": "B1", "dependencies": [2]},
+			                          {"id": 2, "title": "B2", "status": "blocked"}]}]}`,
+			"#1 A",
+		},
+		{
+			"nothing open",
+			`{"tasks": [{"id": 1, "title": "A", "status": "done"}, {"id": 2, "title": "B", "status": "review"}]}`,
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, tasks := parse(t, tt.input).Select("")
+			got := ""
+			if next := tasks.Next(); next != nil {
+				got = next.String()
+			}
+			if got != tt.want {
+				t.Errorf("Next() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
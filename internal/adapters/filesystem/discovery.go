@@ -0,0 +1,169 @@
+package filesystem
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// claudeProjectsDir is where Claude Code keeps per-project logs, relative to home.
+const claudeProjectsDir = ".claude/projects"
+
+// claudeLogsMarker is reported for directories that have Claude Code logs.
+const claudeLogsMarker = "claude-logs"
+
+// projectMarkers are entries whose presence makes a directory a project:
+// a VCS checkout or a methodology directory the detectors recognize.
+var projectMarkers = []string{
+	".git",
+	".bmad", "_bmad", "_bmad-output", // BMAD
+	"specs", ".speckit", ".specify", // Speckit
+	"openspec",    // OpenSpec
+	".taskmaster", // Task Master
+}
+
+// Compile-time interface compliance check
+var _ ports.ProjectDiscoverer = (*ProjectDiscoverer)(nil)
+
+// ProjectDiscoverer finds project directories under workspace roots.
+type ProjectDiscoverer struct {
+	claudeProjectsDir string // Empty disables the Claude log check
+}
+
+// DiscovererOption is a functional option for configuring ProjectDiscoverer.
+type DiscovererOption func(*ProjectDiscoverer)
+
+// WithClaudeProjectsDir overrides ~/.claude/projects (used by tests).
+func WithClaudeProjectsDir(dir string) DiscovererOption {
+	return func(d *ProjectDiscoverer) {
+		d.claudeProjectsDir = dir
+	}
+}
+
+// NewProjectDiscoverer creates a discoverer that also treats directories with
+// Claude Code logs under ~/.claude/projects as projects.
+func NewProjectDiscoverer(opts ...DiscovererOption) *ProjectDiscoverer {
+	d := &ProjectDiscoverer{}
+	if home, err := os.UserHomeDir(); err == nil {
+		d.claudeProjectsDir = filepath.Join(home, claudeProjectsDir)
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Discover walks each root up to maxDepth levels below it.
+// Symlinked directories are not followed. Hidden and vendor directories
+// (see skipPatterns) are not searched.
+func (d *ProjectDiscoverer) Discover(ctx context.Context, roots []string, maxDepth int) ([]ports.ProjectCandidate, error) {
+	if maxDepth < 0 {
+		maxDepth = 0
+	}
+	claudeDirs := d.loadClaudeDirs()
+
+	seen := make(map[string]bool)
+	var candidates []ports.ProjectCandidate
+	for _, root := range roots {
+		canonical, err := CanonicalPath(root)
+		if err != nil {
+			slog.Warn("skipping workspace root", "root", root, "error", err)
+			continue
+		}
+		if err := d.walk(ctx, canonical, 0, maxDepth, claudeDirs, seen, &candidates); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Path < candidates[j].Path
+	})
+	return candidates, nil
+}
+
+// walk checks dir and, if it is not a project, descends into its subdirectories.
+func (d *ProjectDiscoverer) walk(ctx context.Context, dir string, depth, maxDepth int, claudeDirs map[string]bool, seen map[string]bool, out *[]ports.ProjectCandidate) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if seen[dir] {
+		return nil
+	}
+	seen[dir] = true
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		slog.Debug("skipping unreadable directory", "path", dir, "error", err)
+		return nil
+	}
+
+	if markers := projectMarkersIn(dir, entries, claudeDirs); len(markers) > 0 {
+		*out = append(*out, ports.ProjectCandidate{Path: dir, Markers: markers})
+		return nil // Don't report nested repos inside a project
+	}
+
+	if depth >= maxDepth {
+		return nil
+	}
+	for _, entry := range entries {
+		// DirEntry.IsDir is false for symlinks, so links are never followed
+		if !entry.IsDir() || shouldSkipDirectory(entry.Name()) {
+			continue
+		}
+		if err := d.walk(ctx, filepath.Join(dir, entry.Name()), depth+1, maxDepth, claudeDirs, seen, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// projectMarkersIn returns the markers present in dir, in projectMarkers order.
+func projectMarkersIn(dir string, entries []os.DirEntry, claudeDirs map[string]bool) []string {
+	names := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		names[entry.Name()] = true
+	}
+
+	var markers []string
+	for _, marker := range projectMarkers {
+		if names[marker] {
+			markers = append(markers, marker)
+		}
+	}
+	if claudeDirs[claudeDirName(dir)] {
+		markers = append(markers, claudeLogsMarker)
+	}
+	return markers
+}
+
+// loadClaudeDirs returns the directory names under ~/.claude/projects.
+func (d *ProjectDiscoverer) loadClaudeDirs() map[string]bool {
+	dirs := make(map[string]bool)
+	if d.claudeProjectsDir == "" {
+		return dirs
+	}
+	entries, err := os.ReadDir(d.claudeProjectsDir)
+	if err != nil {
+		return dirs // Claude Code not installed
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs[entry.Name()] = true
+		}
+	}
+	return dirs
+}
+
+// claudeDirName converts a project path to its Claude Code log directory name.
+// Example: /Users/me/work/api → -Users-me-work-api
+func claudeDirName(projectPath string) string {
+	return strings.ReplaceAll(projectPath, "/", "-")
+}
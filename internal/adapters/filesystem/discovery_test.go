@@ -0,0 +1,151 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// mkdirs creates each relative directory under root.
+func mkdirs(t *testing.T, root string, dirs ...string) {
+	t.Helper()
+	for _, d := range dirs {
+		if err := os.MkdirAll(filepath.Join(root, d), 0755); err != nil {
+			t.Fatalf("mkdir %s: %v", d, err)
+		}
+	}
+}
+
+// newWorkspace builds a workspace tree and returns its canonical root.
+//
+//	repo-a/.git            git repo (nested/.git inside is not reported)
+//	client/api/specs       speckit at depth 2
+//	client/web/_bmad       bmad at depth 2
+//	deep/x/y/.git          depth 3
+//	plain/                 nothing
+//	node_modules/pkg/.git  skipped (vendor)
+//	.hidden/repo/.git      skipped (hidden)
+//	notes/                 Claude Code logs only
+func newWorkspace(t *testing.T) string {
+	t.Helper()
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mkdirs(t, root,
+		"repo-a/.git", "repo-a/nested/.git",
+		"client/api/specs",
+		"client/web/_bmad",
+		"deep/x/y/.git",
+		"plain",
+		"node_modules/pkg/.git",
+		".hidden/repo/.git",
+		"notes",
+	)
+	return root
+}
+
+func candidatePaths(candidates []ports.ProjectCandidate, root string) []string {
+	paths := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		rel, _ := filepath.Rel(root, c.Path)
+		paths = append(paths, rel)
+	}
+	return paths
+}
+
+func TestProjectDiscoverer_Discover(t *testing.T) {
+	root := newWorkspace(t)
+	claudeDir := t.TempDir()
+	mkdirs(t, claudeDir, claudeDirName(filepath.Join(root, "notes")))
+
+	d := NewProjectDiscoverer(WithClaudeProjectsDir(claudeDir))
+
+	tests := []struct {
+		name  string
+		depth int
+		want  []string
+	}{
+		{"depth 1", 1, []string{"notes", "repo-a"}},
+		{"depth 2", 2, []string{"client/api", "client/web", "notes", "repo-a"}},
+		{"depth 3", 3, []string{"client/api", "client/web", "deep/x/y", "notes", "repo-a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates, err := d.Discover(context.Background(), []string{root}, tt.depth)
+			if err != nil {
+				t.Fatalf("Discover returned error: %v", err)
+			}
+			if got := candidatePaths(candidates, root); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Discover = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectDiscoverer_Markers(t *testing.T) {
+	root := newWorkspace(t)
+	mkdirs(t, root, "repo-a/.specify", "repo-a/openspec", "repo-a/.taskmaster")
+	claudeDir := t.TempDir()
+	mkdirs(t, claudeDir, claudeDirName(filepath.Join(root, "repo-a")))
+
+	candidates, err := NewProjectDiscoverer(WithClaudeProjectsDir(claudeDir)).
+		Discover(context.Background(), []string{filepath.Join(root, "repo-a")}, 0)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+	if len(candidates) != 1 {
+		t.Fatalf("expected root itself as only candidate, got %v", candidates)
+	}
+	want := []string{".git", ".specify", "openspec", ".taskmaster", claudeLogsMarker}
+	if !reflect.DeepEqual(candidates[0].Markers, want) {
+		t.Errorf("Markers = %v, want %v", candidates[0].Markers, want)
+	}
+}
+
+func TestProjectDiscoverer_SkipsMissingRootsAndDuplicates(t *testing.T) {
+	root := newWorkspace(t)
+	d := NewProjectDiscoverer(WithClaudeProjectsDir(""))
+
+	roots := []string{filepath.Join(root, "does-not-exist"), root, filepath.Join(root, "client")}
+	candidates, err := d.Discover(context.Background(), roots, 2)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+	want := []string{"client/api", "client/web", "repo-a"}
+	if got := candidatePaths(candidates, root); !reflect.DeepEqual(got, want) {
+		t.Errorf("Discover = %v, want %v", got, want)
+	}
+}
+
+func TestProjectDiscoverer_DoesNotFollowSymlinks(t *testing.T) {
+	root := newWorkspace(t)
+	outside := t.TempDir()
+	mkdirs(t, outside, "linked/.git")
+	if err := os.Symlink(filepath.Join(outside, "linked"), filepath.Join(root, "plain", "link")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	candidates, err := NewProjectDiscoverer(WithClaudeProjectsDir("")).
+		Discover(context.Background(), []string{filepath.Join(root, "plain")}, 2)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+	if len(candidates) != 0 {
+		t.Errorf("expected no candidates through symlink, got %v", candidates)
+	}
+}
+
+func TestProjectDiscoverer_CancelledContext(t *testing.T) {
+	root := newWorkspace(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := NewProjectDiscoverer().Discover(ctx, []string{root}, 3); err == nil {
+		t.Error("expected error for cancelled context")
+	}
+}
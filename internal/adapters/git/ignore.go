@@ -0,0 +1,125 @@
+package git
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"strings"
+)
+
+// ignorePattern is one line of a .gitignore-style file.
+type ignorePattern struct {
+	base     string // Directory (relative to the worktree, "" for root) the pattern applies under
+	segments []string
+	negate   bool
+	dirOnly  bool
+	anchored bool // Pattern contains a slash and matches relative to base
+}
+
+// ignoreRules is an ordered list of patterns; later patterns take precedence.
+type ignoreRules []ignorePattern
+
+// parseIgnoreFile reads patterns from file, scoping them to base.
+// Missing or unreadable files contribute nothing.
+func parseIgnoreFile(file, base string) ignoreRules {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var rules ignoreRules
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if p, ok := parseIgnoreLine(scanner.Text(), base); ok {
+			rules = append(rules, p)
+		}
+	}
+	return rules
+}
+
+func parseIgnoreLine(line, base string) (ignorePattern, bool) {
+	line = strings.TrimRight(line, "\r")
+	if !strings.HasSuffix(line, `\ `) {
+		line = strings.TrimRight(line, " ")
+	}
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignorePattern{}, false
+	}
+
+	p := ignorePattern{base: base}
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		p.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return ignorePattern{}, false
+	}
+	p.segments = strings.Split(line, "/")
+	return p, true
+}
+
+// ignored reports whether rel (slash-separated, relative to the worktree)
+// is excluded. The last matching pattern decides.
+func (r ignoreRules) ignored(rel string, isDir bool) bool {
+	for i := len(r) - 1; i >= 0; i-- {
+		if r[i].matches(rel, isDir) {
+			return !r[i].negate
+		}
+	}
+	return false
+}
+
+func (p ignorePattern) matches(rel string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+	if p.base != "" {
+		if !strings.HasPrefix(rel, p.base+"/") {
+			return false
+		}
+		rel = rel[len(p.base)+1:]
+	}
+	if !p.anchored {
+		ok, _ := path.Match(p.segments[0], path.Base(rel))
+		return ok
+	}
+	return matchSegments(p.segments, strings.Split(rel, "/"))
+}
+
+// matchSegments matches glob segments against path segments, where a "**"
+// segment matches zero or more path segments.
+func matchSegments(pattern, parts []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			rest := pattern[1:]
+			if len(rest) == 0 {
+				return true
+			}
+			for i := 0; i <= len(parts); i++ {
+				if matchSegments(rest, parts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(parts) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], parts[0]); !ok {
+			return false
+		}
+		pattern, parts = pattern[1:], parts[1:]
+	}
+	return len(parts) == 0
+}
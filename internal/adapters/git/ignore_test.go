@@ -0,0 +1,61 @@
+package git
+
+import "testing"
+
+func TestIgnoreRules_Ignored(t *testing.T) {
+	var rules ignoreRules
+	for _, line := range []string{
+		"# comment",
+		"",
+		"*.log",
+		"!keep.log",
+		"build/",
+		"/root-only.txt",
+		"docs/**/*.tmp",
+		"**/cache",
+		`\#literal`,
+	} {
+		if p, ok := parseIgnoreLine(line, ""); ok {
+			rules = append(rules, p)
+		}
+	}
+	if p, ok := parseIgnoreLine("*.gen", "pkg"); ok {
+		rules = append(rules, p)
+	}
+
+	tests := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{"debug.log", false, true},
+		{"nested/dir/trace.log", false, true},
+		{"keep.log", false, false},
+		{"build", true, true},
+		{"build", false, false}, // dir-only pattern
+		{"src/build", true, true},
+		{"root-only.txt", false, true},
+		{"sub/root-only.txt", false, false}, // anchored to root
+		{"docs/a/b/x.tmp", false, true},
+		{"docs/x.tmp", false, true}, // ** matches zero segments
+		{"other/x.tmp", false, false},
+		{"a/b/cache", true, true},
+		{"#literal", false, true},
+		{"pkg/api.gen", false, true},
+		{"api.gen", false, false}, // scoped to pkg/
+		{"main.go", false, false},
+	}
+	for _, tt := range tests {
+		if got := rules.ignored(tt.path, tt.isDir); got != tt.want {
+			t.Errorf("ignored(%q, dir=%v) = %v, want %v", tt.path, tt.isDir, got, tt.want)
+		}
+	}
+}
+
+func TestParseIgnoreLine_Skipped(t *testing.T) {
+	for _, line := range []string{"", "   ", "# comment", "/"} {
+		if _, ok := parseIgnoreLine(line, ""); ok {
+			t.Errorf("parseIgnoreLine(%q) should be skipped", line)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package git
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Index entry flag bits.
+const (
+	indexNameMask     = 0x0fff
+	indexStageShift   = 12
+	indexExtended     = 0x4000
+	indexSkipWorktree = 0x4000 // In the extended flags word
+	indexIntentToAdd  = 0x2000 // In the extended flags word
+)
+
+// Entry mode types (upper bits of the mode field).
+const (
+	modeTypeMask = 0o170000
+	modeSymlink  = 0o120000
+	modeGitlink  = 0o160000
+)
+
+// indexEntry is one path in the staging area.
+type indexEntry struct {
+	path         string
+	id           hash
+	mode         uint32
+	size         uint32
+	mtimeSec     uint32
+	mtimeNsec    uint32
+	stage        int  // Non-zero for unmerged (conflicted) entries
+	skipWorktree bool // Sparse checkout: worktree file is not expected
+	intentToAdd  bool // Added with "git add -N"
+}
+
+// readIndex parses .git/index (versions 2-4). A missing index yields no entries.
+func readIndex(path string) ([]indexEntry, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 12 || string(data[:4]) != "DIRC" {
+		return nil, errors.New("not a git index file")
+	}
+	version := binary.BigEndian.Uint32(data[4:8])
+	if version < 2 || version > 4 {
+		return nil, fmt.Errorf("unsupported index version %d", version)
+	}
+	count := int(binary.BigEndian.Uint32(data[8:12]))
+
+	entries := make([]indexEntry, 0, count)
+	pos := 12
+	prevPath := ""
+	for i := 0; i < count; i++ {
+		start := pos
+		if len(data) < pos+62 {
+			return nil, errors.New("truncated index entry")
+		}
+		e := indexEntry{
+			mtimeSec:  binary.BigEndian.Uint32(data[pos+8:]),
+			mtimeNsec: binary.BigEndian.Uint32(data[pos+12:]),
+			mode:      binary.BigEndian.Uint32(data[pos+24:]),
+			size:      binary.BigEndian.Uint32(data[pos+36:]),
+		}
+		copy(e.id[:], data[pos+40:pos+60])
+		flags := binary.BigEndian.Uint16(data[pos+60:])
+		e.stage = int(flags>>indexStageShift) & 3
+		pos += 62
+
+		if version >= 3 && flags&indexExtended != 0 {
+			if len(data) < pos+2 {
+				return nil, errors.New("truncated index entry")
+			}
+			ext := binary.BigEndian.Uint16(data[pos:])
+			e.skipWorktree = ext&indexSkipWorktree != 0
+			e.intentToAdd = ext&indexIntentToAdd != 0
+			pos += 2
+		}
+
+		if version == 4 {
+			// Path is prefix-compressed against the previous entry
+			r := bytes.NewReader(data[pos:])
+			strip, err := readOffsetVarint(r)
+			if err != nil || int(strip) > len(prevPath) {
+				return nil, errors.New("malformed index path prefix")
+			}
+			pos += len(data[pos:]) - r.Len()
+			nul := bytes.IndexByte(data[pos:], 0)
+			if nul < 0 {
+				return nil, errors.New("truncated index path")
+			}
+			e.path = prevPath[:len(prevPath)-int(strip)] + string(data[pos:pos+nul])
+			pos += nul + 1
+		} else {
+			nameLen := int(flags & indexNameMask)
+			if nameLen == indexNameMask {
+				nameLen = bytes.IndexByte(data[pos:], 0)
+			}
+			if nameLen < 0 || len(data) < pos+nameLen {
+				return nil, errors.New("truncated index path")
+			}
+			e.path = string(data[pos : pos+nameLen])
+			// Entries are NUL-padded to a multiple of 8 bytes (at least one NUL)
+			pos = start + ((pos+nameLen-start)/8+1)*8
+		}
+
+		prevPath = e.path
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
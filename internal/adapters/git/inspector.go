@@ -0,0 +1,477 @@
+// Package git reads repository state directly from .git directories.
+//
+// It implements ports.GitInspector without shelling out to git and without
+// any network access: refs, packed-refs, config, the index and loose/packed
+// objects are parsed in-process. Only SHA-1 repositories are supported.
+package git
+
+import (
+	"container/heap"
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// DefaultMaxWalk bounds how many commits the ahead/behind walk visits.
+const DefaultMaxWalk = 20000
+
+// Compile-time interface compliance check
+var _ ports.GitInspector = (*Inspector)(nil)
+
+// Inspector implements ports.GitInspector.
+type Inspector struct {
+	maxWalk      int
+	globalIgnore string // Path to the user's global excludes file; empty disables
+}
+
+// InspectorOption is a functional option for configuring Inspector.
+type InspectorOption func(*Inspector)
+
+// WithMaxWalk sets the commit limit for ahead/behind counting.
+func WithMaxWalk(n int) InspectorOption {
+	return func(i *Inspector) {
+		if n > 0 {
+			i.maxWalk = n
+		}
+	}
+}
+
+// WithGlobalIgnoreFile overrides the global excludes file (empty disables it).
+func WithGlobalIgnoreFile(path string) InspectorOption {
+	return func(i *Inspector) {
+		i.globalIgnore = path
+	}
+}
+
+// NewInspector creates an Inspector. By default it honors the global
+// excludes file at $XDG_CONFIG_HOME/git/ignore (or ~/.config/git/ignore).
+func NewInspector(opts ...InspectorOption) *Inspector {
+	i := &Inspector{maxWalk: DefaultMaxWalk}
+	if dir, err := os.UserConfigDir(); err == nil {
+		i.globalIgnore = filepath.Join(dir, "git", "ignore")
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// Inspect returns the git status of the repository containing path.
+// Dirty and untracked counts are limited to files under path, so a project
+// inside a larger repository only reports its own changes.
+func (i *Inspector) Inspect(ctx context.Context, path string) (*domain.GitStatus, error) {
+	repo, err := findRepository(path)
+	if err != nil || repo == nil {
+		return nil, err
+	}
+
+	store := newObjectStore(filepath.Join(repo.commonDir, "objects"))
+	defer store.close()
+
+	status := &domain.GitStatus{}
+	ref, headID, err := repo.head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HEAD: %w", err)
+	}
+	if ref != "" {
+		status.Branch = strings.TrimPrefix(ref, "refs/heads/")
+		if headID, err = repo.resolveRef(ref); err != nil {
+			return nil, fmt.Errorf("failed to resolve %s: %w", ref, err)
+		}
+	} else {
+		status.Detached = true
+	}
+	status.HeadSHA = headID
+
+	var headTree *hash
+	if headID != "" {
+		id, err := parseHash(headID)
+		if err != nil {
+			return nil, err
+		}
+		head, err := store.commit(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read HEAD commit: %w", err)
+		}
+		status.LastCommitSubject = head.subject
+		status.LastCommitAt = head.time
+		headTree = &head.tree
+
+		if !status.Detached {
+			i.fillUpstream(ctx, repo, store, status, id)
+		}
+	}
+
+	for _, wt := range repo.worktrees() {
+		status.Worktrees = append(status.Worktrees, domain.GitWorktree{Path: wt.path, Branch: wt.branch})
+	}
+
+	if err := i.fillWorkingTree(ctx, repo, store, headTree, path, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// fillUpstream sets Upstream, Ahead and Behind. Failures are logged and leave
+// the counts at zero, since a missing remote ref is common and harmless.
+func (i *Inspector) fillUpstream(ctx context.Context, repo *repository, store *objectStore, status *domain.GitStatus, head hash) {
+	name, ref := repo.upstream(status.Branch)
+	if name == "" {
+		return
+	}
+	status.Upstream = name
+
+	upstreamID, err := repo.resolveRef(ref)
+	if err != nil || upstreamID == "" {
+		slog.Debug("upstream ref not found", "ref", ref, "error", err)
+		return
+	}
+	upstream, err := parseHash(upstreamID)
+	if err != nil {
+		return
+	}
+	ahead, behind, err := aheadBehind(ctx, store, head, upstream, i.maxWalk)
+	if err != nil {
+		slog.Debug("failed to count ahead/behind", "ref", ref, "error", err)
+		return
+	}
+	status.Ahead, status.Behind = ahead, behind
+}
+
+// Walk flags for aheadBehind.
+const (
+	fromLocal    = 1
+	fromUpstream = 2
+	fromBoth     = fromLocal | fromUpstream
+)
+
+// aheadBehind counts commits reachable from only one of local and upstream.
+// Commits are visited newest first; the walk stops once every queued commit
+// is reachable from both sides (their ancestors are all common).
+func aheadBehind(ctx context.Context, store *objectStore, local, upstream hash, maxWalk int) (int, int, error) {
+	if local == upstream {
+		return 0, 0, nil
+	}
+	flags := map[hash]int{local: fromLocal, upstream: fromUpstream}
+	queue := &commitQueue{}
+	for _, h := range []hash{local, upstream} {
+		c, err := store.commit(h)
+		if err != nil {
+			return 0, 0, err
+		}
+		heap.Push(queue, queuedCommit{id: h, commit: c})
+	}
+
+	for visited := 0; queue.Len() > 0 && !queue.allCommon(flags); visited++ {
+		if visited >= maxWalk {
+			return 0, 0, fmt.Errorf("history walk exceeded %d commits", maxWalk)
+		}
+		if visited%256 == 0 {
+			select {
+			case <-ctx.Done():
+				return 0, 0, ctx.Err()
+			default:
+			}
+		}
+
+		item := heap.Pop(queue).(queuedCommit)
+		f := flags[item.id]
+		for _, parent := range item.commit.parents {
+			if flags[parent]|f == flags[parent] {
+				continue
+			}
+			// (Re)queue on any new flag so it reaches the parent's ancestors too
+			flags[parent] |= f
+			c, err := store.commit(parent)
+			if err != nil {
+				return 0, 0, err
+			}
+			heap.Push(queue, queuedCommit{id: parent, commit: c})
+		}
+	}
+
+	ahead, behind := 0, 0
+	for _, f := range flags {
+		switch f {
+		case fromLocal:
+			ahead++
+		case fromUpstream:
+			behind++
+		}
+	}
+	return ahead, behind, nil
+}
+
+type queuedCommit struct {
+	id     hash
+	commit *commit
+}
+
+// commitQueue is a max-heap of commits ordered by committer time.
+type commitQueue []queuedCommit
+
+func (q commitQueue) Len() int           { return len(q) }
+func (q commitQueue) Less(i, j int) bool { return q[i].commit.time.After(q[j].commit.time) }
+func (q commitQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *commitQueue) Push(x any)        { *q = append(*q, x.(queuedCommit)) }
+func (q *commitQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+func (q commitQueue) allCommon(flags map[hash]int) bool {
+	for _, item := range q {
+		if flags[item.id] != fromBoth {
+			return false
+		}
+	}
+	return true
+}
+
+// fillWorkingTree sets Dirty and Untracked for files under scope.
+func (i *Inspector) fillWorkingTree(ctx context.Context, repo *repository, store *objectStore, headTree *hash, scope string, status *domain.GitStatus) error {
+	prefix, err := scopePrefix(repo.worktree, scope)
+	if err != nil {
+		return err
+	}
+	inScope := func(rel string) bool {
+		return prefix == "" || rel == prefix || strings.HasPrefix(rel, prefix+"/")
+	}
+
+	entries, err := readIndex(filepath.Join(repo.gitDir, "index"))
+	if err != nil {
+		return fmt.Errorf("failed to read index: %w", err)
+	}
+	headFiles := make(map[string]hash)
+	if headTree != nil {
+		if err := store.treeFiles(*headTree, "", headFiles); err != nil {
+			return fmt.Errorf("failed to read HEAD tree: %w", err)
+		}
+	}
+
+	dirty := make(map[string]bool)
+	indexed := make(map[string]bool, len(entries))
+	for n, e := range entries {
+		if n%256 == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+		}
+		indexed[e.path] = true
+		if !inScope(e.path) {
+			continue
+		}
+		switch {
+		case e.stage != 0 || e.intentToAdd:
+			dirty[e.path] = true // Unmerged or not yet staged for real
+		case headFiles[e.path] != e.id:
+			dirty[e.path] = true // Staged change (or new file)
+		case !e.skipWorktree && worktreeModified(repo.worktree, e):
+			dirty[e.path] = true
+		}
+	}
+	for p := range headFiles {
+		if !indexed[p] && inScope(p) {
+			dirty[p] = true // Staged deletion
+		}
+	}
+	status.Dirty = len(dirty)
+
+	untracked, err := i.countUntracked(ctx, repo, entries, prefix)
+	if err != nil {
+		return err
+	}
+	status.Untracked = untracked
+	return nil
+}
+
+// scopePrefix returns scope relative to the worktree in slash form ("" for the root).
+func scopePrefix(worktree, scope string) (string, error) {
+	abs, err := filepath.Abs(scope)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(worktree, abs)
+	if err != nil {
+		return "", err
+	}
+	if rel == "." {
+		return "", nil
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// worktreeModified compares a working tree file against its index entry.
+// Matching size and mtime mean unchanged (as git assumes); otherwise the
+// content is hashed so that touched-but-identical files are not reported.
+func worktreeModified(worktree string, e indexEntry) bool {
+	if e.mode&modeTypeMask == modeGitlink {
+		return false // Submodule changes are not tracked here
+	}
+	full := filepath.Join(worktree, filepath.FromSlash(e.path))
+	info, err := os.Lstat(full)
+	if err != nil {
+		return true // Deleted
+	}
+
+	isLink := info.Mode()&fs.ModeSymlink != 0
+	if isLink != (e.mode&modeTypeMask == modeSymlink) {
+		return true // Type changed
+	}
+	if !isLink && (info.Mode()&0o111 != 0) != (e.mode&0o111 != 0) {
+		return true // Executable bit changed
+	}
+	mtime := info.ModTime()
+	if uint32(info.Size()) == e.size && uint32(mtime.Unix()) == e.mtimeSec && uint32(mtime.Nanosecond()) == e.mtimeNsec {
+		return false
+	}
+
+	var content []byte
+	if isLink {
+		target, err := os.Readlink(full)
+		if err != nil {
+			return true
+		}
+		content = []byte(target)
+	} else if content, err = os.ReadFile(full); err != nil {
+		return true
+	}
+	return blobHash(content) != e.id
+}
+
+// blobHash computes the object id git would assign to content.
+func blobHash(content []byte) hash {
+	h := sha1.New()
+	h.Write([]byte("blob " + strconv.Itoa(len(content)) + "\x00"))
+	h.Write(content)
+	var id hash
+	copy(id[:], h.Sum(nil))
+	return id
+}
+
+// countUntracked walks the working tree below prefix like "git status":
+// ignored paths are skipped, and a directory containing no tracked files
+// counts once if it holds any untracked file. Nested repositories count once.
+func (i *Inspector) countUntracked(ctx context.Context, repo *repository, entries []indexEntry, prefix string) (int, error) {
+	tracked := make(map[string]bool, len(entries))
+	trackedDirs := map[string]bool{"": true}
+	for _, e := range entries {
+		tracked[e.path] = true
+		for dir := e.path; strings.Contains(dir, "/"); {
+			dir = dir[:strings.LastIndexByte(dir, '/')]
+			if trackedDirs[dir] {
+				break
+			}
+			trackedDirs[dir] = true
+		}
+	}
+
+	var rules ignoreRules
+	if i.globalIgnore != "" {
+		rules = append(rules, parseIgnoreFile(i.globalIgnore, "")...)
+	}
+	rules = append(rules, parseIgnoreFile(filepath.Join(repo.commonDir, "info", "exclude"), "")...)
+	// .gitignore files above the scope also apply inside it
+	rules = append(rules, parseIgnoreFile(filepath.Join(repo.worktree, ".gitignore"), "")...)
+	if prefix != "" {
+		parts := strings.Split(prefix, "/")
+		for n := 1; n < len(parts); n++ {
+			dir := strings.Join(parts[:n], "/")
+			rules = append(rules, parseIgnoreFile(filepath.Join(repo.worktree, filepath.FromSlash(dir), ".gitignore"), dir)...)
+		}
+		if rules.ignored(prefix, true) {
+			return 0, nil
+		}
+	}
+
+	w := &untrackedWalker{ctx: ctx, worktree: repo.worktree, tracked: tracked, trackedDirs: trackedDirs}
+	return w.walk(prefix, rules, prefix != "")
+}
+
+type untrackedWalker struct {
+	ctx         context.Context
+	worktree    string
+	tracked     map[string]bool
+	trackedDirs map[string]bool
+	visited     int
+}
+
+// walk counts untracked entries in dir. loadIgnore is false for the
+// repository root, whose .gitignore is already part of rules.
+func (w *untrackedWalker) walk(dir string, rules ignoreRules, loadIgnore bool) (int, error) {
+	w.visited++
+	if w.visited%64 == 0 {
+		select {
+		case <-w.ctx.Done():
+			return 0, w.ctx.Err()
+		default:
+		}
+	}
+
+	full := filepath.Join(w.worktree, filepath.FromSlash(dir))
+	if loadIgnore {
+		rules = append(rules[:len(rules):len(rules)], parseIgnoreFile(filepath.Join(full, ".gitignore"), dir)...)
+	}
+	entries, err := os.ReadDir(full)
+	if err != nil {
+		return 0, nil // Unreadable directories are skipped, like git does
+	}
+
+	count := 0
+	for _, e := range entries {
+		name := e.Name()
+		if name == ".git" {
+			continue
+		}
+		rel := name
+		if dir != "" {
+			rel = dir + "/" + name
+		}
+		if w.tracked[rel] {
+			continue
+		}
+
+		isDir := e.IsDir()
+		if rules.ignored(rel, isDir) {
+			continue
+		}
+		if !isDir {
+			count++
+			continue
+		}
+
+		if _, err := os.Lstat(filepath.Join(full, name, ".git")); err == nil {
+			count++ // Nested repository
+			continue
+		}
+		if w.trackedDirs[rel] {
+			n, err := w.walk(rel, rules, true)
+			if err != nil {
+				return 0, err
+			}
+			count += n
+			continue
+		}
+		n, err := w.walk(rel, rules, true)
+		if err != nil {
+			return 0, err
+		}
+		if n > 0 {
+			count++ // Wholly untracked directory is reported once
+		}
+	}
+	return count, nil
+}
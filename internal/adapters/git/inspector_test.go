@@ -0,0 +1,274 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// commitTime is the fixed author/committer date used by test repositories.
+var commitTime = time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+// runGit runs git in dir with a deterministic identity and no user config.
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	date := commitTime.Format(time.RFC3339)
+	cmd.Env = append(os.Environ(),
+		"GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1",
+		"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_AUTHOR_DATE="+date,
+		"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com", "GIT_COMMITTER_DATE="+date,
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newRepo creates a repository on branch main with one commit.
+func newRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git binary not available")
+	}
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, dir, "init", "-q", "-b", "main")
+	writeFile(t, filepath.Join(dir, "README.md"), "hello\n")
+	writeFile(t, filepath.Join(dir, "src", "main.go"), "package main\n")
+	writeFile(t, filepath.Join(dir, ".gitignore"), "*.log\nbuild/\n")
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-q", "-m", "Initial commit\n\nWith a body.")
+	return dir
+}
+
+func commitFile(t *testing.T, dir, name, message string) {
+	t.Helper()
+	writeFile(t, filepath.Join(dir, name), message+"\n")
+	runGit(t, dir, "add", name)
+	runGit(t, dir, "commit", "-q", "-m", message)
+}
+
+func inspect(t *testing.T, path string) *domain.GitStatus {
+	t.Helper()
+	status, err := NewInspector(WithGlobalIgnoreFile("")).Inspect(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Inspect returned error: %v", err)
+	}
+	if status == nil {
+		t.Fatal("Inspect returned nil status for a repository")
+	}
+	return status
+}
+
+func TestInspector_NotARepository(t *testing.T) {
+	status, err := NewInspector().Inspect(context.Background(), t.TempDir())
+	if err != nil || status != nil {
+		t.Errorf("Inspect = %v, %v; want nil, nil", status, err)
+	}
+}
+
+func TestInspector_CleanRepository(t *testing.T) {
+	dir := newRepo(t)
+	s := inspect(t, dir)
+
+	if s.Branch != "main" || s.Detached {
+		t.Errorf("branch = %q detached=%v, want main", s.Branch, s.Detached)
+	}
+	if s.HeadSHA != runGit(t, dir, "rev-parse", "HEAD") {
+		t.Errorf("HeadSHA = %q", s.HeadSHA)
+	}
+	if s.LastCommitSubject != "Initial commit" {
+		t.Errorf("LastCommitSubject = %q", s.LastCommitSubject)
+	}
+	if !s.LastCommitAt.Equal(commitTime) {
+		t.Errorf("LastCommitAt = %v, want %v", s.LastCommitAt, commitTime)
+	}
+	if s.Dirty != 0 || s.Untracked != 0 || s.Upstream != "" {
+		t.Errorf("clean repo reported %+v", *s)
+	}
+}
+
+func TestInspector_DirtyAndUntracked(t *testing.T) {
+	dir := newRepo(t)
+
+	// Dirty: unstaged modification, staged addition, staged deletion
+	writeFile(t, filepath.Join(dir, "README.md"), "changed\n")
+	writeFile(t, filepath.Join(dir, "staged.txt"), "new\n")
+	runGit(t, dir, "add", "staged.txt")
+	runGit(t, dir, "rm", "-q", "--cached", "src/main.go")
+
+	// Untracked: a file, a directory (counted once) and src/ which no longer
+	// has tracked files; ignored files and empty directories are not counted
+	writeFile(t, filepath.Join(dir, "notes.txt"), "todo\n")
+	writeFile(t, filepath.Join(dir, "drafts", "a.md"), "a\n")
+	writeFile(t, filepath.Join(dir, "drafts", "deep", "b.md"), "b\n")
+	writeFile(t, filepath.Join(dir, "debug.log"), "ignored\n")
+	writeFile(t, filepath.Join(dir, "build", "out.bin"), "ignored\n")
+	writeFile(t, filepath.Join(dir, "src", "gen", "tmp.log"), "ignored\n")
+	if err := os.MkdirAll(filepath.Join(dir, "empty"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	s := inspect(t, dir)
+	wantDirty := countPorcelain(t, dir, false)
+	wantUntracked := countPorcelain(t, dir, true)
+	if s.Dirty != wantDirty || s.Dirty != 3 {
+		t.Errorf("Dirty = %d, want 3 (git says %d)", s.Dirty, wantDirty)
+	}
+	if s.Untracked != wantUntracked || s.Untracked != 3 {
+		t.Errorf("Untracked = %d, want 3 (git says %d)", s.Untracked, wantUntracked)
+	}
+}
+
+// countPorcelain counts "git status --porcelain" lines (untracked or not).
+func countPorcelain(t *testing.T, dir string, untracked bool) int {
+	t.Helper()
+	n := 0
+	for _, line := range strings.Split(runGit(t, dir, "status", "--porcelain"), "\n") {
+		if line != "" && strings.HasPrefix(line, "??") == untracked {
+			n++
+		}
+	}
+	return n
+}
+
+func TestInspector_TouchedFileIsNotDirty(t *testing.T) {
+	dir := newRepo(t)
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "README.md"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	if s := inspect(t, dir); s.Dirty != 0 {
+		t.Errorf("Dirty = %d, want 0 for unchanged content", s.Dirty)
+	}
+}
+
+func TestInspector_AheadBehind(t *testing.T) {
+	for _, packed := range []bool{false, true} {
+		name := "loose"
+		if packed {
+			name = "packed"
+		}
+		t.Run(name, func(t *testing.T) {
+			origin := newRepo(t)
+			clone := filepath.Join(t.TempDir(), "clone")
+			runGit(t, origin, "clone", "-q", origin, clone)
+
+			commitFile(t, clone, "local1.txt", "Local one")
+			commitFile(t, clone, "local2.txt", "Local two")
+			commitFile(t, origin, "remote1.txt", "Remote one")
+			runGit(t, clone, "fetch", "-q")
+			if packed {
+				runGit(t, clone, "gc", "-q")
+				runGit(t, clone, "pack-refs", "--all")
+			}
+
+			s := inspect(t, clone)
+			if s.Upstream != "origin/main" {
+				t.Errorf("Upstream = %q, want origin/main", s.Upstream)
+			}
+			if s.Ahead != 2 || s.Behind != 1 {
+				t.Errorf("ahead/behind = %d/%d, want 2/1", s.Ahead, s.Behind)
+			}
+			if s.LastCommitSubject != "Local two" {
+				t.Errorf("LastCommitSubject = %q", s.LastCommitSubject)
+			}
+			if s.Dirty != 0 || s.Untracked != 0 {
+				t.Errorf("clone reported dirty=%d untracked=%d", s.Dirty, s.Untracked)
+			}
+		})
+	}
+}
+
+func TestInspector_DetachedHead(t *testing.T) {
+	dir := newRepo(t)
+	runGit(t, dir, "checkout", "-q", "--detach")
+
+	s := inspect(t, dir)
+	if !s.Detached || s.Branch != "" {
+		t.Errorf("detached=%v branch=%q, want detached", s.Detached, s.Branch)
+	}
+	if !strings.HasPrefix(s.BranchLabel(), "detached@") {
+		t.Errorf("BranchLabel = %q", s.BranchLabel())
+	}
+}
+
+func TestInspector_Worktrees(t *testing.T) {
+	dir := newRepo(t)
+	wt := filepath.Join(filepath.Dir(dir), filepath.Base(dir)+"-feature")
+	runGit(t, dir, "worktree", "add", "-q", "-b", "feature", wt)
+	t.Cleanup(func() { os.RemoveAll(wt) })
+
+	s := inspect(t, dir)
+	if len(s.Worktrees) != 1 || s.Worktrees[0].Path != wt || s.Worktrees[0].Branch != "feature" {
+		t.Errorf("Worktrees = %+v, want [%s feature]", s.Worktrees, wt)
+	}
+
+	// Inspecting the linked worktree reads its own HEAD and index
+	writeFile(t, filepath.Join(wt, "wip.txt"), "wip\n")
+	linked := inspect(t, wt)
+	if linked.Branch != "feature" || linked.Untracked != 1 || linked.LastCommitSubject != "Initial commit" {
+		t.Errorf("linked worktree status = %+v", *linked)
+	}
+}
+
+func TestInspector_SubdirectoryScope(t *testing.T) {
+	dir := newRepo(t)
+	writeFile(t, filepath.Join(dir, "README.md"), "changed outside scope\n")
+	writeFile(t, filepath.Join(dir, "src", "main.go"), "package main // changed\n")
+	writeFile(t, filepath.Join(dir, "src", "new.go"), "package main\n")
+	writeFile(t, filepath.Join(dir, "src", "trace.log"), "ignored by root .gitignore\n")
+
+	s := inspect(t, filepath.Join(dir, "src"))
+	if s.Branch != "main" {
+		t.Errorf("Branch = %q, want main", s.Branch)
+	}
+	if s.Dirty != 1 || s.Untracked != 1 {
+		t.Errorf("dirty/untracked = %d/%d, want 1/1 (scoped to src)", s.Dirty, s.Untracked)
+	}
+}
+
+func TestInspector_UnbornBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git binary not available")
+	}
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q", "-b", "trunk")
+	writeFile(t, filepath.Join(dir, "a.txt"), "a\n")
+
+	s := inspect(t, dir)
+	if s.Branch != "trunk" || s.HeadSHA != "" || s.Untracked != 1 {
+		t.Errorf("unborn status = %+v", *s)
+	}
+}
+
+func TestInspector_IndexVersion4(t *testing.T) {
+	dir := newRepo(t)
+	runGit(t, dir, "update-index", "--index-version", "4")
+	writeFile(t, filepath.Join(dir, "src", "main.go"), "package main // v4\n")
+
+	if s := inspect(t, dir); s.Dirty != 1 || s.Untracked != 0 {
+		t.Errorf("dirty/untracked = %d/%d, want 1/0", s.Dirty, s.Untracked)
+	}
+}
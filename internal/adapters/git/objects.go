@@ -0,0 +1,477 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Object types as stored in pack files.
+const (
+	objCommit   = 1
+	objTree     = 2
+	objBlob     = 3
+	objTag      = 4
+	objOfsDelta = 6
+	objRefDelta = 7
+)
+
+// maxDeltaDepth bounds delta chain resolution to guard against corrupt packs.
+const maxDeltaDepth = 64
+
+// errObjectNotFound is returned when an object is in neither loose storage nor any pack.
+var errObjectNotFound = errors.New("object not found")
+
+// hash is a raw SHA-1 object id.
+type hash [20]byte
+
+func parseHash(s string) (hash, error) {
+	var h hash
+	if len(s) != 40 {
+		return h, fmt.Errorf("invalid object id %q", s)
+	}
+	_, err := hex.Decode(h[:], []byte(s))
+	return h, err
+}
+
+func (h hash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// objectStore reads objects from a repository's objects directory.
+// It is not safe for concurrent use; each Inspect call creates its own.
+type objectStore struct {
+	dir     string
+	packs   []*packFile
+	loaded  bool
+	commits map[hash]*commit
+}
+
+func newObjectStore(dir string) *objectStore {
+	return &objectStore{dir: dir, commits: make(map[hash]*commit)}
+}
+
+// read returns the type and inflated content of an object.
+func (s *objectStore) read(h hash) (int, []byte, error) {
+	name := h.String()
+	if f, err := os.Open(filepath.Join(s.dir, name[:2], name[2:])); err == nil {
+		defer f.Close()
+		return readLooseObject(f)
+	}
+
+	if err := s.loadPacks(); err != nil {
+		return 0, nil, err
+	}
+	for _, p := range s.packs {
+		if offset, ok := p.find(h); ok {
+			return p.readAt(s, offset, 0)
+		}
+	}
+	return 0, nil, fmt.Errorf("%w: %s", errObjectNotFound, name)
+}
+
+// loadPacks reads every pack index under objects/pack once.
+func (s *objectStore) loadPacks() error {
+	if s.loaded {
+		return nil
+	}
+	s.loaded = true
+
+	matches, err := filepath.Glob(filepath.Join(s.dir, "pack", "*.idx"))
+	if err != nil {
+		return err
+	}
+	for _, idx := range matches {
+		p, err := openPackIndex(idx)
+		if err != nil {
+			return fmt.Errorf("failed to read pack index %s: %w", filepath.Base(idx), err)
+		}
+		s.packs = append(s.packs, p)
+	}
+	return nil
+}
+
+// close releases open pack files.
+func (s *objectStore) close() {
+	for _, p := range s.packs {
+		if p.file != nil {
+			p.file.Close()
+		}
+	}
+}
+
+func readLooseObject(r io.Reader) (int, []byte, error) {
+	zr, err := zlib.NewReader(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer zr.Close()
+
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return 0, nil, err
+	}
+	nul := bytes.IndexByte(data, 0)
+	if nul < 0 {
+		return 0, nil, errors.New("malformed loose object header")
+	}
+	kind, _, _ := strings.Cut(string(data[:nul]), " ")
+	var typ int
+	switch kind {
+	case "commit":
+		typ = objCommit
+	case "tree":
+		typ = objTree
+	case "blob":
+		typ = objBlob
+	case "tag":
+		typ = objTag
+	default:
+		return 0, nil, fmt.Errorf("unknown object type %q", kind)
+	}
+	return typ, data[nul+1:], nil
+}
+
+// packFile is a pack with its version 2 index loaded in memory.
+type packFile struct {
+	path    string
+	file    *os.File
+	fanout  [256]uint32
+	names   []byte // 20 bytes per object, sorted
+	offsets []byte // 4 bytes per object
+	large   []byte // 8 bytes per large offset
+}
+
+func openPackIndex(idxPath string) (*packFile, error) {
+	data, err := os.ReadFile(idxPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 8+256*4 || !bytes.Equal(data[:4], []byte{0xff, 't', 'O', 'c'}) {
+		return nil, errors.New("unsupported pack index version")
+	}
+	if v := binary.BigEndian.Uint32(data[4:8]); v != 2 {
+		return nil, fmt.Errorf("unsupported pack index version %d", v)
+	}
+
+	p := &packFile{path: strings.TrimSuffix(idxPath, ".idx") + ".pack"}
+	pos := 8
+	for i := range p.fanout {
+		p.fanout[i] = binary.BigEndian.Uint32(data[pos:])
+		pos += 4
+	}
+	n := int(p.fanout[255])
+	need := pos + n*20 + n*4 + n*4
+	if len(data) < need {
+		return nil, errors.New("truncated pack index")
+	}
+	p.names = data[pos : pos+n*20]
+	pos += n*20 + n*4 // skip CRC32 table
+	p.offsets = data[pos : pos+n*4]
+	pos += n * 4
+	p.large = data[pos:]
+	return p, nil
+}
+
+// find returns the pack offset of h.
+func (p *packFile) find(h hash) (int64, bool) {
+	lo := 0
+	if h[0] > 0 {
+		lo = int(p.fanout[h[0]-1])
+	}
+	hi := int(p.fanout[h[0]])
+	i := lo + sort.Search(hi-lo, func(i int) bool {
+		return bytes.Compare(p.names[(lo+i)*20:(lo+i+1)*20], h[:]) >= 0
+	})
+	if i >= hi || !bytes.Equal(p.names[i*20:(i+1)*20], h[:]) {
+		return 0, false
+	}
+
+	off := binary.BigEndian.Uint32(p.offsets[i*4:])
+	if off&0x80000000 == 0 {
+		return int64(off), true
+	}
+	idx := int(off & 0x7fffffff)
+	if len(p.large) < (idx+1)*8 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(p.large[idx*8:])), true
+}
+
+// readAt reads and resolves the object stored at offset.
+func (p *packFile) readAt(s *objectStore, offset int64, depth int) (int, []byte, error) {
+	if depth > maxDeltaDepth {
+		return 0, nil, errors.New("delta chain too deep")
+	}
+	if p.file == nil {
+		f, err := os.Open(p.path)
+		if err != nil {
+			return 0, nil, err
+		}
+		p.file = f
+	}
+
+	r := bufio.NewReader(io.NewSectionReader(p.file, offset, math.MaxInt64-offset))
+	c, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	typ := int(c>>4) & 7
+	for c&0x80 != 0 { // inflated size; not needed since zlib is self-terminating
+		if c, err = r.ReadByte(); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	var baseType int
+	var base []byte
+	switch typ {
+	case objOfsDelta:
+		rel, err := readOffsetVarint(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		if baseType, base, err = p.readAt(s, offset-rel, depth+1); err != nil {
+			return 0, nil, err
+		}
+	case objRefDelta:
+		var baseHash hash
+		if _, err := io.ReadFull(r, baseHash[:]); err != nil {
+			return 0, nil, err
+		}
+		if baseType, base, err = s.read(baseHash); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	zr, err := zlib.NewReader(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer zr.Close()
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if base == nil {
+		return typ, data, nil
+	}
+	result, err := applyDelta(base, data)
+	return baseType, result, err
+}
+
+// readOffsetVarint decodes the big-endian base-128 offset used by OFS_DELTA
+// entries and index v4 path prefixes (each continuation adds one).
+func readOffsetVarint(r io.ByteReader) (int64, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	v := int64(c & 0x7f)
+	for c&0x80 != 0 {
+		if c, err = r.ReadByte(); err != nil {
+			return 0, err
+		}
+		v = ((v + 1) << 7) | int64(c&0x7f)
+	}
+	return v, nil
+}
+
+// applyDelta reconstructs an object from its base and a git delta.
+func applyDelta(base, delta []byte) ([]byte, error) {
+	r := bytes.NewReader(delta)
+	readSize := func() (int, error) {
+		size, shift := 0, 0
+		for {
+			c, err := r.ReadByte()
+			if err != nil {
+				return 0, err
+			}
+			size |= int(c&0x7f) << shift
+			shift += 7
+			if c&0x80 == 0 {
+				return size, nil
+			}
+		}
+	}
+
+	srcSize, err := readSize()
+	if err != nil || srcSize != len(base) {
+		return nil, errors.New("delta base size mismatch")
+	}
+	dstSize, err := readSize()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, dstSize)
+	for r.Len() > 0 {
+		op, _ := r.ReadByte()
+		if op&0x80 == 0 {
+			if op == 0 {
+				return nil, errors.New("invalid delta opcode")
+			}
+			chunk := make([]byte, op)
+			if _, err := io.ReadFull(r, chunk); err != nil {
+				return nil, err
+			}
+			out = append(out, chunk...)
+			continue
+		}
+
+		var off, size int
+		for i := 0; i < 4; i++ {
+			if op&(1<<i) != 0 {
+				c, _ := r.ReadByte()
+				off |= int(c) << (8 * i)
+			}
+		}
+		for i := 0; i < 3; i++ {
+			if op&(0x10<<i) != 0 {
+				c, _ := r.ReadByte()
+				size |= int(c) << (8 * i)
+			}
+		}
+		if size == 0 {
+			size = 0x10000
+		}
+		if off+size > len(base) {
+			return nil, errors.New("delta copy out of range")
+		}
+		out = append(out, base[off:off+size]...)
+	}
+	if len(out) != dstSize {
+		return nil, errors.New("delta result size mismatch")
+	}
+	return out, nil
+}
+
+// commit holds the parts of a commit object vibe-dash needs.
+type commit struct {
+	tree    hash
+	parents []hash
+	time    time.Time
+	subject string
+}
+
+// commit reads and caches a commit, peeling annotated tags.
+func (s *objectStore) commit(h hash) (*commit, error) {
+	if c, ok := s.commits[h]; ok {
+		return c, nil
+	}
+	typ, data, err := s.read(h)
+	if err != nil {
+		return nil, err
+	}
+	if typ == objTag {
+		target, err := tagTarget(data)
+		if err != nil {
+			return nil, err
+		}
+		return s.commit(target)
+	}
+	if typ != objCommit {
+		return nil, fmt.Errorf("object %s is not a commit", h)
+	}
+	c, err := parseCommit(data)
+	if err != nil {
+		return nil, err
+	}
+	s.commits[h] = c
+	return c, nil
+}
+
+func tagTarget(data []byte) (hash, error) {
+	line, _, _ := bytes.Cut(data, []byte("\n"))
+	target, ok := bytes.CutPrefix(line, []byte("object "))
+	if !ok {
+		return hash{}, errors.New("malformed tag object")
+	}
+	return parseHash(string(target))
+}
+
+func parseCommit(data []byte) (*commit, error) {
+	header, message, _ := bytes.Cut(data, []byte("\n\n"))
+	c := &commit{}
+	for _, line := range strings.Split(string(header), "\n") {
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "tree":
+			h, err := parseHash(value)
+			if err != nil {
+				return nil, err
+			}
+			c.tree = h
+		case "parent":
+			h, err := parseHash(value)
+			if err != nil {
+				return nil, err
+			}
+			c.parents = append(c.parents, h)
+		case "committer":
+			c.time = parseSignatureTime(value)
+		}
+	}
+	subject, _, _ := strings.Cut(strings.TrimLeft(string(message), "\n"), "\n")
+	c.subject = strings.TrimSpace(subject)
+	return c, nil
+}
+
+// parseSignatureTime extracts the time from "Name <email> 1700000000 +0100".
+func parseSignatureTime(sig string) time.Time {
+	fields := strings.Fields(sig[strings.LastIndexByte(sig, '>')+1:])
+	if len(fields) < 1 {
+		return time.Time{}
+	}
+	secs, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(secs, 0)
+}
+
+// treeFiles flattens a tree into path -> blob id, descending into subtrees.
+// Submodule entries are included with their commit id.
+func (s *objectStore) treeFiles(h hash, prefix string, files map[string]hash) error {
+	typ, data, err := s.read(h)
+	if err != nil {
+		return err
+	}
+	if typ != objTree {
+		return fmt.Errorf("object %s is not a tree", h)
+	}
+	for len(data) > 0 {
+		sp := bytes.IndexByte(data, ' ')
+		nul := bytes.IndexByte(data, 0)
+		if sp < 0 || nul < sp || len(data) < nul+21 {
+			return errors.New("malformed tree object")
+		}
+		mode := string(data[:sp])
+		name := prefix + string(data[sp+1:nul])
+		var entry hash
+		copy(entry[:], data[nul+1:nul+21])
+		data = data[nul+21:]
+
+		if mode == "40000" {
+			if err := s.treeFiles(entry, name+"/", files); err != nil {
+				return err
+			}
+			continue
+		}
+		files[name] = entry
+	}
+	return nil
+}
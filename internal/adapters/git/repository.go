@@ -0,0 +1,261 @@
+package git
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// maxRefDepth bounds symbolic ref resolution.
+const maxRefDepth = 5
+
+// repository locates the pieces of a git repository on disk.
+type repository struct {
+	worktree  string // Top-level working directory
+	gitDir    string // Per-worktree git directory (HEAD, index)
+	commonDir string // Shared git directory (objects, refs, config)
+}
+
+// findRepository walks up from path to the nearest directory containing
+// .git (a directory, or a file pointing at a linked worktree's git dir).
+// Returns nil when path is not inside a repository.
+func findRepository(path string) (*repository, error) {
+	dir, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		dotGit := filepath.Join(dir, ".git")
+		info, err := os.Stat(dotGit)
+		if err == nil {
+			if info.IsDir() {
+				return newRepository(dir, dotGit)
+			}
+			gitDir, err := readGitFile(dotGit)
+			if err != nil {
+				return nil, err
+			}
+			return newRepository(dir, gitDir)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// readGitFile resolves a ".git" file of the form "gitdir: <path>".
+func readGitFile(file string) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	target, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return "", fmt.Errorf("malformed .git file %s", file)
+	}
+	target = strings.TrimSpace(target)
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(filepath.Dir(file), target)
+	}
+	return filepath.Clean(target), nil
+}
+
+func newRepository(worktree, gitDir string) (*repository, error) {
+	r := &repository{worktree: worktree, gitDir: gitDir, commonDir: gitDir}
+	if data, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		common := strings.TrimSpace(string(data))
+		if !filepath.IsAbs(common) {
+			common = filepath.Join(gitDir, common)
+		}
+		r.commonDir = filepath.Clean(common)
+	}
+	if _, err := os.Stat(filepath.Join(r.commonDir, "objects")); err != nil {
+		return nil, fmt.Errorf("invalid git directory %s: %w", r.commonDir, err)
+	}
+	return r, nil
+}
+
+// head reads HEAD. For a symbolic HEAD it returns the ref name
+// ("refs/heads/main"); for a detached HEAD it returns the commit id.
+func (r *repository) head() (ref string, id string, err error) {
+	data, err := os.ReadFile(filepath.Join(r.gitDir, "HEAD"))
+	if err != nil {
+		return "", "", err
+	}
+	content := strings.TrimSpace(string(data))
+	if target, ok := strings.CutPrefix(content, "ref:"); ok {
+		return strings.TrimSpace(target), "", nil
+	}
+	return "", content, nil
+}
+
+// resolveRef returns the commit id a ref points at, following symbolic refs.
+// Returns "" without error for refs that do not exist (e.g. unborn branches).
+func (r *repository) resolveRef(name string) (string, error) {
+	for depth := 0; depth < maxRefDepth; depth++ {
+		content, err := r.readLooseRef(name)
+		if err != nil {
+			return "", err
+		}
+		if content == "" {
+			return r.packedRef(name)
+		}
+		target, ok := strings.CutPrefix(content, "ref:")
+		if !ok {
+			return content, nil
+		}
+		name = strings.TrimSpace(target)
+	}
+	return "", fmt.Errorf("symbolic ref loop at %s", name)
+}
+
+// readLooseRef reads a ref file, checking the per-worktree directory first.
+func (r *repository) readLooseRef(name string) (string, error) {
+	for _, dir := range r.refDirs() {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err == nil {
+			return strings.TrimSpace(string(data)), nil
+		}
+		if !errors.Is(err, os.ErrNotExist) && !isDirError(err) {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+func (r *repository) refDirs() []string {
+	if r.gitDir == r.commonDir {
+		return []string{r.commonDir}
+	}
+	return []string{r.gitDir, r.commonDir}
+}
+
+// isDirError reports whether err came from reading a directory as a file,
+// which happens when a ref name is a prefix of other refs.
+func isDirError(err error) bool {
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		info, statErr := os.Stat(pathErr.Path)
+		return statErr == nil && info.IsDir()
+	}
+	return false
+}
+
+// packedRef looks name up in packed-refs.
+func (r *repository) packedRef(name string) (string, error) {
+	f, err := os.Open(filepath.Join(r.commonDir, "packed-refs"))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == '#' || line[0] == '^' {
+			continue
+		}
+		id, ref, ok := strings.Cut(line, " ")
+		if ok && ref == name {
+			return id, nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+// upstream returns the remote-tracking ref configured for branch, e.g.
+// ("origin/main", "refs/remotes/origin/main"). Both are empty when the
+// branch has no upstream.
+func (r *repository) upstream(branch string) (string, string) {
+	cfg := readConfig(filepath.Join(r.commonDir, "config"))
+	section := fmt.Sprintf("branch %q", branch)
+	remote, merge := cfg[section+".remote"], cfg[section+".merge"]
+	if remote == "" || merge == "" {
+		return "", ""
+	}
+	mergeBranch := strings.TrimPrefix(merge, "refs/heads/")
+	if remote == "." {
+		return mergeBranch, merge
+	}
+	return remote + "/" + mergeBranch, "refs/remotes/" + remote + "/" + mergeBranch
+}
+
+// readConfig flattens a git config file into "section.key" -> value.
+// Section names keep their subsection quoting: `branch "main".remote`.
+// Only the subset of the format needed for upstream lookup is supported.
+func readConfig(file string) map[string]string {
+	values := make(map[string]string)
+	f, err := os.Open(file)
+	if err != nil {
+		return values
+	}
+	defer f.Close()
+
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			header := strings.TrimSuffix(strings.TrimPrefix(line, "["), "]")
+			name, sub, hasSub := strings.Cut(header, " ")
+			section = strings.ToLower(name)
+			if hasSub {
+				section += " " + strings.TrimSpace(sub)
+			}
+			continue
+		}
+		key, value, _ := strings.Cut(line, "=")
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		values[section+"."+strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	return values
+}
+
+// worktrees lists linked worktrees whose directory still exists, sorted by path.
+func (r *repository) worktrees() []linkedWorktree {
+	entries, err := os.ReadDir(filepath.Join(r.commonDir, "worktrees"))
+	if err != nil {
+		return nil
+	}
+
+	var result []linkedWorktree
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		adminDir := filepath.Join(r.commonDir, "worktrees", e.Name())
+		data, err := os.ReadFile(filepath.Join(adminDir, "gitdir"))
+		if err != nil {
+			continue
+		}
+		dir := filepath.Dir(strings.TrimSpace(string(data))) // gitdir points at <worktree>/.git
+		if _, err := os.Stat(dir); err != nil {
+			continue // Prunable: worktree directory was removed
+		}
+		wt := &repository{worktree: dir, gitDir: adminDir, commonDir: r.commonDir}
+		ref, _, err := wt.head()
+		if err != nil {
+			continue
+		}
+		result = append(result, linkedWorktree{path: dir, branch: strings.TrimPrefix(ref, "refs/heads/")})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].path < result[j].path })
+	return result
+}
+
+type linkedWorktree struct {
+	path   string
+	branch string // Empty when detached
+}
@@ -0,0 +1,464 @@
+package logreaders
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+const (
+	// aiderChatHistoryFile is Aider's markdown transcript in the project root
+	aiderChatHistoryFile = ".aider.chat.history.md"
+
+	// aiderInputHistoryFile is Aider's prompt history in the project root
+	aiderInputHistoryFile = ".aider.input.history"
+
+	// aiderSessionHeader starts each chat session in the transcript
+	aiderSessionHeader = "# aider chat started at "
+
+	// aiderHeaderTimeLayout is the timestamp format of the session header
+	aiderHeaderTimeLayout = "2006-01-02 15:04:05"
+
+	// aiderInputTimeLayout is the timestamp format of input history entries
+	aiderInputTimeLayout = "2006-01-02 15:04:05.999999"
+
+	// aiderInputSessionID identifies the single session built from input history
+	aiderInputSessionID = "input-history"
+)
+
+// Entry types emitted for Aider transcripts.
+const (
+	aiderEntryUser      = "user"      // "#### " prompt lines
+	aiderEntryAssistant = "assistant" // Plain model output
+	aiderEntryTool      = "tool"      // "> " Aider command/tool output
+)
+
+// AiderReader implements LogReader for Aider chat transcripts.
+// Aider writes .aider.chat.history.md (markdown, one "# aider chat started at"
+// header per session) and .aider.input.history (timestamped prompts) into the
+// project root. Entries are converted to JSON so viewers can treat them like
+// other tools' logs.
+type AiderReader struct{}
+
+// Compile-time interface compliance check
+var _ ports.LogReader = (*AiderReader)(nil)
+
+// NewAiderReader creates a new Aider log reader.
+func NewAiderReader() *AiderReader {
+	return &AiderReader{}
+}
+
+// Tool returns the agentic tool name.
+func (r *AiderReader) Tool() string {
+	return "Aider"
+}
+
+// CanRead checks if Aider history files exist in the project root.
+func (r *AiderReader) CanRead(ctx context.Context, projectPath string) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	default:
+	}
+
+	return r.historyPath(projectPath) != ""
+}
+
+// ListSessions returns Aider sessions sorted by recency (newest first).
+// Sessions come from the chat transcript; when only the input history exists,
+// it is returned as a single session.
+func (r *AiderReader) ListSessions(ctx context.Context, projectPath string) ([]domain.LogSession, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	path := r.historyPath(projectPath)
+	if path == "" {
+		return nil, nil
+	}
+
+	parsed, err := r.parseFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat Aider history: %w", err)
+	}
+
+	sessions := make([]domain.LogSession, 0, len(parsed))
+	for _, p := range parsed {
+		if len(p.entries) == 0 {
+			continue
+		}
+		start := p.start
+		if start.IsZero() {
+			start = info.ModTime()
+		}
+		session := domain.NewLogSession(p.id, path, start, len(p.entries), 0, p.summary())
+		session.Tool = r.Tool()
+		sessions = append(sessions, session)
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].StartTime.After(sessions[j].StartTime)
+	})
+
+	return sessions, nil
+}
+
+// ReadSession reads the entries of one chat session. The history file is
+// shared by all sessions, so entries of other sessions are left out.
+func (r *AiderReader) ReadSession(ctx context.Context, session domain.LogSession) ([]domain.LogEntry, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	parsed, err := r.parseFile(session.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []domain.LogEntry
+	for _, p := range parsed {
+		if p.id == session.ID {
+			entries = append(entries, p.entries...)
+		}
+	}
+	return entries, nil
+}
+
+// TailSession streams new entries of the session as they are written. Only
+// the last session in the file grows; it stops growing once Aider starts
+// another. Caller MUST cancel ctx when done to stop the polling goroutine.
+func (r *AiderReader) TailSession(ctx context.Context, session domain.LogSession) (<-chan domain.LogEntry, error) {
+	parsed, err := r.parseFile(session.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Appended lines continue the last session until the next header
+	var current aiderSession
+	if len(parsed) > 0 {
+		last := parsed[len(parsed)-1]
+		current = aiderSession{id: last.id, start: last.start}
+	}
+	return tailFile(ctx, session.Path, func(sessionPath string, offset int64) ([]domain.LogEntry, int64, error) {
+		entries, newOffset, err := r.readNewEntries(sessionPath, offset, &current)
+		var own []domain.LogEntry
+		for _, e := range entries {
+			if e.SessionID == session.ID {
+				own = append(own, e)
+			}
+		}
+		return own, newOffset, err
+	})
+}
+
+// SearchSession returns the session's prompt, tool and assistant lines that
+// contain query. The history file is shared by all sessions, so lines are
+// attributed to sessions by the headers seen while streaming it.
+func (r *AiderReader) SearchSession(ctx context.Context, session domain.LogSession, query string, limit int) ([]domain.LogSearchHit, error) {
+	needle := strings.ToLower(strings.TrimSpace(query))
+	if needle == "" {
+		return nil, nil
+	}
+
+	file, err := os.Open(session.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+	defer file.Close()
+
+	inputHistory := filepath.Base(session.Path) == aiderInputHistoryFile
+	currentID := ""
+	if inputHistory {
+		currentID = aiderInputSessionID
+	}
+	var ts time.Time
+	var hits []domain.LogSearchHit
+
+	err = forEachLine(ctx, file, maxLineLength, func(lineNo int, offset int64, raw []byte) bool {
+		line := string(raw)
+		var entryType, text string
+		switch {
+		case inputHistory && strings.HasPrefix(line, "# "):
+			ts, _ = time.ParseInLocation(aiderInputTimeLayout, strings.TrimSpace(line[2:]), time.Local)
+			return true
+		case inputHistory && strings.HasPrefix(line, "+"):
+			entryType, text = aiderEntryUser, line[1:]
+		case inputHistory:
+			return true
+		case strings.HasPrefix(line, aiderSessionHeader):
+			ts, _ = time.ParseInLocation(aiderHeaderTimeLayout, strings.TrimSpace(strings.TrimPrefix(line, aiderSessionHeader)), time.Local)
+			currentID = ""
+			if !ts.IsZero() {
+				currentID = ts.Format("20060102-150405")
+			}
+			return true
+		case strings.HasPrefix(line, "#### "):
+			entryType, text = aiderEntryUser, strings.TrimPrefix(line, "#### ")
+		case line == ">" || strings.HasPrefix(line, "> "):
+			entryType, text = aiderEntryTool, strings.TrimPrefix(strings.TrimPrefix(line, ">"), " ")
+		default:
+			entryType, text = aiderEntryAssistant, line
+		}
+
+		if currentID != session.ID || !strings.Contains(strings.ToLower(text), needle) {
+			return true
+		}
+		hits = append(hits, domain.LogSearchHit{
+			Session:   session,
+			Line:      lineNo,
+			Offset:    offset,
+			Timestamp: ts,
+			Type:      entryType,
+			Snippet:   searchSnippet(text, needle),
+		})
+		return limit <= 0 || len(hits) < limit
+	})
+	if err != nil {
+		return hits, fmt.Errorf("error searching session: %w", err)
+	}
+	return hits, nil
+}
+
+// historyPath returns the preferred history file for the project:
+// the chat transcript, then the input history. Empty if neither exists.
+func (r *AiderReader) historyPath(projectPath string) string {
+	for _, name := range []string{aiderChatHistoryFile, aiderInputHistoryFile} {
+		path := filepath.Join(projectPath, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
+
+// parseFile parses a chat transcript or input history depending on its name.
+func (r *AiderReader) parseFile(path string) ([]aiderSession, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+	defer file.Close()
+
+	if filepath.Base(path) == aiderInputHistoryFile {
+		return parseAiderInputHistory(file)
+	}
+	return parseAiderChatHistory(file, aiderSession{})
+}
+
+// readNewEntries parses complete lines appended since offset. Lines before
+// the first header belong to current, which is advanced to the last session
+// seen. A trailing partial line is left for the next poll.
+func (r *AiderReader) readNewEntries(sessionPath string, offset int64, current *aiderSession) ([]domain.LogEntry, int64, error) {
+	file, err := os.Open(sessionPath)
+	if err != nil {
+		return nil, offset, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, offset, err
+	}
+	if info.Size() <= offset {
+		return nil, offset, nil
+	}
+
+	chunk := make([]byte, info.Size()-offset)
+	if _, err := file.ReadAt(chunk, offset); err != nil && err != io.EOF {
+		return nil, offset, err
+	}
+	end := bytes.LastIndexByte(chunk, '\n')
+	if end < 0 {
+		return nil, offset, nil
+	}
+	chunk = chunk[:end+1]
+
+	var parsed []aiderSession
+	if filepath.Base(sessionPath) == aiderInputHistoryFile {
+		parsed, err = parseAiderInputHistory(bytes.NewReader(chunk))
+	} else {
+		parsed, err = parseAiderChatHistory(bytes.NewReader(chunk), *current)
+	}
+	if err != nil {
+		return nil, offset, err
+	}
+	if len(parsed) > 0 {
+		last := parsed[len(parsed)-1]
+		*current = aiderSession{id: last.id, start: last.start}
+	}
+
+	var entries []domain.LogEntry
+	for _, p := range parsed {
+		entries = append(entries, p.entries...)
+	}
+	return entries, offset + int64(len(chunk)), nil
+}
+
+// aiderSession is one parsed chat session.
+type aiderSession struct {
+	id      string
+	start   time.Time
+	entries []domain.LogEntry
+}
+
+// summary returns the first user prompt of the session.
+func (s aiderSession) summary() string {
+	for _, e := range s.entries {
+		if e.Type != aiderEntryUser {
+			continue
+		}
+		var raw aiderEntryJSON
+		if json.Unmarshal(e.RawJSON, &raw) == nil {
+			return truncateSummary(raw.Content)
+		}
+	}
+	return ""
+}
+
+// aiderEntryJSON is the synthesized JSON form of an Aider entry.
+type aiderEntryJSON struct {
+	Type      string `json:"type"`
+	Content   string `json:"content"`
+	Timestamp string `json:"timestamp,omitempty"`
+	SessionID string `json:"sessionId,omitempty"`
+}
+
+// newAiderEntry builds a LogEntry with synthesized RawJSON.
+func newAiderEntry(ts time.Time, entryType, content, sessionID string) domain.LogEntry {
+	raw := aiderEntryJSON{Type: entryType, Content: content, SessionID: sessionID}
+	if !ts.IsZero() {
+		raw.Timestamp = ts.Format(time.RFC3339)
+	}
+	data, _ := json.Marshal(raw)
+	return domain.NewLogEntry(ts, entryType, data, sessionID)
+}
+
+// parseAiderChatHistory splits a markdown transcript into sessions.
+// Content before the first header (e.g., a tail chunk) continues prev, whose
+// ID and start time it takes. Aider records no per-message timestamps, so
+// entries use the session start time.
+func parseAiderChatHistory(r io.Reader, prev aiderSession) ([]aiderSession, error) {
+	var sessions []aiderSession
+	var current *aiderSession
+	var kind string
+	var block []string
+
+	flush := func() {
+		content := strings.TrimSpace(strings.Join(block, "\n"))
+		if kind != "" && content != "" {
+			if current == nil {
+				sessions = append(sessions, aiderSession{id: prev.id, start: prev.start})
+				current = &sessions[len(sessions)-1]
+			}
+			current.entries = append(current.entries, newAiderEntry(current.start, kind, content, current.id))
+		}
+		kind = ""
+		block = nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineLength)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		var lineKind, text string
+		switch {
+		case strings.HasPrefix(line, aiderSessionHeader):
+			flush()
+			start, _ := time.ParseInLocation(aiderHeaderTimeLayout, strings.TrimSpace(strings.TrimPrefix(line, aiderSessionHeader)), time.Local)
+			id := ""
+			if !start.IsZero() {
+				id = start.Format("20060102-150405")
+			}
+			sessions = append(sessions, aiderSession{id: id, start: start})
+			current = &sessions[len(sessions)-1]
+			continue
+		case strings.HasPrefix(line, "#### "):
+			lineKind, text = aiderEntryUser, strings.TrimPrefix(line, "#### ")
+		case line == ">" || strings.HasPrefix(line, "> "):
+			lineKind, text = aiderEntryTool, strings.TrimPrefix(strings.TrimPrefix(line, ">"), " ")
+		case strings.TrimSpace(line) == "":
+			// Blank lines end prompt/tool blocks but are part of assistant prose
+			if kind == aiderEntryAssistant {
+				block = append(block, "")
+			} else {
+				flush()
+			}
+			continue
+		default:
+			lineKind, text = aiderEntryAssistant, line
+		}
+
+		if lineKind != kind {
+			flush()
+			kind = lineKind
+		}
+		block = append(block, text)
+	}
+	flush()
+
+	if err := scanner.Err(); err != nil {
+		return sessions, fmt.Errorf("error reading session: %w", err)
+	}
+	return sessions, nil
+}
+
+// parseAiderInputHistory reads the prompt history as a single session.
+// Format: "# <timestamp>" followed by one "+<line>" per prompt line.
+func parseAiderInputHistory(r io.Reader) ([]aiderSession, error) {
+	session := aiderSession{id: aiderInputSessionID}
+	var ts time.Time
+	var block []string
+
+	flush := func() {
+		content := strings.TrimSpace(strings.Join(block, "\n"))
+		if content != "" {
+			if session.start.IsZero() {
+				session.start = ts
+			}
+			session.entries = append(session.entries, newAiderEntry(ts, aiderEntryUser, content, session.id))
+		}
+		block = nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineLength)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "# "):
+			flush()
+			ts, _ = time.ParseInLocation(aiderInputTimeLayout, strings.TrimSpace(line[2:]), time.Local)
+		case strings.HasPrefix(line, "+"):
+			block = append(block, line[1:])
+		}
+	}
+	flush()
+
+	if err := scanner.Err(); err != nil {
+		return []aiderSession{session}, fmt.Errorf("error reading session: %w", err)
+	}
+	return []aiderSession{session}, nil
+}
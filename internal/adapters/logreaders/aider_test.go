@@ -0,0 +1,209 @@
+package logreaders
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func aiderFixtureDir(t *testing.T) string {
+	t.Helper()
+	return filepath.Join(fixturesDir(t), "aider-logs")
+}
+
+func TestAiderReader_Tool(t *testing.T) {
+	if got := NewAiderReader().Tool(); got != "Aider" {
+		t.Errorf("Tool() = %q, want %q", got, "Aider")
+	}
+}
+
+func TestAiderReader_CanRead(t *testing.T) {
+	reader := NewAiderReader()
+	ctx := context.Background()
+
+	if !reader.CanRead(ctx, aiderFixtureDir(t)) {
+		t.Error("CanRead() should return true for project with Aider history")
+	}
+	if reader.CanRead(ctx, t.TempDir()) {
+		t.Error("CanRead() should return false for project without Aider history")
+	}
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if reader.CanRead(cancelledCtx, aiderFixtureDir(t)) {
+		t.Error("CanRead() should return false when context is cancelled")
+	}
+}
+
+func TestAiderReader_ListSessions_ChatHistory(t *testing.T) {
+	reader := NewAiderReader()
+	dir := aiderFixtureDir(t)
+
+	sessions, err := reader.ListSessions(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("ListSessions() returned %d sessions, want 2", len(sessions))
+	}
+
+	// Newest first
+	newest, oldest := sessions[0], sessions[1]
+	wantNewest := time.Date(2026, 1, 12, 14, 30, 0, 0, time.Local)
+	if !newest.StartTime.Equal(wantNewest) {
+		t.Errorf("newest StartTime = %v, want %v", newest.StartTime, wantNewest)
+	}
+	if newest.ID != "20260112-143000" {
+		t.Errorf("newest ID = %q, want %q", newest.ID, "20260112-143000")
+	}
+	if newest.Summary != "Write a test for the version handler Use httptest" {
+		t.Errorf("newest Summary = %q", newest.Summary)
+	}
+	if oldest.Summary != "Add a /version endpoint" {
+		t.Errorf("oldest Summary = %q", oldest.Summary)
+	}
+	// tool, user, assistant, tool
+	if oldest.EntryCount != 4 {
+		t.Errorf("oldest EntryCount = %d, want 4", oldest.EntryCount)
+	}
+	for _, s := range sessions {
+		if s.Tool != "Aider" {
+			t.Errorf("Tool = %q, want %q", s.Tool, "Aider")
+		}
+		if s.Path != filepath.Join(dir, aiderChatHistoryFile) {
+			t.Errorf("Path = %q", s.Path)
+		}
+	}
+}
+
+func TestAiderReader_ListSessions_InputHistoryOnly(t *testing.T) {
+	dir := t.TempDir()
+	data, err := os.ReadFile(filepath.Join(aiderFixtureDir(t), aiderInputHistoryFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, aiderInputHistoryFile), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sessions, err := NewAiderReader().ListSessions(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("ListSessions() returned %d sessions, want 1", len(sessions))
+	}
+	if sessions[0].ID != aiderInputSessionID {
+		t.Errorf("ID = %q, want %q", sessions[0].ID, aiderInputSessionID)
+	}
+	if sessions[0].EntryCount != 2 {
+		t.Errorf("EntryCount = %d, want 2", sessions[0].EntryCount)
+	}
+	wantStart := time.Date(2026, 1, 10, 9, 0, 5, 123456000, time.Local)
+	if !sessions[0].StartTime.Equal(wantStart) {
+		t.Errorf("StartTime = %v, want %v", sessions[0].StartTime, wantStart)
+	}
+}
+
+func TestAiderReader_ReadSession(t *testing.T) {
+	reader := NewAiderReader()
+	sessions, err := reader.ListSessions(context.Background(), aiderFixtureDir(t))
+	if err != nil || len(sessions) != 2 {
+		t.Fatalf("ListSessions() = %d sessions, %v; want 2", len(sessions), err)
+	}
+	newest, oldest := sessions[0], sessions[1]
+
+	// Sessions share the history file but read back only their own entries
+	entries, err := reader.ReadSession(context.Background(), oldest)
+	if err != nil {
+		t.Fatalf("ReadSession() error = %v", err)
+	}
+	wantTypes := []string{"tool", "user", "assistant", "tool"}
+	if len(entries) != len(wantTypes) {
+		t.Fatalf("ReadSession() returned %d entries, want %d", len(entries), len(wantTypes))
+	}
+	for i, want := range wantTypes {
+		if entries[i].Type != want {
+			t.Errorf("entries[%d].Type = %q, want %q", i, entries[i].Type, want)
+		}
+		if entries[i].SessionID != oldest.ID {
+			t.Errorf("entries[%d].SessionID = %q, want %q", i, entries[i].SessionID, oldest.ID)
+		}
+	}
+
+	// Assistant prose keeps its blank lines and code fences
+	var raw aiderEntryJSON
+	if err := json.Unmarshal(entries[2].RawJSON, &raw); err != nil {
+		t.Fatalf("RawJSON is not valid JSON: %v", err)
+	}
+	if !strings.Contains(raw.Content, "```go") || !strings.HasPrefix(raw.Content, "I'll add a handler") {
+		t.Errorf("assistant content = %q", raw.Content)
+	}
+
+	newer, err := reader.ReadSession(context.Background(), newest)
+	if err != nil {
+		t.Fatalf("ReadSession() error = %v", err)
+	}
+	if len(newer) != 4 {
+		t.Fatalf("ReadSession() returned %d entries for the newest session, want 4", len(newer))
+	}
+	if err := json.Unmarshal(newer[1].RawJSON, &raw); err != nil {
+		t.Fatalf("RawJSON is not valid JSON: %v", err)
+	}
+	if raw.Content != "Write a test for the version handler\nUse httptest" || newer[1].SessionID != newest.ID {
+		t.Errorf("newest session prompt = %q (session %q)", raw.Content, newer[1].SessionID)
+	}
+
+	missing := domain.LogSession{ID: "20260110-090000", Path: "/nonexistent/.aider.chat.history.md"}
+	if _, err := reader.ReadSession(context.Background(), missing); err == nil {
+		t.Error("ReadSession() should return error for non-existent file")
+	}
+}
+
+func TestAiderReader_ReadNewEntries(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, aiderChatHistoryFile)
+	initial := "\n# aider chat started at 2026-01-12 14:30:00\n\n"
+	if err := os.WriteFile(path, []byte(initial), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	reader := NewAiderReader()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Trailing partial line must wait for the next poll
+	_, _ = f.WriteString("#### rename the handler\n\nDone.\n> Applied edit")
+	f.Close()
+
+	current := aiderSession{id: "20260112-143000"}
+	entries, offset, err := reader.readNewEntries(path, int64(len(initial)), &current)
+	if err != nil {
+		t.Fatalf("readNewEntries() error = %v", err)
+	}
+	if len(entries) != 2 || entries[0].Type != "user" || entries[1].Type != "assistant" {
+		t.Fatalf("readNewEntries() = %+v, want user and assistant entries", entries)
+	}
+	// Lines without a header continue the session being tailed
+	if entries[0].SessionID != "20260112-143000" {
+		t.Errorf("SessionID = %q, want the tailed session", entries[0].SessionID)
+	}
+	wantOffset := int64(len(initial) + len("#### rename the handler\n\nDone.\n"))
+	if offset != wantOffset {
+		t.Errorf("offset = %d, want %d", offset, wantOffset)
+	}
+}
+
+func TestAiderReader_TailSession_MissingFile(t *testing.T) {
+	missing := domain.LogSession{Path: "/nonexistent/.aider.chat.history.md"}
+	if _, err := NewAiderReader().TailSession(context.Background(), missing); err == nil {
+		t.Error("TailSession() should return error for non-existent file")
+	}
+}
@@ -97,6 +97,7 @@ func (r *ClaudeCodeReader) ListSessions(ctx context.Context, projectPath string)
 			// Skip sessions we can't read, but continue with others
 			continue
 		}
+		session.Tool = r.Tool()
 		sessions = append(sessions, session)
 	}
 
@@ -109,14 +110,14 @@ func (r *ClaudeCodeReader) ListSessions(ctx context.Context, projectPath string)
 }
 
 // ReadSession reads all entries from a session file.
-func (r *ClaudeCodeReader) ReadSession(ctx context.Context, sessionPath string) ([]domain.LogEntry, error) {
+func (r *ClaudeCodeReader) ReadSession(ctx context.Context, session domain.LogSession) ([]domain.LogEntry, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	default:
 	}
 
-	file, err := os.Open(sessionPath)
+	file, err := os.Open(session.Path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open session file: %w", err)
 	}
@@ -156,45 +157,13 @@ func (r *ClaudeCodeReader) ReadSession(ctx context.Context, sessionPath string)
 
 // TailSession streams new log entries as they are written.
 // Caller MUST cancel ctx when done to stop the polling goroutine.
-func (r *ClaudeCodeReader) TailSession(ctx context.Context, sessionPath string) (<-chan domain.LogEntry, error) {
-	// Verify file exists initially
-	info, err := os.Stat(sessionPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to access session file: %w", err)
-	}
-
-	ch := make(chan domain.LogEntry, 100)
-	lastOffset := info.Size()
-
-	go func() {
-		defer close(ch)
-		ticker := time.NewTicker(tailPollInterval)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				newEntries, newOffset, err := r.readNewEntries(sessionPath, lastOffset)
-				if err != nil {
-					// On error, continue trying
-					continue
-				}
-				lastOffset = newOffset
-
-				for _, entry := range newEntries {
-					select {
-					case <-ctx.Done():
-						return
-					case ch <- entry:
-					}
-				}
-			}
-		}
-	}()
+func (r *ClaudeCodeReader) TailSession(ctx context.Context, session domain.LogSession) (<-chan domain.LogEntry, error) {
+	return tailFile(ctx, session.Path, r.readNewEntries)
+}
 
-	return ch, nil
+// SearchSession returns the session's entries whose text contains query.
+func (r *ClaudeCodeReader) SearchSession(ctx context.Context, session domain.LogSession, query string, limit int) ([]domain.LogSearchHit, error) {
+	return searchJSONL(ctx, session, query, limit, maxLineLength, r.parseLogEntry)
 }
 
 // pathToClaudeDir converts a project path to the Claude logs directory.
@@ -323,45 +292,7 @@ func (r *ClaudeCodeReader) parseLogEntry(line []byte) (domain.LogEntry, error) {
 
 // readNewEntries reads entries added since the given offset.
 func (r *ClaudeCodeReader) readNewEntries(sessionPath string, offset int64) ([]domain.LogEntry, int64, error) {
-	file, err := os.Open(sessionPath)
-	if err != nil {
-		return nil, offset, err
-	}
-	defer file.Close()
-
-	info, err := file.Stat()
-	if err != nil {
-		return nil, offset, err
-	}
-
-	// No new data
-	if info.Size() <= offset {
-		return nil, offset, nil
-	}
-
-	// Seek to last known position
-	if _, err := file.Seek(offset, 0); err != nil {
-		return nil, offset, err
-	}
-
-	var entries []domain.LogEntry
-	scanner := bufio.NewScanner(file)
-	scanner.Buffer(make([]byte, 64*1024), maxLineLength)
-
-	for scanner.Scan() {
-		line := scanner.Bytes()
-		if len(line) == 0 {
-			continue
-		}
-
-		entry, err := r.parseLogEntry(line)
-		if err != nil {
-			continue
-		}
-		entries = append(entries, entry)
-	}
-
-	return entries, info.Size(), scanner.Err()
+	return readNewLines(sessionPath, offset, r.parseLogEntry)
 }
 
 // PathToClaudeDir is exported for testing purposes.
@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 )
 
 func TestNewClaudeCodeReader(t *testing.T) {
@@ -109,7 +111,7 @@ func TestClaudeCodeReader_ReadSession(t *testing.T) {
 	ctx := context.Background()
 
 	// Test with non-existent file
-	_, err := reader.ReadSession(ctx, "/nonexistent/session.jsonl")
+	_, err := reader.ReadSession(ctx, domain.LogSession{Path: "/nonexistent/session.jsonl"})
 	if err == nil {
 		t.Error("ReadSession() should return error for non-existent file")
 	}
@@ -156,7 +158,7 @@ func TestClaudeCodeReader_ReadSession_ValidJSONL(t *testing.T) {
 	reader := NewClaudeCodeReader()
 	ctx := context.Background()
 
-	result, err := reader.ReadSession(ctx, sessionPath)
+	result, err := reader.ReadSession(ctx, domain.LogSession{Path: sessionPath})
 	if err != nil {
 		t.Fatalf("ReadSession() error = %v", err)
 	}
@@ -192,7 +194,7 @@ not valid json
 	reader := NewClaudeCodeReader()
 	ctx := context.Background()
 
-	result, err := reader.ReadSession(ctx, sessionPath)
+	result, err := reader.ReadSession(ctx, domain.LogSession{Path: sessionPath})
 	if err != nil {
 		t.Fatalf("ReadSession() error = %v", err)
 	}
@@ -208,7 +210,7 @@ func TestClaudeCodeReader_TailSession(t *testing.T) {
 	ctx := context.Background()
 
 	// Test with non-existent file
-	_, err := reader.TailSession(ctx, "/nonexistent/session.jsonl")
+	_, err := reader.TailSession(ctx, domain.LogSession{Path: "/nonexistent/session.jsonl"})
 	if err == nil {
 		t.Error("TailSession() should return error for non-existent file")
 	}
@@ -228,7 +230,7 @@ func TestClaudeCodeReader_TailSession_ReceivesNewEntries(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	ch, err := reader.TailSession(ctx, sessionPath)
+	ch, err := reader.TailSession(ctx, domain.LogSession{Path: sessionPath})
 	if err != nil {
 		t.Fatalf("TailSession() error = %v", err)
 	}
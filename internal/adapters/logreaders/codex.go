@@ -0,0 +1,264 @@
+package logreaders
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/agentdetectors"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+const (
+	// summaryMaxLen caps session summaries taken from the first prompt.
+	summaryMaxLen = 80
+
+	// codexMaxLineLength allows for session_meta lines, which embed the full instructions text.
+	codexMaxLineLength = 4 * maxLineLength
+)
+
+// CodexReader implements LogReader for OpenAI Codex CLI rollout files.
+// Sessions live under ~/.codex/sessions/YYYY/MM/DD/rollout-*.jsonl and are
+// mapped to projects by the cwd recorded in the session header.
+type CodexReader struct {
+	finder *agentdetectors.CodexSessionFinder
+}
+
+// Compile-time interface compliance check
+var _ ports.LogReader = (*CodexReader)(nil)
+
+// CodexReaderOption is a functional option for configuring CodexReader.
+type CodexReaderOption func(*CodexReader)
+
+// WithCodexFinder sets a custom session finder (for testing).
+func WithCodexFinder(f *agentdetectors.CodexSessionFinder) CodexReaderOption {
+	return func(r *CodexReader) {
+		r.finder = f
+	}
+}
+
+// NewCodexReader creates a new Codex CLI log reader.
+func NewCodexReader(opts ...CodexReaderOption) *CodexReader {
+	r := &CodexReader{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.finder == nil {
+		r.finder = agentdetectors.NewCodexSessionFinder()
+	}
+	return r
+}
+
+// Tool returns the agentic tool name.
+func (r *CodexReader) Tool() string {
+	return "Codex"
+}
+
+// CanRead checks if Codex has at least one session for this project.
+func (r *CodexReader) CanRead(ctx context.Context, projectPath string) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	default:
+	}
+
+	path, err := r.finder.FindMostRecentSession(ctx, projectPath)
+	return err == nil && path != ""
+}
+
+// ListSessions returns Codex sessions for the project sorted by recency (newest first).
+func (r *CodexReader) ListSessions(ctx context.Context, projectPath string) ([]domain.LogSession, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	files, err := r.finder.ListSessions(ctx, projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Codex sessions: %w", err)
+	}
+
+	sessions := make([]domain.LogSession, 0, len(files))
+	for _, f := range files {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		session, err := r.extractSessionMetadata(f)
+		if err != nil {
+			// Skip sessions we can't read, but continue with others
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// ReadSession reads all entries from a rollout file.
+func (r *CodexReader) ReadSession(ctx context.Context, session domain.LogSession) ([]domain.LogEntry, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	file, err := os.Open(session.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+	defer file.Close()
+
+	var entries []domain.LogEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), codexMaxLineLength)
+
+	for scanner.Scan() {
+		select {
+		case <-ctx.Done():
+			return entries, ctx.Err()
+		default:
+		}
+
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		entry, err := r.parseLogEntry(line)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return entries, fmt.Errorf("error reading session: %w", err)
+	}
+
+	return entries, nil
+}
+
+// TailSession streams new rollout entries as they are written.
+// Caller MUST cancel ctx when done to stop the polling goroutine.
+func (r *CodexReader) TailSession(ctx context.Context, session domain.LogSession) (<-chan domain.LogEntry, error) {
+	return tailFile(ctx, session.Path, r.readNewEntries)
+}
+
+// SearchSession returns the session's entries whose text contains query.
+func (r *CodexReader) SearchSession(ctx context.Context, session domain.LogSession, query string, limit int) ([]domain.LogSearchHit, error) {
+	return searchJSONL(ctx, session, query, limit, codexMaxLineLength, r.parseLogEntry)
+}
+
+// extractSessionMetadata counts entries and picks the start time and first prompt.
+func (r *CodexReader) extractSessionMetadata(f agentdetectors.CodexSessionFile) (domain.LogSession, error) {
+	file, err := os.Open(f.Path)
+	if err != nil {
+		return domain.LogSession{}, err
+	}
+	defer file.Close()
+
+	var entryCount, skippedCount int
+	var summary string
+	startTime := f.ModTime
+	foundStart := false
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), codexMaxLineLength)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		entry, err := agentdetectors.ParseCodexLine(line)
+		if err != nil {
+			skippedCount++
+			continue
+		}
+		entryCount++
+
+		if !foundStart && !entry.Timestamp.IsZero() {
+			startTime = entry.Timestamp
+			foundStart = true
+		}
+		if summary == "" && entry.Kind == "user_message" {
+			summary = codexUserMessage(line)
+		}
+	}
+
+	session := domain.NewLogSession(f.ID, f.Path, startTime, entryCount, skippedCount, summary)
+	session.Tool = r.Tool()
+	return session, nil
+}
+
+// parseLogEntry converts a rollout line into a LogEntry.
+// Message items use the role as type ("user", "assistant"); other items use
+// their payload type (e.g., "function_call", "task_complete").
+func (r *CodexReader) parseLogEntry(line []byte) (domain.LogEntry, error) {
+	entry, err := agentdetectors.ParseCodexLine(line)
+	if err != nil {
+		return domain.LogEntry{}, err
+	}
+
+	entryType := entry.Kind
+	if entryType == "message" && entry.Role != "" {
+		entryType = entry.Role
+	}
+
+	// Copy: the scanner reuses its buffer between lines
+	rawJSON := make(json.RawMessage, len(line))
+	copy(rawJSON, line)
+
+	return domain.NewLogEntry(entry.Timestamp, entryType, rawJSON, codexSessionID(line)), nil
+}
+
+// readNewEntries reads entries added since the given offset.
+func (r *CodexReader) readNewEntries(sessionPath string, offset int64) ([]domain.LogEntry, int64, error) {
+	return readNewLines(sessionPath, offset, r.parseLogEntry)
+}
+
+// codexSessionID returns the session id carried by a session_meta line, if any.
+func codexSessionID(line []byte) string {
+	var raw struct {
+		Type    string `json:"type"`
+		Payload struct {
+			ID string `json:"id"`
+		} `json:"payload"`
+	}
+	if json.Unmarshal(line, &raw) != nil || raw.Type != "session_meta" {
+		return ""
+	}
+	return raw.Payload.ID
+}
+
+// codexUserMessage extracts the prompt text from a user_message event.
+func codexUserMessage(line []byte) string {
+	var raw struct {
+		Payload struct {
+			Message string `json:"message"`
+		} `json:"payload"`
+	}
+	if json.Unmarshal(line, &raw) != nil {
+		return ""
+	}
+	return truncateSummary(raw.Payload.Message)
+}
+
+// truncateSummary collapses a prompt to one line of at most summaryMaxLen runes.
+func truncateSummary(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	runes := []rune(s)
+	if len(runes) > summaryMaxLen {
+		return string(runes[:summaryMaxLen-3]) + "..."
+	}
+	return s
+}
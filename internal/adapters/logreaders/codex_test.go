@@ -0,0 +1,185 @@
+package logreaders
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/agentdetectors"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+const codexFixtureFile = "rollout-2026-01-12T08-00-00-0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b.jsonl"
+
+// fixturesDir returns the repository's test/fixtures directory.
+func fixturesDir(t *testing.T) string {
+	t.Helper()
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("failed to get caller info")
+	}
+	return filepath.Join(filepath.Dir(filename), "..", "..", "..", "test", "fixtures")
+}
+
+// newFixtureCodexReader copies the Codex fixture into a temp sessions dir
+// (legacy flat layout) and returns a reader rooted there.
+func newFixtureCodexReader(t *testing.T) (*CodexReader, string) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(fixturesDir(t), "codex-logs", codexFixtureFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessionsDir := t.TempDir()
+	sessionPath := filepath.Join(sessionsDir, codexFixtureFile)
+	if err := os.WriteFile(sessionPath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	finder := agentdetectors.NewCodexSessionFinder(agentdetectors.WithCodexSessionsDir(sessionsDir))
+	return NewCodexReader(WithCodexFinder(finder)), sessionPath
+}
+
+func TestCodexReader_Tool(t *testing.T) {
+	if got := NewCodexReader().Tool(); got != "Codex" {
+		t.Errorf("Tool() = %q, want %q", got, "Codex")
+	}
+}
+
+func TestCodexReader_CanRead(t *testing.T) {
+	reader, _ := newFixtureCodexReader(t)
+	ctx := context.Background()
+
+	if !reader.CanRead(ctx, "/test/codex-project") {
+		t.Error("CanRead() should return true for project with Codex sessions")
+	}
+	if reader.CanRead(ctx, "/test/other-project") {
+		t.Error("CanRead() should return false for project without Codex sessions")
+	}
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if reader.CanRead(cancelledCtx, "/test/codex-project") {
+		t.Error("CanRead() should return false when context is cancelled")
+	}
+}
+
+func TestCodexReader_ListSessions(t *testing.T) {
+	reader, sessionPath := newFixtureCodexReader(t)
+
+	sessions, err := reader.ListSessions(context.Background(), "/test/codex-project")
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("ListSessions() returned %d sessions, want 1", len(sessions))
+	}
+
+	s := sessions[0]
+	if s.ID != "0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b" {
+		t.Errorf("ID = %q", s.ID)
+	}
+	if s.Path != sessionPath {
+		t.Errorf("Path = %q, want %q", s.Path, sessionPath)
+	}
+	if s.Tool != "Codex" {
+		t.Errorf("Tool = %q, want %q", s.Tool, "Codex")
+	}
+	if s.EntryCount != 12 {
+		t.Errorf("EntryCount = %d, want 12", s.EntryCount)
+	}
+	if s.Summary != "Add a health check endpoint" {
+		t.Errorf("Summary = %q", s.Summary)
+	}
+	wantStart, _ := time.Parse(time.RFC3339, "2026-01-12T08:00:00Z")
+	if !s.StartTime.Equal(wantStart) {
+		t.Errorf("StartTime = %v, want %v", s.StartTime, wantStart)
+	}
+}
+
+func TestCodexReader_ReadSession(t *testing.T) {
+	reader, sessionPath := newFixtureCodexReader(t)
+
+	entries, err := reader.ReadSession(context.Background(), domain.LogSession{Path: sessionPath})
+	if err != nil {
+		t.Fatalf("ReadSession() error = %v", err)
+	}
+	if len(entries) != 12 {
+		t.Fatalf("ReadSession() returned %d entries, want 12", len(entries))
+	}
+
+	wantTypes := map[int]string{
+		0:  "session_meta",
+		1:  "user",
+		5:  "reasoning",
+		6:  "function_call",
+		8:  "assistant",
+		11: "task_complete",
+	}
+	for i, want := range wantTypes {
+		if entries[i].Type != want {
+			t.Errorf("entries[%d].Type = %q, want %q", i, entries[i].Type, want)
+		}
+	}
+	if entries[0].SessionID != "0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b" {
+		t.Errorf("session_meta SessionID = %q", entries[0].SessionID)
+	}
+
+	if _, err := reader.ReadSession(context.Background(), domain.LogSession{Path: "/nonexistent/rollout.jsonl"}); err == nil {
+		t.Error("ReadSession() should return error for non-existent file")
+	}
+}
+
+func TestCodexReader_ReadNewEntries(t *testing.T) {
+	tmpDir := t.TempDir()
+	sessionPath := filepath.Join(tmpDir, "rollout-tail.jsonl")
+	initial := `{"timestamp":"2026-01-12T08:00:00.000Z","type":"event_msg","payload":{"type":"task_started"}}` + "\n"
+	if err := os.WriteFile(sessionPath, []byte(initial), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	reader := NewCodexReader()
+	f, err := os.OpenFile(sessionPath, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`{"timestamp":"2026-01-12T08:00:05.000Z","type":"event_msg","payload":{"type":"task_complete"}}` + "\n")
+	f.Close()
+
+	entries, offset, err := reader.readNewEntries(sessionPath, int64(len(initial)))
+	if err != nil {
+		t.Fatalf("readNewEntries() error = %v", err)
+	}
+	if len(entries) != 1 || entries[0].Type != "task_complete" {
+		t.Fatalf("readNewEntries() = %+v, want one task_complete entry", entries)
+	}
+	info, _ := os.Stat(sessionPath)
+	if offset != info.Size() {
+		t.Errorf("offset = %d, want %d", offset, info.Size())
+	}
+}
+
+func TestTruncateSummary(t *testing.T) {
+	long := ""
+	for i := 0; i < 100; i++ {
+		long += "가"
+	}
+	tests := []struct {
+		name string
+		in   string
+		want int // rune count
+	}{
+		{"short", "hello", 5},
+		{"multiline collapsed", "fix\nthe  bug", len("fix the bug")},
+		{"long multibyte", long, summaryMaxLen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateSummary(tt.in)
+			if n := len([]rune(got)); n != tt.want {
+				t.Errorf("truncateSummary() = %q (%d runes), want %d runes", got, n, tt.want)
+			}
+		})
+	}
+}
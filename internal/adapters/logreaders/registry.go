@@ -1,6 +1,6 @@
 // Package logreaders provides log reading implementations for agentic tools.
 // It contains the Registry that coordinates all registered log readers and
-// individual reader packages (e.g., claude_code).
+// individual readers (Claude Code, Codex, Aider).
 //
 // Thread Safety: Registry is NOT safe for concurrent modification.
 // All Register() calls must complete before any GetReader() calls.
@@ -10,7 +10,9 @@ package logreaders
 
 import (
 	"context"
+	"sort"
 
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
 )
 
@@ -59,3 +61,63 @@ func (r *Registry) GetReader(ctx context.Context, projectPath string) ports.LogR
 	}
 	return nil
 }
+
+// GetReaders returns all readers where CanRead() returns true, in registration order.
+// Returns nil if no reader can handle this project.
+func (r *Registry) GetReaders(ctx context.Context, projectPath string) []ports.LogReader {
+	var readers []ports.LogReader
+	for _, reader := range r.readers {
+		select {
+		case <-ctx.Done():
+			return readers
+		default:
+		}
+
+		if reader.CanRead(ctx, projectPath) {
+			readers = append(readers, reader)
+		}
+	}
+	return readers
+}
+
+// Search searches all sessions of every reader that can read the project,
+// newest session first, until limit hits are found (0 = no limit).
+// Readers or sessions that fail to list or read are skipped.
+func (r *Registry) Search(ctx context.Context, projectPath, query string, limit int) ([]domain.LogSearchHit, error) {
+	var sessions []domain.LogSession
+	readers := make(map[string]ports.LogReader)
+	for _, reader := range r.GetReaders(ctx, projectPath) {
+		list, err := reader.ListSessions(ctx, projectPath)
+		if err != nil {
+			continue
+		}
+		readers[reader.Tool()] = reader
+		for _, session := range list {
+			session.Tool = reader.Tool()
+			sessions = append(sessions, session)
+		}
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].StartTime.After(sessions[j].StartTime)
+	})
+
+	var hits []domain.LogSearchHit
+	for _, session := range sessions {
+		if err := ctx.Err(); err != nil {
+			return hits, err
+		}
+		remaining := 0
+		if limit > 0 {
+			remaining = limit - len(hits)
+		}
+		found, err := readers[session.Tool].SearchSession(ctx, session, query, remaining)
+		if err != nil {
+			continue
+		}
+		hits = append(hits, found...)
+		if limit > 0 && len(hits) >= limit {
+			break
+		}
+	}
+	return hits, nil
+}
@@ -3,6 +3,7 @@ package logreaders
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
@@ -10,8 +11,10 @@ import (
 
 // mockLogReader implements ports.LogReader for testing
 type mockLogReader struct {
-	name    string
-	canRead bool
+	name     string
+	canRead  bool
+	sessions []domain.LogSession
+	hits     map[string][]domain.LogSearchHit // Session ID -> hits
 }
 
 var _ ports.LogReader = (*mockLogReader)(nil)
@@ -25,17 +28,25 @@ func (m *mockLogReader) CanRead(_ context.Context, _ string) bool {
 }
 
 func (m *mockLogReader) ListSessions(_ context.Context, _ string) ([]domain.LogSession, error) {
-	return nil, nil
+	return m.sessions, nil
 }
 
-func (m *mockLogReader) ReadSession(_ context.Context, _ string) ([]domain.LogEntry, error) {
+func (m *mockLogReader) ReadSession(_ context.Context, _ domain.LogSession) ([]domain.LogEntry, error) {
 	return nil, nil
 }
 
-func (m *mockLogReader) TailSession(_ context.Context, _ string) (<-chan domain.LogEntry, error) {
+func (m *mockLogReader) TailSession(_ context.Context, _ domain.LogSession) (<-chan domain.LogEntry, error) {
 	return nil, nil
 }
 
+func (m *mockLogReader) SearchSession(_ context.Context, session domain.LogSession, _ string, limit int) ([]domain.LogSearchHit, error) {
+	hits := m.hits[session.ID]
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
 func TestNewRegistry(t *testing.T) {
 	registry := NewRegistry()
 	if registry == nil {
@@ -143,3 +154,116 @@ func TestRegistryGetReaderContextCancellation(t *testing.T) {
 		t.Error("expected nil when context is cancelled")
 	}
 }
+
+func TestRegistryGetReaders(t *testing.T) {
+	tests := []struct {
+		name        string
+		readers     []*mockLogReader
+		wantReaders []string
+	}{
+		{
+			name:        "no readers registered",
+			readers:     nil,
+			wantReaders: nil,
+		},
+		{
+			name: "all matching readers returned in order",
+			readers: []*mockLogReader{
+				{name: "reader1", canRead: true},
+				{name: "reader2", canRead: false},
+				{name: "reader3", canRead: true},
+			},
+			wantReaders: []string{"reader1", "reader3"},
+		},
+		{
+			name: "no matching reader",
+			readers: []*mockLogReader{
+				{name: "reader1", canRead: false},
+			},
+			wantReaders: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			for _, r := range tt.readers {
+				registry.Register(r)
+			}
+
+			readers := registry.GetReaders(context.Background(), "/some/project")
+
+			if len(readers) != len(tt.wantReaders) {
+				t.Fatalf("expected %d readers, got %d", len(tt.wantReaders), len(readers))
+			}
+			for i, want := range tt.wantReaders {
+				if readers[i].Tool() != want {
+					t.Errorf("readers[%d] = %s, want %s", i, readers[i].Tool(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistryGetReadersContextCancellation(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&mockLogReader{name: "reader1", canRead: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if readers := registry.GetReaders(ctx, "/some/project"); len(readers) != 0 {
+		t.Errorf("expected no readers when context is cancelled, got %d", len(readers))
+	}
+}
+
+func TestRegistrySearch_NewestSessionFirstWithLimit(t *testing.T) {
+	base := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	claude := &mockLogReader{
+		name:    "Claude Code",
+		canRead: true,
+		sessions: []domain.LogSession{
+			{ID: "old", StartTime: base},
+			{ID: "new", StartTime: base.Add(2 * time.Hour)},
+		},
+		hits: map[string][]domain.LogSearchHit{
+			"old": {{Line: 1}, {Line: 2}},
+			"new": {{Line: 7}},
+		},
+	}
+	aider := &mockLogReader{
+		name:     "Aider",
+		canRead:  true,
+		sessions: []domain.LogSession{{ID: "mid", StartTime: base.Add(time.Hour)}},
+		hits:     map[string][]domain.LogSearchHit{"mid": {{Line: 3}}},
+	}
+	skipped := &mockLogReader{
+		name:     "Codex",
+		canRead:  false,
+		sessions: []domain.LogSession{{ID: "other", StartTime: base.Add(3 * time.Hour)}},
+		hits:     map[string][]domain.LogSearchHit{"other": {{Line: 9}}},
+	}
+
+	registry := NewRegistry()
+	registry.Register(claude)
+	registry.Register(skipped)
+	registry.Register(aider)
+
+	hits, err := registry.Search(context.Background(), "/some/project", "query", 3)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	wantLines := []int{7, 3, 1}
+	if len(hits) != len(wantLines) {
+		t.Fatalf("got %d hits, want %d", len(hits), len(wantLines))
+	}
+	for i, want := range wantLines {
+		if hits[i].Line != want {
+			t.Errorf("hits[%d].Line = %d, want %d", i, hits[i].Line, want)
+		}
+	}
+
+	if all, _ := registry.Search(context.Background(), "/some/project", "query", 0); len(all) != 4 {
+		t.Errorf("Search() without limit returned %d hits, want 4", len(all))
+	}
+}
@@ -0,0 +1,204 @@
+package logreaders
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+const (
+	// snippetContext is the number of characters kept before a match in hit snippets.
+	snippetContext = 40
+
+	// snippetMaxLen is the maximum length of a hit snippet in characters.
+	snippetMaxLen = 120
+
+	// searchCancelCheckLines is how often (in lines) a search checks for cancellation.
+	searchCancelCheckLines = 1000
+)
+
+// searchMetadataKeys are JSON fields holding identifiers and bookkeeping
+// rather than conversation text. Search does not match their values.
+var searchMetadataKeys = map[string]bool{
+	"uuid":        true,
+	"parentUuid":  true,
+	"leafUuid":    true,
+	"sessionId":   true,
+	"requestId":   true,
+	"messageId":   true,
+	"id":          true,
+	"tool_use_id": true,
+	"call_id":     true,
+	"signature":   true,
+	"timestamp":   true,
+	"cwd":         true,
+	"version":     true,
+	"gitBranch":   true,
+	"snapshot":    true,
+}
+
+// forEachLine calls fn for every line of r with its 1-based line number and
+// byte offset, without the line terminator. Lines longer than maxLen are counted but
+// skipped without being buffered, so arbitrarily large files are read in
+// constant memory. Stops early when fn returns false or ctx is cancelled.
+func forEachLine(ctx context.Context, r io.Reader, maxLen int, fn func(lineNo int, offset int64, line []byte) bool) error {
+	reader := bufio.NewReaderSize(r, 64*1024)
+	var buf []byte
+	lineNo := 0
+	var offset, next int64 // Start of the current and the next line
+	tooLong := false
+
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		next += int64(len(chunk))
+		if !tooLong {
+			if len(buf)+len(chunk) > maxLen {
+				tooLong = true
+				buf = buf[:0]
+			} else {
+				buf = append(buf, chunk...)
+			}
+		}
+		if errors.Is(err, bufio.ErrBufferFull) {
+			continue // Line continues in the next chunk
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if len(buf) > 0 || tooLong {
+			lineNo++
+			if !tooLong && !fn(lineNo, offset, bytes.TrimRight(buf, "\r\n")) {
+				return nil
+			}
+			if lineNo%searchCancelCheckLines == 0 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+				}
+			}
+		}
+		buf = buf[:0]
+		tooLong = false
+		offset = next
+
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// searchJSONL searches a JSONL session for entries whose text values
+// contain query. parse supplies the type and timestamp of matching entries.
+func searchJSONL(ctx context.Context, session domain.LogSession, query string, limit, maxLen int, parse func([]byte) (domain.LogEntry, error)) ([]domain.LogSearchHit, error) {
+	needle := strings.ToLower(strings.TrimSpace(query))
+	if needle == "" {
+		return nil, nil
+	}
+
+	file, err := os.Open(session.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+	defer file.Close()
+
+	// Cheap rejection on the raw line. Quotes and backslashes are escaped
+	// in JSON, so queries containing them are matched on decoded text only.
+	rawNeedle := []byte(needle)
+	prefilter := !strings.ContainsAny(needle, "\"\\")
+
+	var hits []domain.LogSearchHit
+	err = forEachLine(ctx, file, maxLen, func(lineNo int, offset int64, line []byte) bool {
+		if prefilter && !bytes.Contains(bytes.ToLower(line), rawNeedle) {
+			return true
+		}
+		var value any
+		if json.Unmarshal(line, &value) != nil {
+			return true
+		}
+		text, ok := matchJSONText(value, needle)
+		if !ok {
+			return true
+		}
+		entry, err := parse(line)
+		if err != nil {
+			return true
+		}
+		hits = append(hits, domain.LogSearchHit{
+			Session:   session,
+			Line:      lineNo,
+			Offset:    offset,
+			Timestamp: entry.Timestamp,
+			Type:      entry.Type,
+			Snippet:   searchSnippet(text, needle),
+		})
+		return limit <= 0 || len(hits) < limit
+	})
+	if err != nil {
+		return hits, fmt.Errorf("error searching session: %w", err)
+	}
+	return hits, nil
+}
+
+// matchJSONText returns the first string value in v (object keys in sorted
+// order, skipping metadata fields) that contains the lowercase needle.
+func matchJSONText(v any, needle string) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		if strings.Contains(strings.ToLower(v), needle) {
+			return v, true
+		}
+	case []any:
+		for _, item := range v {
+			if text, ok := matchJSONText(item, needle); ok {
+				return text, true
+			}
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			if !searchMetadataKeys[key] {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if text, ok := matchJSONText(v[key], needle); ok {
+				return text, true
+			}
+		}
+	}
+	return "", false
+}
+
+// searchSnippet returns text on a single line, trimmed to snippetMaxLen
+// characters with the match near the start.
+func searchSnippet(text, needle string) string {
+	line := strings.Join(strings.Fields(text), " ")
+	runes := []rune(line)
+	start := 0
+	lower := strings.ToLower(line)
+	if i := strings.Index(lower, needle); i >= 0 {
+		start = max(utf8.RuneCountInString(lower[:i])-snippetContext, 0)
+	}
+	end := min(start+snippetMaxLen, len(runes))
+	snippet := string(runes[start:end])
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
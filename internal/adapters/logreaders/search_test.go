@@ -0,0 +1,158 @@
+package logreaders
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func TestForEachLine_SkipsLongLinesAndCountsAll(t *testing.T) {
+	long := strings.Repeat("x", 200*1024)
+	input := "first\n\n" + long + "\nlast"
+
+	var lineNos []int
+	var offsets []int64
+	var lines []string
+	err := forEachLine(context.Background(), strings.NewReader(input), 1024, func(lineNo int, offset int64, line []byte) bool {
+		lineNos = append(lineNos, lineNo)
+		offsets = append(offsets, offset)
+		lines = append(lines, string(line))
+		return true
+	})
+	if err != nil {
+		t.Fatalf("forEachLine() error = %v", err)
+	}
+
+	// The over-long line 3 is skipped but still counted
+	if got := strings.Join(lines, "|"); got != "first||last" {
+		t.Errorf("lines = %q, want %q", got, "first||last")
+	}
+	if len(lineNos) != 3 || lineNos[2] != 4 {
+		t.Errorf("line numbers = %v, want [1 2 4]", lineNos)
+	}
+	if want := int64(len(input) - len("last")); len(offsets) != 3 || offsets[1] != 6 || offsets[2] != want {
+		t.Errorf("offsets = %v, want [0 6 %d]", offsets, want)
+	}
+}
+
+func TestForEachLine_StopsEarly(t *testing.T) {
+	calls := 0
+	err := forEachLine(context.Background(), strings.NewReader("a\nb\nc\n"), 1024, func(int, int64, []byte) bool {
+		calls++
+		return false
+	})
+	if err != nil || calls != 1 {
+		t.Errorf("forEachLine() calls = %d, err = %v, want 1 call", calls, err)
+	}
+}
+
+func TestSearchSnippet(t *testing.T) {
+	text := strings.Repeat("lead ", 20) + "the NEEDLE\nin   a haystack " + strings.Repeat("tail ", 40)
+	got := searchSnippet(text, "needle")
+
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") {
+		t.Errorf("snippet should be trimmed on both sides, got %q", got)
+	}
+	if !strings.Contains(got, "the NEEDLE in a haystack") {
+		t.Errorf("snippet should keep the match on one line, got %q", got)
+	}
+	if n := len([]rune(got)); n > snippetMaxLen+2 {
+		t.Errorf("snippet is %d characters, want <= %d", n, snippetMaxLen+2)
+	}
+
+	if got := searchSnippet("short text", "text"); got != "short text" {
+		t.Errorf("short snippet = %q, want unchanged", got)
+	}
+}
+
+func TestClaudeCodeReader_SearchSession(t *testing.T) {
+	reader := NewClaudeCodeReader()
+	session := domain.LogSession{ID: "str-456", Path: filepath.Join(fixturesDir(t), "claude-logs", "structured-session.jsonl")}
+	ctx := context.Background()
+
+	hits, err := reader.SearchSession(ctx, session, "CHANGELOG", 0)
+	if err != nil {
+		t.Fatalf("SearchSession() error = %v", err)
+	}
+	if len(hits) != 1 {
+		t.Fatalf("got %d hits, want 1", len(hits))
+	}
+	hit := hits[0]
+	if hit.Line != 11 || hit.Type != "user" || hit.Session.ID != "str-456" {
+		t.Errorf("hit = line %d type %q session %q, want line 11 user str-456", hit.Line, hit.Type, hit.Session.ID)
+	}
+	data, _ := os.ReadFile(session.Path)
+	if lines := strings.SplitAfter(string(data), "\n"); int(hit.Offset) != len(strings.Join(lines[:10], "")) {
+		t.Errorf("Offset = %d, want the start of line 11", hit.Offset)
+	}
+	if want := time.Date(2026, 1, 12, 10, 1, 0, 0, time.UTC); !hit.Timestamp.Equal(want) {
+		t.Errorf("Timestamp = %v, want %v", hit.Timestamp, want)
+	}
+	if hit.Snippet != "Thanks! Please also update the changelog." {
+		t.Errorf("Snippet = %q", hit.Snippet)
+	}
+
+	// Identifiers and bookkeeping fields are not searched
+	for _, query := range []string{"str-456", "toolu_01", "leafUuid"} {
+		if hits, _ := reader.SearchSession(ctx, session, query, 0); len(hits) != 0 {
+			t.Errorf("SearchSession(%q) = %d hits, want 0", query, len(hits))
+		}
+	}
+
+	hits, _ = reader.SearchSession(ctx, session, "parser", 2)
+	if len(hits) != 2 || hits[0].Line != 1 || hits[1].Line != 3 {
+		t.Errorf("limited hits = %+v, want lines 1 and 3", hits)
+	}
+
+	// Queries with quotes are matched on decoded text
+	if hits, _ := reader.SearchSession(ctx, session, `"/version"`, 0); len(hits) != 0 {
+		t.Errorf("unexpected hits for quoted query: %+v", hits)
+	}
+
+	if hits, _ := reader.SearchSession(ctx, session, "   ", 0); hits != nil {
+		t.Errorf("blank query should return no hits, got %+v", hits)
+	}
+}
+
+func TestAiderReader_SearchSession(t *testing.T) {
+	reader := NewAiderReader()
+	path := filepath.Join(aiderFixtureDir(t), aiderChatHistoryFile)
+	ctx := context.Background()
+
+	older := domain.LogSession{ID: "20260110-090000", Path: path}
+	hits, err := reader.SearchSession(ctx, older, "version", 0)
+	if err != nil {
+		t.Fatalf("SearchSession() error = %v", err)
+	}
+	wantLines := []int{8, 10, 14, 18}
+	wantTypes := []string{aiderEntryUser, aiderEntryAssistant, aiderEntryAssistant, aiderEntryTool}
+	if len(hits) != len(wantLines) {
+		t.Fatalf("got %d hits, want %d: %+v", len(hits), len(wantLines), hits)
+	}
+	for i := range wantLines {
+		if hits[i].Line != wantLines[i] || hits[i].Type != wantTypes[i] {
+			t.Errorf("hits[%d] = line %d %s, want line %d %s", i, hits[i].Line, hits[i].Type, wantLines[i], wantTypes[i])
+		}
+	}
+	if want := time.Date(2026, 1, 10, 9, 0, 0, 0, time.Local); !hits[0].Timestamp.Equal(want) {
+		t.Errorf("Timestamp = %v, want session start %v", hits[0].Timestamp, want)
+	}
+
+	// Lines of other sessions in the shared file are not attributed to this one
+	newer := domain.LogSession{ID: "20260112-143000", Path: path}
+	hits, _ = reader.SearchSession(ctx, newer, "version", 0)
+	if len(hits) != 1 || hits[0].Line != 25 || hits[0].Snippet != "Write a test for the version handler" {
+		t.Errorf("newer session hits = %+v, want the prompt on line 25", hits)
+	}
+
+	input := domain.LogSession{ID: aiderInputSessionID, Path: filepath.Join(aiderFixtureDir(t), aiderInputHistoryFile)}
+	hits, _ = reader.SearchSession(ctx, input, "httptest", 0)
+	if len(hits) != 1 || hits[0].Line != 7 || hits[0].Type != aiderEntryUser || hits[0].Timestamp.IsZero() {
+		t.Errorf("input history hits = %+v, want the prompt on line 7", hits)
+	}
+}
@@ -0,0 +1,103 @@
+package logreaders
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// readNewFunc reads entries appended to sessionPath after offset and
+// returns them with the new offset.
+type readNewFunc func(sessionPath string, offset int64) ([]domain.LogEntry, int64, error)
+
+// tailFile streams entries appended to sessionPath, polling every tailPollInterval.
+// Shared by all readers; each supplies its own readNew for its log format.
+// The returned channel is closed when ctx is cancelled.
+func tailFile(ctx context.Context, sessionPath string, readNew readNewFunc) (<-chan domain.LogEntry, error) {
+	// Verify file exists initially
+	info, err := os.Stat(sessionPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to access session file: %w", err)
+	}
+
+	ch := make(chan domain.LogEntry, 100)
+	lastOffset := info.Size()
+
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(tailPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				newEntries, newOffset, err := readNew(sessionPath, lastOffset)
+				if err != nil {
+					// On error, continue trying
+					continue
+				}
+				lastOffset = newOffset
+
+				for _, entry := range newEntries {
+					select {
+					case <-ctx.Done():
+						return
+					case ch <- entry:
+					}
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// readNewLines parses JSONL lines added since offset using parse.
+// Lines that fail to parse are skipped.
+func readNewLines(sessionPath string, offset int64, parse func([]byte) (domain.LogEntry, error)) ([]domain.LogEntry, int64, error) {
+	file, err := os.Open(sessionPath)
+	if err != nil {
+		return nil, offset, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, offset, err
+	}
+
+	// No new data
+	if info.Size() <= offset {
+		return nil, offset, nil
+	}
+
+	// Seek to last known position
+	if _, err := file.Seek(offset, 0); err != nil {
+		return nil, offset, err
+	}
+
+	var entries []domain.LogEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxLineLength)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		entry, err := parse(line)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, info.Size(), scanner.Err()
+}
@@ -0,0 +1,65 @@
+// Package metrics adapts methodology artifacts for the progress metrics
+// collector. Storage lives in adapters/persistence/metrics.
+package metrics
+
+import (
+	"context"
+	"strings"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/bmad"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/openspec"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/speckit"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/detectors/taskmaster"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// Compile-time interface check
+var _ ports.ProgressReader = (*ProgressReader)(nil)
+
+// ProgressReader counts BMAD stories (sprint-status.yaml), Speckit or
+// OpenSpec tasks (tasks.md checklists) and Task Master tasks (tasks.json)
+// based on the project's detected method.
+type ProgressReader struct{}
+
+// NewProgressReader creates a ProgressReader.
+func NewProgressReader() *ProgressReader {
+	return &ProgressReader{}
+}
+
+// ReadProgress returns the project's story or task counts. Other methods
+// return a zero WorkProgress.
+func (r *ProgressReader) ReadProgress(ctx context.Context, project *domain.Project) (domain.WorkProgress, error) {
+	var progress domain.WorkProgress
+	if project == nil {
+		return progress, nil
+	}
+
+	switch strings.ToLower(project.DetectedMethod) {
+	case "bmad":
+		done, total, _, err := bmad.StoryProgress(ctx, project.Path)
+		if err != nil {
+			return progress, err
+		}
+		progress.StoriesDone, progress.StoriesTotal = done, total
+	case "speckit":
+		done, total, _, err := speckit.TaskProgress(ctx, project.Path)
+		if err != nil {
+			return progress, err
+		}
+		progress.TasksDone, progress.TasksTotal = done, total
+	case "openspec":
+		done, total, _, err := openspec.TaskProgress(ctx, project.Path)
+		if err != nil {
+			return progress, err
+		}
+		progress.TasksDone, progress.TasksTotal = done, total
+	case "taskmaster":
+		done, total, _, err := taskmaster.TaskProgress(ctx, project.Path)
+		if err != nil {
+			return progress, err
+		}
+		progress.TasksDone, progress.TasksTotal = done, total
+	}
+	return progress, nil
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func fixturesDir() string {
+	return filepath.Join("..", "..", "..", "test", "fixtures")
+}
+
+func TestProgressReader_ReadProgress(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		fixture string
+		want    domain.WorkProgress
+	}{
+		{"bmad stories", "bmad", "bmad-v6-mid-sprint", domain.WorkProgress{StoriesDone: 2, StoriesTotal: 4}},
+		{"speckit tasks", "speckit", "speckit-stage-tasks-partial", domain.WorkProgress{TasksTotal: 2}},
+		{"openspec tasks", "openspec", "openspec-stage-apply", domain.WorkProgress{TasksDone: 2, TasksTotal: 5}},
+		{"openspec without tasks", "openspec", "openspec-archived", domain.WorkProgress{}},
+		{"taskmaster tasks", "taskmaster", "taskmaster-stage-implement", domain.WorkProgress{TasksDone: 2, TasksTotal: 6}},
+		{"method case-insensitive", "BMAD", "bmad-v6-mid-sprint", domain.WorkProgress{StoriesDone: 2, StoriesTotal: 4}},
+		{"unknown method", "unknown", "bmad-v6-mid-sprint", domain.WorkProgress{}},
+		{"no artifacts", "speckit", "empty-project", domain.WorkProgress{}},
+	}
+
+	r := NewProgressReader()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &domain.Project{Path: filepath.Join(fixturesDir(), tt.fixture), DetectedMethod: tt.method}
+			got, err := r.ReadProgress(context.Background(), p)
+			if err != nil {
+				t.Fatalf("ReadProgress() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ReadProgress() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package notifiers
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// BellNotifier rings the terminal bell (BEL, \a).
+// Writes to stderr by default so it reaches the terminal while the TUI owns stdout.
+type BellNotifier struct {
+	out io.Writer
+}
+
+// Compile-time interface compliance check
+var _ ports.Notifier = (*BellNotifier)(nil)
+
+// NewBellNotifier creates a bell notifier writing to out (os.Stderr if nil).
+func NewBellNotifier(out io.Writer) *BellNotifier {
+	if out == nil {
+		out = os.Stderr
+	}
+	return &BellNotifier{out: out}
+}
+
+// Name returns the notifier identifier.
+func (b *BellNotifier) Name() string {
+	return "bell"
+}
+
+// Notify writes the bell character.
+func (b *BellNotifier) Notify(ctx context.Context, _ ports.Notification) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+	_, err := io.WriteString(b.out, "\a")
+	return err
+}
@@ -0,0 +1,37 @@
+package notifiers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+func TestBellNotifier_WritesBell(t *testing.T) {
+	var buf bytes.Buffer
+	b := NewBellNotifier(&buf)
+
+	if b.Name() != "bell" {
+		t.Errorf("Name() = %q, want bell", b.Name())
+	}
+	if err := b.Notify(context.Background(), ports.Notification{ProjectName: "alpha"}); err != nil {
+		t.Fatalf("Notify returned error: %v", err)
+	}
+	if buf.String() != "\a" {
+		t.Errorf("expected BEL, got %q", buf.String())
+	}
+}
+
+func TestBellNotifier_CancelledContext(t *testing.T) {
+	var buf bytes.Buffer
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := NewBellNotifier(&buf).Notify(ctx, ports.Notification{}); err == nil {
+		t.Error("expected error for cancelled context")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing written, got %q", buf.String())
+	}
+}
@@ -0,0 +1,117 @@
+package notifiers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/timeformat"
+)
+
+// commandShell runs notification commands. Always POSIX sh (not $SHELL) so
+// the single-quote escaping in shellQuote is valid.
+const commandShell = "/bin/sh"
+
+// CommandNotifier runs a user-configured shell command for each notification.
+// The command is a text/template; values are shell-quoted when substituted,
+// so `say {{.Project}} is waiting` is safe for any project name.
+// The same values are exported as VDASH_PROJECT, VDASH_PATH, VDASH_TOOL,
+// VDASH_DURATION and VDASH_MINUTES.
+type CommandNotifier struct {
+	tmpl  *template.Template
+	shell string
+}
+
+// Compile-time interface compliance check
+var _ ports.Notifier = (*CommandNotifier)(nil)
+
+// commandData holds the template fields available to notification commands.
+type commandData struct {
+	Project  string // Effective project name
+	Path     string // Project path
+	Tool     string // Agent tool (may be empty)
+	Duration string // Waiting duration, e.g. "12m", "2h"
+	Minutes  string // Waiting duration in whole minutes
+	Message  string // Full one-line message
+}
+
+// NewCommandNotifier parses the command template.
+// Returns an error for an empty command or invalid template syntax.
+func NewCommandNotifier(command string) (*CommandNotifier, error) {
+	if strings.TrimSpace(command) == "" {
+		return nil, fmt.Errorf("notification command is empty")
+	}
+	tmpl, err := template.New("command").Option("missingkey=error").Parse(command)
+	if err != nil {
+		return nil, fmt.Errorf("invalid notification command template: %w", err)
+	}
+	return &CommandNotifier{tmpl: tmpl, shell: commandShell}, nil
+}
+
+// Name returns the notifier identifier.
+func (c *CommandNotifier) Name() string {
+	return "command"
+}
+
+// Notify renders the template and runs it with /bin/sh.
+func (c *CommandNotifier) Notify(ctx context.Context, n ports.Notification) error {
+	data := newCommandData(n)
+
+	script, err := c.render(data)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, c.shell, "-c", script)
+	cmd.Env = append(os.Environ(),
+		"VDASH_PROJECT="+data.Project,
+		"VDASH_PATH="+data.Path,
+		"VDASH_TOOL="+data.Tool,
+		"VDASH_DURATION="+data.Duration,
+		"VDASH_MINUTES="+data.Minutes,
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("notification command failed: %w (%s)", err, bytes.TrimSpace(out))
+	}
+	return nil
+}
+
+// render executes the template with shell-quoted values.
+func (c *CommandNotifier) render(data commandData) (string, error) {
+	quoted := commandData{
+		Project:  shellQuote(data.Project),
+		Path:     shellQuote(data.Path),
+		Tool:     shellQuote(data.Tool),
+		Duration: shellQuote(data.Duration),
+		Minutes:  shellQuote(data.Minutes),
+		Message:  shellQuote(data.Message),
+	}
+
+	var buf bytes.Buffer
+	if err := c.tmpl.Execute(&buf, quoted); err != nil {
+		return "", fmt.Errorf("failed to render notification command: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func newCommandData(n ports.Notification) commandData {
+	return commandData{
+		Project:  n.ProjectName,
+		Path:     n.ProjectPath,
+		Tool:     n.Tool,
+		Duration: timeformat.FormatWaitingDuration(n.WaitingFor, false),
+		Minutes:  strconv.Itoa(int(n.WaitingFor.Minutes())),
+		Message:  formatMessage(n),
+	}
+}
+
+// shellQuote wraps s in single quotes for POSIX shells.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,79 @@
+package notifiers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+func TestNewCommandNotifier_Errors(t *testing.T) {
+	if _, err := NewCommandNotifier("  "); err == nil {
+		t.Error("expected error for empty command")
+	}
+	if _, err := NewCommandNotifier("echo {{.Project"); err == nil {
+		t.Error("expected error for invalid template")
+	}
+}
+
+func TestCommandNotifier_Render(t *testing.T) {
+	c, err := NewCommandNotifier(`say {{.Project}} waited {{.Minutes}} minutes`)
+	if err != nil {
+		t.Fatalf("NewCommandNotifier returned error: %v", err)
+	}
+
+	got, err := c.render(newCommandData(ports.Notification{ProjectName: "it's; rm -rf", WaitingFor: 12 * time.Minute}))
+	if err != nil {
+		t.Fatalf("render returned error: %v", err)
+	}
+	want := `say 'it'\''s; rm -rf' waited '12' minutes`
+	if got != want {
+		t.Errorf("render = %q, want %q", got, want)
+	}
+}
+
+func TestCommandNotifier_UnknownField(t *testing.T) {
+	c, err := NewCommandNotifier(`echo {{.Nope}}`)
+	if err != nil {
+		t.Fatalf("NewCommandNotifier returned error: %v", err)
+	}
+	if err := c.Notify(context.Background(), ports.Notification{}); err == nil {
+		t.Error("expected error for unknown template field")
+	}
+}
+
+func TestCommandNotifier_Notify(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out.txt")
+	c, err := NewCommandNotifier(`printf '%s|%s|%s' {{.Project}} "$VDASH_DURATION" {{.Tool}} > ` + shellQuote(out))
+	if err != nil {
+		t.Fatalf("NewCommandNotifier returned error: %v", err)
+	}
+
+	n := ports.Notification{ProjectName: "my app", Tool: "Codex", WaitingFor: 2 * time.Hour}
+	if err := c.Notify(context.Background(), n); err != nil {
+		t.Fatalf("Notify returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("command did not write output: %v", err)
+	}
+	if string(data) != "my app|2h|Codex" {
+		t.Errorf("unexpected output %q", data)
+	}
+}
+
+func TestCommandNotifier_FailureIncludesOutput(t *testing.T) {
+	c, err := NewCommandNotifier(`echo boom >&2; exit 3`)
+	if err != nil {
+		t.Fatalf("NewCommandNotifier returned error: %v", err)
+	}
+	err = c.Notify(context.Background(), ports.Notification{})
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("expected error with command output, got %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package notifiers
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strconv"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// DesktopNotifier shows a desktop notification using the platform tool:
+// notify-send on Linux/BSD, osascript on macOS.
+type DesktopNotifier struct {
+	goos     string
+	lookPath func(string) (string, error)
+	run      func(ctx context.Context, name string, args ...string) error
+}
+
+// Compile-time interface compliance check
+var _ ports.Notifier = (*DesktopNotifier)(nil)
+
+// NewDesktopNotifier creates a desktop notifier for the current platform.
+func NewDesktopNotifier() *DesktopNotifier {
+	return &DesktopNotifier{
+		goos:     runtime.GOOS,
+		lookPath: exec.LookPath,
+		run:      runCommand,
+	}
+}
+
+// Name returns the notifier identifier.
+func (d *DesktopNotifier) Name() string {
+	return "desktop"
+}
+
+// Notify shows the notification. Returns an error if no notification tool is available.
+func (d *DesktopNotifier) Notify(ctx context.Context, n ports.Notification) error {
+	name, args, err := d.command(n)
+	if err != nil {
+		return err
+	}
+	return d.run(ctx, name, args...)
+}
+
+// command builds the platform-specific command line.
+// Arguments are passed directly (no shell), so project names need no escaping.
+func (d *DesktopNotifier) command(n ports.Notification) (string, []string, error) {
+	message := formatMessage(n)
+
+	switch d.goos {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", strconv.Quote(message), strconv.Quote(notificationTitle))
+		return "osascript", []string{"-e", script}, nil
+	case "windows":
+		return "", nil, fmt.Errorf("desktop notifications not supported on %s", d.goos)
+	default:
+		if _, err := d.lookPath("notify-send"); err != nil {
+			return "", nil, fmt.Errorf("notify-send not found: %w", err)
+		}
+		return "notify-send", []string{"--app-name=vibe-dash", notificationTitle, message}, nil
+	}
+}
+
+// runCommand executes name with args and reports output on failure.
+func runCommand(ctx context.Context, name string, args ...string) error {
+	out, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s failed: %w (%s)", name, err, out)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package notifiers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+func TestDesktopNotifier_Command(t *testing.T) {
+	n := ports.Notification{ProjectName: `my "app"`, Tool: "Claude Code", WaitingFor: 12 * time.Minute}
+
+	tests := []struct {
+		name     string
+		goos     string
+		lookErr  error
+		wantName string
+		wantArg  string
+		wantErr  bool
+	}{
+		{"linux uses notify-send", "linux", nil, "notify-send", `my "app": Claude Code waiting for input (12m)`, false},
+		{"linux without notify-send", "linux", errors.New("not found"), "", "", true},
+		{"macOS uses osascript", "darwin", nil, "osascript", `display notification "my \"app\": Claude Code waiting for input (12m)" with title "vibe-dash"`, false},
+		{"windows unsupported", "windows", nil, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &DesktopNotifier{
+				goos:     tt.goos,
+				lookPath: func(string) (string, error) { return "/usr/bin/notify-send", tt.lookErr },
+			}
+			name, args, err := d.command(n)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if name != tt.wantName {
+				t.Errorf("command = %q, want %q", name, tt.wantName)
+			}
+			if args[len(args)-1] != tt.wantArg {
+				t.Errorf("last arg = %q, want %q", args[len(args)-1], tt.wantArg)
+			}
+		})
+	}
+}
+
+func TestDesktopNotifier_Notify(t *testing.T) {
+	var ran []string
+	d := &DesktopNotifier{
+		goos:     "linux",
+		lookPath: func(string) (string, error) { return "/usr/bin/notify-send", nil },
+		run: func(_ context.Context, name string, args ...string) error {
+			ran = append([]string{name}, args...)
+			return nil
+		},
+	}
+
+	if err := d.Notify(context.Background(), ports.Notification{ProjectName: "alpha"}); err != nil {
+		t.Fatalf("Notify returned error: %v", err)
+	}
+	if len(ran) == 0 || ran[0] != "notify-send" || !strings.Contains(strings.Join(ran, " "), "alpha: Agent waiting for input") {
+		t.Errorf("unexpected command: %v", ran)
+	}
+}
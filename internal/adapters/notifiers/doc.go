@@ -0,0 +1,3 @@
+// Package notifiers provides ports.Notifier sinks for agent waiting alerts:
+// the terminal bell, desktop notifications and a user-configured command.
+package notifiers
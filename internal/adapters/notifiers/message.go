@@ -0,0 +1,21 @@
+package notifiers
+
+import (
+	"fmt"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/timeformat"
+)
+
+// notificationTitle is the headline shown by desktop notifications.
+const notificationTitle = "vibe-dash"
+
+// formatMessage renders a one-line description of the notification:
+// "my-project: Claude Code waiting for input (12m)"
+func formatMessage(n ports.Notification) string {
+	agent := n.Tool
+	if agent == "" {
+		agent = "Agent"
+	}
+	return fmt.Sprintf("%s: %s waiting for input (%s)", n.ProjectName, agent, timeformat.FormatWaitingDuration(n.WaitingFor, false))
+}
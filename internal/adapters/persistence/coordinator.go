@@ -16,8 +16,11 @@ import (
 	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
 )
 
-// Compile-time interface check
-var _ ports.ProjectRepository = (*RepositoryCoordinator)(nil)
+// Compile-time interface checks
+var (
+	_ ports.ProjectRepository      = (*RepositoryCoordinator)(nil)
+	_ ports.ProjectEventRepository = (*RepositoryCoordinator)(nil)
+)
 
 // RepositoryCoordinator aggregates multiple per-project repositories.
 // Implements ports.ProjectRepository for seamless service layer integration.
@@ -28,6 +31,7 @@ type RepositoryCoordinator struct {
 	basePath           string
 	repoCache          map[string]*sqlite.ProjectRepository
 	projectIDToDirName map[string]string // project ID -> dirName for O(1) lookup in UpdateLastActivity
+	listeners          []ports.ProjectEventListener
 	mu                 sync.RWMutex
 }
 
@@ -47,6 +51,25 @@ func NewRepositoryCoordinator(
 	}
 }
 
+// AddEventListener registers a listener told about every recorded history event,
+// whether from Save (added, stage, method) or AppendEvent (state, agent).
+func (c *RepositoryCoordinator) AddEventListener(l ports.ProjectEventListener) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, l)
+}
+
+// notifyListeners passes a recorded event to all registered listeners.
+func (c *RepositoryCoordinator) notifyListeners(event domain.ProjectEvent) {
+	c.mu.RLock()
+	listeners := c.listeners
+	c.mu.RUnlock()
+
+	for _, l := range listeners {
+		l.OnProjectEvent(event)
+	}
+}
+
 // getProjectRepo returns a cached or newly created repository for the given directory.
 // Uses double-checked locking pattern for thread safety.
 // If database corruption is detected, attempts auto-recovery before returning error.
@@ -313,7 +336,32 @@ func (c *RepositoryCoordinator) Save(ctx context.Context, project *domain.Projec
 	if err != nil {
 		return err
 	}
-	return repo.Save(ctx, project)
+
+	// Snapshot before overwriting so transitions can be recorded in history
+	before, findErr := repo.FindByID(ctx, project.ID)
+	if findErr != nil && !errors.Is(findErr, domain.ErrProjectNotFound) {
+		slog.Debug("failed to load previous snapshot for history", "project", project.ID, "error", findErr)
+	}
+
+	if err := repo.Save(ctx, project); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.projectIDToDirName[project.ID] = dirName
+	c.mu.Unlock()
+
+	// History is best-effort: a failed append must not fail the save
+	if findErr == nil || errors.Is(findErr, domain.ErrProjectNotFound) {
+		for _, event := range domain.ProjectChangeEvents(before, project, time.Now()) {
+			if err := repo.AppendEvent(ctx, event); err != nil {
+				slog.Warn("failed to record project event", "project", project.ID, "type", event.Type, "error", err)
+				continue
+			}
+			c.notifyListeners(event)
+		}
+	}
+	return nil
 }
 
 // FindByID searches for a project by ID across all databases.
@@ -524,6 +572,94 @@ func (c *RepositoryCoordinator) UpdateLastActivity(ctx context.Context, id strin
 	return repo.UpdateLastActivity(ctx, id, timestamp)
 }
 
+// repoForProjectID returns the per-project repository holding projectID.
+// Uses the projectID -> dirName cache, falling back to a full lookup.
+// Returns domain.ErrProjectNotFound if not found.
+func (c *RepositoryCoordinator) repoForProjectID(ctx context.Context, id string) (*sqlite.ProjectRepository, error) {
+	c.mu.RLock()
+	dirName, cached := c.projectIDToDirName[id]
+	c.mu.RUnlock()
+
+	if cached {
+		if repo, err := c.getProjectRepo(ctx, dirName); err == nil {
+			return repo, nil
+		}
+	}
+
+	project, err := c.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := c.configLoader.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	dirName, found := cfg.GetDirectoryName(project.Path)
+	if !found {
+		return nil, fmt.Errorf("project path not in config: %s", project.Path)
+	}
+
+	c.mu.Lock()
+	c.projectIDToDirName[id] = dirName
+	c.mu.Unlock()
+
+	return c.getProjectRepo(ctx, dirName)
+}
+
+// AppendEvent records a history event in the project's database.
+// Returns domain.ErrProjectNotFound if the project is not tracked.
+func (c *RepositoryCoordinator) AppendEvent(ctx context.Context, event domain.ProjectEvent) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	repo, err := c.repoForProjectID(ctx, event.ProjectID)
+	if err != nil {
+		return err
+	}
+	if err := repo.AppendEvent(ctx, event); err != nil {
+		return err
+	}
+	c.notifyListeners(event)
+	return nil
+}
+
+// FindEvents returns a project's history in chronological order.
+// Returns domain.ErrProjectNotFound if the project is not tracked.
+func (c *RepositoryCoordinator) FindEvents(ctx context.Context, projectID string, filter ports.EventFilter) ([]domain.ProjectEvent, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	repo, err := c.repoForProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return repo.FindEvents(ctx, projectID, filter)
+}
+
+// LastEvent returns the newest event of eventType, or nil if none exists.
+// Returns domain.ErrProjectNotFound if the project is not tracked.
+func (c *RepositoryCoordinator) LastEvent(ctx context.Context, projectID string, eventType domain.EventType) (*domain.ProjectEvent, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	repo, err := c.repoForProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return repo.LastEvent(ctx, projectID, eventType)
+}
+
 // resolveToDirName resolves a projectID (which can be dirName, project name, or path) to a dirName.
 // Returns empty string if not found.
 func (c *RepositoryCoordinator) resolveToDirName(cfg *ports.Config, projectID string) string {
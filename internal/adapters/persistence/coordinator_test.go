@@ -1206,3 +1206,125 @@ func TestRecoverFromCorruption_VerifiesDeletion(t *testing.T) {
 		t.Error("expected state.db to be deleted after recovery")
 	}
 }
+
+// TestSave_RecordsHistoryEvents tests that Save appends added/stage events to history
+func TestSave_RecordsHistoryEvents(t *testing.T) {
+	basePath := t.TempDir()
+	ctx := context.Background()
+
+	setupProjectDir(t, basePath, "hist-proj")
+
+	cfg := ports.NewConfig()
+	cfg.SetProjectEntry("hist-proj", "/path/to/history", "", false)
+
+	mockLoader := &mockConfigLoader{
+		loadFunc: func(ctx context.Context) (*ports.Config, error) {
+			return cfg, nil
+		},
+	}
+
+	coord := NewRepositoryCoordinator(mockLoader, &mockDirectoryManager{}, basePath)
+
+	project := createTestProject("/path/to/history")
+	project.CurrentStage = domain.StagePlan
+	project.DetectedMethod = "speckit"
+	if err := coord.Save(ctx, project); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	// Saving unchanged project must not add events
+	if err := coord.Save(ctx, project); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	project.CurrentStage = domain.StageImplement
+	project.DetectionReasoning = "tasks.md exists"
+	if err := coord.Save(ctx, project); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	events, err := coord.FindEvents(ctx, project.ID, ports.EventFilter{})
+	if err != nil {
+		t.Fatalf("FindEvents returned error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d: %+v", len(events), events)
+	}
+	if events[0].Type != domain.EventProjectAdded || events[0].To != "Plan" || events[0].Detail != "speckit" {
+		t.Errorf("unexpected first event: %+v", events[0])
+	}
+	if events[1].Type != domain.EventStageChanged || events[1].From != "Plan" || events[1].To != "Implement" {
+		t.Errorf("unexpected second event: %+v", events[1])
+	}
+	if events[1].Detail != "tasks.md exists" {
+		t.Errorf("expected detection reasoning as detail, got %q", events[1].Detail)
+	}
+
+	last, err := coord.LastEvent(ctx, project.ID, domain.EventStageChanged)
+	if err != nil || last == nil {
+		t.Fatalf("LastEvent = %v, %v", last, err)
+	}
+	if last.To != "Implement" {
+		t.Errorf("expected last stage Implement, got %q", last.To)
+	}
+}
+
+// TestFindEvents_UnknownProject tests that history lookups fail for unknown projects
+func TestFindEvents_UnknownProject(t *testing.T) {
+	coord := NewRepositoryCoordinator(&mockConfigLoader{}, &mockDirectoryManager{}, t.TempDir())
+
+	_, err := coord.FindEvents(context.Background(), "missing", ports.EventFilter{})
+	if !errors.Is(err, domain.ErrProjectNotFound) {
+		t.Errorf("expected ErrProjectNotFound, got %v", err)
+	}
+}
+
+// recordingListener captures events passed to OnProjectEvent.
+type recordingListener struct {
+	events []domain.ProjectEvent
+}
+
+func (l *recordingListener) OnProjectEvent(event domain.ProjectEvent) {
+	l.events = append(l.events, event)
+}
+
+func TestEventListener_ReceivesRecordedEvents(t *testing.T) {
+	basePath := t.TempDir()
+	ctx := context.Background()
+
+	setupProjectDir(t, basePath, "listen-proj")
+
+	cfg := ports.NewConfig()
+	cfg.SetProjectEntry("listen-proj", "/path/to/listen", "", false)
+
+	mockLoader := &mockConfigLoader{
+		loadFunc: func(ctx context.Context) (*ports.Config, error) {
+			return cfg, nil
+		},
+	}
+
+	coord := NewRepositoryCoordinator(mockLoader, &mockDirectoryManager{}, basePath)
+	listener := &recordingListener{}
+	coord.AddEventListener(listener)
+
+	project := createTestProject("/path/to/listen")
+	project.CurrentStage = domain.StagePlan
+	if err := coord.Save(ctx, project); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	event := domain.NewProjectEvent(project.ID, domain.EventAgentStateChanged, "", "Waiting", "Claude Code", time.Now())
+	if err := coord.AppendEvent(ctx, event); err != nil {
+		t.Fatalf("AppendEvent returned error: %v", err)
+	}
+
+	// Failed appends are not passed on
+	_ = coord.AppendEvent(ctx, domain.NewProjectEvent("unknown-id", domain.EventHibernated, "", "", "", time.Now()))
+
+	if len(listener.events) != 2 {
+		t.Fatalf("expected 2 events, got %d: %+v", len(listener.events), listener.events)
+	}
+	if listener.events[0].Type != domain.EventProjectAdded || listener.events[1].Type != domain.EventAgentStateChanged {
+		t.Errorf("unexpected events: %+v", listener.events)
+	}
+}
@@ -0,0 +1,232 @@
+// Package metrics stores progress metric samples in a dedicated SQLite
+// database (~/.vibe-dash/metrics.db), isolated from per-project state.db
+// files so the metrics feature can be dropped without affecting core storage.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // SQLite driver
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// DBFileName is the metrics database file name inside the vibe-dash home.
+const DBFileName = "metrics.db"
+
+// walConnectionParams matches the per-project databases: WAL for concurrent
+// readers (TUI, CLI) while the daemon writes, and a busy timeout for locks.
+const walConnectionParams = "?_journal_mode=WAL&_busy_timeout=5000"
+
+// sampleTimeLayout is a fixed-width UTC timestamp so sampled_at sorts lexically.
+const sampleTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// Compile-time interface check
+var _ ports.MetricsRepository = (*Repository)(nil)
+
+// Repository implements ports.MetricsRepository.
+// Each operation opens and closes its own connection, like the project repository.
+type Repository struct {
+	dbPath string
+}
+
+// NewRepository opens (creating if needed) the metrics database at dbPath
+// and applies migrations. The parent directory must exist.
+func NewRepository(dbPath string) (*Repository, error) {
+	if _, err := os.Stat(filepath.Dir(dbPath)); err != nil {
+		return nil, fmt.Errorf("%w: metrics directory: %v", domain.ErrPathNotAccessible, err)
+	}
+
+	r := &Repository{dbPath: dbPath}
+	ctx := context.Background()
+	db, err := r.openDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	if err := runMigrations(ctx, db); err != nil {
+		return nil, fmt.Errorf("failed to initialize metrics schema: %w", err)
+	}
+	return r, nil
+}
+
+// openDB opens a connection. Caller MUST close it.
+func (r *Repository) openDB(ctx context.Context) (*sqlx.DB, error) {
+	db, err := sqlx.ConnectContext(ctx, "sqlite3", r.dbPath+walConnectionParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open metrics database: %w", err)
+	}
+	return db, nil
+}
+
+// RecordSamples appends samples in one transaction.
+func (r *Repository) RecordSamples(ctx context.Context, samples []domain.MetricSample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	db, err := r.openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // No-op after commit
+
+	now := time.Now()
+	for _, s := range samples {
+		if s.ProjectID == "" {
+			return fmt.Errorf("invalid metric sample: project ID is required")
+		}
+		sampledAt := s.SampledAt
+		if sampledAt.IsZero() {
+			sampledAt = now
+		}
+		if _, err := tx.ExecContext(ctx, insertSampleSQL,
+			s.ProjectID,
+			sampledAt.UTC().Format(sampleTimeLayout),
+			s.Stage.String(),
+			s.Agent.String(),
+			s.Progress.StoriesDone,
+			s.Progress.StoriesTotal,
+			s.Progress.TasksDone,
+			s.Progress.TasksTotal,
+		); err != nil {
+			return fmt.Errorf("failed to record metric sample: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit metric samples: %w", err)
+	}
+	return nil
+}
+
+// sampleRow is the database row representation for scanning
+type sampleRow struct {
+	ProjectID    string `db:"project_id"`
+	SampledAt    string `db:"sampled_at"`
+	Stage        string `db:"stage"`
+	AgentStatus  string `db:"agent_status"`
+	StoriesDone  int    `db:"stories_done"`
+	StoriesTotal int    `db:"stories_total"`
+	TasksDone    int    `db:"tasks_done"`
+	TasksTotal   int    `db:"tasks_total"`
+}
+
+// FindSamples returns a project's samples at or after since, oldest first.
+func (r *Repository) FindSamples(ctx context.Context, projectID string, since time.Time) ([]domain.MetricSample, error) {
+	db, err := r.openDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var rows []sampleRow
+	if err := db.SelectContext(ctx, &rows, selectSamplesSQL, projectID, since.UTC().Format(sampleTimeLayout)); err != nil {
+		return nil, fmt.Errorf("failed to query metric samples: %w", err)
+	}
+
+	samples := make([]domain.MetricSample, 0, len(rows))
+	for _, row := range rows {
+		sampledAt, err := time.Parse(sampleTimeLayout, row.SampledAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sampled_at: %w", err)
+		}
+		// Unknown names (e.g., from a newer version) read as the zero value
+		stage, _ := domain.ParseStage(row.Stage)
+		agent, _ := domain.ParseAgentStatus(row.AgentStatus)
+		samples = append(samples, domain.MetricSample{
+			ProjectID: row.ProjectID,
+			SampledAt: sampledAt,
+			Stage:     stage,
+			Agent:     agent,
+			Progress: domain.WorkProgress{
+				StoriesDone:  row.StoriesDone,
+				StoriesTotal: row.StoriesTotal,
+				TasksDone:    row.TasksDone,
+				TasksTotal:   row.TasksTotal,
+			},
+		})
+	}
+	return samples, nil
+}
+
+// DeleteProjectSamples removes all samples for a project.
+func (r *Repository) DeleteProjectSamples(ctx context.Context, projectID string) error {
+	db, err := r.openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := db.ExecContext(ctx, deleteSamplesByProjectSQL, projectID); err != nil {
+		return fmt.Errorf("failed to delete metric samples: %w", err)
+	}
+	return nil
+}
+
+// DeleteSamplesBefore removes every project's samples taken before before.
+func (r *Repository) DeleteSamplesBefore(ctx context.Context, before time.Time) (int64, error) {
+	db, err := r.openDB(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	result, err := db.ExecContext(ctx, deleteSamplesBeforeSQL, before.UTC().Format(sampleTimeLayout))
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune metric samples: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune metric samples: %w", err)
+	}
+	return n, nil
+}
+
+// runMigrations applies pending metrics.db migrations.
+func runMigrations(ctx context.Context, db *sqlx.DB) error {
+	if _, err := db.ExecContext(ctx, createSchemaVersionTableSQL); err != nil {
+		return fmt.Errorf("failed to create schema_version table: %w", err)
+	}
+
+	var current int
+	if err := db.GetContext(ctx, &current, "SELECT COALESCE(MAX(version), 0) FROM schema_version"); err != nil {
+		return fmt.Errorf("failed to get current version: %w", err)
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		tx, err := db.BeginTxx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, m.sql); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to apply migration v%d (%s): %w", m.version, m.description, err)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO schema_version (version, applied_at) VALUES (?, ?)",
+			m.version, time.Now().Format(time.RFC3339)); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to record migration version: %w", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit migration: %w", err)
+		}
+	}
+	return nil
+}
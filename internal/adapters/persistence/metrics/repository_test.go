@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func setupRepo(t *testing.T) *Repository {
+	t.Helper()
+	repo, err := NewRepository(filepath.Join(t.TempDir(), DBFileName))
+	if err != nil {
+		t.Fatalf("NewRepository() error: %v", err)
+	}
+	return repo
+}
+
+func TestNewRepository_MissingDirectory(t *testing.T) {
+	_, err := NewRepository(filepath.Join(t.TempDir(), "missing", DBFileName))
+	if !errors.Is(err, domain.ErrPathNotAccessible) {
+		t.Errorf("expected ErrPathNotAccessible, got %v", err)
+	}
+}
+
+func TestNewRepository_Reopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DBFileName)
+	if _, err := NewRepository(path); err != nil {
+		t.Fatalf("first open: %v", err)
+	}
+	if _, err := NewRepository(path); err != nil {
+		t.Fatalf("reopen should skip applied migrations: %v", err)
+	}
+}
+
+func TestRepository_RecordAndFindSamples(t *testing.T) {
+	repo := setupRepo(t)
+	ctx := context.Background()
+	base := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	err := repo.RecordSamples(ctx, []domain.MetricSample{
+		{ProjectID: "p1", SampledAt: base.Add(10 * time.Minute), Stage: domain.StageImplement, Agent: domain.AgentWaitingForUser,
+			Progress: domain.WorkProgress{StoriesDone: 3, StoriesTotal: 8, TasksDone: 1, TasksTotal: 2}},
+		{ProjectID: "p1", SampledAt: base, Stage: domain.StagePlan, Agent: domain.AgentWorking},
+		{ProjectID: "p1", SampledAt: base.Add(-time.Hour), Stage: domain.StageSpecify},
+		{ProjectID: "p2", SampledAt: base, Stage: domain.StageTasks},
+	})
+	if err != nil {
+		t.Fatalf("RecordSamples() error: %v", err)
+	}
+
+	samples, err := repo.FindSamples(ctx, "p1", base)
+	if err != nil {
+		t.Fatalf("FindSamples() error: %v", err)
+	}
+	if len(samples) != 2 {
+		t.Fatalf("FindSamples() returned %d samples, want 2", len(samples))
+	}
+	if !samples[0].SampledAt.Equal(base) || samples[0].Stage != domain.StagePlan || samples[0].Agent != domain.AgentWorking {
+		t.Errorf("samples[0] = %+v, want oldest first", samples[0])
+	}
+	want := domain.WorkProgress{StoriesDone: 3, StoriesTotal: 8, TasksDone: 1, TasksTotal: 2}
+	if samples[1].Progress != want || samples[1].Agent != domain.AgentWaitingForUser {
+		t.Errorf("samples[1] = %+v", samples[1])
+	}
+}
+
+func TestRepository_FindSamples_EmptyNotNil(t *testing.T) {
+	repo := setupRepo(t)
+	samples, err := repo.FindSamples(context.Background(), "none", time.Time{})
+	if err != nil || samples == nil || len(samples) != 0 {
+		t.Errorf("FindSamples() = %v, %v; want empty slice", samples, err)
+	}
+}
+
+func TestRepository_RecordSamples_RequiresProjectID(t *testing.T) {
+	repo := setupRepo(t)
+	ctx := context.Background()
+	err := repo.RecordSamples(ctx, []domain.MetricSample{{ProjectID: "p1"}, {}})
+	if err == nil {
+		t.Fatal("expected error for missing project ID")
+	}
+	// The batch is atomic
+	if samples, _ := repo.FindSamples(ctx, "p1", time.Time{}); len(samples) != 0 {
+		t.Errorf("partial batch recorded: %d samples", len(samples))
+	}
+}
+
+func TestRepository_DeleteProjectSamples(t *testing.T) {
+	repo := setupRepo(t)
+	ctx := context.Background()
+	_ = repo.RecordSamples(ctx, []domain.MetricSample{{ProjectID: "p1"}, {ProjectID: "p2"}})
+
+	if err := repo.DeleteProjectSamples(ctx, "p1"); err != nil {
+		t.Fatalf("DeleteProjectSamples() error: %v", err)
+	}
+	if samples, _ := repo.FindSamples(ctx, "p1", time.Time{}); len(samples) != 0 {
+		t.Errorf("p1 samples remain: %d", len(samples))
+	}
+	if samples, _ := repo.FindSamples(ctx, "p2", time.Time{}); len(samples) != 1 {
+		t.Errorf("p2 samples = %d, want 1", len(samples))
+	}
+}
+
+func TestRepository_DeleteSamplesBefore(t *testing.T) {
+	repo := setupRepo(t)
+	ctx := context.Background()
+	cutoff := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	_ = repo.RecordSamples(ctx, []domain.MetricSample{
+		{ProjectID: "p1", SampledAt: cutoff.Add(-time.Hour)},
+		{ProjectID: "p2", SampledAt: cutoff.Add(-time.Minute)},
+		{ProjectID: "p1", SampledAt: cutoff},
+	})
+
+	n, err := repo.DeleteSamplesBefore(ctx, cutoff)
+	if err != nil || n != 2 {
+		t.Fatalf("DeleteSamplesBefore() = %d, %v; want 2", n, err)
+	}
+	if samples, _ := repo.FindSamples(ctx, "p1", time.Time{}); len(samples) != 1 || !samples[0].SampledAt.Equal(cutoff) {
+		t.Errorf("p1 samples = %+v, want only the one at the cutoff", samples)
+	}
+}
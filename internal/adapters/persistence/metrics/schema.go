@@ -0,0 +1,69 @@
+package metrics
+
+// SchemaVersion is the current metrics.db schema version
+const SchemaVersion = 2
+
+// createSchemaVersionTableSQL tracks applied migrations (same layout as state.db)
+const createSchemaVersionTableSQL = `
+CREATE TABLE IF NOT EXISTS schema_version (
+    version INTEGER PRIMARY KEY,
+    applied_at TEXT NOT NULL
+);`
+
+// createSamplesTableSQL stores raw periodic samples; aggregation happens on read.
+// Stage and agent status are stored by name so enum reordering cannot corrupt history.
+const createSamplesTableSQL = `
+CREATE TABLE IF NOT EXISTS metric_samples (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id TEXT NOT NULL,
+    sampled_at TEXT NOT NULL,
+    stage TEXT NOT NULL,
+    agent_status TEXT NOT NULL,
+    stories_done INTEGER NOT NULL DEFAULT 0,
+    stories_total INTEGER NOT NULL DEFAULT 0,
+    tasks_done INTEGER NOT NULL DEFAULT 0,
+    tasks_total INTEGER NOT NULL DEFAULT 0
+);`
+
+// createIndexSamplesSQL supports per-project time range queries
+const createIndexSamplesSQL = `CREATE INDEX IF NOT EXISTS idx_metric_samples_project_time ON metric_samples(project_id, sampled_at);`
+
+// createIndexSampledAtSQL supports pruning samples past the retention period
+const createIndexSampledAtSQL = `CREATE INDEX IF NOT EXISTS idx_metric_samples_time ON metric_samples(sampled_at);`
+
+// migration is one metrics.db schema change
+type migration struct {
+	version     int
+	description string
+	sql         string
+}
+
+// migrations is the ordered list of metrics.db migrations
+var migrations = []migration{
+	{
+		version:     1,
+		description: "Initial schema with metric_samples table",
+		sql:         createSamplesTableSQL + "\n" + createIndexSamplesSQL,
+	},
+	{
+		version:     2,
+		description: "Index sampled_at for retention pruning",
+		sql:         createIndexSampledAtSQL,
+	},
+}
+
+// sampleColumns is the column list for SELECT queries
+const sampleColumns = `project_id, sampled_at, stage, agent_status, stories_done, stories_total, tasks_done, tasks_total`
+
+// insertSampleSQL appends one sample
+const insertSampleSQL = `INSERT INTO metric_samples (` + sampleColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+// selectSamplesSQL returns a project's samples since a time, oldest first
+const selectSamplesSQL = `SELECT ` + sampleColumns + ` FROM metric_samples
+WHERE project_id = ? AND sampled_at >= ? ORDER BY sampled_at ASC, id ASC`
+
+// deleteSamplesByProjectSQL removes all of a project's samples
+const deleteSamplesByProjectSQL = `DELETE FROM metric_samples WHERE project_id = ?`
+
+// deleteSamplesBeforeSQL removes every project's samples taken before a time
+const deleteSamplesBeforeSQL = `DELETE FROM metric_samples WHERE sampled_at < ?`
@@ -20,8 +20,19 @@ type projectRow struct {
 	DisplayName        sql.NullString `db:"display_name"`
 	DetectedMethod     sql.NullString `db:"detected_method"`
 	CurrentStage       string         `db:"current_stage"`
+	CoarseStage        sql.NullString `db:"coarse_stage"`
+	StageLabel         sql.NullString `db:"stage_label"`
+	StageOrdinal       int            `db:"stage_ordinal"`
+	StageDone          int            `db:"stage_done"`
 	Confidence         sql.NullString `db:"confidence"`
 	DetectionReasoning sql.NullString `db:"detection_reasoning"`
+	TasksDone          int            `db:"tasks_done"`
+	TasksTotal         int            `db:"tasks_total"`
+	NextTask           sql.NullString `db:"next_task"`
+	NextTaskPhase      sql.NullString `db:"next_task_phase"`
+	TaskPhases         sql.NullString `db:"task_phases"`
+	SprintProgress     sql.NullString `db:"sprint_progress"`
+	ActiveChange       sql.NullString `db:"active_change"`
 	IsFavorite         int            `db:"is_favorite"`
 	State              string         `db:"state"`
 	Notes              sql.NullString `db:"notes"`
@@ -58,7 +69,7 @@ func rowToProject(row *projectRow) (*domain.Project, error) {
 	}
 
 	// Parse enums (use zero value on error)
-	stage, _ := domain.ParseStage(row.CurrentStage)
+	stage, methodStage := rowToStage(row)
 	state, _ := domain.ParseProjectState(row.State)
 	confidence, _ := domain.ParseConfidence(row.Confidence.String)
 
@@ -69,8 +80,16 @@ func rowToProject(row *projectRow) (*domain.Project, error) {
 		DisplayName:        row.DisplayName.String,
 		DetectedMethod:     row.DetectedMethod.String,
 		CurrentStage:       stage,
+		MethodStage:        methodStage,
 		Confidence:         confidence,
 		DetectionReasoning: row.DetectionReasoning.String,
+		TasksDone:          row.TasksDone,
+		TasksTotal:         row.TasksTotal,
+		NextTask:           row.NextTask.String,
+		NextTaskPhase:      row.NextTaskPhase.String,
+		TaskPhases:         taskPhasesFromJSON(row.TaskPhases),
+		Sprint:             sprintFromJSON(row.SprintProgress),
+		ActiveChange:       row.ActiveChange.String,
 		IsFavorite:         row.IsFavorite == 1,
 		State:              state,
 		Notes:              row.Notes.String,
@@ -82,6 +101,65 @@ func rowToProject(row *projectRow) (*domain.Project, error) {
 	}, nil
 }
 
+// rowToStage returns the coarse stage and the methodology stage of a row.
+// current_stage holds the method's stage ID when stage_label is set, and the
+// coarse stage name otherwise (rows written before v7, or unmapped stages).
+func rowToStage(row *projectRow) (domain.Stage, domain.MethodStage) {
+	coarseName := row.CurrentStage
+	if row.CoarseStage.Valid {
+		coarseName = row.CoarseStage.String
+	}
+	stage, _ := domain.ParseStage(coarseName)
+
+	if !row.StageLabel.Valid {
+		return stage, domain.MethodStage{}
+	}
+	return stage, domain.MethodStage{
+		ID:      row.CurrentStage,
+		Label:   row.StageLabel.String,
+		Ordinal: row.StageOrdinal,
+		Done:    row.StageDone == 1,
+		Coarse:  stage,
+	}
+}
+
+// stageID returns the value stored in current_stage: the methodology's stage
+// ID when mapped, otherwise the coarse stage name.
+func stageID(p *domain.Project) string {
+	if !p.MethodStage.IsZero() {
+		return p.MethodStage.ID
+	}
+	return p.CurrentStage.String()
+}
+
+// eventRow is the database row representation of a project_events row
+type eventRow struct {
+	ID         int64          `db:"id"`
+	ProjectID  string         `db:"project_id"`
+	EventType  string         `db:"event_type"`
+	FromValue  sql.NullString `db:"from_value"`
+	ToValue    sql.NullString `db:"to_value"`
+	Detail     sql.NullString `db:"detail"`
+	OccurredAt string         `db:"occurred_at"`
+}
+
+// rowToEvent converts a database row to a domain ProjectEvent
+func rowToEvent(row *eventRow) (domain.ProjectEvent, error) {
+	occurred, err := time.Parse(time.RFC3339Nano, row.OccurredAt)
+	if err != nil {
+		return domain.ProjectEvent{}, fmt.Errorf("invalid occurred_at: %w", err)
+	}
+	return domain.ProjectEvent{
+		ID:         row.ID,
+		ProjectID:  row.ProjectID,
+		Type:       domain.EventType(row.EventType),
+		From:       row.FromValue.String,
+		To:         row.ToValue.String,
+		Detail:     row.Detail.String,
+		OccurredAt: occurred,
+	}, nil
+}
+
 // nullString converts a string to sql.NullString, treating empty strings as NULL
 func nullString(s string) sql.NullString {
 	if s == "" {
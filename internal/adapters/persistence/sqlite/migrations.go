@@ -35,6 +35,45 @@ var migrations = []Migration{
 		Description: "Add hibernated_at column to projects",
 		SQL:         "ALTER TABLE projects ADD COLUMN hibernated_at TEXT;",
 	},
+	{
+		Version:     4,
+		Description: "Add project_events history table",
+		SQL:         CreateProjectEventsTableSQL + "\n" + CreateIndexProjectEventsSQL,
+	},
+	{
+		Version:     5,
+		Description: "Add task progress columns to projects",
+		SQL: "ALTER TABLE projects ADD COLUMN tasks_done INTEGER DEFAULT 0;\n" +
+			"ALTER TABLE projects ADD COLUMN tasks_total INTEGER DEFAULT 0;\n" +
+			"ALTER TABLE projects ADD COLUMN next_task TEXT;",
+	},
+	{
+		Version:     6,
+		Description: "Add sprint_progress column to projects",
+		SQL:         "ALTER TABLE projects ADD COLUMN sprint_progress TEXT;",
+	},
+	{
+		// current_stage keeps its name but stores the methodology's stage ID from
+		// now on; rows written before hold a coarse stage name, copied here.
+		Version:     7,
+		Description: "Add methodology stage columns to projects",
+		SQL: "ALTER TABLE projects ADD COLUMN coarse_stage TEXT;\n" +
+			"ALTER TABLE projects ADD COLUMN stage_label TEXT;\n" +
+			"ALTER TABLE projects ADD COLUMN stage_ordinal INTEGER DEFAULT 0;\n" +
+			"ALTER TABLE projects ADD COLUMN stage_done INTEGER DEFAULT 0;\n" +
+			"UPDATE projects SET coarse_stage = current_stage;",
+	},
+	{
+		Version:     8,
+		Description: "Add active_change column to projects",
+		SQL:         "ALTER TABLE projects ADD COLUMN active_change TEXT;",
+	},
+	{
+		Version:     9,
+		Description: "Add task phase columns to projects",
+		SQL: "ALTER TABLE projects ADD COLUMN next_task_phase TEXT;\n" +
+			"ALTER TABLE projects ADD COLUMN task_phases TEXT;",
+	},
 }
 
 // RunMigrations applies all pending migrations to the database
@@ -0,0 +1,141 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// eventTimeLayout is a fixed-width UTC timestamp so occurred_at sorts lexically.
+// (RFC3339Nano trims trailing zeros, which breaks string ordering within a second.)
+const eventTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// Compile-time interface check
+var _ ports.ProjectEventRepository = (*ProjectRepository)(nil)
+
+// AppendEvent records a history event in the project's database.
+// OccurredAt defaults to now when zero.
+func (r *ProjectRepository) AppendEvent(ctx context.Context, event domain.ProjectEvent) error {
+	if event.ProjectID == "" {
+		return fmt.Errorf("invalid event: project ID is required")
+	}
+	if event.Type == "" {
+		return fmt.Errorf("invalid event: %w", domain.ErrInvalidEventType)
+	}
+
+	db, err := r.openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	occurred := event.OccurredAt
+	if occurred.IsZero() {
+		occurred = time.Now()
+	}
+
+	_, err = db.ExecContext(ctx, insertEventSQL,
+		event.ProjectID,
+		string(event.Type),
+		nullString(event.From),
+		nullString(event.To),
+		nullString(event.Detail),
+		occurred.UTC().Format(eventTimeLayout),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append event: %w", err)
+	}
+	return nil
+}
+
+// FindEvents returns a project's events in chronological order (oldest first).
+// Returns an empty slice (not nil) if no events match.
+func (r *ProjectRepository) FindEvents(ctx context.Context, projectID string, filter ports.EventFilter) ([]domain.ProjectEvent, error) {
+	db, err := r.openDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	query, args, err := buildFindEventsQuery(projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []eventRow
+	if err := db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+
+	// Rows are newest-first so LIMIT keeps the most recent; reverse for a timeline
+	events := make([]domain.ProjectEvent, len(rows))
+	for i := range rows {
+		event, err := rowToEvent(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		events[len(rows)-1-i] = event
+	}
+	return events, nil
+}
+
+// LastEvent returns the newest event of eventType, or nil if none exists.
+func (r *ProjectRepository) LastEvent(ctx context.Context, projectID string, eventType domain.EventType) (*domain.ProjectEvent, error) {
+	db, err := r.openDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var row eventRow
+	if err := db.GetContext(ctx, &row, selectLastEventSQL, projectID, string(eventType)); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query last event: %w", err)
+	}
+
+	event, err := rowToEvent(&row)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// buildFindEventsQuery assembles the filtered SELECT for FindEvents.
+func buildFindEventsQuery(projectID string, filter ports.EventFilter) (string, []interface{}, error) {
+	var sb strings.Builder
+	sb.WriteString(`SELECT ` + eventColumns + ` FROM project_events WHERE project_id = ?`)
+	args := []interface{}{projectID}
+
+	if len(filter.Types) > 0 {
+		types := make([]string, len(filter.Types))
+		for i, t := range filter.Types {
+			types[i] = string(t)
+		}
+		clause, inArgs, err := sqlx.In(` AND event_type IN (?)`, types)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to build event filter: %w", err)
+		}
+		sb.WriteString(clause)
+		args = append(args, inArgs...)
+	}
+	if !filter.Since.IsZero() {
+		sb.WriteString(` AND occurred_at >= ?`)
+		args = append(args, filter.Since.UTC().Format(eventTimeLayout))
+	}
+
+	sb.WriteString(` ORDER BY occurred_at DESC, id DESC`)
+	if filter.Limit > 0 {
+		sb.WriteString(` LIMIT ?`)
+		args = append(args, filter.Limit)
+	}
+	return sb.String(), args, nil
+}
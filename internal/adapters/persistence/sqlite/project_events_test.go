@@ -0,0 +1,185 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+func TestProjectRepository_AppendEvent_Validation(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+
+	if err := repo.AppendEvent(ctx, domain.ProjectEvent{Type: domain.EventHibernated}); err == nil {
+		t.Error("expected error for missing project ID")
+	}
+
+	err := repo.AppendEvent(ctx, domain.ProjectEvent{ProjectID: "p1"})
+	if !errors.Is(err, domain.ErrInvalidEventType) {
+		t.Errorf("expected ErrInvalidEventType, got %v", err)
+	}
+}
+
+func TestProjectRepository_FindEvents_ChronologicalOrder(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+	base := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	// Insert out of order; same-second events must still sort correctly
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventStageChanged, "Plan", "Tasks", "", base.Add(2*time.Hour)))
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventProjectAdded, "", "Plan", "speckit", base))
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventStageChanged, "Tasks", "Implement", "", base.Add(2*time.Hour+500*time.Millisecond)))
+	appendTestEvent(t, repo, domain.NewProjectEvent("other", domain.EventHibernated, "Active", "Hibernated", "", base))
+
+	events, err := repo.FindEvents(ctx, "p1", ports.EventFilter{})
+	if err != nil {
+		t.Fatalf("FindEvents returned error: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+
+	wantTo := []string{"Plan", "Tasks", "Implement"}
+	for i, e := range events {
+		if e.To != wantTo[i] {
+			t.Errorf("events[%d].To = %q, want %q", i, e.To, wantTo[i])
+		}
+		if e.ID == 0 {
+			t.Errorf("events[%d].ID not assigned", i)
+		}
+	}
+	if !events[0].OccurredAt.Equal(base) {
+		t.Errorf("OccurredAt = %v, want %v", events[0].OccurredAt, base)
+	}
+	if events[0].Detail != "speckit" || events[0].From != "" {
+		t.Errorf("unexpected first event fields: %+v", events[0])
+	}
+}
+
+func TestProjectRepository_FindEvents_Filters(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+	base := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventProjectAdded, "", "Plan", "", base))
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventStageChanged, "Plan", "Tasks", "", base.Add(time.Hour)))
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventHibernated, "Active", "Hibernated", "", base.Add(2*time.Hour)))
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventStageChanged, "Tasks", "Implement", "", base.Add(3*time.Hour)))
+
+	tests := []struct {
+		name   string
+		filter ports.EventFilter
+		wantTo []string
+	}{
+		{"limit keeps most recent", ports.EventFilter{Limit: 2}, []string{"Hibernated", "Implement"}},
+		{"type filter", ports.EventFilter{Types: []domain.EventType{domain.EventStageChanged}}, []string{"Tasks", "Implement"}},
+		{"multiple types", ports.EventFilter{Types: []domain.EventType{domain.EventProjectAdded, domain.EventHibernated}}, []string{"Plan", "Hibernated"}},
+		{"since", ports.EventFilter{Since: base.Add(90 * time.Minute)}, []string{"Hibernated", "Implement"}},
+		{"type and limit", ports.EventFilter{Types: []domain.EventType{domain.EventStageChanged}, Limit: 1}, []string{"Implement"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := repo.FindEvents(ctx, "p1", tt.filter)
+			if err != nil {
+				t.Fatalf("FindEvents returned error: %v", err)
+			}
+			if len(events) != len(tt.wantTo) {
+				t.Fatalf("expected %d events, got %d", len(tt.wantTo), len(events))
+			}
+			for i, e := range events {
+				if e.To != tt.wantTo[i] {
+					t.Errorf("events[%d].To = %q, want %q", i, e.To, tt.wantTo[i])
+				}
+			}
+		})
+	}
+}
+
+func TestProjectRepository_FindEvents_Empty(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+
+	events, err := repo.FindEvents(context.Background(), "missing", ports.EventFilter{})
+	if err != nil {
+		t.Fatalf("FindEvents returned error: %v", err)
+	}
+	if events == nil || len(events) != 0 {
+		t.Errorf("expected empty non-nil slice, got %v", events)
+	}
+}
+
+func TestProjectRepository_LastEvent(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+	base := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	last, err := repo.LastEvent(ctx, "p1", domain.EventAgentStateChanged)
+	if err != nil {
+		t.Fatalf("LastEvent returned error: %v", err)
+	}
+	if last != nil {
+		t.Fatalf("expected nil when no events, got %+v", last)
+	}
+
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventAgentStateChanged, "", "Working", "Claude Code", base))
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventAgentStateChanged, "Working", "WaitingForUser", "Claude Code", base.Add(time.Minute)))
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventStageChanged, "Plan", "Tasks", "", base.Add(time.Hour)))
+
+	last, err = repo.LastEvent(ctx, "p1", domain.EventAgentStateChanged)
+	if err != nil {
+		t.Fatalf("LastEvent returned error: %v", err)
+	}
+	if last == nil || last.To != "WaitingForUser" {
+		t.Errorf("expected last agent event To=WaitingForUser, got %+v", last)
+	}
+}
+
+func TestProjectRepository_AppendEvent_DefaultsOccurredAt(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+
+	before := time.Now().Add(-time.Second)
+	appendTestEvent(t, repo, domain.ProjectEvent{ProjectID: "p1", Type: domain.EventActivated})
+
+	last, err := repo.LastEvent(ctx, "p1", domain.EventActivated)
+	if err != nil || last == nil {
+		t.Fatalf("LastEvent = %v, %v", last, err)
+	}
+	if last.OccurredAt.Before(before) {
+		t.Errorf("expected OccurredAt to default to now, got %v", last.OccurredAt)
+	}
+}
+
+func TestProjectRepository_Delete_RemovesEvents(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+
+	project := createTestProject("p1", "events", "/path/to/events")
+	if err := repo.Save(ctx, project); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	appendTestEvent(t, repo, domain.NewProjectEvent("p1", domain.EventHibernated, "Active", "Hibernated", "", time.Now()))
+
+	if err := repo.Delete(ctx, "p1"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+
+	events, err := repo.FindEvents(ctx, "p1", ports.EventFilter{})
+	if err != nil {
+		t.Fatalf("FindEvents returned error: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected events to be deleted, got %d", len(events))
+	}
+}
+
+func appendTestEvent(t *testing.T, repo *ProjectRepository, event domain.ProjectEvent) {
+	t.Helper()
+	if err := repo.AppendEvent(context.Background(), event); err != nil {
+		t.Fatalf("AppendEvent returned error: %v", err)
+	}
+}
@@ -111,6 +111,15 @@ func (r *ProjectRepository) Save(ctx context.Context, project *domain.Project) e
 	}
 	defer db.Close()
 
+	sprint, err := sprintToJSON(project.Sprint)
+	if err != nil {
+		return fmt.Errorf("failed to encode sprint progress: %w", err)
+	}
+	phases, err := taskPhasesToJSON(project.TaskPhases)
+	if err != nil {
+		return fmt.Errorf("failed to encode task phases: %w", err)
+	}
+
 	project.UpdatedAt = time.Now()
 
 	_, err = db.ExecContext(ctx, insertOrReplaceProjectSQL,
@@ -119,9 +128,20 @@ func (r *ProjectRepository) Save(ctx context.Context, project *domain.Project) e
 		project.Path,
 		nullString(project.DisplayName),
 		nullString(project.DetectedMethod),
+		stageID(project),
 		project.CurrentStage.String(),
+		nullString(project.MethodStage.Label),
+		project.MethodStage.Ordinal,
+		boolToInt(project.MethodStage.Done),
 		nullString(project.Confidence.String()),
 		nullString(project.DetectionReasoning),
+		project.TasksDone,
+		project.TasksTotal,
+		nullString(project.NextTask),
+		nullString(project.NextTaskPhase),
+		phases,
+		sprint,
+		nullString(project.ActiveChange),
 		boolToInt(project.IsFavorite),
 		stateToString(project.State),
 		nullString(project.Notes),
@@ -280,6 +300,11 @@ func (r *ProjectRepository) Delete(ctx context.Context, id string) error {
 	if rowsAffected == 0 {
 		return domain.ErrProjectNotFound
 	}
+
+	// History belongs to the project; drop it with the row
+	if _, err := db.ExecContext(ctx, deleteEventsByProjectSQL, id); err != nil {
+		return fmt.Errorf("failed to delete project events: %w", err)
+	}
 	return nil
 }
 
@@ -5,11 +5,14 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/jmoiron/sqlx"
+
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 )
 
@@ -257,6 +260,208 @@ func TestProjectRepository_Save(t *testing.T) {
 	}
 }
 
+func TestProjectRepository_Save_TaskProgress(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+
+	project := createTestProject("test-id-tasks", "tasks-project", "/path/to/tasks-project")
+	project.TasksDone = 12
+	project.TasksTotal = 40
+	project.NextTask = "T013 Add login endpoint"
+	project.NextTaskPhase = "Phase 2: API"
+	project.TaskPhases = []domain.TaskPhase{
+		{Name: "Phase 1: Setup", Done: 10, Total: 10},
+		{Name: "Phase 2: API", Done: 2, Total: 30, Parallel: 4},
+	}
+	if err := repo.Save(ctx, project); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.TasksDone != 12 || found.TasksTotal != 40 || found.NextTask != "T013 Add login endpoint" {
+		t.Errorf("tasks = %d/%d next %q, want 12/40 next T013", found.TasksDone, found.TasksTotal, found.NextTask)
+	}
+	if found.NextTaskPhase != "Phase 2: API" || !reflect.DeepEqual(found.TaskPhases, project.TaskPhases) {
+		t.Errorf("phases = %+v next in %q, want %+v next in Phase 2", found.TaskPhases, found.NextTaskPhase, project.TaskPhases)
+	}
+
+	project.NextTaskPhase = ""
+	project.TaskPhases = nil
+	if err := repo.Save(ctx, project); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if found, _ = repo.FindByID(ctx, project.ID); found.TaskPhases != nil || found.NextTaskPhase != "" {
+		t.Errorf("phases = %+v next in %q after clearing, want none", found.TaskPhases, found.NextTaskPhase)
+	}
+}
+
+func TestProjectRepository_Save_ActiveChange(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+
+	project := createTestProject("test-id-change", "change-project", "/path/to/change-project")
+	project.DetectedMethod = "openspec"
+	project.ActiveChange = "add-2fa"
+	if err := repo.Save(ctx, project); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.ActiveChange != "add-2fa" {
+		t.Errorf("ActiveChange = %q, want add-2fa", found.ActiveChange)
+	}
+
+	project.ActiveChange = ""
+	if err := repo.Save(ctx, project); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if found, _ = repo.FindByID(ctx, project.ID); found.ActiveChange != "" {
+		t.Errorf("ActiveChange = %q after clearing, want empty", found.ActiveChange)
+	}
+}
+
+func TestProjectRepository_Save_SprintProgress(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+
+	sprint := &domain.SprintProgress{
+		Epics: []domain.SprintEpic{
+			{Key: "epic-1", ID: "1", Status: domain.WorkDone, Retrospective: domain.WorkDone},
+			{Key: "epic-4-5", ID: "4.5", Status: domain.WorkInProgress, Stories: []domain.SprintStory{
+				{Key: "4-5-1-a", ID: "4.5.1", Status: domain.WorkDone, RawStatus: "done"},
+				{Key: "4-5-2-b", ID: "4.5.2", Status: domain.WorkUnknown, RawStatus: "blocked"},
+			}},
+		},
+		Focus:    &domain.SprintFocus{Kind: domain.FocusStory, Epic: "4.5", Story: "4.5.2", Status: domain.WorkUnknown},
+		Warnings: []domain.SprintWarning{{Kind: domain.WarningOrphanStory, Key: "9-1-x", Detail: "9.1"}},
+	}
+
+	project := createTestProject("test-id-sprint", "sprint-project", "/path/to/sprint-project")
+	project.Sprint = sprint
+	if err := repo.Save(ctx, project); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !reflect.DeepEqual(found.Sprint, sprint) {
+		t.Errorf("Sprint = %+v, want %+v", found.Sprint, sprint)
+	}
+
+	// Clearing the sprint (e.g. method changed) stores NULL
+	project.Sprint = nil
+	if err := repo.Save(ctx, project); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	found, err = repo.FindByID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Sprint != nil {
+		t.Errorf("Sprint = %+v, want nil", found.Sprint)
+	}
+}
+
+func TestProjectRepository_Save_MethodStage(t *testing.T) {
+	repo, _ := setupProjectRepo(t)
+	ctx := context.Background()
+
+	review := domain.MethodStage{ID: "code-review", Label: "Code review", Ordinal: 6, Coarse: domain.StageTasks}
+	project := createTestProject("test-id-stage", "stage-project", "/path/to/stage-project")
+	project.CurrentStage = domain.StageTasks
+	project.MethodStage = review
+	if err := repo.Save(ctx, project); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.CurrentStage != domain.StageTasks || found.MethodStage != review {
+		t.Errorf("stage = %v %+v, want Tasks %+v", found.CurrentStage, found.MethodStage, review)
+	}
+
+	// current_stage stores the methodology's stage ID
+	db, err := repo.openDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	defer db.Close()
+	var stored string
+	if err := db.Get(&stored, "SELECT current_stage FROM projects WHERE id = ?", project.ID); err != nil {
+		t.Fatal(err)
+	}
+	if stored != "code-review" {
+		t.Errorf("current_stage = %q, want %q", stored, "code-review")
+	}
+
+	// Unmapped stages store the coarse name and load without a method stage
+	project.MethodStage = domain.MethodStage{}
+	project.CurrentStage = domain.StagePlan
+	if err := repo.Save(ctx, project); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	found, err = repo.FindByID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.CurrentStage != domain.StagePlan || !found.MethodStage.IsZero() {
+		t.Errorf("stage = %v %+v, want Plan without method stage", found.CurrentStage, found.MethodStage)
+	}
+}
+
+func TestRunMigrations_V7KeepsCoarseStage(t *testing.T) {
+	ctx := context.Background()
+	db, err := sqlx.ConnectContext(ctx, "sqlite3", filepath.Join(t.TempDir(), "state.db"))
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	defer db.Close()
+
+	// Database as written by a v6 binary
+	if _, err := db.ExecContext(ctx, CreateSchemaVersionTableSQL); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range migrations[:6] {
+		if err := applyMigration(ctx, db, m); err != nil {
+			t.Fatalf("migration v%d: %v", m.Version, err)
+		}
+	}
+	now := time.Now().Format(time.RFC3339Nano)
+	if _, err := db.ExecContext(ctx, `INSERT INTO projects (id, name, path, current_stage, last_activity_at, created_at, updated_at)
+		VALUES ('p1', 'legacy', '/legacy', 'Implement', ?, ?, ?)`, now, now, now); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RunMigrations(ctx, db); err != nil {
+		t.Fatalf("RunMigrations() error = %v", err)
+	}
+
+	var row projectRow
+	if err := db.GetContext(ctx, &row, selectByIDSQL, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if row.CoarseStage.String != "Implement" {
+		t.Errorf("coarse_stage = %q, want copied from current_stage", row.CoarseStage.String)
+	}
+	project, err := rowToProject(&row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.CurrentStage != domain.StageImplement || !project.MethodStage.IsZero() {
+		t.Errorf("stage = %v %+v, want Implement without method stage", project.CurrentStage, project.MethodStage)
+	}
+}
+
 func TestProjectRepository_FindByID(t *testing.T) {
 	repo, _ := setupProjectRepo(t)
 	ctx := context.Background()
@@ -2,13 +2,14 @@ package sqlite
 
 // projectColumns lists all columns for SELECT queries (DRY)
 const projectColumns = `id, name, path, display_name, detected_method, current_stage,
-       confidence, detection_reasoning, is_favorite, state, notes, path_missing,
-       hibernated_at, last_activity_at, created_at, updated_at`
+       coarse_stage, stage_label, stage_ordinal, stage_done, confidence, detection_reasoning, tasks_done, tasks_total, next_task,
+       next_task_phase, task_phases, sprint_progress, active_change, is_favorite, state, notes, path_missing, hibernated_at, last_activity_at,
+       created_at, updated_at`
 
 // insertOrReplaceProjectSQL upserts a project by ID
 const insertOrReplaceProjectSQL = `
 INSERT OR REPLACE INTO projects (` + projectColumns + `)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 // selectByIDSQL retrieves a project by its unique identifier
 const selectByIDSQL = `SELECT ` + projectColumns + ` FROM projects WHERE id = ?`
@@ -33,3 +34,18 @@ const updateStateSQL = `UPDATE projects SET state = ?, updated_at = ? WHERE id =
 
 // updateLastActivitySQL updates only the last_activity_at and updated_at fields
 const updateLastActivitySQL = `UPDATE projects SET last_activity_at = ?, updated_at = ? WHERE id = ?`
+
+// eventColumns lists all columns for project_events SELECT queries
+const eventColumns = `id, project_id, event_type, from_value, to_value, detail, occurred_at`
+
+// insertEventSQL appends a history event
+const insertEventSQL = `
+INSERT INTO project_events (project_id, event_type, from_value, to_value, detail, occurred_at)
+VALUES (?, ?, ?, ?, ?, ?)`
+
+// selectLastEventSQL retrieves the newest event of one type for a project
+const selectLastEventSQL = `SELECT ` + eventColumns + ` FROM project_events
+WHERE project_id = ? AND event_type = ? ORDER BY occurred_at DESC, id DESC LIMIT 1`
+
+// deleteEventsByProjectSQL removes a project's history (used with deleteByIDSQL)
+const deleteEventsByProjectSQL = `DELETE FROM project_events WHERE project_id = ?`
@@ -1,7 +1,7 @@
 package sqlite
 
 // SchemaVersion is the current schema version for migrations
-const SchemaVersion = 3
+const SchemaVersion = 9
 
 // CreateSchemaVersionTableSQL creates the schema_version table for tracking migrations
 const CreateSchemaVersionTableSQL = `
@@ -14,12 +14,21 @@ CREATE TABLE IF NOT EXISTS schema_version (
 // IMPORTANT: Additional columns are added via migrations (see migrations.go):
 //   - v2: path_missing INTEGER DEFAULT 0
 //   - v3: hibernated_at TEXT
+//   - v5: tasks_done INTEGER DEFAULT 0, tasks_total INTEGER DEFAULT 0, next_task TEXT
+//   - v6: sprint_progress TEXT (JSON epic/story tree, see sprint_record.go)
+//   - v7: coarse_stage TEXT, stage_label TEXT, stage_ordinal INTEGER DEFAULT 0,
+//     stage_done INTEGER DEFAULT 0 (current_stage now holds the method's stage ID)
+//   - v8: active_change TEXT (OpenSpec change ID)
+//   - v9: next_task_phase TEXT, task_phases TEXT (JSON per-heading task counts, see task_record.go)
 //
 // The full schema after all migrations:
 //
 //	id, name, path, display_name, detected_method, current_stage,
-//	confidence, detection_reasoning, is_favorite, state, notes,
-//	path_missing, hibernated_at, last_activity_at, created_at, updated_at
+//	coarse_stage, stage_label, stage_ordinal, stage_done,
+//	confidence, detection_reasoning, tasks_done, tasks_total, next_task,
+//	next_task_phase, task_phases, sprint_progress, active_change,
+//	is_favorite, state, notes, path_missing, hibernated_at,
+//	last_activity_at, created_at, updated_at
 const CreateProjectsTableSQL = `
 CREATE TABLE IF NOT EXISTS projects (
     id TEXT PRIMARY KEY,
@@ -41,3 +50,19 @@ CREATE TABLE IF NOT EXISTS projects (
 // CreateIndexesSQL creates indexes for common queries
 const CreateIndexPathSQL = `CREATE INDEX IF NOT EXISTS idx_projects_path ON projects(path);`
 const CreateIndexStateSQL = `CREATE INDEX IF NOT EXISTS idx_projects_state ON projects(state);`
+
+// CreateProjectEventsTableSQL creates the append-only project history table (v4).
+// Rows are written on stage/method/state/agent transitions and never updated.
+const CreateProjectEventsTableSQL = `
+CREATE TABLE IF NOT EXISTS project_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    from_value TEXT,
+    to_value TEXT,
+    detail TEXT,
+    occurred_at TEXT NOT NULL
+);`
+
+// CreateIndexProjectEventsSQL supports per-project timeline queries
+const CreateIndexProjectEventsSQL = `CREATE INDEX IF NOT EXISTS idx_project_events_project_time ON project_events(project_id, occurred_at);`
@@ -0,0 +1,134 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// sprintRecord is the JSON stored in the sprint_progress column.
+// Domain types carry no storage tags, so the tree is copied into these records.
+type sprintRecord struct {
+	Epics    []sprintEpicRecord    `json:"epics"`
+	Focus    *sprintFocusRecord    `json:"focus,omitempty"`
+	Warnings []sprintWarningRecord `json:"warnings,omitempty"`
+}
+
+type sprintEpicRecord struct {
+	Key           string              `json:"key"`
+	ID            string              `json:"id"`
+	Status        string              `json:"status"`
+	Retrospective string              `json:"retrospective,omitempty"`
+	Stories       []sprintStoryRecord `json:"stories,omitempty"`
+}
+
+type sprintStoryRecord struct {
+	Key       string `json:"key"`
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	RawStatus string `json:"raw_status,omitempty"`
+}
+
+type sprintFocusRecord struct {
+	Kind   string `json:"kind"`
+	Epic   string `json:"epic,omitempty"`
+	Story  string `json:"story,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type sprintWarningRecord struct {
+	Kind   string `json:"kind"`
+	Key    string `json:"key"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// sprintToJSON encodes sprint progress for storage, NULL when there is none
+func sprintToJSON(s *domain.SprintProgress) (sql.NullString, error) {
+	if s == nil {
+		return sql.NullString{}, nil
+	}
+
+	rec := sprintRecord{Epics: make([]sprintEpicRecord, 0, len(s.Epics))}
+	for _, e := range s.Epics {
+		epic := sprintEpicRecord{
+			Key:           e.Key,
+			ID:            e.ID,
+			Status:        string(e.Status),
+			Retrospective: string(e.Retrospective),
+		}
+		for _, story := range e.Stories {
+			epic.Stories = append(epic.Stories, sprintStoryRecord{
+				Key:       story.Key,
+				ID:        story.ID,
+				Status:    string(story.Status),
+				RawStatus: story.RawStatus,
+			})
+		}
+		rec.Epics = append(rec.Epics, epic)
+	}
+	if s.Focus != nil {
+		rec.Focus = &sprintFocusRecord{
+			Kind:   string(s.Focus.Kind),
+			Epic:   s.Focus.Epic,
+			Story:  s.Focus.Story,
+			Status: string(s.Focus.Status),
+		}
+	}
+	for _, w := range s.Warnings {
+		rec.Warnings = append(rec.Warnings, sprintWarningRecord{Kind: string(w.Kind), Key: w.Key, Detail: w.Detail})
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// sprintFromJSON decodes stored sprint progress. NULL or unreadable JSON
+// yields nil; the next detection rewrites the column.
+func sprintFromJSON(ns sql.NullString) *domain.SprintProgress {
+	if !ns.Valid || ns.String == "" {
+		return nil
+	}
+	var rec sprintRecord
+	if err := json.Unmarshal([]byte(ns.String), &rec); err != nil {
+		return nil
+	}
+
+	s := &domain.SprintProgress{Epics: make([]domain.SprintEpic, 0, len(rec.Epics))}
+	for _, e := range rec.Epics {
+		epic := domain.SprintEpic{
+			Key:           e.Key,
+			ID:            e.ID,
+			Status:        domain.WorkStatus(e.Status),
+			Retrospective: domain.WorkStatus(e.Retrospective),
+		}
+		for _, story := range e.Stories {
+			epic.Stories = append(epic.Stories, domain.SprintStory{
+				Key:       story.Key,
+				ID:        story.ID,
+				Status:    domain.WorkStatus(story.Status),
+				RawStatus: story.RawStatus,
+			})
+		}
+		s.Epics = append(s.Epics, epic)
+	}
+	if rec.Focus != nil {
+		s.Focus = &domain.SprintFocus{
+			Kind:   domain.SprintFocusKind(rec.Focus.Kind),
+			Epic:   rec.Focus.Epic,
+			Story:  rec.Focus.Story,
+			Status: domain.WorkStatus(rec.Focus.Status),
+		}
+	}
+	for _, w := range rec.Warnings {
+		s.Warnings = append(s.Warnings, domain.SprintWarning{
+			Kind:   domain.SprintWarningKind(w.Kind),
+			Key:    w.Key,
+			Detail: w.Detail,
+		})
+	}
+	return s
+}
@@ -0,0 +1,53 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// taskPhaseRecord is one entry of the JSON stored in the task_phases column.
+type taskPhaseRecord struct {
+	Name     string `json:"name"`
+	Done     int    `json:"done"`
+	Total    int    `json:"total"`
+	Parallel int    `json:"parallel,omitempty"`
+}
+
+// taskPhasesToJSON encodes per-heading task counts for storage, NULL when
+// there are none
+func taskPhasesToJSON(phases []domain.TaskPhase) (sql.NullString, error) {
+	if len(phases) == 0 {
+		return sql.NullString{}, nil
+	}
+
+	recs := make([]taskPhaseRecord, 0, len(phases))
+	for _, p := range phases {
+		recs = append(recs, taskPhaseRecord{Name: p.Name, Done: p.Done, Total: p.Total, Parallel: p.Parallel})
+	}
+
+	data, err := json.Marshal(recs)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// taskPhasesFromJSON decodes stored task phases. NULL or unreadable JSON
+// yields nil; the next detection rewrites the column.
+func taskPhasesFromJSON(ns sql.NullString) []domain.TaskPhase {
+	if !ns.Valid || ns.String == "" {
+		return nil
+	}
+	var recs []taskPhaseRecord
+	if err := json.Unmarshal([]byte(ns.String), &recs); err != nil || len(recs) == 0 {
+		return nil
+	}
+
+	phases := make([]domain.TaskPhase, 0, len(recs))
+	for _, r := range recs {
+		phases = append(phases, domain.TaskPhase{Name: r.Name, Done: r.Done, Total: r.Total, Parallel: r.Parallel})
+	}
+	return phases
+}
@@ -9,54 +9,106 @@ import (
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/emoji"
 )
 
+// Deps holds the services the dashboard uses. Only Repo is required; a nil
+// service disables its feature.
+// Note: Config is passed in rather than loaded to avoid a cli→tui→cli import cycle.
+type Deps struct {
+	Repo                ports.ProjectRepository
+	Refresher           ports.ProjectRefresher    // Re-detection on refresh, records agent state history
+	WaitingDetector     ports.WaitingDetector     // WAITING indicator (Story 4.5)
+	FileWatcher         ports.FileWatcher         // Real-time updates (Story 4.6)
+	DetailLayout        string                    // "vertical" = side-by-side, "horizontal" = stacked (Story 8.6)
+	Config              *ports.Config             // Help overlay and emoji settings (Story 8.7)
+	HibernationService  ports.HibernationService  // Auto-hibernation (Story 11.2)
+	StateService        ports.StateActivator      // Auto-activation on file events (Story 11.3)
+	LogReaderRegistry   ports.LogReaderRegistry   // Log viewing (Story 12.1)
+	NotificationService ports.NotificationService // Waiting notifications
+	ProjectScanner      ports.ProjectScanner      // Re-scans of config workspace_roots
+	GitInspector        ports.GitInspector        // Repository status in the detail panel
+	ConfigLoader        ports.ConfigLoader        // Persists sort mode and grouping
+	MetricsCollector    ports.MetricsCollector    // Progress metrics and the stats view
+	UsageReader         ports.UsageReader         // Token usage and cost
+
+	// Daemon, if set, owns file watching, hibernation and re-detection; the
+	// TUI attaches to it instead.
+	Daemon ports.DaemonController
+}
+
 // Run starts the TUI application with the given context.
 // The context is used for graceful shutdown on Ctrl+C.
-// The repository parameter is used for project persistence operations.
-// The detector parameter is optional - if nil, refresh will be disabled.
-// The waitingDetector parameter is optional - if nil, waiting indicators are disabled (Story 4.5).
-// The fileWatcher parameter is optional - if nil, real-time updates are disabled (Story 4.6).
-// The detailLayout parameter controls detail panel position (Story 8.6):
-// "vertical" (default) = side-by-side, "horizontal" = stacked (top/bottom).
-// The config parameter is used for help overlay display (Story 8.7) - nil-safe.
-// The hibernationService parameter is optional - if nil, auto-hibernation is disabled (Story 11.2).
-// The stateService parameter is optional - if nil, auto-activation is disabled (Story 11.3).
-// The logReaderRegistry parameter is optional - if nil, log viewing is disabled (Story 12.1).
-// Note: Config passed as parameter to avoid cli→tui→cli import cycle.
-func Run(ctx context.Context, repo ports.ProjectRepository, detector ports.Detector, waitingDetector ports.WaitingDetector, fileWatcher ports.FileWatcher, detailLayout string, config *ports.Config, hibernationService ports.HibernationService, stateService ports.StateActivator, logReaderRegistry ports.LogReaderRegistry) error {
+func Run(ctx context.Context, deps Deps) error {
 	// Story 8.9: Initialize emoji fallback system BEFORE TUI renders
 	var useEmoji *bool
-	if config != nil {
-		useEmoji = config.UseEmoji
+	if deps.Config != nil {
+		useEmoji = deps.Config.UseEmoji
 	}
 	emoji.InitEmoji(useEmoji)
+	// Pick up the theme applied by the CLI (styles.ApplyTheme / --no-color)
+	syncStyles()
 
-	m := NewModel(repo)
-	if detector != nil {
-		m.SetDetectionService(detector)
+	m := NewModel(deps.Repo)
+	if deps.Refresher != nil {
+		m.SetProjectRefresher(deps.Refresher)
 	}
 	// Story 4.5: Wire waiting detector for WAITING indicator display
-	if waitingDetector != nil {
-		m.SetWaitingDetector(waitingDetector)
+	if deps.WaitingDetector != nil {
+		m.SetWaitingDetector(deps.WaitingDetector)
+	}
+	// A running daemon owns watching, hibernation and workspace scans; don't run a second writer.
+	// It also sends waiting notifications, so the TUI would only duplicate them.
+	if deps.Daemon != nil {
+		m.SetDaemon(deps.Daemon)
+		deps.FileWatcher, deps.HibernationService, deps.ProjectScanner = nil, nil, nil
+		deps.NotificationService = nil
 	}
 	// Story 4.6: Wire file watcher for real-time dashboard updates
-	if fileWatcher != nil {
-		m.SetFileWatcher(fileWatcher)
+	if deps.FileWatcher != nil {
+		m.SetFileWatcher(deps.FileWatcher)
 	}
 	// Story 8.6: Set detail panel layout mode from config
-	m.SetDetailLayout(detailLayout)
+	m.SetDetailLayout(deps.DetailLayout)
 	// Story 8.7: Set config for help overlay display
-	m.SetConfig(config)
+	m.SetConfig(deps.Config)
 	// Story 11.2: Wire hibernation service for auto-hibernation
-	if hibernationService != nil {
-		m.SetHibernationService(hibernationService)
+	if deps.HibernationService != nil {
+		m.SetHibernationService(deps.HibernationService)
 	}
 	// Story 11.3: Wire state service for auto-activation on file events
-	if stateService != nil {
-		m.SetStateService(stateService)
+	if deps.StateService != nil {
+		m.SetStateService(deps.StateService)
 	}
 	// Story 12.1: Wire log reader registry for log viewing
-	if logReaderRegistry != nil {
-		m.SetLogReaderRegistry(logReaderRegistry)
+	if deps.LogReaderRegistry != nil {
+		m.SetLogReaderRegistry(deps.LogReaderRegistry)
+	}
+	// Notify when an agent starts waiting (checked on the 5s tick)
+	if deps.NotificationService != nil {
+		m.SetNotificationService(deps.NotificationService)
+	}
+
+	// Pick up projects created under config workspace_roots
+	if deps.ProjectScanner != nil {
+		m.SetProjectScanner(deps.ProjectScanner)
+	}
+
+	// Show branch and working tree status in the detail panel
+	if deps.GitInspector != nil {
+		m.SetGitInspector(deps.GitInspector)
+	}
+
+	// Persist sort mode and grouping changed with keys
+	if deps.ConfigLoader != nil {
+		m.SetConfigLoader(deps.ConfigLoader)
+	}
+
+	// Sample progress metrics and enable the stats view
+	if deps.MetricsCollector != nil {
+		m.SetMetricsCollector(deps.MetricsCollector)
+	}
+
+	// Show token usage and estimated cost in the detail panel and session picker
+	if deps.UsageReader != nil {
+		m.SetUsageReader(deps.UsageReader)
 	}
 
 	p := tea.NewProgram(
@@ -0,0 +1,354 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/tui/components"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// bulkAction is an action applied to every marked project.
+type bulkAction int
+
+const (
+	bulkFavorite bulkAction = iota
+	bulkUnfavorite
+	bulkHibernate
+	bulkActivate
+	bulkRemove
+	bulkRedetect
+)
+
+// verbs returns the progress and completion verbs shown in the status bar,
+// e.g. "Hibernating" and "Hibernated".
+func (a bulkAction) verbs() (progress, done string) {
+	switch a {
+	case bulkFavorite:
+		return "Favoriting", "Favorited"
+	case bulkUnfavorite:
+		return "Unfavoriting", "Unfavorited"
+	case bulkHibernate:
+		return "Hibernating", "Hibernated"
+	case bulkActivate:
+		return "Activating", "Activated"
+	case bulkRemove:
+		return "Removing", "Removed"
+	default:
+		return "Re-detecting", "Re-detected"
+	}
+}
+
+// bulkJob is a bulk action in progress. Projects are processed one at a
+// time so the status bar can report progress.
+type bulkJob struct {
+	action  bulkAction
+	targets []*domain.Project
+	failed  int
+	skipped int // Favorites left awake by bulk hibernate
+}
+
+// running reports whether a bulk action is in progress.
+func (j bulkJob) running() bool {
+	return len(j.targets) > 0
+}
+
+// bulkStepMsg reports that the project at index in the running bulk job
+// has been processed.
+type bulkStepMsg struct {
+	index int
+	err   error
+}
+
+// currentList returns the list shown in the current view.
+func (m *Model) currentList() *components.ProjectListModel {
+	if m.viewMode == viewModeHibernated {
+		return &m.hibernatedList
+	}
+	return &m.projectList
+}
+
+// currentProjects returns every project of the current view, including
+// projects hidden by the search filter.
+func (m Model) currentProjects() []*domain.Project {
+	if m.viewMode == viewModeHibernated {
+		return m.hibernatedProjects
+	}
+	return m.projects
+}
+
+// markedProjects returns the marked projects of the current view.
+func (m Model) markedProjects() []*domain.Project {
+	var marked []*domain.Project
+	for _, p := range m.currentProjects() {
+		if m.marked[p.ID] {
+			marked = append(marked, p)
+		}
+	}
+	return marked
+}
+
+// setMarked marks or unmarks a project. The marked map is shared with the
+// list delegates, so it is created once and mutated in place.
+func (m *Model) setMarked(id string, marked bool) {
+	if m.marked == nil {
+		m.marked = make(map[string]bool)
+		m.projectList.SetMarked(m.marked)
+		m.hibernatedList.SetMarked(m.marked)
+	}
+	if marked {
+		m.marked[id] = true
+	} else {
+		delete(m.marked, id)
+	}
+}
+
+// clearMarks unmarks every project.
+func (m *Model) clearMarks() {
+	clear(m.marked)
+	m.markAnchor = ""
+	m.statusBar.SetMarkedCount(0)
+}
+
+// syncMarkedCount updates the status bar with the number of marked projects.
+func (m *Model) syncMarkedCount() {
+	m.statusBar.SetMarkedCount(len(m.markedProjects()))
+}
+
+// toggleMark marks or unmarks the selected project and moves the cursor
+// down, so consecutive presses mark consecutive projects.
+func (m Model) toggleMark() (tea.Model, tea.Cmd) {
+	list := m.currentList()
+	selected := list.SelectedProject()
+	if selected == nil {
+		return m, nil
+	}
+	m.setMarked(selected.ID, !m.marked[selected.ID])
+	m.markAnchor = selected.ID
+	if next := list.Index() + 1; next < list.Len() {
+		list.SelectByIndex(next)
+		m.detailPanel.SetProject(list.SelectedProject())
+	}
+	m.syncMarkedCount()
+	return m, nil
+}
+
+// markRange marks every shown project between the last toggled project and
+// the cursor. Without a visible anchor it marks the selected project only.
+func (m Model) markRange() (tea.Model, tea.Cmd) {
+	list := m.currentList()
+	visible := list.Projects()
+	cursor := list.Index()
+	if cursor < 0 || cursor >= len(visible) {
+		return m, nil
+	}
+	anchor := indexOfProject(visible, m.markAnchor)
+	if anchor < 0 {
+		anchor = cursor
+	}
+	from, to := min(anchor, cursor), max(anchor, cursor)
+	for _, p := range visible[from : to+1] {
+		m.setMarked(p.ID, true)
+	}
+	m.markAnchor = visible[cursor].ID
+	m.syncMarkedCount()
+	return m, nil
+}
+
+// markAll marks every shown project, or unmarks them when all are marked.
+func (m Model) markAll() (tea.Model, tea.Cmd) {
+	visible := m.currentList().Projects()
+	if len(visible) == 0 {
+		return m, nil
+	}
+	allMarked := true
+	for _, p := range visible {
+		if !m.marked[p.ID] {
+			allMarked = false
+			break
+		}
+	}
+	for _, p := range visible {
+		m.setMarked(p.ID, !allMarked)
+	}
+	m.syncMarkedCount()
+	return m, nil
+}
+
+// bulkFavoriteAction favorites the marked projects, or unfavorites them
+// when all are already favorites.
+func bulkFavoriteAction(targets []*domain.Project) bulkAction {
+	for _, p := range targets {
+		if !p.IsFavorite {
+			return bulkFavorite
+		}
+	}
+	return bulkUnfavorite
+}
+
+// startBulkStateToggle hibernates the marked projects, or activates them in
+// the hibernated view.
+func (m Model) startBulkStateToggle(targets []*domain.Project) (tea.Model, tea.Cmd) {
+	if m.stateService == nil {
+		m.statusBar.SetRefreshComplete("✗ State change failed")
+		return m, clearFeedbackAfter(3 * time.Second)
+	}
+	if m.viewMode == viewModeHibernated {
+		return m.startBulk(bulkActivate, targets)
+	}
+	return m.startBulk(bulkHibernate, targets)
+}
+
+// startBulkRedetect re-runs detection for the marked projects. An attached
+// daemon owns detection, so it rescans every project instead.
+func (m Model) startBulkRedetect(targets []*domain.Project) (tea.Model, tea.Cmd) {
+	if m.refresher == nil {
+		m.refreshError = "Detection service not available"
+		return m, nil
+	}
+	if m.daemon != nil {
+		m.clearMarks()
+		return m.startRefresh()
+	}
+	return m.startBulk(bulkRedetect, targets)
+}
+
+// startBulkRemoveConfirmation opens the remove dialog for the marked projects.
+func (m Model) startBulkRemoveConfirmation(targets []*domain.Project) (tea.Model, tea.Cmd) {
+	m.isConfirmingRemove = true
+	m.confirmTargets = targets
+	return m, removeConfirmTimeoutCmd()
+}
+
+// startBulk starts applying action to targets, one project at a time.
+func (m Model) startBulk(action bulkAction, targets []*domain.Project) (tea.Model, tea.Cmd) {
+	if len(targets) == 0 {
+		return m, nil
+	}
+	m.bulk = bulkJob{action: action, targets: targets}
+	progress, _ := action.verbs()
+	m.statusBar.SetBulkProgress(progress, 0, len(targets))
+	return m, m.bulkStepCmd(0)
+}
+
+// bulkStepCmd applies the running bulk action to the project at index.
+func (m Model) bulkStepCmd(index int) tea.Cmd {
+	action := m.bulk.action
+	target := m.bulk.targets[index]
+	return func() tea.Msg {
+		timeout := 5 * time.Second
+		if action == bulkRedetect {
+			timeout = 30 * time.Second // Detection reads the project tree
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return bulkStepMsg{index: index, err: m.applyBulkAction(ctx, action, target)}
+	}
+}
+
+// applyBulkAction applies action to a single project.
+func (m Model) applyBulkAction(ctx context.Context, action bulkAction, p *domain.Project) error {
+	switch action {
+	case bulkFavorite, bulkUnfavorite:
+		current, err := m.repository.FindByID(ctx, p.ID)
+		if err != nil {
+			return err
+		}
+		current.IsFavorite = action == bulkFavorite
+		current.UpdatedAt = time.Now()
+		return m.repository.Save(ctx, current)
+	case bulkHibernate:
+		return m.stateService.Hibernate(ctx, p.ID)
+	case bulkActivate:
+		return m.stateService.Activate(ctx, p.ID)
+	case bulkRemove:
+		if err := m.repository.Delete(ctx, p.ID); err != nil {
+			return err
+		}
+		m.deleteProjectStats(ctx, p.ID)
+		return nil
+	case bulkRedetect:
+		_, err := m.refresher.RefreshProject(ctx, p.ID, p.Path)
+		return err
+	}
+	return fmt.Errorf("unknown bulk action %d", action)
+}
+
+// handleBulkStep records one processed project and continues with the next,
+// or finishes the job with a summary and reloads the lists.
+func (m Model) handleBulkStep(msg bulkStepMsg) (tea.Model, tea.Cmd) {
+	if !m.bulk.running() || msg.index >= len(m.bulk.targets) {
+		return m, nil
+	}
+	if msg.err != nil {
+		switch {
+		case errors.Is(msg.err, domain.ErrFavoriteCannotHibernate):
+			m.bulk.skipped++
+		case errors.Is(msg.err, domain.ErrInvalidStateTransition):
+			// Already in the requested state (e.g. auto-activated meanwhile)
+		default:
+			slog.Debug("bulk action failed", "project", m.bulk.targets[msg.index].Name, "error", msg.err)
+			m.bulk.failed++
+		}
+	}
+
+	next := msg.index + 1
+	if next < len(m.bulk.targets) {
+		progress, _ := m.bulk.action.verbs()
+		m.statusBar.SetBulkProgress(progress, next, len(m.bulk.targets))
+		return m, m.bulkStepCmd(next)
+	}
+
+	job := m.bulk
+	m.bulk = bulkJob{}
+	m.statusBar.SetBulkProgress("", 0, 0)
+	m.clearMarks()
+	m.statusBar.SetRefreshComplete(bulkSummary(job))
+
+	cmds := []tea.Cmd{m.loadProjectsCmd(), clearFeedbackAfter(3 * time.Second)}
+	if m.viewMode == viewModeHibernated {
+		cmds = append(cmds, m.loadHibernatedProjectsCmd())
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// bulkSummary formats the status bar message for a finished bulk job,
+// e.g. "✓ Hibernated 3 projects (1 favorite skipped)".
+func bulkSummary(job bulkJob) string {
+	progress, done := job.action.verbs()
+	succeeded := len(job.targets) - job.failed - job.skipped
+	if succeeded == 0 && job.failed > 0 {
+		return fmt.Sprintf("✗ %s failed for %d %s", progress, job.failed, pluralProjects(job.failed))
+	}
+	summary := fmt.Sprintf("✓ %s %d %s", done, succeeded, pluralProjects(succeeded))
+	switch {
+	case job.skipped == 1:
+		summary += " (1 favorite skipped)"
+	case job.skipped > 1:
+		summary += fmt.Sprintf(" (%d favorites skipped)", job.skipped)
+	}
+	if job.failed > 0 {
+		summary += fmt.Sprintf(" (%d failed)", job.failed)
+	}
+	return summary
+}
+
+// pluralProjects returns "project" or "projects" for n.
+func pluralProjects(n int) string {
+	if n == 1 {
+		return "project"
+	}
+	return "projects"
+}
+
+// clearFeedbackAfter clears the status bar feedback message after d.
+func clearFeedbackAfter(d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return clearRemoveFeedbackMsg{}
+	})
+}
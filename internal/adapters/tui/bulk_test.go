@@ -0,0 +1,308 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/tui/components"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// createBulkModel returns a model whose projects are stored in a mock repository.
+func createBulkModel(count int) (Model, *favoriteMockRepository) {
+	m := createModelWithProjects(count)
+	repo := &favoriteMockRepository{projects: m.projects}
+	m.repository = repo
+	m.projectList.SetMarked(m.marked)
+	return m, repo
+}
+
+// runBulk runs cmd and feeds bulk step messages back into the model until
+// the bulk job finishes.
+func runBulk(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	for cmd != nil {
+		step, ok := cmd().(bulkStepMsg)
+		if !ok {
+			break
+		}
+		var updated tea.Model
+		updated, cmd = m.Update(step)
+		m = updated.(Model)
+	}
+	if m.bulk.running() {
+		t.Fatal("bulk job still running")
+	}
+	return m
+}
+
+// markedIDs returns the marked project IDs of the current view in order.
+func markedIDs(m Model) string {
+	var ids []string
+	for _, p := range m.markedProjects() {
+		ids = append(ids, p.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestModel_Mark_SpaceTogglesAndMovesDown(t *testing.T) {
+	m, _ := createBulkModel(3)
+
+	m = pressKey(t, m, tea.KeySpace)
+	if got := markedIDs(m); got != "a" {
+		t.Errorf("marked = %q, want %q", got, "a")
+	}
+	if m.projectList.Index() != 1 {
+		t.Errorf("cursor = %d, want 1 (moved down after marking)", m.projectList.Index())
+	}
+	if !strings.Contains(m.statusBar.View(), "1 marked") {
+		t.Errorf("status bar should show marked count, got %q", m.statusBar.View())
+	}
+
+	// Go back up and unmark
+	m = typeKeys(t, m, "k")
+	m = pressKey(t, m, tea.KeySpace)
+	if got := markedIDs(m); got != "" {
+		t.Errorf("marked = %q, want none after second press", got)
+	}
+}
+
+func TestModel_Mark_RangeFromAnchor(t *testing.T) {
+	m, _ := createBulkModel(5)
+
+	m = typeKeys(t, m, "j")
+	m = pressKey(t, m, tea.KeySpace) // mark b, cursor on c
+	m = typeKeys(t, m, "jjV")        // cursor on e, mark b..e
+
+	if got := markedIDs(m); got != "b,c,d,e" {
+		t.Errorf("marked = %q, want %q", got, "b,c,d,e")
+	}
+}
+
+func TestModel_Mark_RangeWithoutAnchorMarksSelected(t *testing.T) {
+	m, _ := createBulkModel(3)
+
+	m = typeKeys(t, m, "jV")
+	if got := markedIDs(m); got != "b" {
+		t.Errorf("marked = %q, want %q", got, "b")
+	}
+}
+
+func TestModel_Mark_AllMarksFilteredProjectsOnly(t *testing.T) {
+	m, _ := createBulkModel(3)
+	m.projects[0].Name = "api-server"
+	m.projects[1].Name = "api-client"
+	m.projects[2].Name = "web"
+	m.projectList.SetProjects(m.projects)
+	m.setProjectFilter("api")
+
+	m = typeKeys(t, m, "*")
+	if got := markedIDs(m); got != "a,b" {
+		t.Errorf("marked = %q, want %q", got, "a,b")
+	}
+
+	// Pressing again unmarks the shown projects
+	m = typeKeys(t, m, "*")
+	if got := markedIDs(m); got != "" {
+		t.Errorf("marked = %q, want none", got)
+	}
+}
+
+func TestModel_Mark_EscapeClearsMarksBeforeFilter(t *testing.T) {
+	m, _ := createBulkModel(3)
+	m.setProjectFilter("a")
+	m = typeKeys(t, m, "*")
+
+	m = pressKey(t, m, tea.KeyEsc)
+	if got := markedIDs(m); got != "" {
+		t.Errorf("marked = %q, want none after Esc", got)
+	}
+	if m.filterQuery != "a" {
+		t.Errorf("filter = %q, want it kept until the second Esc", m.filterQuery)
+	}
+	if strings.Contains(m.statusBar.View(), "marked") {
+		t.Errorf("status bar should not show marked count, got %q", m.statusBar.View())
+	}
+}
+
+func TestModel_Mark_ClearedWhenSwitchingViews(t *testing.T) {
+	m, _ := createBulkModel(3)
+	m = typeKeys(t, m, "*")
+
+	m = typeKeys(t, m, "h")
+	if len(m.marked) != 0 {
+		t.Errorf("marks should be cleared when entering the hibernated view, got %v", m.marked)
+	}
+}
+
+func TestModel_Bulk_Favorite(t *testing.T) {
+	m, repo := createBulkModel(3)
+	repo.projects[2].IsFavorite = true
+	m = typeKeys(t, m, "*")
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	m = runBulk(t, updated.(Model), cmd)
+
+	for _, p := range repo.projects {
+		if !p.IsFavorite {
+			t.Errorf("project %s should be favorite", p.ID)
+		}
+	}
+	if !strings.Contains(m.statusBar.View(), "✓ Favorited 3 projects") {
+		t.Errorf("status bar should show summary, got %q", m.statusBar.View())
+	}
+	if len(m.marked) != 0 {
+		t.Error("marks should be cleared after the bulk action")
+	}
+
+	// All marked projects are favorites now, so f unfavorites them
+	m = typeKeys(t, m, "*")
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	runBulk(t, updated.(Model), cmd)
+	for _, p := range repo.projects {
+		if p.IsFavorite {
+			t.Errorf("project %s should no longer be favorite", p.ID)
+		}
+	}
+}
+
+func TestModel_Bulk_HibernateShowsProgress(t *testing.T) {
+	m, _ := createBulkModel(3)
+	state := &mockStateActivator{}
+	m.SetStateService(state)
+	m = typeKeys(t, m, " j ")
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	m = updated.(Model)
+	if !strings.Contains(m.statusBar.View(), "Hibernating... (0/2)") {
+		t.Errorf("status bar should show progress, got %q", m.statusBar.View())
+	}
+
+	m = runBulk(t, m, cmd)
+	if got := strings.Join(state.hibernateCalls, ","); got != "a,c" {
+		t.Errorf("hibernated = %q, want %q", got, "a,c")
+	}
+	if !strings.Contains(m.statusBar.View(), "✓ Hibernated 2 projects") {
+		t.Errorf("status bar should show summary, got %q", m.statusBar.View())
+	}
+}
+
+func TestModel_Bulk_ActivateInHibernatedView(t *testing.T) {
+	m, _ := createBulkModel(0)
+	hibernated := []*domain.Project{
+		{ID: "h1", Name: "one", State: domain.StateHibernated},
+		{ID: "h2", Name: "two", State: domain.StateHibernated},
+	}
+	m.viewMode = viewModeHibernated
+	m.hibernatedProjects = hibernated
+	m.hibernatedList = components.NewProjectListModel(hibernated, 80, 20)
+	state := &mockStateActivator{}
+	m.SetStateService(state)
+
+	m = typeKeys(t, m, "*")
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	runBulk(t, updated.(Model), cmd)
+
+	if got := strings.Join(state.activateCalls, ","); got != "h1,h2" {
+		t.Errorf("activated = %q, want %q", got, "h1,h2")
+	}
+}
+
+func TestModel_Bulk_RemoveUsesAggregatedConfirmation(t *testing.T) {
+	m, _ := createBulkModel(3)
+	metrics := &stubMetricsCollector{}
+	m.SetMetricsCollector(metrics)
+	m = typeKeys(t, m, "*")
+
+	m = typeKeys(t, m, "x")
+	if !m.isConfirmingRemove || len(m.confirmTargets) != 3 {
+		t.Fatalf("expected confirmation for 3 projects, got confirming=%v targets=%d", m.isConfirmingRemove, len(m.confirmTargets))
+	}
+	if view := m.View(); !strings.Contains(view, "Remove 3 projects from tracking?") {
+		t.Errorf("expected aggregated dialog, got:\n%s", view)
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m = runBulk(t, updated.(Model), cmd)
+	if !strings.Contains(m.statusBar.View(), "✓ Removed 3 projects") {
+		t.Errorf("status bar should show summary, got %q", m.statusBar.View())
+	}
+	if got := strings.Join(metrics.deleted, ","); got != "a,b,c" {
+		t.Errorf("metrics deleted for %q, want %q", got, "a,b,c")
+	}
+}
+
+func TestModel_Bulk_RemoveCancelKeepsMarks(t *testing.T) {
+	m, _ := createBulkModel(3)
+	m = typeKeys(t, m, "*xn")
+
+	if m.isConfirmingRemove {
+		t.Error("confirmation should be closed")
+	}
+	if got := markedIDs(m); got != "a,b,c" {
+		t.Errorf("marked = %q, want marks kept after cancel", got)
+	}
+}
+
+func TestModel_Bulk_RedetectMarkedOnly(t *testing.T) {
+	m, _ := createBulkModel(3)
+	var detected []string
+	useDetector(&m, &refreshMockDetector{
+		detectFunc: func(_ context.Context, path string) (*domain.DetectionResult, error) {
+			detected = append(detected, path)
+			if path == "/path/c" {
+				return nil, errors.New("detection failed")
+			}
+			return &domain.DetectionResult{Method: "test", Stage: domain.StagePlan}, nil
+		},
+	})
+	m = typeKeys(t, m, "j  ") // mark b and c
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	m = runBulk(t, updated.(Model), cmd)
+
+	if got := strings.Join(detected, ","); got != "/path/b,/path/c" {
+		t.Errorf("detected = %q, want %q", got, "/path/b,/path/c")
+	}
+	if !strings.Contains(m.statusBar.View(), "✓ Re-detected 1 project (1 failed)") {
+		t.Errorf("status bar should show summary, got %q", m.statusBar.View())
+	}
+}
+
+func TestModel_Bulk_IgnoresActionsWhileRunning(t *testing.T) {
+	m, _ := createBulkModel(3)
+	m.SetStateService(&mockStateActivator{})
+	m = typeKeys(t, m, "*")
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	m = updated.(Model)
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	if cmd != nil {
+		t.Error("bulk favorite should be ignored while another bulk action runs")
+	}
+}
+
+func TestBulkSummary(t *testing.T) {
+	targets := make([]*domain.Project, 4)
+	tests := []struct {
+		name string
+		job  bulkJob
+		want string
+	}{
+		{"all succeeded", bulkJob{action: bulkHibernate, targets: targets}, "✓ Hibernated 4 projects"},
+		{"one skipped", bulkJob{action: bulkHibernate, targets: targets, skipped: 1}, "✓ Hibernated 3 projects (1 favorite skipped)"},
+		{"skipped and failed", bulkJob{action: bulkHibernate, targets: targets, skipped: 2, failed: 1}, "✓ Hibernated 1 project (2 favorites skipped) (1 failed)"},
+		{"all failed", bulkJob{action: bulkHibernate, targets: targets, failed: 4}, "✗ Hibernating failed for 4 projects"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bulkSummary(tt.job); got != tt.want {
+				t.Errorf("bulkSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"io"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/charts"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/emoji"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/stageformat"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
@@ -19,7 +21,7 @@ import (
 
 // Column widths for project row layout
 const (
-	colSelection = 2 // "> " or "  "
+	colSelection = 2 // "> " or "  "; second cell shows the bulk mark "+"
 	colFavorite  = 2 // styled "⭐" or "  " (Story 3.8)
 	colIndicator = 3 // "✨ " or "⚡ " or "   "
 	colTime      = 8 // "2w ago" max
@@ -46,6 +48,10 @@ const (
 	// Width breakpoints for responsive stage display (Story 8.3)
 	widthBreakpointFull  = 100 // >= 100: Full stage info "E8 S8.3 review"
 	widthBreakpointShort = 80  // 80-99: Shortened "E8 S8.3"
+
+	// Task progress appended to stage info: "001-auth Tasks ██▍░░░ 12/40"
+	colTaskBar      = 6  // Progress bar cells
+	colTaskStageMin = 30 // Minimum stage width that still fits the progress
 )
 
 // WaitingChecker checks if a project is waiting.
@@ -61,11 +67,21 @@ type WaitingDurationGetter func(p *domain.Project) time.Duration
 // Note: Does not take context parameter - caller captures context via closure.
 type AgentStateGetter func(p *domain.Project) domain.AgentState
 
+// GitStatusGetter returns the repository status for a project, or nil when
+// it is unknown or the project is not a git repository.
+type GitStatusGetter func(p *domain.Project) *domain.GitStatus
+
+// UsageGetter returns the token usage for a project, or nil when it is unknown.
+type UsageGetter func(p *domain.Project) *domain.ProjectUsage
+
 // ProjectItemDelegate is a custom delegate for rendering project rows.
 type ProjectItemDelegate struct {
 	width          int
 	waitingChecker WaitingChecker        // nil = no waiting display (Story 4.5)
 	durationGetter WaitingDurationGetter // nil = no duration display (Story 4.5)
+	agentState     AgentStateGetter      // nil = working agents are not marked
+	filter         ProjectFilter         // Active search; matched name characters are highlighted
+	marked         map[string]bool       // Project IDs marked for bulk actions; nil = none
 }
 
 // NewProjectItemDelegate creates a new ProjectItemDelegate with the given width.
@@ -91,6 +107,23 @@ func (d *ProjectItemDelegate) SetWaitingCallbacks(checker WaitingChecker, getter
 	d.durationGetter = getter
 }
 
+// SetAgentStateCallback sets the callback used to mark projects whose agent
+// is working.
+func (d *ProjectItemDelegate) SetAgentStateCallback(getter AgentStateGetter) {
+	d.agentState = getter
+}
+
+// SetFilter sets the active search used to highlight matched name characters.
+func (d *ProjectItemDelegate) SetFilter(filter ProjectFilter) {
+	d.filter = filter
+}
+
+// SetMarked sets the project IDs marked for bulk actions. The map is read
+// on every render, so the owner may mutate it in place.
+func (d *ProjectItemDelegate) SetMarked(marked map[string]bool) {
+	d.marked = marked
+}
+
 // SetWidth updates the delegate's width for responsive layout.
 func (d *ProjectItemDelegate) SetWidth(width int) {
 	d.width = width
@@ -113,6 +146,11 @@ func (d ProjectItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
 
 // Render renders a single project row.
 func (d ProjectItemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	if header, ok := listItem.(GroupHeaderItem); ok {
+		fmt.Fprint(w, d.renderGroupHeader(header))
+		return
+	}
+
 	item, ok := listItem.(ProjectItem)
 	if !ok {
 		return
@@ -219,11 +257,16 @@ func (d ProjectItemDelegate) waitingColumnWidth() int {
 func (d ProjectItemDelegate) renderRow(item ProjectItem, isSelected bool, nameWidth int) string {
 	var sb strings.Builder
 
-	// Selection indicator
+	// Selection indicator, followed by the bulk mark
 	if isSelected {
-		sb.WriteString("> ")
+		sb.WriteString(">")
 	} else {
-		sb.WriteString("  ")
+		sb.WriteString(" ")
+	}
+	if d.marked[item.Project.ID] {
+		sb.WriteString(styles.TitleStyle.Render("+"))
+	} else {
+		sb.WriteString(" ")
 	}
 
 	// Favorite indicator (Story 3.8, Story 8.9: emoji fallback)
@@ -235,12 +278,20 @@ func (d ProjectItemDelegate) renderRow(item ProjectItem, isSelected bool, nameWi
 
 	// Project name (truncate if needed)
 	name := item.EffectiveName()
+	matchable := utf8.RuneCountInString(name) // Runes that may be highlighted
 	if len(name) > nameWidth {
 		name = name[:nameWidth-3] + "..."
+		matchable = utf8.RuneCountInString(name) - 3
 	}
 	nameStr := fmt.Sprintf("%-*s", nameWidth, name)
-	if isSelected {
+	if highlights := d.filter.NameHighlights(item.Project); len(highlights) > 0 {
+		nameStr = renderHighlightedName(nameStr, highlights, matchable, isSelected)
+	} else if isSelected {
 		nameStr = styles.SelectedStyle.Render(nameStr)
+	} else if item.Project.State == domain.StateHibernated {
+		nameStr = styles.HibernatedStyle.Render(nameStr)
+	} else if d.isWorking(item.Project) {
+		nameStr = styles.WorkingStyle.Render(nameStr)
 	}
 	sb.WriteString(nameStr)
 	sb.WriteString(" ")
@@ -260,7 +311,12 @@ func (d ProjectItemDelegate) renderRow(item ProjectItem, isSelected bool, nameWi
 	// Stage info (Story 8.3: Rich BMAD stage display with responsive breakpoints)
 	if d.showStageColumn() {
 		stageWidth := d.stageColumnWidth()
-		stage := stageformat.FormatStageInfoWithWidth(item.Project, stageWidth)
+		tasks := ""
+		if stageWidth >= colTaskStageMin {
+			tasks = taskProgress(item.Project)
+		}
+		infoWidth := stageWidth - lipgloss.Width(tasks)
+		stage := stageformat.FormatStageInfoWithWidth(item.Project, infoWidth)
 
 		// Add coexistence indicator if warning set (Story 14.5)
 		if item.Project.CoexistenceWarning {
@@ -269,14 +325,15 @@ func (d ProjectItemDelegate) renderRow(item ProjectItem, isSelected bool, nameWi
 			stage = fmt.Sprintf("%s %s", warningEmoji, stage)
 			// Truncate if needed to fit width using rune-safe truncation
 			// Note: lipgloss.Width handles ANSI escape codes and multi-byte chars
-			if lipgloss.Width(stage) > stageWidth && stageWidth > 3 {
+			if lipgloss.Width(stage) > infoWidth && infoWidth > 3 {
 				// Use rune-safe truncation to avoid cutting UTF-8 sequences
 				runes := []rune(stage)
-				if len(runes) > stageWidth-3 {
-					stage = string(runes[:stageWidth-3]) + "..."
+				if len(runes) > infoWidth-3 {
+					stage = string(runes[:infoWidth-3]) + "..."
 				}
 			}
 		}
+		stage += tasks
 
 		stageStr := fmt.Sprintf("%-*s", stageWidth, stage)
 		sb.WriteString(styles.DimStyle.Render(stageStr))
@@ -284,11 +341,15 @@ func (d ProjectItemDelegate) renderRow(item ProjectItem, isSelected bool, nameWi
 	}
 
 	// WAITING indicator (Story 4.5, Story 8.10: dynamic width)
+	// Without color, working and hibernated projects get a glyph here instead
 	waitingWidth := d.waitingColumnWidth()
 	waiting := d.waitingIndicator(item.Project)
 	if waiting != "" {
 		waitingStr := fmt.Sprintf("%-*s", waitingWidth, waiting)
 		sb.WriteString(styles.WaitingStyle.Render(waitingStr))
+	} else if status := d.monochromeStatus(item.Project); status != "" {
+		// Pad by display width: the glyph emoji are one rune but two cells wide
+		sb.WriteString(status + strings.Repeat(" ", max(waitingWidth-lipgloss.Width(status), 0)))
 	} else {
 		sb.WriteString(fmt.Sprintf("%-*s", waitingWidth, ""))
 	}
@@ -306,6 +367,55 @@ func (d ProjectItemDelegate) renderRow(item ProjectItem, isSelected bool, nameWi
 	return lipgloss.NewStyle().Width(d.width).Render(row)
 }
 
+// taskProgress renders task completion for the stage column,
+// " ██▍░░░ 12/40", or "" when the method reports no tasks.
+func taskProgress(p *domain.Project) string {
+	if p.TasksTotal <= 0 {
+		return ""
+	}
+	bar := charts.Progress(float64(p.TasksDone), float64(p.TasksTotal), colTaskBar)
+	return fmt.Sprintf(" %s %d/%d", bar, p.TasksDone, p.TasksTotal)
+}
+
+// renderGroupHeader renders a group label row, e.g. "Implementing (7)".
+// Indented to align with project names (after selection and favorite columns).
+func (d ProjectItemDelegate) renderGroupHeader(h GroupHeaderItem) string {
+	label := fmt.Sprintf("%s (%d)", h.Label, h.Count)
+	row := strings.Repeat(" ", colSelection) + styles.TitleStyle.Render(label)
+	return lipgloss.NewStyle().Width(d.width).Render(row)
+}
+
+// renderHighlightedName styles the runes of a padded name at the given
+// positions with MatchStyle. Positions at or past matchable (the truncation
+// point) are ignored.
+func renderHighlightedName(name string, highlights map[int]bool, matchable int, isSelected bool) string {
+	plain := lipgloss.NewStyle()
+	match := styles.MatchStyle
+	if isSelected {
+		plain = styles.SelectedStyle
+		match = styles.MatchStyle.Inherit(styles.SelectedStyle)
+	}
+
+	runes := []rune(name)
+	var sb strings.Builder
+	start := 0
+	for start < len(runes) {
+		highlighted := start < matchable && highlights[start]
+		end := start + 1
+		for end < len(runes) && (end < matchable && highlights[end]) == highlighted {
+			end++
+		}
+		segment := string(runes[start:end])
+		if highlighted {
+			sb.WriteString(match.Render(segment))
+		} else {
+			sb.WriteString(plain.Render(segment))
+		}
+		start = end
+	}
+	return sb.String()
+}
+
 // waitingIndicator returns the waiting indicator string for a project.
 // Story 4.5: Uses callbacks to determine waiting state and duration.
 // Story 8.9: Uses emoji fallback for waiting indicator.
@@ -320,3 +430,24 @@ func (d ProjectItemDelegate) waitingIndicator(p *domain.Project) string {
 	}
 	return fmt.Sprintf("%s WAITING %s", emoji.Waiting(), timeformat.FormatWaitingDuration(duration, false))
 }
+
+// isWorking reports whether the project's agent is actively working.
+func (d ProjectItemDelegate) isWorking(p *domain.Project) bool {
+	return d.agentState != nil && d.agentState(p).IsWorking()
+}
+
+// monochromeStatus returns the glyph status shown in place of color when
+// rendering without color: "[z] HIBERNATED" or "[>] WORKING". Empty in color
+// mode, where the project name's color conveys the same.
+func (d ProjectItemDelegate) monochromeStatus(p *domain.Project) string {
+	if !styles.Monochrome() {
+		return ""
+	}
+	switch {
+	case p.State == domain.StateHibernated:
+		return emoji.Hibernated() + " HIBERNATED"
+	case d.isWorking(p):
+		return emoji.Working() + " WORKING"
+	}
+	return ""
+}
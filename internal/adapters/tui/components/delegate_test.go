@@ -7,9 +7,14 @@ import (
 	"time"
 
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/muesli/termenv"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/emoji"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
 )
 
 func init() {
@@ -498,7 +503,10 @@ func TestProjectItemDelegate_RendersStageInfo_BMAD(t *testing.T) {
 		DetectedMethod:     "bmad",
 		DetectionReasoning: "Story 8.3 in code review",
 		CurrentStage:       domain.StageTasks,
-		LastActivityAt:     time.Now(),
+		Sprint: &domain.SprintProgress{
+			Focus: &domain.SprintFocus{Kind: domain.FocusStory, Epic: "8", Story: "8.3", Status: domain.WorkReview},
+		},
+		LastActivityAt: time.Now(),
 	}
 	item := ProjectItem{Project: project}
 
@@ -541,6 +549,40 @@ func TestProjectItemDelegate_RendersStageInfo_Speckit(t *testing.T) {
 	}
 }
 
+func TestProjectItemDelegate_RendersTaskProgress(t *testing.T) {
+	project := &domain.Project{
+		ID:                 "1",
+		Name:               "speckit-project",
+		Path:               "/test",
+		DetectedMethod:     "speckit",
+		CurrentStage:       domain.StageTasks,
+		DetectionReasoning: "tasks.md exists, 3/8 tasks done (spec: 001-user-auth)",
+		TasksDone:          3,
+		TasksTotal:         8,
+		LastActivityAt:     time.Now(),
+	}
+	item := ProjectItem{Project: project}
+
+	render := func(width int) string {
+		delegate := NewProjectItemDelegate(width)
+		l := list.New([]list.Item{item}, delegate, width, 10)
+		var buf bytes.Buffer
+		delegate.Render(&buf, l, 0, item)
+		return buf.String()
+	}
+
+	output := render(120)
+	if !strings.Contains(output, "001-user-auth Tasks ██▎░░░ 3/8") {
+		t.Errorf("Render() should show task progress after the stage, got: %q", output)
+	}
+
+	// Narrow stage column keeps the stage info only
+	output = render(85)
+	if !strings.Contains(output, "001-user-auth Tasks") || strings.Contains(output, "3/8") {
+		t.Errorf("Render() should drop task progress in a narrow stage column, got: %q", output)
+	}
+}
+
 func TestProjectItemDelegate_RendersStageInfo_Unknown(t *testing.T) {
 	delegate := NewProjectItemDelegate(100)
 
@@ -638,7 +680,10 @@ func TestProjectItemDelegate_StageHiddenAtNarrowWidth(t *testing.T) {
 		DetectedMethod:     "bmad",
 		DetectionReasoning: "Story 8.3 in code review",
 		CurrentStage:       domain.StageTasks,
-		LastActivityAt:     time.Now(),
+		Sprint: &domain.SprintProgress{
+			Focus: &domain.SprintFocus{Kind: domain.FocusStory, Epic: "8", Story: "8.3", Status: domain.WorkReview},
+		},
+		LastActivityAt: time.Now(),
 	}
 	item := ProjectItem{Project: project}
 
@@ -784,3 +829,164 @@ func TestProjectItemDelegate_CoexistenceIndicator_NarrowWidth(t *testing.T) {
 		t.Errorf("Render() should NOT contain ⚠️ at narrow width (stage hidden), got: %q", output)
 	}
 }
+
+func TestProjectItemDelegate_Render_HighlightsFilterMatches(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI)
+	defer lipgloss.SetColorProfile(prev)
+
+	delegate := NewProjectItemDelegate(80)
+	nameWidth := delegate.calculateNameWidth()
+	item := ProjectItem{Project: &domain.Project{Name: "vibe-dash"}}
+
+	plain := delegate.renderRow(item, false, nameWidth)
+	delegate.SetFilter(ParseProjectFilter("dash"))
+	highlighted := delegate.renderRow(item, false, nameWidth)
+
+	if plain == highlighted {
+		t.Fatal("renderRow() output unchanged by filter, want highlighted match")
+	}
+	if !strings.Contains(highlighted, styles.MatchStyle.Render("dash")) {
+		t.Errorf("renderRow() = %q, want %q highlighted", highlighted, "dash")
+	}
+	if ansi.Strip(highlighted) != ansi.Strip(plain) {
+		t.Errorf("highlighting changed row text:\n got %q\nwant %q", ansi.Strip(highlighted), ansi.Strip(plain))
+	}
+}
+
+func TestProjectItemDelegate_Render_Marked(t *testing.T) {
+	delegate := NewProjectItemDelegate(80)
+	nameWidth := delegate.calculateNameWidth()
+	marked := &domain.Project{ID: "a", Name: "alpha"}
+	unmarked := &domain.Project{ID: "b", Name: "beta"}
+
+	delegate.SetMarked(map[string]bool{"a": true})
+
+	tests := []struct {
+		project  *domain.Project
+		selected bool
+		want     string
+	}{
+		{marked, true, ">+"},
+		{marked, false, " +"},
+		{unmarked, true, "> "},
+		{unmarked, false, "  "},
+	}
+	for _, tt := range tests {
+		row := ansi.Strip(delegate.renderRow(ProjectItem{Project: tt.project}, tt.selected, nameWidth))
+		if !strings.HasPrefix(row, tt.want) {
+			t.Errorf("renderRow(%s, selected=%v) prefix = %q, want %q", tt.project.Name, tt.selected, row[:2], tt.want)
+		}
+	}
+
+	// Row width is unchanged by the mark
+	plain := NewProjectItemDelegate(80).renderRow(ProjectItem{Project: marked}, false, nameWidth)
+	if got, want := lipgloss.Width(delegate.renderRow(ProjectItem{Project: marked}, false, nameWidth)), lipgloss.Width(plain); got != want {
+		t.Errorf("marked row width = %d, want %d", got, want)
+	}
+}
+
+func TestRenderHighlightedName_IgnoresTruncatedPositions(t *testing.T) {
+	// Positions at or past the truncation point must not style the "..."
+	got := renderHighlightedName("abcdefg...", map[int]bool{0: true, 8: true}, 7, false)
+	if ansi.Strip(got) != "abcdefg..." {
+		t.Errorf("renderHighlightedName() text = %q", ansi.Strip(got))
+	}
+}
+
+func TestProjectItemDelegate_RenderGroupHeader(t *testing.T) {
+	d := NewProjectItemDelegate(40)
+	var buf bytes.Buffer
+	d.Render(&buf, list.New(nil, d, 40, 10), 0, GroupHeaderItem{Label: "Implementing", Count: 7})
+
+	if got := ansi.Strip(buf.String()); !strings.Contains(got, "Implementing (7)") {
+		t.Errorf("header render = %q, want it to contain %q", got, "Implementing (7)")
+	}
+}
+
+// forceColor enables colored themes for the test even when NO_COLOR or
+// TERM=dumb is set (as in CI), restoring the color setting afterwards.
+func forceColor(t *testing.T) {
+	t.Helper()
+	prevUseColor, prevProfile := styles.UseColor, lipgloss.ColorProfile()
+	styles.UseColor = true
+	t.Cleanup(func() {
+		styles.UseColor = prevUseColor
+		lipgloss.SetColorProfile(prevProfile)
+		styles.ApplyTheme(styles.DarkTheme) // Monochrome again if color is off
+	})
+}
+
+// renderThemeRows renders one row per status (selected favorite, waiting,
+// working, hibernated) with the given theme applied.
+func renderThemeRows(t *testing.T, theme styles.Theme) string {
+	t.Helper()
+	styles.ApplyTheme(theme)
+	t.Cleanup(func() { styles.ApplyTheme(styles.DarkTheme) })
+
+	now := time.Now()
+	projects := []*domain.Project{
+		{ID: "a", Name: "alpha", CurrentStage: domain.StageImplement, IsFavorite: true, LastActivityAt: now.Add(-time.Hour)},
+		{ID: "b", Name: "beta", CurrentStage: domain.StagePlan, LastActivityAt: now.Add(-72 * time.Hour)},
+		{ID: "c", Name: "gamma", CurrentStage: domain.StageTasks, LastActivityAt: now.Add(-2 * time.Hour)},
+		{ID: "d", Name: "delta", CurrentStage: domain.StageSpecify, State: domain.StateHibernated, LastActivityAt: now.Add(-30 * 24 * time.Hour)},
+	}
+	d := NewProjectItemDelegateWithWaiting(100,
+		func(p *domain.Project) bool { return p.ID == "b" },
+		func(*domain.Project) time.Duration { return 45 * time.Minute })
+	d.SetAgentStateCallback(func(p *domain.Project) domain.AgentState {
+		if p.ID == "c" {
+			return domain.NewAgentState("Claude Code", domain.AgentWorking, time.Minute, domain.ConfidenceCertain)
+		}
+		return domain.AgentState{}
+	})
+
+	items := make([]list.Item, len(projects))
+	for i, p := range projects {
+		items[i] = ProjectItem{Project: p}
+	}
+	l := list.New(items, d, 100, 10)
+
+	var buf bytes.Buffer
+	for i, item := range items {
+		d.Render(&buf, l, i, item)
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+// TestProjectItemDelegate_Render_Themes compares rows against golden files
+// per theme. Regenerate with: go test ./internal/adapters/tui/components -run Themes -update
+func TestProjectItemDelegate_Render_Themes(t *testing.T) {
+	forceColor(t)
+
+	for _, name := range []string{"dark", "light", "high-contrast", "solarized"} {
+		t.Run(name, func(t *testing.T) {
+			lipgloss.SetColorProfile(termenv.TrueColor)
+			theme, _ := styles.BuiltinTheme(name)
+			golden.RequireEqualEscape(t, []byte(renderThemeRows(t, theme)), true)
+		})
+	}
+
+	t.Run("monochrome", func(t *testing.T) {
+		lipgloss.SetColorProfile(termenv.Ascii)
+		out := renderThemeRows(t, styles.MonochromeTheme)
+		golden.RequireEqual(t, []byte(out))
+
+		// Status must survive without color
+		for _, want := range []string{"WAITING 45m", "🔨 WORKING", "💤 HIBERNATED", "> "} {
+			if !strings.Contains(out, want) {
+				t.Errorf("monochrome rows missing %q:\n%s", want, out)
+			}
+		}
+	})
+}
+
+func TestProjectItemDelegate_Render_WorkingColorOnlyInColorMode(t *testing.T) {
+	forceColor(t)
+	lipgloss.SetColorProfile(termenv.ANSI)
+	out := ansi.Strip(renderThemeRows(t, styles.DarkTheme))
+	if strings.Contains(out, "WORKING") || strings.Contains(out, "HIBERNATED") {
+		t.Errorf("color themes should mark working/hibernated by color, not text:\n%s", out)
+	}
+}
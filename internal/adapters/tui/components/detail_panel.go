@@ -2,20 +2,26 @@ package components
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/charts"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/emoji"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/timeformat"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/usageformat"
 )
 
 const labelWidth = 12
 
+// detailTaskBar is the width of the task progress bar in cells.
+const detailTaskBar = 16
+
 // DetailPanelModel displays detailed information about a selected project.
 type DetailPanelModel struct {
 	project          *domain.Project
@@ -26,6 +32,9 @@ type DetailPanelModel struct {
 	durationGetter   WaitingDurationGetter // nil = no duration display (Story 4.5)
 	isHorizontal     bool                  // Story 8.12: Use horizontal border style when true
 	agentStateGetter AgentStateGetter      // Story 15.7: Full agent state for confidence display
+	gitStatusGetter  GitStatusGetter       // nil = no git section
+	usageGetter      UsageGetter           // nil = no usage line
+	sprintExpanded   bool                  // Show every epic of a BMAD sprint, not just the current one
 }
 
 // NewDetailPanelModel creates a new DetailPanelModel with the given dimensions.
@@ -51,6 +60,27 @@ func (m *DetailPanelModel) SetAgentStateCallback(getter AgentStateGetter) {
 	m.agentStateGetter = getter
 }
 
+// SetGitStatusCallback sets the git status retrieval callback.
+func (m *DetailPanelModel) SetGitStatusCallback(getter GitStatusGetter) {
+	m.gitStatusGetter = getter
+}
+
+// SetUsageCallback sets the token usage retrieval callback.
+func (m *DetailPanelModel) SetUsageCallback(getter UsageGetter) {
+	m.usageGetter = getter
+}
+
+// SetSprintExpanded switches the BMAD sprint tree between the current epic
+// and all epics.
+func (m *DetailPanelModel) SetSprintExpanded(expanded bool) {
+	m.sprintExpanded = expanded
+}
+
+// SprintExpanded returns whether the sprint tree lists all epics.
+func (m DetailPanelModel) SprintExpanded() bool {
+	return m.sprintExpanded
+}
+
 // SetProject updates the displayed project.
 func (m *DetailPanelModel) SetProject(p *domain.Project) {
 	m.project = p
@@ -145,11 +175,18 @@ func (m DetailPanelModel) renderProject() string {
 	lines = append(lines, formatField("Method", method))
 
 	// Stage
-	stage := p.CurrentStage.String()
-	if stage == "" {
-		stage = "Unknown"
+	lines = append(lines, formatField("Stage", formatStage(p)))
+
+	// Task completion of the detected feature
+	if p.TasksTotal > 0 {
+		lines = append(lines, formatField("Tasks", formatTasks(p)))
+		lines = append(lines, formatTaskPhases(p)...)
+	}
+
+	// BMAD sprint completion; the epic/story tree follows the other fields
+	if p.Sprint != nil && len(p.Sprint.Epics) > 0 {
+		lines = append(lines, formatField("Sprint", formatSprintSummary(p.Sprint)))
 	}
-	lines = append(lines, formatField("Stage", stage))
 
 	// Detection reasoning
 	reasoning := p.DetectionReasoning
@@ -163,7 +200,7 @@ func (m DetailPanelModel) renderProject() string {
 	if p.CoexistenceWarning && p.SecondaryMethod != "" {
 		warningText := fmt.Sprintf("Both %s (%s) and %s (%s) detected with similar activity",
 			p.DetectedMethod,
-			p.CurrentStage.String(),
+			p.StageLabel(),
 			p.SecondaryMethod,
 			p.SecondaryStage.String(),
 		)
@@ -196,6 +233,20 @@ func (m DetailPanelModel) renderProject() string {
 	lastActive := timeformat.FormatRelativeTime(p.LastActivityAt)
 	lines = append(lines, formatField("Last Active", lastActive))
 
+	// Git repository status
+	if m.gitStatusGetter != nil {
+		if status := m.gitStatusGetter(p); status != nil {
+			lines = append(lines, formatGitLines(*status)...)
+		}
+	}
+
+	// Token usage and estimated cost of agent sessions
+	if m.usageGetter != nil {
+		if u := m.usageGetter(p); u != nil && !u.Tokens.IsZero() {
+			lines = append(lines, formatField("Usage", formatUsage(*u, time.Now())))
+		}
+	}
+
 	// Waiting status with confidence (Story 15.7)
 	if m.agentStateGetter != nil {
 		state := m.agentStateGetter(p)
@@ -214,6 +265,11 @@ func (m DetailPanelModel) renderProject() string {
 		lines = append(lines, formatField("Waiting", styledWaiting))
 	}
 
+	// BMAD epic/story tree, capped to the lines left in the panel
+	if p.Sprint != nil && len(p.Sprint.Epics) > 0 {
+		lines = append(lines, renderSprintTree(p.Sprint, m.sprintExpanded, m.height-2-len(lines))...)
+	}
+
 	// Join lines
 	content := strings.Join(lines, "\n")
 
@@ -240,6 +296,114 @@ func formatField(label, value string) string {
 	return paddedLabel + " " + value
 }
 
+// formatGitLines renders branch/upstream, working tree changes, the HEAD
+// commit and any linked worktrees.
+func formatGitLines(s domain.GitStatus) []string {
+	branch := s.BranchLabel()
+	if s.Upstream != "" {
+		sync := s.Upstream
+		if s.Ahead > 0 || s.Behind > 0 {
+			sync = fmt.Sprintf("%s ↑%d ↓%d", s.Upstream, s.Ahead, s.Behind)
+		}
+		branch = fmt.Sprintf("%s → %s", branch, sync)
+	}
+	lines := []string{formatField("Branch", branch)}
+
+	changes := s.ChangeSummary()
+	if !s.IsClean() {
+		changes = styles.WarningStyle.Render(changes)
+	}
+	lines = append(lines, formatField("Changes", changes))
+
+	if s.HeadSHA != "" {
+		commit := fmt.Sprintf("%s %s (%s)", s.ShortSHA(), s.LastCommitSubject, timeformat.FormatRelativeTime(s.LastCommitAt))
+		lines = append(lines, formatField("Commit", commit))
+	}
+
+	if len(s.Worktrees) > 0 {
+		names := make([]string, 0, len(s.Worktrees))
+		for _, wt := range s.Worktrees {
+			name := wt.Branch
+			if name == "" {
+				name = filepath.Base(wt.Path) + " (detached)"
+			}
+			names = append(names, name)
+		}
+		lines = append(lines, formatField("Worktrees", strings.Join(names, ", ")))
+	}
+	return lines
+}
+
+// formatStage renders the stage: the method's own stage with its coarse stage
+// when they differ, e.g. "Code review (Tasks)", and a check mark once the
+// method's workflow is finished. Unmapped stages show the coarse stage.
+func formatStage(p *domain.Project) string {
+	s := p.MethodStage
+	if s.IsZero() {
+		return p.CurrentStage.String()
+	}
+	text := s.Label
+	if s.Label != s.Coarse.String() {
+		text += styles.DimStyle.Render(" (" + s.Coarse.String() + ")")
+	}
+	if s.Done {
+		text = "✓ " + text
+	}
+	return text
+}
+
+// formatTasks renders task completion and the next open task with its phase:
+// "██████░░░░░░░░░░ 3/8 · next in Phase 2: T004 [P] Implement hashing".
+func formatTasks(p *domain.Project) string {
+	text := fmt.Sprintf("%s %d/%d", charts.Progress(float64(p.TasksDone), float64(p.TasksTotal), detailTaskBar), p.TasksDone, p.TasksTotal)
+	if p.NextTask == "" {
+		return text
+	}
+	if p.NextTaskPhase != "" {
+		return text + " · next in " + domain.ShortPhaseName(p.NextTaskPhase) + ": " + p.NextTask
+	}
+	return text + " · next: " + p.NextTask
+}
+
+// formatTaskPhases renders one line per phase heading, marking finished
+// phases and the phase of the next task, with open [P] tasks counted:
+// "  ▸ Phase 2: Foundational 1/3 · 2 parallel".
+func formatTaskPhases(p *domain.Project) []string {
+	lines := make([]string, 0, len(p.TaskPhases))
+	for _, phase := range p.TaskPhases {
+		marker := " "
+		switch {
+		case phase.IsDone():
+			marker = "✓"
+		case phase.Name == p.NextTaskPhase:
+			marker = "▸"
+		}
+		line := fmt.Sprintf("  %s %s %d/%d", marker, phase.Name, phase.Done, phase.Total)
+		if phase.Parallel > 0 {
+			line += fmt.Sprintf(" · %d parallel", phase.Parallel)
+		}
+		if phase.IsDone() {
+			line = styles.DimStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// formatUsage summarizes usage over its window and today:
+// "$3.42 (4.1M tokens, 30d) · today $0.80". Costs are estimates.
+func formatUsage(u domain.ProjectUsage, now time.Time) string {
+	window := "all time"
+	if !u.Since.IsZero() {
+		window = fmt.Sprintf("%dd", int(now.Sub(u.Since).Round(24*time.Hour).Hours()/24))
+	}
+	text := fmt.Sprintf("%s (%s tokens, %s)", usageformat.FormatCost(u.Cost), usageformat.FormatTokens(u.Tokens.Total()), window)
+	if today := u.Day(now); !today.Tokens.IsZero() {
+		text += fmt.Sprintf(" · today %s", usageformat.FormatCost(today.Cost))
+	}
+	return text
+}
+
 // confidenceToText converts Confidence enum to display text.
 // Story 15.7: ConfidenceLikely included for future extensibility.
 func confidenceToText(c domain.Confidence) string {
@@ -259,6 +423,8 @@ func toolToSourceText(tool string) string {
 	switch tool {
 	case "Claude Code":
 		return "Claude Code logs"
+	case "Codex":
+		return "Codex session logs"
 	case "Generic":
 		return "file activity"
 	default:
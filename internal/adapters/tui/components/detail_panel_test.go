@@ -5,6 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 )
 
@@ -759,3 +762,216 @@ func TestDetailPanel_CoexistenceWarning_HiddenWhenSecondaryMethodEmpty(t *testin
 		t.Error("should not show Coexistence label when SecondaryMethod is empty")
 	}
 }
+
+func TestDetailPanel_View_GitStatus(t *testing.T) {
+	project := &domain.Project{ID: "g1", Name: "git-project", Path: "/home/user/git-project"}
+	status := &domain.GitStatus{
+		Branch:            "main",
+		HeadSHA:           "0123456789abcdef0123456789abcdef01234567",
+		Upstream:          "origin/main",
+		Ahead:             2,
+		Behind:            1,
+		Dirty:             3,
+		Untracked:         1,
+		LastCommitSubject: "Fix parser",
+		LastCommitAt:      time.Now().Add(-2 * time.Hour),
+		Worktrees: []domain.GitWorktree{
+			{Path: "/home/user/git-project-feature", Branch: "feature"},
+			{Path: "/home/user/git-project-bisect"},
+		},
+	}
+
+	panel := NewDetailPanelModel(100, 30)
+	panel.SetProject(project)
+	panel.SetVisible(true)
+
+	if strings.Contains(panel.View(), "Branch:") {
+		t.Error("git section should be hidden without a callback")
+	}
+
+	panel.SetGitStatusCallback(func(*domain.Project) *domain.GitStatus { return status })
+	view := panel.View()
+	for _, want := range []string{
+		"main → origin/main ↑2 ↓1",
+		"3 modified, 1 untracked",
+		"0123456 Fix parser (2h ago)",
+		"feature, git-project-bisect (detached)",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view should contain %q, got:\n%s", want, view)
+		}
+	}
+
+	// Not a repository: no git section
+	panel.SetGitStatusCallback(func(*domain.Project) *domain.GitStatus { return nil })
+	if strings.Contains(panel.View(), "Branch:") {
+		t.Error("git section should be hidden for non-repositories")
+	}
+}
+
+func TestFormatGitLines_CleanInSync(t *testing.T) {
+	lines := strings.Join(formatGitLines(domain.GitStatus{Branch: "main", Upstream: "origin/main"}), "\n")
+	if !strings.Contains(lines, "main → origin/main") || strings.Contains(lines, "↑") {
+		t.Errorf("in-sync branch should not show counts:\n%s", lines)
+	}
+	if !strings.Contains(lines, "clean") {
+		t.Errorf("expected clean changes:\n%s", lines)
+	}
+	if strings.Contains(lines, "Commit:") {
+		t.Errorf("unborn branch should not show a commit line:\n%s", lines)
+	}
+}
+
+func TestDetailPanel_Usage(t *testing.T) {
+	project := &domain.Project{ID: "u", Name: "usage-project", Path: "/home/user/usage-project"}
+	now := time.Now()
+	usage := &domain.ProjectUsage{
+		Since:  now.AddDate(0, 0, -30),
+		Tokens: domain.TokenUsage{Input: 4000, Output: 100_000, CacheRead: 4_000_000},
+		Cost:   3.42,
+		ByDay: []domain.DailyUsage{{
+			Day:    time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local),
+			Tokens: domain.TokenUsage{Output: 20_000},
+			Cost:   0.8,
+		}},
+	}
+
+	panel := NewDetailPanelModel(100, 30)
+	panel.SetProject(project)
+	panel.SetVisible(true)
+	panel.SetUsageCallback(func(*domain.Project) *domain.ProjectUsage { return usage })
+
+	if view := panel.View(); !strings.Contains(view, "$3.42 (4.1M tokens, 30d) · today $0.80") {
+		t.Errorf("view should show usage, got:\n%s", view)
+	}
+
+	// No usage in the window: no line
+	panel.SetUsageCallback(func(*domain.Project) *domain.ProjectUsage { return &domain.ProjectUsage{} })
+	if strings.Contains(panel.View(), "Usage:") {
+		t.Error("usage line should be hidden without tokens")
+	}
+}
+
+func TestFormatUsage_AllTimeWithoutToday(t *testing.T) {
+	u := domain.ProjectUsage{Tokens: domain.TokenUsage{Output: 950}, Cost: 0.001}
+	if got := formatUsage(u, time.Now()); got != "<$0.01 (950 tokens, all time)" {
+		t.Errorf("formatUsage() = %q", got)
+	}
+}
+
+func TestDetailPanel_Tasks(t *testing.T) {
+	project := &domain.Project{
+		ID:           "t",
+		Name:         "tasks-project",
+		Path:         "/home/user/tasks-project",
+		CurrentStage: domain.StageTasks,
+		TasksDone:    3,
+		TasksTotal:   8,
+		NextTask:     "T004 Implement hashing",
+	}
+
+	panel := NewDetailPanelModel(120, 30)
+	panel.SetProject(project)
+	panel.SetVisible(true)
+	if view := panel.View(); !strings.Contains(view, "██████░░░░░░░░░░ 3/8 · next: T004 Implement hashing") {
+		t.Errorf("view should show task progress, got:\n%s", view)
+	}
+
+	// Phases: next task's phase in the Tasks line, one line per heading
+	project.NextTask = "T004 [P] Implement hashing"
+	project.NextTaskPhase = "Phase 2: Foundational"
+	project.TaskPhases = []domain.TaskPhase{
+		{Name: "Phase 1: Setup", Done: 2, Total: 2},
+		{Name: "Phase 2: Foundational", Done: 1, Total: 3, Parallel: 2},
+		{Name: "Phase 3: User Story 1", Total: 3},
+	}
+	view := panel.View()
+	for _, want := range []string{
+		"3/8 · next in Phase 2: T004 [P] Implement hashing",
+		"✓ Phase 1: Setup 2/2",
+		"▸ Phase 2: Foundational 1/3 · 2 parallel",
+		"  Phase 3: User Story 1 0/3",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view should contain %q, got:\n%s", want, view)
+		}
+	}
+	project.NextTaskPhase, project.TaskPhases = "", nil
+
+	// All done: no next task
+	project.TasksDone, project.NextTask = 8, ""
+	if got := formatTasks(project); got != "████████████████ 8/8" {
+		t.Errorf("formatTasks() = %q", got)
+	}
+
+	// Methods without tasks have no line
+	project.TasksTotal = 0
+	if strings.Contains(panel.View(), "Tasks:") {
+		t.Error("tasks line should be hidden without tasks")
+	}
+}
+
+func TestDetailPanel_SprintTree(t *testing.T) {
+	project := &domain.Project{
+		ID:             "b",
+		Name:           "bmad-project",
+		Path:           "/home/user/bmad-project",
+		DetectedMethod: "bmad",
+		CurrentStage:   domain.StageImplement,
+		Sprint:         testSprintProgress(),
+	}
+
+	panel := NewDetailPanelModel(120, 40)
+	panel.SetProject(project)
+	panel.SetVisible(true)
+
+	view := panel.View()
+	if !strings.Contains(view, "Sprint:") || !strings.Contains(view, "2/4 stories · 1/3 epics") {
+		t.Errorf("view should show sprint summary, got:\n%s", view)
+	}
+	if !strings.Contains(view, "4.5.2 in-progress ◂") || strings.Contains(view, "Epic 5 backlog") {
+		t.Errorf("collapsed view should list only the current epic, got:\n%s", view)
+	}
+
+	panel.SetSprintExpanded(true)
+	if !panel.SprintExpanded() {
+		t.Fatal("SprintExpanded() = false after SetSprintExpanded(true)")
+	}
+	if view := panel.View(); !strings.Contains(view, "Epic 5 backlog") {
+		t.Errorf("expanded view should list all epics, got:\n%s", view)
+	}
+
+	// The tree never grows the panel past its height
+	panel.SetSize(120, 16)
+	if got := lipgloss.Height(panel.View()); got != 16 {
+		t.Errorf("panel height = %d, want 16", got)
+	}
+
+	project.Sprint = nil
+	if strings.Contains(panel.View(), "Sprint:") {
+		t.Error("sprint line should be hidden without sprint progress")
+	}
+}
+
+func TestDetailPanel_MethodStage(t *testing.T) {
+	tests := []struct {
+		name  string
+		stage domain.Stage
+		ms    domain.MethodStage
+		want  string
+	}{
+		{"coarse only", domain.StagePlan, domain.MethodStage{}, "Plan"},
+		{"same label", domain.StagePlan, domain.MethodStage{ID: "plan", Label: "Plan", Coarse: domain.StagePlan}, "Plan"},
+		{"method label", domain.StageTasks, domain.MethodStage{ID: "code-review", Label: "Code review", Coarse: domain.StageTasks}, "Code review (Tasks)"},
+		{"done", domain.StageImplement, domain.MethodStage{ID: "done", Label: "Done", Done: true, Coarse: domain.StageImplement}, "✓ Done (Implement)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &domain.Project{CurrentStage: tt.stage, MethodStage: tt.ms}
+			if got := ansi.Strip(formatStage(p)); got != tt.want {
+				t.Errorf("formatStage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
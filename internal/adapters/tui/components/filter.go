@@ -0,0 +1,178 @@
+package components
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
+)
+
+// Structured filter tokens accepted by ParseProjectFilter.
+const (
+	filterPrefixStage  = "stage:"
+	filterPrefixMethod = "method:"
+	filterWaiting      = "waiting"
+	filterFavorite     = "fav"
+	filterFavoriteLong = "favorite"
+)
+
+// ProjectFilter is a parsed project list search query.
+//
+// Query syntax is whitespace-separated tokens:
+//   - stage:<prefix>  current stage starts with prefix (stage:impl)
+//   - method:<prefix> detected method starts with prefix (method:bmad)
+//   - waiting         agent is waiting for input
+//   - fav, favorite   project is a favorite
+//   - anything else   fuzzy-matched against name, display name, path,
+//     method, stage and notes
+//
+// All tokens must match for a project to be shown.
+type ProjectFilter struct {
+	Terms    []string // Lowercased free-text terms
+	Stage    string   // Lowercased stage prefix, empty = any
+	Method   string   // Lowercased method prefix, empty = any
+	Waiting  bool
+	Favorite bool
+}
+
+// ParseProjectFilter parses a search query. Matching is case-insensitive.
+func ParseProjectFilter(query string) ProjectFilter {
+	var f ProjectFilter
+	for _, token := range strings.Fields(strings.ToLower(query)) {
+		switch {
+		case token == filterWaiting:
+			f.Waiting = true
+		case token == filterFavorite || token == filterFavoriteLong:
+			f.Favorite = true
+		case strings.HasPrefix(token, filterPrefixStage) && len(token) > len(filterPrefixStage):
+			f.Stage = strings.TrimPrefix(token, filterPrefixStage)
+		case strings.HasPrefix(token, filterPrefixMethod) && len(token) > len(filterPrefixMethod):
+			f.Method = strings.TrimPrefix(token, filterPrefixMethod)
+		default:
+			f.Terms = append(f.Terms, token)
+		}
+	}
+	return f
+}
+
+// IsEmpty returns true if the filter matches every project.
+func (f ProjectFilter) IsEmpty() bool {
+	return len(f.Terms) == 0 && f.Stage == "" && f.Method == "" && !f.Waiting && !f.Favorite
+}
+
+// Matches reports whether p satisfies every token of the filter.
+// isWaiting may be nil, in which case the waiting token matches nothing.
+func (f ProjectFilter) Matches(p *domain.Project, isWaiting WaitingChecker) bool {
+	if p == nil {
+		return false
+	}
+	if f.Favorite && !p.IsFavorite {
+		return false
+	}
+	if f.Waiting && (isWaiting == nil || !isWaiting(p)) {
+		return false
+	}
+	if f.Stage != "" && !matchesStage(p, f.Stage) {
+		return false
+	}
+	if f.Method != "" && !strings.HasPrefix(strings.ToLower(p.DetectedMethod), f.Method) {
+		return false
+	}
+
+	fields := []string{p.Name, p.DisplayName, p.Path, p.DetectedMethod, p.CurrentStage.String(), p.MethodStage.Label, p.Notes}
+	for _, term := range f.Terms {
+		if !anyFieldMatches(term, fields) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesStage reports whether stage prefixes the project's coarse stage or
+// its method stage ID or label, so "stage:impl" finds every implementing
+// project and "stage:code" finds BMAD stories in code review.
+func matchesStage(p *domain.Project, stage string) bool {
+	for _, name := range []string{p.CurrentStage.String(), p.MethodStage.ID, p.MethodStage.Label} {
+		if name != "" && strings.HasPrefix(strings.ToLower(name), stage) {
+			return true
+		}
+	}
+	return false
+}
+
+func anyFieldMatches(term string, fields []string) bool {
+	for _, field := range fields {
+		if _, ok := fuzzyMatch(term, field); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// NameHighlights returns the rune positions in the project's effective name
+// matched by the filter's free-text terms, for highlighting.
+func (f ProjectFilter) NameHighlights(p *domain.Project) map[int]bool {
+	if len(f.Terms) == 0 || p == nil {
+		return nil
+	}
+	name := project.EffectiveName(p)
+	var positions map[int]bool
+	for _, term := range f.Terms {
+		matched, ok := fuzzyMatch(term, name)
+		if !ok {
+			continue
+		}
+		if positions == nil {
+			positions = make(map[int]bool, len(matched))
+		}
+		for _, pos := range matched {
+			positions[pos] = true
+		}
+	}
+	return positions
+}
+
+// FuzzyMatch reports whether every rune of pattern appears in text in order,
+// ignoring case, returning the rune positions matched in text.
+func FuzzyMatch(pattern, text string) ([]int, bool) {
+	return fuzzyMatch(strings.ToLower(pattern), text)
+}
+
+// fuzzyMatch reports whether every rune of pattern appears in text in order
+// (case-insensitive), returning the rune positions matched in text.
+// A contiguous substring match is preferred over a scattered one so that
+// "dash" highlights "vibe-dash" rather than "d...a...s...h".
+func fuzzyMatch(pattern, text string) ([]int, bool) {
+	if pattern == "" {
+		return nil, true
+	}
+	lowerText := strings.ToLower(text)
+	if idx := strings.Index(lowerText, pattern); idx >= 0 && len(lowerText) == len(text) {
+		start := utf8.RuneCountInString(lowerText[:idx])
+		n := utf8.RuneCountInString(pattern)
+		positions := make([]int, n)
+		for i := range positions {
+			positions[i] = start + i
+		}
+		return positions, true
+	}
+
+	patternRunes := []rune(pattern)
+	positions := make([]int, 0, len(patternRunes))
+	pi := 0
+	for ti, r := range []rune(text) {
+		if pi == len(patternRunes) {
+			break
+		}
+		if unicode.ToLower(r) == patternRunes[pi] {
+			positions = append(positions, ti)
+			pi++
+		}
+	}
+	if pi < len(patternRunes) {
+		return nil, false
+	}
+	return positions, true
+}
@@ -0,0 +1,128 @@
+package components
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func TestParseProjectFilter(t *testing.T) {
+	tests := []struct {
+		query string
+		want  ProjectFilter
+	}{
+		{"", ProjectFilter{}},
+		{"  Dash  ", ProjectFilter{Terms: []string{"dash"}}},
+		{"stage:Implement method:bmad", ProjectFilter{Stage: "implement", Method: "bmad"}},
+		{"waiting fav api", ProjectFilter{Terms: []string{"api"}, Waiting: true, Favorite: true}},
+		{"favorite", ProjectFilter{Favorite: true}},
+		{"stage:", ProjectFilter{Terms: []string{"stage:"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := ParseProjectFilter(tt.query); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseProjectFilter(%q) = %+v, want %+v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectFilter_Matches(t *testing.T) {
+	p := &domain.Project{
+		Name:           "vibe-dash",
+		DisplayName:    "Dashboard",
+		Path:           "/home/dev/work/vibe-dash",
+		DetectedMethod: "bmad",
+		CurrentStage:   domain.StageImplement,
+		MethodStage:    domain.MethodStage{ID: "retrospective", Label: "Retrospective", Ordinal: 7, Coarse: domain.StageImplement},
+		Notes:          "Release blocker",
+		IsFavorite:     true,
+	}
+	waiting := func(*domain.Project) bool { return true }
+	notWaiting := func(*domain.Project) bool { return false }
+
+	tests := []struct {
+		name      string
+		query     string
+		isWaiting WaitingChecker
+		want      bool
+	}{
+		{"empty matches all", "", nil, true},
+		{"substring of name", "dash", nil, true},
+		{"fuzzy subsequence", "vbd", nil, true},
+		{"path", "work", nil, true},
+		{"notes", "blocker", nil, true},
+		{"case insensitive", "RELEASE", nil, true},
+		{"all terms must match", "dash nothing", nil, false},
+		{"no match", "zzz", nil, false},
+		{"stage prefix", "stage:impl", nil, true},
+		{"stage mismatch", "stage:plan", nil, false},
+		{"method stage id", "stage:retro", nil, true},
+		{"method stage mismatch", "stage:code-review", nil, false},
+		{"method stage label", "retrospective", nil, true},
+		{"method", "method:bmad", nil, true},
+		{"method mismatch", "method:speckit", nil, false},
+		{"favorite", "fav", nil, true},
+		{"waiting", "waiting", waiting, true},
+		{"not waiting", "waiting", notWaiting, false},
+		{"waiting without checker", "waiting", nil, false},
+		{"combined", "stage:implement fav vibe", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseProjectFilter(tt.query).Matches(p, tt.isWaiting); got != tt.want {
+				t.Errorf("Matches(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectFilter_MatchesNotFavorite(t *testing.T) {
+	p := &domain.Project{Name: "api"}
+	if ParseProjectFilter("fav").Matches(p, nil) {
+		t.Error("fav token matched a non-favorite project")
+	}
+}
+
+func TestFuzzyMatch_Positions(t *testing.T) {
+	tests := []struct {
+		pattern, text string
+		want          []int
+		ok            bool
+	}{
+		{"dash", "vibe-dash", []int{5, 6, 7, 8}, true},
+		{"vd", "vibe-dash", []int{0, 5}, true},
+		{"VD", "vibe-dash", nil, false}, // Patterns are lowercased by the parser
+		{"xyz", "vibe-dash", nil, false},
+		{"é", "café", []int{3}, true},
+	}
+	for _, tt := range tests {
+		got, ok := fuzzyMatch(tt.pattern, tt.text)
+		if ok != tt.ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("fuzzyMatch(%q, %q) = %v, %v; want %v, %v", tt.pattern, tt.text, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestFuzzyMatch_IgnoresPatternCase(t *testing.T) {
+	got, ok := FuzzyMatch("OL", "Open log")
+	if !ok || !reflect.DeepEqual(got, []int{0, 5}) {
+		t.Errorf("FuzzyMatch(OL, Open log) = %v, %v; want [0 5], true", got, ok)
+	}
+}
+
+func TestProjectFilter_NameHighlights(t *testing.T) {
+	p := &domain.Project{Name: "vibe-dash", Path: "/work/vibe-dash"}
+
+	got := ParseProjectFilter("dash stage:impl").NameHighlights(p)
+	want := map[int]bool{5: true, 6: true, 7: true, 8: true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NameHighlights = %v, want %v", got, want)
+	}
+
+	// Terms matching only the path highlight nothing in the name
+	if got := ParseProjectFilter("work").NameHighlights(p); len(got) != 0 {
+		t.Errorf("NameHighlights(work) = %v, want none", got)
+	}
+}
@@ -24,9 +24,9 @@ func (i ProjectItem) Title() string {
 }
 
 // Description returns the description for the list item.
-// Returns the current stage as a string.
+// Returns the current stage label (the method's own stage when mapped).
 func (i ProjectItem) Description() string {
-	return i.Project.CurrentStage.String()
+	return i.Project.StageLabel()
 }
 
 // EffectiveName returns the display name if set, otherwise the name.
@@ -34,3 +34,15 @@ func (i ProjectItem) Description() string {
 func (i ProjectItem) EffectiveName() string {
 	return project.EffectiveName(i.Project)
 }
+
+// GroupHeaderItem is a non-selectable list row that labels a group of
+// projects, e.g. "Waiting (3)".
+type GroupHeaderItem struct {
+	Label string
+	Count int
+}
+
+// FilterValue returns an empty string; headers never match a filter.
+func (h GroupHeaderItem) FilterValue() string {
+	return ""
+}
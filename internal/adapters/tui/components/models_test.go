@@ -102,6 +102,17 @@ func TestProjectItem_Description(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("method stage label", func(t *testing.T) {
+		project := &domain.Project{
+			Name:         "test",
+			CurrentStage: domain.StageTasks,
+			MethodStage:  domain.MethodStage{ID: "code-review", Label: "Code review", Coarse: domain.StageTasks},
+		}
+		if got := (ProjectItem{Project: project}).Description(); got != "Code review" {
+			t.Errorf("Description() = %q, want %q", got, "Code review")
+		}
+	})
 }
 
 func TestProjectItem_EffectiveName(t *testing.T) {
@@ -1,6 +1,8 @@
 package components
 
 import (
+	"time"
+
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 
@@ -9,70 +11,168 @@ import (
 )
 
 // ProjectListModel wraps a Bubbles list for displaying projects.
+// Projects are filtered (SetFilter), ordered (SetOrdering) and optionally
+// grouped under non-selectable GroupHeaderItem rows.
 type ProjectListModel struct {
-	list     list.Model
-	projects []*domain.Project
-	width    int
-	height   int
-	delegate ProjectItemDelegate
+	list        list.Model
+	projects    []*domain.Project // Visible projects in display order
+	allProjects []*domain.Project // All projects, unfiltered
+	listIndex   []int             // Visible project index -> list item index
+	filterQuery string
+	filter      ProjectFilter
+	sortMode    domain.SortMode
+	groupBy     domain.GroupBy
+	tagOf       func(p *domain.Project) string // nil = all projects untagged
+	width       int
+	height      int
+	delegate    ProjectItemDelegate
 }
 
 // NewProjectListModel creates a new ProjectListModel with the given projects and dimensions.
+// Projects are sorted by name (favorites first) and not grouped until SetOrdering is called.
 func NewProjectListModel(projects []*domain.Project, width, height int) ProjectListModel {
-	// Sort projects alphabetically by effective name
-	sortedProjects := make([]*domain.Project, len(projects))
-	copy(sortedProjects, projects)
-	project.SortByName(sortedProjects)
-
-	// Convert to ProjectItem slice
-	items := make([]list.Item, len(sortedProjects))
-	for i, p := range sortedProjects {
-		items[i] = ProjectItem{Project: p}
-	}
-
 	// Create custom delegate
 	delegate := NewProjectItemDelegate(width)
 
 	// Initialize Bubbles list
-	l := list.New(items, delegate, width, height)
+	l := list.New(nil, delegate, width, height)
 	l.SetShowHelp(false)         // We have our own help (Story 3.5)
 	l.SetShowTitle(false)        // Title in our own header
 	l.SetShowStatusBar(false)    // Hide Bubbles pagination (Story 8.9)
-	l.SetFilteringEnabled(false) // For now, enable in post-MVP
+	l.SetFilteringEnabled(false) // Filtering is done by SetFilter
 
 	// Configure keymap
 	l.KeyMap = list.DefaultKeyMap()
 	l.KeyMap.ForceQuit.Unbind() // We handle quit ourselves
 	l.KeyMap.Filter.Unbind()    // Disable '/' filter key (conflicts with search in text view)
 
-	// Select first item if available
-	if len(items) > 0 {
-		l.Select(0)
-	}
-
-	return ProjectListModel{
+	m := ProjectListModel{
 		list:     l,
-		projects: sortedProjects,
+		sortMode: domain.SortByName,
+		groupBy:  domain.GroupByNone,
 		width:    width,
 		height:   height,
 		delegate: delegate,
 	}
+	m.SetProjects(projects)
+
+	// Select first project if available
+	m.SelectByIndex(0)
+
+	return m
 }
 
 // SetProjects updates the list with new projects.
 func (m *ProjectListModel) SetProjects(projects []*domain.Project) {
-	// Sort projects alphabetically by effective name
-	sortedProjects := make([]*domain.Project, len(projects))
-	copy(sortedProjects, projects)
-	project.SortByName(sortedProjects)
+	m.allProjects = make([]*domain.Project, len(projects))
+	copy(m.allProjects, projects)
+	m.rebuild()
+}
+
+// SetFilter filters the visible projects by a search query (see
+// ParseProjectFilter). An empty query shows all projects. The query is kept
+// across SetProjects calls. The selected project stays selected if it still
+// matches; otherwise the first match is selected.
+func (m *ProjectListModel) SetFilter(query string) {
+	m.filterQuery = query
+	m.filter = ParseProjectFilter(query)
+	m.delegate.SetFilter(m.filter)
+	m.list.SetDelegate(m.delegate)
+	m.rebuildKeepingSelection()
+}
+
+// FilterQuery returns the active search query, or "" when unfiltered.
+func (m ProjectListModel) FilterQuery() string {
+	return m.filterQuery
+}
+
+// SetOrdering sets the sort mode and grouping, keeping the selected project.
+func (m *ProjectListModel) SetOrdering(mode domain.SortMode, groupBy domain.GroupBy) {
+	m.sortMode = mode
+	m.groupBy = groupBy
+	m.rebuildKeepingSelection()
+}
+
+// SortMode returns the active sort mode.
+func (m ProjectListModel) SortMode() domain.SortMode {
+	return m.sortMode
+}
+
+// GroupBy returns the active grouping.
+func (m ProjectListModel) GroupBy() domain.GroupBy {
+	return m.groupBy
+}
+
+// SetTagLookup sets the function that returns a project's user-defined tag,
+// used when grouping by tag.
+func (m *ProjectListModel) SetTagLookup(tagOf func(p *domain.Project) string) {
+	m.tagOf = tagOf
+	if m.groupBy == domain.GroupByTag {
+		m.rebuildKeepingSelection()
+	}
+}
+
+// RefreshWaiting re-applies the filter and ordering when either depends on
+// waiting state, which changes over time without a SetProjects call.
+func (m *ProjectListModel) RefreshWaiting() {
+	if m.dependsOnWaiting() {
+		m.rebuildKeepingSelection()
+	}
+}
+
+func (m ProjectListModel) dependsOnWaiting() bool {
+	return m.filter.Waiting || m.sortMode == domain.SortByWaiting || m.sortMode == domain.SortByWaitingDuration
+}
+
+// TotalLen returns the number of projects before filtering.
+func (m ProjectListModel) TotalLen() int {
+	return len(m.allProjects)
+}
+
+// rebuildKeepingSelection rebuilds the list and reselects the previously
+// selected project, or the first project if it is no longer visible.
+func (m *ProjectListModel) rebuildKeepingSelection() {
+	selected := m.SelectedProject()
+	m.rebuild()
+	if selected == nil || !m.selectByID(selected.ID) {
+		m.SelectByIndex(0)
+	}
+}
 
-	// Convert to ProjectItem slice
-	items := make([]list.Item, len(sortedProjects))
-	for i, p := range sortedProjects {
-		items[i] = ProjectItem{Project: p}
+// rebuild recomputes the visible projects and list items from allProjects,
+// the filter, the sort mode and the grouping.
+func (m *ProjectListModel) rebuild() {
+	visible := make([]*domain.Project, 0, len(m.allProjects))
+	for _, p := range m.allProjects {
+		if m.filter.IsEmpty() || m.filter.Matches(p, m.delegate.waitingChecker) {
+			visible = append(visible, p)
+		}
+	}
+
+	waiting := m.waitingState()
+	project.Sort(visible, m.sortMode, waiting)
+
+	items := make([]list.Item, 0, len(visible))
+	m.projects = make([]*domain.Project, 0, len(visible))
+	m.listIndex = make([]int, 0, len(visible))
+	addProject := func(p *domain.Project) {
+		m.listIndex = append(m.listIndex, len(items))
+		m.projects = append(m.projects, p)
+		items = append(items, ProjectItem{Project: p})
+	}
+	if groups := project.GroupProjects(visible, m.groupBy, m.sortMode, waiting, m.tagOf); groups != nil {
+		for _, g := range groups {
+			items = append(items, GroupHeaderItem{Label: g.Label, Count: len(g.Projects)})
+			for _, p := range g.Projects {
+				addProject(p)
+			}
+		}
+	} else {
+		for _, p := range visible {
+			addProject(p)
+		}
 	}
 
-	m.projects = sortedProjects
 	m.list.SetItems(items)
 
 	// Handle selection after list update (Story 3.9 AC6 fix)
@@ -84,6 +184,43 @@ func (m *ProjectListModel) SetProjects(projects []*domain.Project) {
 	if len(items) > 0 && m.list.Index() < 0 {
 		m.list.Select(0)
 	}
+	m.skipHeader(true)
+}
+
+// waitingState adapts the delegate's waiting callbacks for project.Sort.
+func (m ProjectListModel) waitingState() project.WaitingState {
+	checker, getter := m.delegate.waitingChecker, m.delegate.durationGetter
+	if checker == nil {
+		return nil
+	}
+	return func(p *domain.Project) (bool, time.Duration) {
+		if !checker(p) {
+			return false, 0
+		}
+		if getter == nil {
+			return true, 0
+		}
+		return true, getter(p)
+	}
+}
+
+// skipHeader moves the cursor off a group header onto the nearest project
+// in the direction of travel. Groups are never empty, so the row after a
+// header is always a project.
+func (m *ProjectListModel) skipHeader(forward bool) {
+	idx := m.list.Index()
+	items := m.list.Items()
+	if idx < 0 || idx >= len(items) {
+		return
+	}
+	if _, ok := items[idx].(GroupHeaderItem); !ok {
+		return
+	}
+	if forward || idx == 0 {
+		m.list.Select(idx + 1)
+	} else {
+		m.list.Select(idx - 1)
+	}
 }
 
 // SetSize updates the list dimensions for responsive layout.
@@ -104,7 +241,9 @@ func (m ProjectListModel) View() string {
 // Update handles messages and returns updated model with commands.
 func (m ProjectListModel) Update(msg tea.Msg) (ProjectListModel, tea.Cmd) {
 	var cmd tea.Cmd
+	prev := m.list.Index()
 	m.list, cmd = m.list.Update(msg)
+	m.skipHeader(m.list.Index() >= prev)
 	return m, cmd
 }
 
@@ -126,12 +265,19 @@ func (m ProjectListModel) HasProjects() bool {
 	return len(m.projects) > 0
 }
 
-// Index returns the current selection index.
+// Index returns the current selection index into Projects().
+// Group header rows are not counted.
 func (m ProjectListModel) Index() int {
-	return m.list.Index()
+	idx := m.list.Index()
+	for i, li := range m.listIndex {
+		if li == idx {
+			return i
+		}
+	}
+	return idx
 }
 
-// Len returns the number of items in the list.
+// Len returns the number of visible projects.
 func (m ProjectListModel) Len() int {
 	return len(m.projects)
 }
@@ -141,6 +287,23 @@ func (m ProjectListModel) Len() int {
 func (m *ProjectListModel) SetDelegateWaitingCallbacks(checker WaitingChecker, getter WaitingDurationGetter) {
 	m.delegate.SetWaitingCallbacks(checker, getter)
 	m.list.SetDelegate(m.delegate)
+	if m.dependsOnWaiting() {
+		m.rebuildKeepingSelection()
+	}
+}
+
+// SetDelegateAgentStateCallback sets the agent state callback on the delegate,
+// used to mark projects whose agent is working.
+func (m *ProjectListModel) SetDelegateAgentStateCallback(getter AgentStateGetter) {
+	m.delegate.SetAgentStateCallback(getter)
+	m.list.SetDelegate(m.delegate)
+}
+
+// SetMarked sets the project IDs marked for bulk actions. The map is shared
+// with the caller, which may mutate it in place.
+func (m *ProjectListModel) SetMarked(marked map[string]bool) {
+	m.delegate.SetMarked(marked)
+	m.list.SetDelegate(m.delegate)
 }
 
 // Width returns the current width of the project list.
@@ -155,12 +318,24 @@ func (m ProjectListModel) Height() int {
 	return m.height
 }
 
-// SelectByIndex selects the item at the given index.
+// SelectByIndex selects the project at the given index into Projects().
 // Story 8.5: Used for selection preservation after list re-sort.
 func (m *ProjectListModel) SelectByIndex(idx int) {
 	if idx >= 0 && idx < len(m.projects) {
-		m.list.Select(idx)
+		m.list.Select(m.listIndex[idx])
+	}
+}
+
+// selectByID selects the visible project with the given ID.
+// Returns false if no visible project has that ID.
+func (m *ProjectListModel) selectByID(id string) bool {
+	for i, p := range m.projects {
+		if p.ID == id {
+			m.SelectByIndex(i)
+			return true
+		}
 	}
+	return false
 }
 
 // ResetViewport resets the list viewport to first page.
@@ -168,9 +343,10 @@ func (m *ProjectListModel) SelectByIndex(idx int) {
 // Story 8.12: Fixes horizontal layout invisible list bug.
 func (m *ProjectListModel) ResetViewport() {
 	m.list.ResetSelected()
+	m.skipHeader(true)
 }
 
-// Projects returns the internal sorted projects slice.
+// Projects returns the visible projects in display order.
 func (m ProjectListModel) Projects() []*domain.Project {
 	return m.projects
 }
@@ -1,9 +1,12 @@
 package components
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
+
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 )
 
@@ -451,3 +454,150 @@ func TestProjectListModel_Story85_SelectionPreservedAfterFavoriteToggle(t *testi
 		t.Errorf("Beta should now be at index 0 (first favorite), got index %d", model.Index())
 	}
 }
+
+func TestProjectListModel_SetFilter(t *testing.T) {
+	projects := []*domain.Project{
+		createTestProject("alpha", ""),
+		createTestProject("beta", ""),
+		createTestProject("gamma", ""),
+	}
+	projects[2].IsFavorite = true
+	model := NewProjectListModel(projects, 80, 24)
+	model.SelectByIndex(2) // Favorites sort first: gamma, alpha, beta
+
+	model.SetFilter("a")
+	if model.Len() != 3 || model.TotalLen() != 3 {
+		t.Fatalf("Len/TotalLen = %d/%d, want 3/3", model.Len(), model.TotalLen())
+	}
+	if got := model.SelectedProject(); got == nil || got.Name != "beta" {
+		t.Errorf("selection not preserved while still matching: %v", got)
+	}
+
+	model.SetFilter("fav")
+	if model.Len() != 1 || model.SelectedProject().Name != "gamma" {
+		t.Errorf("fav filter: Len = %d, selected = %v", model.Len(), model.SelectedProject())
+	}
+	if model.FilterQuery() != "fav" {
+		t.Errorf("FilterQuery() = %q, want fav", model.FilterQuery())
+	}
+
+	model.SetFilter("zzz")
+	if model.Len() != 0 || model.SelectedProject() != nil {
+		t.Errorf("no-match filter: Len = %d, selected = %v", model.Len(), model.SelectedProject())
+	}
+
+	model.SetFilter("")
+	if model.Len() != 3 {
+		t.Errorf("cleared filter: Len = %d, want 3", model.Len())
+	}
+}
+
+func TestProjectListModel_FilterPersistsAcrossSetProjects(t *testing.T) {
+	model := NewProjectListModel([]*domain.Project{createTestProject("api", "")}, 80, 24)
+	model.SetFilter("web")
+
+	model.SetProjects([]*domain.Project{
+		createTestProject("api", ""),
+		createTestProject("web-app", ""),
+		createTestProject("web-site", ""),
+	})
+
+	if model.Len() != 2 || model.TotalLen() != 3 {
+		t.Errorf("Len/TotalLen = %d/%d, want 2/3", model.Len(), model.TotalLen())
+	}
+	for _, p := range model.Projects() {
+		if p.Name == "api" {
+			t.Error("filtered-out project visible after SetProjects")
+		}
+	}
+}
+
+func TestProjectListModel_WaitingFilterUsesDelegateChecker(t *testing.T) {
+	projects := []*domain.Project{createTestProject("idle", ""), createTestProject("blocked", "")}
+	model := NewProjectListModel(projects, 80, 24)
+	model.SetFilter("waiting")
+	if model.Len() != 0 {
+		t.Errorf("waiting filter without checker: Len = %d, want 0", model.Len())
+	}
+
+	model.SetDelegateWaitingCallbacks(func(p *domain.Project) bool { return p.Name == "blocked" }, nil)
+	if model.Len() != 1 || model.SelectedProject().Name != "blocked" {
+		t.Errorf("waiting filter: Len = %d, selected = %v", model.Len(), model.SelectedProject())
+	}
+}
+
+func TestProjectListModel_SetOrdering_SortsAndGroups(t *testing.T) {
+	alpha := createTestProject("alpha", "")
+	alpha.CurrentStage = domain.StagePlan
+	bravo := createTestProject("bravo", "")
+	bravo.CurrentStage = domain.StageImplement
+	charlie := createTestProject("charlie", "")
+	charlie.CurrentStage = domain.StageImplement
+
+	model := NewProjectListModel([]*domain.Project{alpha, bravo, charlie}, 80, 24)
+	model.SetDelegateWaitingCallbacks(func(p *domain.Project) bool { return p == charlie }, nil)
+	model.SetOrdering(domain.SortByWaiting, domain.GroupByStage)
+
+	// Waiting (charlie) / Implementing (bravo) / Planning (alpha)
+	var got []string
+	for _, p := range model.Projects() {
+		got = append(got, p.Name)
+	}
+	if want := []string{"charlie", "bravo", "alpha"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Projects() = %v, want %v", got, want)
+	}
+
+	items := model.list.Items()
+	if len(items) != 6 {
+		t.Fatalf("list items = %d, want 6 (3 headers + 3 projects)", len(items))
+	}
+	if h, ok := items[0].(GroupHeaderItem); !ok || h.Label != "Waiting" || h.Count != 1 {
+		t.Errorf("items[0] = %+v, want Waiting (1) header", items[0])
+	}
+
+	// Headers are never selected and Index counts projects only
+	model.SelectByIndex(0)
+	if model.SelectedProject() != charlie || model.Index() != 0 {
+		t.Errorf("selected %v at %d, want charlie at 0", model.SelectedProject(), model.Index())
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	if model.SelectedProject() != bravo || model.Index() != 1 {
+		t.Errorf("after down: selected %v at %d, want bravo at 1", model.SelectedProject(), model.Index())
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'k'}})
+	if model.SelectedProject() != charlie {
+		t.Errorf("after up: selected %v, want charlie", model.SelectedProject())
+	}
+}
+
+func TestProjectListModel_SetOrdering_KeepsSelection(t *testing.T) {
+	projects := []*domain.Project{createTestProject("alpha", ""), createTestProject("bravo", ""), createTestProject("charlie", "")}
+	projects[0].LastActivityAt = time.Now().Add(-time.Hour)
+	projects[2].LastActivityAt = time.Now()
+	model := NewProjectListModel(projects, 80, 24)
+	model.SelectByIndex(2) // charlie
+
+	model.SetOrdering(domain.SortByRecent, domain.GroupByNone)
+	if model.SelectedProject().Name != "charlie" || model.Index() != 0 {
+		t.Errorf("selected %v at %d, want charlie at 0", model.SelectedProject(), model.Index())
+	}
+}
+
+func TestProjectListModel_GroupByTag(t *testing.T) {
+	projects := []*domain.Project{createTestProject("alpha", ""), createTestProject("bravo", "")}
+	model := NewProjectListModel(projects, 80, 24)
+	model.SetOrdering(domain.SortByName, domain.GroupByTag)
+	model.SetTagLookup(func(p *domain.Project) string {
+		if p.Name == "bravo" {
+			return "work"
+		}
+		return ""
+	})
+
+	if h, ok := model.list.Items()[0].(GroupHeaderItem); !ok || h.Label != "work" {
+		t.Errorf("first header = %+v, want work", model.list.Items()[0])
+	}
+	if model.SelectedProject().Name != "alpha" || model.Index() != 1 {
+		t.Errorf("selected %v at %d, want alpha kept at 1", model.SelectedProject(), model.Index())
+	}
+}
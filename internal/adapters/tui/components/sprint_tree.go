@@ -0,0 +1,125 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/charts"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
+)
+
+// formatSprintSummary renders story and epic completion of a BMAD sprint:
+// "██████░░░░░░░░░░ 12/30 stories · 2/6 epics".
+func formatSprintSummary(s *domain.SprintProgress) string {
+	storiesDone, storiesTotal := s.StoryCounts()
+	epicsDone, epicsTotal := s.EpicCounts()
+	return fmt.Sprintf("%s %d/%d stories · %d/%d epics",
+		charts.Progress(float64(storiesDone), float64(storiesTotal), detailTaskBar),
+		storiesDone, storiesTotal, epicsDone, epicsTotal)
+}
+
+// renderSprintTree renders the epic/story tree of a BMAD sprint in at most
+// maxLines lines. Collapsed, only the focused epic (or the first active one)
+// is listed with its stories; expanded, every epic is. Lines that do not fit
+// are replaced by a "… N more" line.
+func renderSprintTree(s *domain.SprintProgress, expanded bool, maxLines int) []string {
+	if s == nil || maxLines <= 0 {
+		return nil
+	}
+
+	var epics []domain.SprintEpic
+	if expanded {
+		epics = s.Epics
+	} else if epic := currentEpic(s); epic != nil {
+		epics = []domain.SprintEpic{*epic}
+	}
+
+	var lines []string
+	for _, e := range epics {
+		lines = append(lines, formatSprintEpic(e))
+		for _, story := range e.Stories {
+			lines = append(lines, formatSprintStory(story, isFocusStory(s.Focus, story)))
+		}
+	}
+	for _, w := range s.Warnings {
+		lines = append(lines, "  "+styles.WarningStyle.Render("! "+w.String()))
+	}
+
+	if len(lines) > maxLines {
+		hidden := len(lines) - maxLines + 1
+		lines = append(lines[:maxLines-1], styles.DimStyle.Render(fmt.Sprintf("  … %d more", hidden)))
+	}
+	return lines
+}
+
+// currentEpic returns the epic the sprint focus points at, or else the first
+// epic in progress. Returns nil when neither exists.
+func currentEpic(s *domain.SprintProgress) *domain.SprintEpic {
+	if s.Focus != nil && s.Focus.Epic != "" {
+		if epic := s.Epic(s.Focus.Epic); epic != nil {
+			return epic
+		}
+	}
+	for i := range s.Epics {
+		if s.Epics[i].Status.IsActive() {
+			return &s.Epics[i]
+		}
+	}
+	return nil
+}
+
+// isFocusStory reports whether story is the one the stage was derived from.
+func isFocusStory(f *domain.SprintFocus, story domain.SprintStory) bool {
+	return f != nil && f.Kind == domain.FocusStory && f.Story == story.ID
+}
+
+// formatSprintEpic renders an epic line: "  Epic 4.5 in-progress 3/5".
+func formatSprintEpic(e domain.SprintEpic) string {
+	line := fmt.Sprintf("Epic %s %s", e.ID, e.Status)
+	if len(e.Stories) > 0 {
+		done, total := e.StoryCounts()
+		line += fmt.Sprintf(" %d/%d", done, total)
+	}
+	if e.Retrospective != "" {
+		line += " · retro " + string(e.Retrospective)
+	}
+	if e.Status == domain.WorkDone || e.Status == domain.WorkDeferred {
+		return "  " + styles.DimStyle.Render(line)
+	}
+	return "  " + line
+}
+
+// formatSprintStory renders a story line: "    ● 4.5.2 in-progress ◂".
+// Unknown statuses show the value as written.
+func formatSprintStory(story domain.SprintStory, focus bool) string {
+	status := string(story.Status)
+	if story.Status == domain.WorkUnknown {
+		status = fmt.Sprintf("%q", story.RawStatus)
+	}
+	line := fmt.Sprintf("%s %s %s", storyMarker(story.Status), story.ID, status)
+
+	switch {
+	case focus:
+		return "    " + styles.ActiveStyle.Render(line+" ◂")
+	case story.Status == domain.WorkDone || story.Status == domain.WorkDeferred:
+		return "    " + styles.DimStyle.Render(line)
+	default:
+		return "    " + line
+	}
+}
+
+// storyMarker returns the status symbol shown before a story.
+func storyMarker(status domain.WorkStatus) string {
+	switch {
+	case status == domain.WorkDone:
+		return "✓"
+	case status.IsActive():
+		return "●"
+	case status == domain.WorkDeferred:
+		return "–"
+	case status == domain.WorkUnknown:
+		return "?"
+	default:
+		return "○"
+	}
+}
@@ -0,0 +1,76 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func testSprintProgress() *domain.SprintProgress {
+	return &domain.SprintProgress{
+		Epics: []domain.SprintEpic{
+			{ID: "1", Status: domain.WorkDone, Retrospective: domain.WorkDone, Stories: []domain.SprintStory{
+				{ID: "1.1", Status: domain.WorkDone},
+			}},
+			{ID: "4.5", Status: domain.WorkInProgress, Stories: []domain.SprintStory{
+				{ID: "4.5.1", Status: domain.WorkDone},
+				{ID: "4.5.2", Status: domain.WorkInProgress},
+				{ID: "4.5.3", Status: domain.WorkUnknown, RawStatus: "blocked"},
+			}},
+			{ID: "5", Status: domain.WorkBacklog},
+		},
+		Focus:    &domain.SprintFocus{Kind: domain.FocusStory, Epic: "4.5", Story: "4.5.2", Status: domain.WorkInProgress},
+		Warnings: []domain.SprintWarning{{Kind: domain.WarningOrphanStory, Key: "9-1-x", Detail: "9.1"}},
+	}
+}
+
+func TestRenderSprintTree_Collapsed(t *testing.T) {
+	lines := renderSprintTree(testSprintProgress(), false, 20)
+	want := []string{
+		"  Epic 4.5 in-progress 1/3",
+		"    ✓ 4.5.1 done",
+		"    ● 4.5.2 in-progress ◂",
+		`    ? 4.5.3 "blocked"`,
+		"  ! orphan story 9.1",
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Errorf("renderSprintTree() =\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestRenderSprintTree_Expanded(t *testing.T) {
+	lines := renderSprintTree(testSprintProgress(), true, 20)
+	if len(lines) != 8 {
+		t.Fatalf("renderSprintTree() = %d lines, want 8:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	if lines[0] != "  Epic 1 done 1/1 · retro done" || lines[6] != "  Epic 5 backlog" {
+		t.Errorf("unexpected epics:\n%s", strings.Join(lines, "\n"))
+	}
+}
+
+func TestRenderSprintTree_Capped(t *testing.T) {
+	lines := renderSprintTree(testSprintProgress(), true, 4)
+	if len(lines) != 4 || lines[3] != "  … 5 more" {
+		t.Errorf("renderSprintTree() =\n%s", strings.Join(lines, "\n"))
+	}
+	if lines := renderSprintTree(testSprintProgress(), true, 0); lines != nil {
+		t.Errorf("renderSprintTree() with no room = %q, want nil", lines)
+	}
+}
+
+func TestRenderSprintTree_NoCurrentEpic(t *testing.T) {
+	s := &domain.SprintProgress{
+		Epics: []domain.SprintEpic{{ID: "1", Status: domain.WorkDone}},
+		Focus: &domain.SprintFocus{Kind: domain.FocusComplete, Status: domain.WorkDone},
+	}
+	if lines := renderSprintTree(s, false, 10); len(lines) != 0 {
+		t.Errorf("collapsed tree without current epic = %q, want empty", lines)
+	}
+}
+
+func TestFormatSprintSummary(t *testing.T) {
+	if got := formatSprintSummary(testSprintProgress()); got != "████████░░░░░░░░ 2/4 stories · 1/3 epics" {
+		t.Errorf("formatSprintSummary() = %q", got)
+	}
+}
@@ -42,6 +42,12 @@ type StatusBarModel struct {
 	refreshTotal    int
 	lastRefreshMsg  string // "Refreshed N projects" or error message
 
+	// Bulk action state (marked projects)
+	markedCount  int
+	bulkLabel    string // "Hibernating"; empty when no bulk action is running
+	bulkProgress int
+	bulkTotal    int
+
 	// Watcher warning (Story 4.6)
 	watcherWarning string // Empty means no warning, "⚠️ File watching unavailable" on error
 
@@ -105,6 +111,19 @@ func (s *StatusBarModel) SetRefreshComplete(msg string) {
 	s.lastRefreshMsg = msg
 }
 
+// SetMarkedCount sets how many projects are marked for bulk actions.
+func (s *StatusBarModel) SetMarkedCount(count int) {
+	s.markedCount = count
+}
+
+// SetBulkProgress shows the progress of a running bulk action, e.g.
+// "Hibernating... (2/5)". Pass an empty label to clear it.
+func (s *StatusBarModel) SetBulkProgress(label string, progress, total int) {
+	s.bulkLabel = label
+	s.bulkProgress = progress
+	s.bulkTotal = total
+}
+
 // SetWatcherWarning sets the file watcher warning message (Story 4.6).
 // Pass empty string to clear the warning.
 func (s *StatusBarModel) SetWatcherWarning(warning string) {
@@ -154,6 +173,10 @@ func (s StatusBarModel) renderCondensed() string {
 		return fmt.Sprintf("│ Refreshing %d/%d │ [j/k][?][q] │", s.refreshProgress, s.refreshTotal)
 	}
 
+	if s.bulkLabel != "" {
+		return fmt.Sprintf("│ %s %d/%d │ [j/k][?][q] │", s.bulkLabel, s.bulkProgress, s.bulkTotal)
+	}
+
 	// Abbreviated counts (Story 3.10)
 	counts := fmt.Sprintf("%dA %dH", s.activeCount, s.hibernatedCount)
 
@@ -164,6 +187,10 @@ func (s StatusBarModel) renderCondensed() string {
 		counts += " " + styles.DimStyle.Render("0W")
 	}
 
+	if s.markedCount > 0 {
+		counts += " " + styles.TitleStyle.Render(fmt.Sprintf("%dM", s.markedCount))
+	}
+
 	// Include refresh message if present (Story 3.6)
 	if s.lastRefreshMsg != "" {
 		counts += " " + s.lastRefreshMsg
@@ -190,9 +217,21 @@ func (s StatusBarModel) renderCondensed() string {
 
 // renderCounts renders the counts line with pipe separators (AC1, AC4, AC5).
 func (s StatusBarModel) renderCounts() string {
+	// Bulk action progress replaces the counts while it runs
+	if s.bulkLabel != "" {
+		return fmt.Sprintf("│ %s... (%d/%d) │", s.bulkLabel, s.bulkProgress, s.bulkTotal)
+	}
+
 	// Story 11.4: Show hibernated count when in hibernated view (AC7)
 	if s.inHibernatedView {
-		return fmt.Sprintf("│ Viewing %d hibernated projects │", s.hibernatedViewCount)
+		parts := []string{fmt.Sprintf("Viewing %d hibernated projects", s.hibernatedViewCount)}
+		if s.markedCount > 0 {
+			parts = append(parts, s.renderMarked())
+		}
+		if s.lastRefreshMsg != "" {
+			parts = append(parts, s.lastRefreshMsg)
+		}
+		return "│ " + strings.Join(parts, " │ ") + " │"
 	}
 
 	// Story 7.4 AC1: Show loading indicator first
@@ -223,6 +262,10 @@ func (s StatusBarModel) renderCounts() string {
 		parts = append(parts, waitingText)
 	}
 
+	if s.markedCount > 0 {
+		parts = append(parts, s.renderMarked())
+	}
+
 	// Show refresh result for 3 seconds after completion (Story 3.6)
 	if s.lastRefreshMsg != "" {
 		parts = append(parts, s.lastRefreshMsg)
@@ -246,6 +289,11 @@ func (s StatusBarModel) renderCounts() string {
 	return "│ " + strings.Join(parts, " │ ") + " │"
 }
 
+// renderMarked renders the marked project count, e.g. "3 marked".
+func (s StatusBarModel) renderMarked() string {
+	return styles.TitleStyle.Render(fmt.Sprintf("%d marked", s.markedCount))
+}
+
 // renderShortcuts renders the shortcuts line (AC7: responsive width).
 func (s StatusBarModel) renderShortcuts() string {
 	// Story 11.4: Use hibernated shortcuts when in hibernated view
@@ -1085,3 +1085,69 @@ func TestStatusBarModel_HeightHint_WithOtherWarnings(t *testing.T) {
 		t.Error("expected height hint to appear")
 	}
 }
+
+// ============================================================================
+// Bulk Action Tests
+// ============================================================================
+
+func TestStatusBar_MarkedCount(t *testing.T) {
+	sb := NewStatusBarModel(100)
+	sb.SetCounts(5, 2, 0)
+
+	if view := sb.View(); strings.Contains(view, "marked") {
+		t.Errorf("expected no marked count when nothing is marked, got: %s", view)
+	}
+
+	sb.SetMarkedCount(3)
+	if view := sb.View(); !strings.Contains(view, "3 marked") {
+		t.Errorf("expected '3 marked' in output, got: %s", view)
+	}
+
+	sb.SetInHibernatedView(true)
+	sb.SetHibernatedViewCount(4)
+	view := sb.View()
+	if !strings.Contains(view, "Viewing 4 hibernated projects") || !strings.Contains(view, "3 marked") {
+		t.Errorf("expected hibernated count and marked count, got: %s", view)
+	}
+
+	sb.SetInHibernatedView(false)
+	sb.SetCondensed(true)
+	if view := sb.View(); !strings.Contains(view, "3M") {
+		t.Errorf("expected condensed '3M' in output, got: %s", view)
+	}
+}
+
+func TestStatusBar_BulkProgress(t *testing.T) {
+	sb := NewStatusBarModel(100)
+	sb.SetCounts(5, 2, 0)
+	sb.SetBulkProgress("Hibernating", 2, 4)
+
+	view := sb.View()
+	if !strings.Contains(view, "Hibernating... (2/4)") {
+		t.Errorf("expected bulk progress in output, got: %s", view)
+	}
+	if strings.Contains(view, "5 active") {
+		t.Errorf("expected counts hidden during bulk action, got: %s", view)
+	}
+
+	sb.SetCondensed(true)
+	if view := sb.View(); !strings.Contains(view, "Hibernating 2/4") {
+		t.Errorf("expected condensed bulk progress, got: %s", view)
+	}
+
+	sb.SetCondensed(false)
+	sb.SetBulkProgress("", 0, 0)
+	if view := sb.View(); !strings.Contains(view, "5 active") {
+		t.Errorf("expected counts after bulk action ends, got: %s", view)
+	}
+}
+
+func TestStatusBar_HibernatedView_ShowsRefreshMessage(t *testing.T) {
+	sb := NewStatusBarModel(100)
+	sb.SetInHibernatedView(true)
+	sb.SetRefreshComplete("✓ Activated 2 projects")
+
+	if view := sb.View(); !strings.Contains(view, "✓ Activated 2 projects") {
+		t.Errorf("expected bulk summary in hibernated view, got: %s", view)
+	}
+}
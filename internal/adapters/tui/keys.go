@@ -1,5 +1,13 @@
 package tui
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
 // Key binding constants for the TUI.
 // These define the keyboard shortcuts used throughout the application.
 const (
@@ -9,6 +17,11 @@ const (
 	KeyHelp      = "?"
 	KeyEscape    = "esc"
 	KeyDetail    = "d"
+	KeySprint    = "e" // Expand/collapse the BMAD sprint tree in the detail panel
+	KeySearch    = "/" // Filter the project list
+	KeySort      = "s" // Cycle project list sort mode
+	KeyGroup     = "o" // Cycle project list grouping
+	KeyPalette   = ":" // Open the command palette (also ctrl+p)
 
 	// Navigation
 	KeyDown      = "j"
@@ -23,82 +36,354 @@ const (
 	KeyAdd      = "a"
 	KeyRefresh  = "r"
 
+	// Selection (bulk actions)
+	KeyMark      = " " // Mark/unmark the selected project
+	KeyMarkRange = "V" // Mark from the last marked project to the cursor
+	KeyMarkAll   = "*" // Mark/unmark every shown project
+
 	// Views
 	KeyHibernated  = "h"
 	KeyStateToggle = "H" // Story 11.7: Manual state toggle (uppercase H)
+	KeyStats       = "S" // Progress metrics for the selected project
+	KeyStatsRange  = "t" // Cycle stats view time range
 
 	// Log Session (Story 12.1)
 	KeyLogSession  = "S"           // Session picker in log view (AC6)
 	KeyLogJumpEnd  = "G"           // Jump to end, resume auto-scroll (AC3)
 	KeyShiftEnter  = "shift+enter" // Open session picker from project list
 	KeyLogOpenView = "l"           // Story 12.2 AC1: Open session selector from project list
+
+	// Structured Claude Code logs
+	KeyLogNextPrompt    = "]" // Jump to the next user prompt
+	KeyLogPrevPrompt    = "[" // Jump to the previous user prompt
+	KeyLogToggleResult  = "c" // Expand/collapse the first tool result on screen
+	KeyLogToggleResults = "C" // Expand/collapse every tool result
+	KeyLogLoadEarlier   = "{" // Load the entries before the first one shown
+	KeyLogLoadLater     = "}" // Load the entries after the last one shown
 )
 
+// Keys are the keys bound to one action. The first key is shown first in help.
+type Keys []string
+
+// Has reports whether key is one of the keys.
+func (k Keys) Has(key string) bool {
+	for _, bound := range k {
+		if bound == key {
+			return true
+		}
+	}
+	return false
+}
+
+// String joins the keys with "/" for display (e.g. "j/ctrl+n").
+func (k Keys) String() string {
+	return strings.Join(k, "/")
+}
+
 // KeyBindings holds the current key bindings for the TUI.
-// This allows for future customization of key bindings.
+// Defaults are overridden per action by the config keybindings section
+// (see KeyBindingsFromConfig).
 type KeyBindings struct {
 	// General
-	Quit      string
-	ForceQuit string
-	Help      string
-	Escape    string
-	Detail    string
+	Quit      Keys
+	ForceQuit Keys
+	Help      Keys
+	Escape    Keys
+	Detail    Keys
+	Sprint    Keys
+	Search    Keys
+	Sort      Keys
+	Group     Keys
+	Palette   Keys
 
 	// Navigation
-	Down      string
-	DownArrow string
-	Up        string
-	UpArrow   string
+	Down      Keys
+	DownArrow Keys
+	Up        Keys
+	UpArrow   Keys
 
 	// Actions
-	Favorite string
-	Notes    string
-	Remove   string
-	Add      string
-	Refresh  string
+	Favorite Keys
+	Notes    Keys
+	Remove   Keys
+	Add      Keys
+	Refresh  Keys
+
+	// Selection (bulk actions)
+	Mark      Keys
+	MarkRange Keys
+	MarkAll   Keys
 
 	// Views
-	Hibernated  string
-	StateToggle string // Story 11.7: Manual state toggle
+	Hibernated  Keys
+	StateToggle Keys // Story 11.7: Manual state toggle
+	Stats       Keys
+	StatsRange  Keys
 
 	// Log Session (Story 12.1)
-	LogSession  string
-	LogJumpEnd  string
-	ShiftEnter  string
-	LogOpenView string // Story 12.2 AC1: Open session selector from project list
+	LogSession  Keys
+	LogJumpEnd  Keys
+	ShiftEnter  Keys
+	LogOpenView Keys // Story 12.2 AC1: Open session selector from project list
+
+	// Structured Claude Code logs
+	LogNextPrompt    Keys
+	LogPrevPrompt    Keys
+	LogToggleResult  Keys
+	LogToggleResults Keys
+	LogLoadEarlier   Keys
+	LogLoadLater     Keys
 }
 
 // DefaultKeyBindings returns the default key bindings.
 func DefaultKeyBindings() KeyBindings {
 	return KeyBindings{
 		// General
-		Quit:      KeyQuit,
-		ForceQuit: KeyForceQuit,
-		Help:      KeyHelp,
-		Escape:    KeyEscape,
-		Detail:    KeyDetail,
+		Quit:      Keys{KeyQuit},
+		ForceQuit: Keys{KeyForceQuit},
+		Help:      Keys{KeyHelp},
+		Escape:    Keys{KeyEscape},
+		Detail:    Keys{KeyDetail},
+		Sprint:    Keys{KeySprint},
+		Search:    Keys{KeySearch},
+		Sort:      Keys{KeySort},
+		Group:     Keys{KeyGroup},
+		Palette:   Keys{KeyPalette, "ctrl+p"},
 
 		// Navigation
-		Down:      KeyDown,
-		DownArrow: KeyDownArrow,
-		Up:        KeyUp,
-		UpArrow:   KeyUpArrow,
+		Down:      Keys{KeyDown},
+		DownArrow: Keys{KeyDownArrow},
+		Up:        Keys{KeyUp},
+		UpArrow:   Keys{KeyUpArrow},
 
 		// Actions
-		Favorite: KeyFavorite,
-		Notes:    KeyNotes,
-		Remove:   KeyRemove,
-		Add:      KeyAdd,
-		Refresh:  KeyRefresh,
+		Favorite: Keys{KeyFavorite},
+		Notes:    Keys{KeyNotes},
+		Remove:   Keys{KeyRemove},
+		Add:      Keys{KeyAdd},
+		Refresh:  Keys{KeyRefresh},
+
+		// Selection (bulk actions)
+		Mark:      Keys{KeyMark},
+		MarkRange: Keys{KeyMarkRange},
+		MarkAll:   Keys{KeyMarkAll},
 
 		// Views
-		Hibernated:  KeyHibernated,
-		StateToggle: KeyStateToggle, // Story 11.7
+		Hibernated:  Keys{KeyHibernated},
+		StateToggle: Keys{KeyStateToggle}, // Story 11.7
+		Stats:       Keys{KeyStats},
+		StatsRange:  Keys{KeyStatsRange},
 
 		// Log Session (Story 12.1)
-		LogSession:  KeyLogSession,
-		LogJumpEnd:  KeyLogJumpEnd,
-		ShiftEnter:  KeyShiftEnter,
-		LogOpenView: KeyLogOpenView, // Story 12.2 AC1
+		LogSession:  Keys{KeyLogSession},
+		LogJumpEnd:  Keys{KeyLogJumpEnd},
+		ShiftEnter:  Keys{KeyShiftEnter},
+		LogOpenView: Keys{KeyLogOpenView}, // Story 12.2 AC1
+
+		// Structured Claude Code logs
+		LogNextPrompt:    Keys{KeyLogNextPrompt},
+		LogPrevPrompt:    Keys{KeyLogPrevPrompt},
+		LogToggleResult:  Keys{KeyLogToggleResult},
+		LogToggleResults: Keys{KeyLogToggleResults},
+		LogLoadEarlier:   Keys{KeyLogLoadEarlier},
+		LogLoadLater:     Keys{KeyLogLoadLater},
+	}
+}
+
+// keyScope is the view an action's keys are read in. Actions in different
+// scopes may share a key; global actions apply in every scope.
+type keyScope int
+
+const (
+	scopeGlobal keyScope = iota
+	scopeList            // Project list and hibernated view
+	scopeLog             // Log text view and session picker
+	scopeStats           // Stats view
+)
+
+// keyAction describes one configurable action.
+type keyAction struct {
+	name  string // Config key under keybindings:
+	scope keyScope
+	def   string // Default key the handlers switch on
+	keys  func(*KeyBindings) *Keys
+}
+
+// keyActions lists the configurable actions. Earlier actions win when a
+// key is bound to two actions in overlapping scopes.
+var keyActions = []keyAction{
+	{"quit", scopeGlobal, KeyQuit, func(kb *KeyBindings) *Keys { return &kb.Quit }},
+	{"force_quit", scopeGlobal, KeyForceQuit, func(kb *KeyBindings) *Keys { return &kb.ForceQuit }},
+	{"help", scopeGlobal, KeyHelp, func(kb *KeyBindings) *Keys { return &kb.Help }},
+	{"escape", scopeGlobal, KeyEscape, func(kb *KeyBindings) *Keys { return &kb.Escape }},
+	{"down", scopeGlobal, KeyDown, func(kb *KeyBindings) *Keys { return &kb.Down }},
+	{"down_arrow", scopeGlobal, KeyDownArrow, func(kb *KeyBindings) *Keys { return &kb.DownArrow }},
+	{"up", scopeGlobal, KeyUp, func(kb *KeyBindings) *Keys { return &kb.Up }},
+	{"up_arrow", scopeGlobal, KeyUpArrow, func(kb *KeyBindings) *Keys { return &kb.UpArrow }},
+	{"palette", scopeList, KeyPalette, func(kb *KeyBindings) *Keys { return &kb.Palette }},
+	{"detail", scopeList, KeyDetail, func(kb *KeyBindings) *Keys { return &kb.Detail }},
+	{"sprint", scopeList, KeySprint, func(kb *KeyBindings) *Keys { return &kb.Sprint }},
+	{"search", scopeList, KeySearch, func(kb *KeyBindings) *Keys { return &kb.Search }},
+	{"sort", scopeList, KeySort, func(kb *KeyBindings) *Keys { return &kb.Sort }},
+	{"group", scopeList, KeyGroup, func(kb *KeyBindings) *Keys { return &kb.Group }},
+	{"favorite", scopeList, KeyFavorite, func(kb *KeyBindings) *Keys { return &kb.Favorite }},
+	{"notes", scopeList, KeyNotes, func(kb *KeyBindings) *Keys { return &kb.Notes }},
+	{"remove", scopeList, KeyRemove, func(kb *KeyBindings) *Keys { return &kb.Remove }},
+	{"add", scopeList, KeyAdd, func(kb *KeyBindings) *Keys { return &kb.Add }},
+	{"refresh", scopeList, KeyRefresh, func(kb *KeyBindings) *Keys { return &kb.Refresh }},
+	{"mark", scopeList, KeyMark, func(kb *KeyBindings) *Keys { return &kb.Mark }},
+	{"mark_range", scopeList, KeyMarkRange, func(kb *KeyBindings) *Keys { return &kb.MarkRange }},
+	{"mark_all", scopeList, KeyMarkAll, func(kb *KeyBindings) *Keys { return &kb.MarkAll }},
+	{"hibernated", scopeList, KeyHibernated, func(kb *KeyBindings) *Keys { return &kb.Hibernated }},
+	{"state_toggle", scopeList, KeyStateToggle, func(kb *KeyBindings) *Keys { return &kb.StateToggle }},
+	{"stats", scopeList, KeyStats, func(kb *KeyBindings) *Keys { return &kb.Stats }},
+	{"shift_enter", scopeList, KeyShiftEnter, func(kb *KeyBindings) *Keys { return &kb.ShiftEnter }},
+	{"log_open_view", scopeList, KeyLogOpenView, func(kb *KeyBindings) *Keys { return &kb.LogOpenView }},
+	{"log_session", scopeLog, KeyLogSession, func(kb *KeyBindings) *Keys { return &kb.LogSession }},
+	{"log_jump_end", scopeLog, KeyLogJumpEnd, func(kb *KeyBindings) *Keys { return &kb.LogJumpEnd }},
+	{"log_next_prompt", scopeLog, KeyLogNextPrompt, func(kb *KeyBindings) *Keys { return &kb.LogNextPrompt }},
+	{"log_prev_prompt", scopeLog, KeyLogPrevPrompt, func(kb *KeyBindings) *Keys { return &kb.LogPrevPrompt }},
+	{"log_toggle_result", scopeLog, KeyLogToggleResult, func(kb *KeyBindings) *Keys { return &kb.LogToggleResult }},
+	{"log_toggle_results", scopeLog, KeyLogToggleResults, func(kb *KeyBindings) *Keys { return &kb.LogToggleResults }},
+	{"log_load_earlier", scopeLog, KeyLogLoadEarlier, func(kb *KeyBindings) *Keys { return &kb.LogLoadEarlier }},
+	{"log_load_later", scopeLog, KeyLogLoadLater, func(kb *KeyBindings) *Keys { return &kb.LogLoadLater }},
+	{"stats_range", scopeStats, KeyStatsRange, func(kb *KeyBindings) *Keys { return &kb.StatsRange }},
+}
+
+// keysFor returns the keys bound to the action whose default key is def,
+// or def itself for keys that are not configurable.
+func (kb KeyBindings) keysFor(def string) Keys {
+	for _, a := range keyActions {
+		if a.def == def {
+			return *a.keys(&kb)
+		}
+	}
+	return Keys{def}
+}
+
+// scopesOverlap reports whether actions in scopes a and b can both receive a key.
+func scopesOverlap(a, b keyScope) bool {
+	return a == b || a == scopeGlobal || b == scopeGlobal
+}
+
+// KeyBindingsFromConfig applies config keybindings overrides to the
+// defaults. Unknown actions and empty key lists are ignored. Returns a
+// warning for each ignored entry and each key bound to two actions that
+// can receive it (the action listed first in help keeps the key).
+func KeyBindingsFromConfig(overrides map[string][]string) (KeyBindings, []string) {
+	kb := DefaultKeyBindings()
+	var warnings []string
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		action := findKeyAction(name)
+		if action == nil {
+			warnings = append(warnings, fmt.Sprintf("keybindings: unknown action %q", name))
+			continue
+		}
+		var keys Keys
+		for _, k := range overrides[name] {
+			k = strings.TrimSpace(k)
+			if k == "space" {
+				k = KeyMark // bubbletea reports the space bar as " "
+			}
+			if k != "" && !keys.Has(k) {
+				keys = append(keys, k)
+			}
+		}
+		if len(keys) == 0 {
+			warnings = append(warnings, fmt.Sprintf("keybindings: %s has no keys, using default", name))
+			continue
+		}
+		*action.keys(&kb) = keys
+	}
+
+	for i, a := range keyActions {
+		for _, b := range keyActions[i+1:] {
+			if !scopesOverlap(a.scope, b.scope) {
+				continue
+			}
+			for _, k := range *b.keys(&kb) {
+				if a.keys(&kb).Has(k) {
+					warnings = append(warnings, fmt.Sprintf("keybindings: %q is bound to both %s and %s (%s wins)", k, a.name, b.name, a.name))
+				}
+			}
+		}
+	}
+
+	return kb, warnings
+}
+
+// findKeyAction returns the action with the given config name, or nil.
+func findKeyAction(name string) *keyAction {
+	for i := range keyActions {
+		if keyActions[i].name == name {
+			return &keyActions[i]
+		}
+	}
+	return nil
+}
+
+// resolve maps a pressed key to the default key of the action it is bound
+// to in scope, so handlers can keep switching on the Key* constants.
+// Returns ok=false for a default key whose action was rebound to other
+// keys. Keys that are not configurable (enter, g, ...) pass through.
+func (kb KeyBindings) resolve(key string, scope keyScope) (string, bool) {
+	for _, a := range keyActions {
+		if scopesOverlap(a.scope, scope) && a.keys(&kb).Has(key) {
+			return a.def, true
+		}
+	}
+	for _, a := range keyActions {
+		if scopesOverlap(a.scope, scope) && a.def == key {
+			return "", false
+		}
+	}
+	return key, true
+}
+
+// resolveKeyMsg applies resolve to a key message. The returned message
+// reports the action's default key from String(), so it can be forwarded
+// to bubbles components that match on default keys.
+func (kb KeyBindings) resolveKeyMsg(msg tea.KeyMsg, scope keyScope) (tea.KeyMsg, bool) {
+	key, ok := kb.resolve(msg.String(), scope)
+	if !ok || key == msg.String() {
+		return msg, ok
+	}
+	return keyMsgFor(key), true
+}
+
+// keyTypesByName maps bubbletea key names ("down", "ctrl+c", "esc") to key types.
+var keyTypesByName = func() map[string]tea.KeyType {
+	names := make(map[string]tea.KeyType)
+	// Special keys are negative, control keys 0-31 and 127
+	for t := tea.KeyType(-128); t <= 127; t++ {
+		if t == tea.KeyRunes {
+			continue
+		}
+		if name := (tea.Key{Type: t}).String(); name != "" {
+			if _, dup := names[name]; !dup {
+				names[name] = t
+			}
+		}
+	}
+	return names
+}()
+
+// keyMsgFor builds the key message whose String() is name.
+func keyMsgFor(name string) tea.KeyMsg {
+	if t, ok := keyTypesByName[name]; ok {
+		return tea.KeyMsg{Type: t}
+	}
+	if rest, ok := strings.CutPrefix(name, "alt+"); ok && rest != "" {
+		msg := keyMsgFor(rest)
+		msg.Alt = true
+		return msg
 	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(name)}
 }
@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+func TestKeyBindingsFromConfig_Overrides(t *testing.T) {
+	kb, warnings := KeyBindingsFromConfig(map[string][]string{
+		"down":       {"j", "ctrl+n"},
+		"up":         {"k", "ctrl+k", "k"}, // Duplicates dropped
+		"hibernated": {"z"},
+	})
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	if kb.Down.String() != "j/ctrl+n" || kb.Up.String() != "k/ctrl+k" || kb.Hibernated.String() != "z" {
+		t.Errorf("Down = %v, Up = %v, Hibernated = %v", kb.Down, kb.Up, kb.Hibernated)
+	}
+	if kb.Quit.String() != KeyQuit {
+		t.Errorf("Quit = %v, want default", kb.Quit)
+	}
+}
+
+func TestKeyBindingsFromConfig_SpaceName(t *testing.T) {
+	kb, warnings := KeyBindingsFromConfig(map[string][]string{
+		"mark_all": {"space"},
+		"mark":     {"m"},
+	})
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	if !kb.MarkAll.Has(" ") {
+		t.Errorf("MarkAll = %q, want the space bar", kb.MarkAll)
+	}
+	if key, ok := kb.resolve(" ", scopeList); !ok || key != KeyMarkAll {
+		t.Errorf("resolve(space) = %q, %v; want %q", key, ok, KeyMarkAll)
+	}
+	if got := displayKeys(kb.MarkAll); got != "Space" {
+		t.Errorf("displayKeys(MarkAll) = %q, want %q", got, "Space")
+	}
+}
+
+func TestKeyBindingsFromConfig_Warnings(t *testing.T) {
+	kb, warnings := KeyBindingsFromConfig(map[string][]string{
+		"teleport": {"t"},
+		"notes":    {" "},
+		"favorite": {"x"}, // Conflicts with remove
+		"stats":    {"t"}, // Same key as stats_range, but in another view: fine
+	})
+
+	joined := strings.Join(warnings, "\n")
+	for _, want := range []string{
+		`unknown action "teleport"`,
+		"notes has no keys, using default",
+		`"x" is bound to both favorite and remove (favorite wins)`,
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("warnings missing %q:\n%s", want, joined)
+		}
+	}
+	if len(warnings) != 3 {
+		t.Errorf("got %d warnings, want 3:\n%s", len(warnings), joined)
+	}
+	if kb.Notes.String() != KeyNotes {
+		t.Errorf("Notes = %v, want default after empty override", kb.Notes)
+	}
+}
+
+func TestKeyBindings_Resolve(t *testing.T) {
+	kb, _ := KeyBindingsFromConfig(map[string][]string{
+		"down":       {"ctrl+n"},
+		"hibernated": {"z"},
+	})
+
+	tests := []struct {
+		key   string
+		scope keyScope
+		want  string
+		bound bool
+	}{
+		{"ctrl+n", scopeList, KeyDown, true},       // New key maps to the handler's key
+		{"j", scopeList, "", false},                // Default key no longer bound
+		{"z", scopeList, KeyHibernated, true},      // Rebound list action
+		{"h", scopeList, "", false},                // Its old key is free
+		{"z", scopeLog, "z", true},                 // List actions don't apply in the log view
+		{"enter", scopeList, "enter", true},        // Not configurable: passes through
+		{KeyStatsRange, scopeList, "t", true},      // Stats action outside the stats view
+		{KeyStatsRange, scopeStats, "t", true},     // Stats action in the stats view
+		{KeyLogSession, scopeLog, KeyStats, true},  // "S" in the log view is log_session
+		{KeyStats, scopeList, KeyLogSession, true}, // ...and stats in the list (both "S")
+	}
+	for _, tt := range tests {
+		got, bound := kb.resolve(tt.key, tt.scope)
+		if got != tt.want || bound != tt.bound {
+			t.Errorf("resolve(%q, %d) = %q, %v; want %q, %v", tt.key, tt.scope, got, bound, tt.want, tt.bound)
+		}
+	}
+}
+
+func TestKeyMsgFor(t *testing.T) {
+	for _, name := range []string{"j", "G", "down", "esc", "ctrl+c", "enter", "alt+j", "shift+enter"} {
+		if got := keyMsgFor(name).String(); got != name {
+			t.Errorf("keyMsgFor(%q).String() = %q", name, got)
+		}
+	}
+	if keyMsgFor("down").Type != tea.KeyDown {
+		t.Error("keyMsgFor(down) should be a KeyDown message")
+	}
+}
+
+func TestModel_CustomKeyBindings(t *testing.T) {
+	m := createModelWithProjects(3)
+	cfg := ports.NewConfig()
+	cfg.KeyBindings = map[string][]string{"down": {"ctrl+n"}, "detail": {"i"}}
+	m.SetConfig(cfg)
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = updated.(Model)
+	if m.projectList.Index() != 1 {
+		t.Errorf("ctrl+n should move down, index = %d", m.projectList.Index())
+	}
+
+	// 'j' is no longer bound
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m = updated.(Model)
+	if m.projectList.Index() != 1 {
+		t.Errorf("j should do nothing once down is rebound, index = %d", m.projectList.Index())
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	m = updated.(Model)
+	if !m.showDetailPanel {
+		t.Error("i should toggle the detail panel")
+	}
+
+	help := renderHelpOverlay(100, 60, cfg)
+	if !strings.Contains(help, "Ctrl+N/↓ Move down") || !strings.Contains(help, "i        Toggle detail panel") {
+		t.Errorf("help should show effective bindings:\n%s", help)
+	}
+}
+
+func TestModel_KeyBindingWarningShown(t *testing.T) {
+	m := createModelWithProjects(1)
+	if m.keyWarningCmd() != nil {
+		t.Error("no warning expected with default bindings")
+	}
+
+	cfg := ports.NewConfig()
+	cfg.KeyBindings = map[string][]string{"favorite": {"x"}, "teleport": {"t"}}
+	m.SetConfig(cfg)
+
+	cmd := m.keyWarningCmd()
+	if cmd == nil {
+		t.Fatal("expected a warning command")
+	}
+	msg, ok := cmd().(configWarningMsg)
+	if !ok || !strings.Contains(msg.warning, "(+1 more, see log)") {
+		t.Errorf("warning = %+v", msg)
+	}
+}
@@ -0,0 +1,554 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// Native Claude Code log rendering for the log text view. Each JSONL entry
+// is decoded from domain.LogEntry.RawJSON into blocks (prompts, replies,
+// thinking, tool calls and results, errors) that are styled and wrapped to
+// the view width. Other tools' logs are still shown as jq/raw text.
+
+const (
+	// toolResultPreviewLines is the number of lines a collapsed tool result shows.
+	toolResultPreviewLines = 3
+
+	// toolArgMaxLines caps the lines shown for one tool call argument
+	// (e.g. the new_string of an Edit).
+	toolArgMaxLines = 12
+
+	// logIndent indents block bodies below their header line.
+	logIndent = "  "
+)
+
+// logBlockKind is the kind of a rendered log block.
+type logBlockKind int
+
+const (
+	logBlockUser logBlockKind = iota
+	logBlockAssistant
+	logBlockThinking
+	logBlockToolCall
+	logBlockToolResult
+	logBlockError
+	logBlockSummary
+)
+
+// logBlock is one rendered unit of a session log.
+type logBlock struct {
+	kind      logBlockKind
+	timestamp time.Time
+	line      int    // 1-based JSONL line the block was decoded from
+	tool      string // Tool name of tool calls and results
+	toolID    string // tool_use ID of tool results, to name them once their call loads
+	text      string
+	isError   bool // Tool result reported an error
+	expanded  bool // Tool results start collapsed
+}
+
+// logDocument is a Claude Code session, or its tail, decoded into blocks,
+// together with the line positions of its last rendering.
+type logDocument struct {
+	blocks    []logBlock
+	toolNames map[string]string // tool_use ID -> tool name, for result headers
+	partial   string            // Incomplete trailing JSONL line of the last read
+	lines     int               // JSONL lines up to the end of the last read
+	midLine   bool              // Last read ended in a complete entry without newline
+	start     int64             // File offset of the first decoded line; earlier entries are not loaded when > 0
+	startLine int               // JSONL lines before start
+	end       int64             // File offset decoding stopped at
+	size      int64             // File size as last seen; later entries are not loaded when end < size
+
+	blockLines []int // First rendered line of each block
+	turnLines  []int // First rendered line of each user prompt
+}
+
+// newLogDocument creates an empty log document.
+func newLogDocument() *logDocument {
+	return &logDocument{toolNames: make(map[string]string)}
+}
+
+// newLogDocumentAt creates an empty log document for the part of a session
+// that starts at file offset start, after startLine lines.
+func newLogDocumentAt(start int64, startLine int) *logDocument {
+	d := newLogDocument()
+	d.start, d.startLine, d.lines = start, startLine, startLine
+	return d
+}
+
+// prepend adds the blocks of earlier, decoded from the lines just before
+// the document, and names tool results whose call was among them.
+func (d *logDocument) prepend(earlier *logDocument) {
+	for id, name := range earlier.toolNames {
+		d.toolNames[id] = name
+	}
+	for i := range d.blocks {
+		if b := &d.blocks[i]; b.kind == logBlockToolResult && b.tool == "" {
+			b.tool = d.toolNames[b.toolID]
+		}
+	}
+	d.blocks = append(earlier.blocks, d.blocks...)
+	d.start, d.startLine = earlier.start, earlier.startLine
+}
+
+// claudeLogLine is the subset of a Claude Code JSONL entry that is rendered.
+type claudeLogLine struct {
+	Type       string          `json:"type"`
+	Timestamp  string          `json:"timestamp"`
+	IsMeta     bool            `json:"isMeta"`
+	IsAPIError bool            `json:"isApiErrorMessage"`
+	Level      string          `json:"level"`   // system entries
+	Content    json.RawMessage `json:"content"` // system entries
+	Summary    string          `json:"summary"` // summary entries
+	Message    struct {
+		Role    string          `json:"role"`
+		Content json.RawMessage `json:"content"`
+	} `json:"message"`
+}
+
+// claudeContentBlock is one element of a message content array.
+type claudeContentBlock struct {
+	Type      string          `json:"type"`
+	Text      string          `json:"text"`
+	Thinking  string          `json:"thinking"`
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Input     json.RawMessage `json:"input"`
+	ToolUseID string          `json:"tool_use_id"`
+	Content   json.RawMessage `json:"content"`
+	IsError   bool            `json:"is_error"`
+}
+
+// appendJSONL decodes newly read JSONL content and appends its blocks.
+// A trailing line that is still being written (no newline, invalid JSON)
+// is kept until the next read completes it.
+func (d *logDocument) appendJSONL(content string) {
+	lines := strings.Split(d.partial+content, "\n")
+	continued := d.midLine
+	d.partial, d.midLine = "", false
+	if last := lines[len(lines)-1]; !json.Valid([]byte(last)) {
+		d.partial = last
+		lines = lines[:len(lines)-1]
+	} else {
+		d.midLine = true
+	}
+	for i, line := range lines {
+		// The newline ending an entry decoded in the last read is not a new line
+		if i > 0 || !continued {
+			d.lines++
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		first := len(d.blocks)
+		d.appendEntry(domain.NewLogEntry(time.Time{}, "", json.RawMessage(line), ""))
+		for j := first; j < len(d.blocks); j++ {
+			d.blocks[j].line = d.lines
+		}
+	}
+}
+
+// blockForLine returns the index of the first block decoded from JSONL line
+// or a later one, or -1.
+func (d *logDocument) blockForLine(line int) int {
+	for i, b := range d.blocks {
+		if b.line >= line {
+			return i
+		}
+	}
+	return -1
+}
+
+// appendEntry decodes one log entry into blocks. Entries that are not
+// valid JSON or carry nothing to show (snapshots, meta messages) are skipped.
+func (d *logDocument) appendEntry(entry domain.LogEntry) {
+	var line claudeLogLine
+	if err := json.Unmarshal(entry.RawJSON, &line); err != nil {
+		return
+	}
+	ts := entry.Timestamp
+	if ts.IsZero() && line.Timestamp != "" {
+		ts, _ = time.Parse(time.RFC3339Nano, line.Timestamp)
+	}
+	kind := entry.Type
+	if kind == "" {
+		kind = line.Type
+	}
+	if kind == "" {
+		kind = line.Message.Role
+	}
+
+	add := func(b logBlock) {
+		if strings.TrimSpace(b.text) == "" && b.kind != logBlockToolResult && b.kind != logBlockToolCall {
+			return
+		}
+		b.timestamp = ts
+		d.blocks = append(d.blocks, b)
+	}
+
+	switch kind {
+	case "user":
+		if line.IsMeta {
+			return
+		}
+		if text, ok := jsonString(line.Message.Content); ok {
+			add(logBlock{kind: logBlockUser, text: text})
+			return
+		}
+		for _, c := range contentBlocks(line.Message.Content) {
+			switch c.Type {
+			case "text":
+				add(logBlock{kind: logBlockUser, text: c.Text})
+			case "image":
+				add(logBlock{kind: logBlockUser, text: "[image]"})
+			case "tool_result":
+				add(logBlock{kind: logBlockToolResult, tool: d.toolNames[c.ToolUseID], toolID: c.ToolUseID, text: contentText(c.Content), isError: c.IsError})
+			}
+		}
+
+	case "assistant":
+		if line.IsAPIError {
+			add(logBlock{kind: logBlockError, text: contentText(line.Message.Content)})
+			return
+		}
+		if text, ok := jsonString(line.Message.Content); ok {
+			add(logBlock{kind: logBlockAssistant, text: text})
+			return
+		}
+		for _, c := range contentBlocks(line.Message.Content) {
+			switch c.Type {
+			case "text":
+				add(logBlock{kind: logBlockAssistant, text: c.Text})
+			case "thinking":
+				add(logBlock{kind: logBlockThinking, text: c.Thinking})
+			case "redacted_thinking":
+				add(logBlock{kind: logBlockThinking, text: "[redacted]"})
+			case "tool_use":
+				d.toolNames[c.ID] = c.Name
+				add(logBlock{kind: logBlockToolCall, tool: c.Name, text: formatToolInput(c.Input)})
+			}
+		}
+
+	case "system":
+		if line.Level == "error" || line.Level == "warning" {
+			add(logBlock{kind: logBlockError, text: contentText(line.Content)})
+		}
+
+	case "summary":
+		add(logBlock{kind: logBlockSummary, text: line.Summary})
+	}
+}
+
+// jsonString returns raw as a string if it is a JSON string.
+func jsonString(raw json.RawMessage) (string, bool) {
+	var s string
+	if len(raw) == 0 || raw[0] != '"' || json.Unmarshal(raw, &s) != nil {
+		return "", false
+	}
+	return s, true
+}
+
+// contentBlocks decodes a message content array. Returns nil if raw is not an array.
+func contentBlocks(raw json.RawMessage) []claudeContentBlock {
+	var blocks []claudeContentBlock
+	if len(raw) == 0 || raw[0] != '[' || json.Unmarshal(raw, &blocks) != nil {
+		return nil
+	}
+	return blocks
+}
+
+// contentText returns the text of content that is either a string or an
+// array of content blocks (tool results, API errors).
+func contentText(raw json.RawMessage) string {
+	if s, ok := jsonString(raw); ok {
+		return s
+	}
+	var parts []string
+	for _, c := range contentBlocks(raw) {
+		switch c.Type {
+		case "text":
+			parts = append(parts, c.Text)
+		case "image":
+			parts = append(parts, "[image]")
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// formatToolInput formats tool call arguments as "key: value" lines in their
+// original order. String values are shown as-is, other values as compact JSON.
+func formatToolInput(raw json.RawMessage) string {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return compactJSON(raw)
+	}
+	var lines []string
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			break
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			break
+		}
+		text, ok := jsonString(value)
+		if !ok {
+			text = compactJSON(value)
+		}
+		valueLines := strings.Split(text, "\n")
+		if len(valueLines) > toolArgMaxLines {
+			more := len(valueLines) - toolArgMaxLines
+			valueLines = append(valueLines[:toolArgMaxLines], fmt.Sprintf("… %d more lines", more))
+		}
+		lines = append(lines, fmt.Sprintf("%v: %s", key, strings.Join(valueLines, "\n")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// compactJSON returns raw without insignificant whitespace.
+func compactJSON(raw json.RawMessage) string {
+	var buf bytes.Buffer
+	if json.Compact(&buf, raw) != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
+
+// render lays the blocks out for width and records where each block and
+// user prompt starts.
+func (d *logDocument) render(width int) []string {
+	d.blockLines = d.blockLines[:0]
+	d.turnLines = d.turnLines[:0]
+	var lines []string
+	if d.start > 0 {
+		lines = append(lines, DimStyle.Render("⋯ Earlier entries not loaded"))
+	}
+	for i, b := range d.blocks {
+		// Tool results stay attached to their call
+		if len(lines) > 0 && (i == 0 || b.kind != logBlockToolResult) {
+			lines = append(lines, "")
+		}
+		d.blockLines = append(d.blockLines, len(lines))
+		if b.kind == logBlockUser {
+			d.turnLines = append(d.turnLines, len(lines))
+		}
+		lines = append(lines, b.render(width)...)
+	}
+	if d.end < d.size {
+		lines = append(lines, "", DimStyle.Render("⋯ Later entries not loaded"))
+	}
+	if len(lines) == 0 {
+		lines = []string{DimStyle.Render("No messages in this session yet")}
+	}
+	return lines
+}
+
+// blockAt returns the index of the block shown at line, or -1.
+func (d *logDocument) blockAt(line int) int {
+	index := -1
+	for i, start := range d.blockLines {
+		if start > line {
+			break
+		}
+		index = i
+	}
+	return index
+}
+
+// render returns the block's header and wrapped body lines.
+func (b logBlock) render(width int) []string {
+	var header string
+	body := b.text
+	bodyStyle := lipgloss.NewStyle()
+
+	switch b.kind {
+	case logBlockUser:
+		header = titleStyle.Render("▶ You") + logTime(b.timestamp)
+	case logBlockAssistant:
+		header = RecentStyle.Render("◆ Claude") + logTime(b.timestamp)
+	case logBlockThinking:
+		header = DimStyle.Render("∴ Thinking")
+		bodyStyle = DimStyle
+	case logBlockToolCall:
+		header = ActiveStyle.Render("● " + b.tool)
+	case logBlockToolResult:
+		return b.renderToolResult(width)
+	case logBlockError:
+		header = WarningStyle.Render("✗ Error") + logTime(b.timestamp)
+		bodyStyle = WarningStyle
+	case logBlockSummary:
+		header = DimStyle.Render("≡ Summary")
+		bodyStyle = DimStyle
+	}
+
+	lines := []string{header}
+	if strings.TrimSpace(body) != "" {
+		lines = append(lines, wrapLogText(body, width, bodyStyle)...)
+	}
+	return lines
+}
+
+// renderToolResult renders a tool result, showing only its first lines
+// unless expanded.
+func (b logBlock) renderToolResult(width int) []string {
+	label := "result"
+	if b.isError {
+		label = "error"
+	}
+	if b.tool != "" {
+		label = b.tool + " " + label
+	}
+	text := strings.TrimRight(b.text, "\n")
+	if strings.TrimSpace(text) == "" {
+		return []string{DimStyle.Render("⎿ " + label + " (empty)")}
+	}
+
+	style := DimStyle
+	header := DimStyle.Render("⎿ " + label)
+	if b.isError {
+		style = WarningStyle
+		header = WarningStyle.Render("⎿ " + label)
+	}
+
+	textLines := strings.Split(text, "\n")
+	hidden := 0
+	if !b.expanded && len(textLines) > toolResultPreviewLines {
+		hidden = len(textLines) - toolResultPreviewLines
+		textLines = textLines[:toolResultPreviewLines]
+	}
+	lines := append([]string{header}, wrapLogText(strings.Join(textLines, "\n"), width, style)...)
+	if hidden > 0 {
+		lines = append(lines, logIndent+DimStyle.Render(fmt.Sprintf("… %d more lines", hidden)))
+	}
+	return lines
+}
+
+// logTime formats a block header timestamp, or "" if unknown.
+func logTime(ts time.Time) string {
+	if ts.IsZero() {
+		return ""
+	}
+	return " " + DimStyle.Render(ts.Local().Format("15:04:05"))
+}
+
+// wrapLogText wraps text to width below the block indent and styles each line.
+func wrapLogText(text string, width int, style lipgloss.Style) []string {
+	textWidth := max(width-len(logIndent), 10)
+	text = strings.ReplaceAll(text, "\r", "")
+	text = strings.ReplaceAll(text, "\t", "    ")
+	wrapped := ansi.Wrap(ansi.Strip(text), textWidth, "")
+	lines := strings.Split(wrapped, "\n")
+	for i, line := range lines {
+		lines[i] = logIndent + style.Render(line)
+	}
+	return lines
+}
+
+// logWidth returns the width structured logs are wrapped to.
+func (m Model) logWidth() int {
+	if m.isWideWidth() {
+		return m.maxContentWidth
+	}
+	return m.width
+}
+
+// rerenderLog lays the structured log out again after new entries, a resize
+// or a collapse toggle, keeping search matches and the scroll position valid.
+func (m *Model) rerenderLog() {
+	m.textViewContent = m.logDoc.render(m.logWidth())
+	if m.searchQuery != "" {
+		m.searchMatches = m.findMatches(m.searchQuery)
+		if m.searchIndex >= len(m.searchMatches) {
+			m.searchIndex = 0
+		}
+	}
+	contentHeight := m.height - statusBarHeight(m.height) - 2
+	maxScroll := max(len(m.textViewContent)-contentHeight, 0)
+	if m.logAutoScroll || m.textViewScroll > maxScroll {
+		m.textViewScroll = maxScroll
+	}
+}
+
+// jumpToPrompt scrolls the next (or previous) user prompt to the top of the
+// log view.
+func (m Model) jumpToPrompt(forward bool) (tea.Model, tea.Cmd) {
+	if m.logDoc == nil {
+		return m, nil
+	}
+	target := -1
+	turns := m.logDoc.turnLines
+	if forward {
+		for _, line := range turns {
+			if line > m.textViewScroll {
+				target = line
+				break
+			}
+		}
+	} else {
+		for i := len(turns) - 1; i >= 0; i-- {
+			if turns[i] < m.textViewScroll {
+				target = turns[i]
+				break
+			}
+		}
+	}
+	if target < 0 {
+		return m, func() tea.Msg {
+			return flashMsg{text: "No more prompts"}
+		}
+	}
+	contentHeight := m.height - statusBarHeight(m.height) - 2
+	m.textViewScroll = min(target, max(len(m.textViewContent)-contentHeight, 0))
+	m.logAutoScroll = false
+	return m, nil
+}
+
+// toggleToolResults expands or collapses the first tool result on screen,
+// or every tool result when all is set (expanding unless all are expanded).
+func (m Model) toggleToolResults(all bool) (tea.Model, tea.Cmd) {
+	if m.logDoc == nil {
+		return m, nil
+	}
+	blocks := m.logDoc.blocks
+	if all {
+		expand := false
+		for _, b := range blocks {
+			if b.kind == logBlockToolResult && !b.expanded {
+				expand = true
+				break
+			}
+		}
+		for i := range blocks {
+			if blocks[i].kind == logBlockToolResult {
+				blocks[i].expanded = expand
+			}
+		}
+		m.rerenderLog()
+		return m, nil
+	}
+
+	contentHeight := m.height - statusBarHeight(m.height) - 2
+	bottom := m.textViewScroll + contentHeight
+	for i := max(m.logDoc.blockAt(m.textViewScroll), 0); i < len(blocks) && m.logDoc.blockLines[i] < bottom; i++ {
+		if blocks[i].kind == logBlockToolResult {
+			blocks[i].expanded = !blocks[i].expanded
+			m.rerenderLog()
+			return m, nil
+		}
+	}
+	return m, func() tea.Msg {
+		return flashMsg{text: "No tool result on screen"}
+	}
+}
@@ -0,0 +1,347 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// structuredSessionPath returns the path of the structured Claude Code log fixture.
+func structuredSessionPath(t *testing.T) string {
+	t.Helper()
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("failed to get caller info")
+	}
+	return filepath.Join(filepath.Dir(filename), "..", "..", "..", "test", "fixtures", "claude-logs", "structured-session.jsonl")
+}
+
+// loadStructuredSession decodes the structured Claude Code log fixture.
+func loadStructuredSession(t *testing.T) *logDocument {
+	t.Helper()
+	data, err := os.ReadFile(structuredSessionPath(t))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	doc := newLogDocument()
+	doc.appendJSONL(string(data))
+	return doc
+}
+
+// plainLines renders doc at width and strips styling.
+func plainLines(doc *logDocument, width int) []string {
+	lines := doc.render(width)
+	for i, line := range lines {
+		lines[i] = stripANSI(line)
+	}
+	return lines
+}
+
+func TestLogDocument_RendersBlocks(t *testing.T) {
+	doc := loadStructuredSession(t)
+	out := strings.Join(plainLines(doc, 80), "\n")
+
+	for _, want := range []string{
+		"≡ Summary",
+		"▶ You",
+		"The parser test is failing, can you fix it?",
+		"∴ Thinking",
+		"I should run the tests first",
+		"◆ Claude",
+		"Let me run the tests.",
+		"● Bash",
+		"command: go test ./internal/parser/...",
+		"description: Run parser tests",
+		"⎿ Bash error",
+		"--- FAIL: TestParse",
+		"… 2 more lines",
+		"● Read",
+		"file_path: /work/internal/parser/parser.go",
+		"limit: 40",
+		"⎿ Read result",
+		"Thanks! Please also update the changelog.",
+		"✗ Error",
+		"API Error: 529 overloaded",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered log missing %q:\n%s", want, out)
+		}
+	}
+
+	// Meta messages, snapshots and info system entries are not shown
+	for _, hidden := range []string{"Caveat", "snapshot", "Conversation compacted"} {
+		if strings.Contains(out, hidden) {
+			t.Errorf("rendered log should not contain %q", hidden)
+		}
+	}
+
+	if len(doc.turnLines) != 2 {
+		t.Errorf("turnLines = %v, want 2 user prompts", doc.turnLines)
+	}
+}
+
+func TestLogDocument_ToolResultCollapse(t *testing.T) {
+	doc := newLogDocument()
+	doc.appendJSONL(`{"type":"assistant","message":{"content":[{"type":"tool_use","id":"t1","name":"Bash","input":{"command":"seq 5"}}]}}
+{"type":"user","message":{"content":[{"type":"tool_result","tool_use_id":"t1","content":"1\n2\n3\n4\n5"}]}}
+`)
+
+	collapsed := strings.Join(plainLines(doc, 80), "\n")
+	if strings.Contains(collapsed, "  4") || !strings.Contains(collapsed, "… 2 more lines") {
+		t.Errorf("collapsed result should show 3 lines:\n%s", collapsed)
+	}
+
+	doc.blocks[1].expanded = true
+	expanded := strings.Join(plainLines(doc, 80), "\n")
+	if !strings.Contains(expanded, "  5") || strings.Contains(expanded, "more lines") {
+		t.Errorf("expanded result should show every line:\n%s", expanded)
+	}
+}
+
+func TestLogDocument_WrapsToWidth(t *testing.T) {
+	doc := newLogDocument()
+	long := strings.Repeat("word ", 40)
+	doc.appendJSONL(fmt.Sprintf(`{"type":"user","message":{"content":%q}}`+"\n", long))
+
+	lines := plainLines(doc, 40)
+	if len(lines) < 5 {
+		t.Fatalf("expected the prompt to wrap, got %d lines", len(lines))
+	}
+	for _, line := range lines {
+		if w := visibleWidth(line); w > 40 {
+			t.Errorf("line %q is %d wide, want <= 40", line, w)
+		}
+	}
+}
+
+func TestLogDocument_AppendJSONL_KeepsPartialLine(t *testing.T) {
+	doc := newLogDocument()
+	entry := `{"type":"user","message":{"content":"hello"}}`
+
+	doc.appendJSONL(entry[:20])
+	if len(doc.blocks) != 0 {
+		t.Fatalf("partial line should not be decoded, got %d blocks", len(doc.blocks))
+	}
+	doc.appendJSONL(entry[20:] + "\n")
+	if len(doc.blocks) != 1 || doc.blocks[0].text != "hello" {
+		t.Errorf("blocks = %+v, want the completed prompt", doc.blocks)
+	}
+
+	// A complete last line without newline is decoded right away
+	doc.appendJSONL(`{"type":"assistant","message":{"content":"hi"}}`)
+	if len(doc.blocks) != 2 || doc.partial != "" {
+		t.Errorf("blocks = %d, partial = %q, want 2 blocks and no partial", len(doc.blocks), doc.partial)
+	}
+}
+
+func TestLogDocument_RecordsSourceLines(t *testing.T) {
+	doc := loadStructuredSession(t)
+
+	// Line 6 holds the Bash result; lines 1-2 (summary, meta prompt) precede the first prompt
+	if i := doc.blockForLine(6); i < 0 || doc.blocks[i].kind != logBlockToolResult || doc.blocks[i].line != 6 {
+		t.Errorf("blockForLine(6) = %d, want the Bash result", i)
+	}
+	if i := doc.blockForLine(2); i < 0 || doc.blocks[i].line != 3 {
+		t.Errorf("blockForLine(2) should skip to the prompt on line 3")
+	}
+	if i := doc.blockForLine(99); i != -1 {
+		t.Errorf("blockForLine(99) = %d, want -1", i)
+	}
+
+	// Entries completed across reads keep counting file lines
+	doc = newLogDocument()
+	doc.appendJSONL(`{"type":"user","message":{"content":"one"}}`)
+	doc.appendJSONL("\n\n" + `{"type":"user","message":{"content":"three"}}` + "\n")
+	if len(doc.blocks) != 2 || doc.blocks[0].line != 1 || doc.blocks[1].line != 3 {
+		t.Errorf("blocks = %+v, want lines 1 and 3", doc.blocks)
+	}
+}
+
+func TestLogDocument_AppendEntry_UsesLogEntryFields(t *testing.T) {
+	doc := newLogDocument()
+	// Entries without a type field are typed by the reader
+	raw := json.RawMessage(`{"message":{"content":"from reader"}}`)
+	doc.appendEntry(domain.NewLogEntry(time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC), "user", raw, "s1"))
+
+	if len(doc.blocks) != 1 || doc.blocks[0].kind != logBlockUser {
+		t.Fatalf("blocks = %+v, want one user prompt", doc.blocks)
+	}
+	if doc.blocks[0].timestamp.IsZero() {
+		t.Error("block should use the entry timestamp")
+	}
+}
+
+func TestLogDocument_Empty(t *testing.T) {
+	lines := plainLines(newLogDocument(), 80)
+	if len(lines) != 1 || !strings.Contains(lines[0], "No messages") {
+		t.Errorf("empty log = %q, want placeholder", lines)
+	}
+}
+
+func TestFormatToolInput(t *testing.T) {
+	long := strings.TrimSuffix(strings.Repeat("x\n", toolArgMaxLines+3), "\n")
+	input := fmt.Sprintf(`{"z_first":"a","nested":{"b":[1, 2]},"content":%q}`, long)
+
+	got := formatToolInput(json.RawMessage(input))
+	lines := strings.Split(got, "\n")
+	if lines[0] != "z_first: a" || lines[1] != `nested: {"b":[1,2]}` {
+		t.Errorf("formatToolInput() should keep key order and compact JSON, got:\n%s", got)
+	}
+	if !strings.HasSuffix(got, "… 3 more lines") {
+		t.Errorf("long argument should be capped, got:\n%s", got)
+	}
+
+	if got := formatToolInput(json.RawMessage(`["a", "b"]`)); got != `["a","b"]` {
+		t.Errorf("non-object input = %q", got)
+	}
+}
+
+// createLogViewModel returns a model showing the structured fixture log.
+func createLogViewModel(t *testing.T) Model {
+	t.Helper()
+	m := NewModel(nil)
+	m.ready = true
+	m.width = 80
+	m.height = 20
+	updated, _ := m.Update(textViewContentMsg{title: "proj - session.jsonl", log: loadStructuredSession(t)})
+	return updated.(Model)
+}
+
+func TestModel_OpenLogCmd_ClaudeCodeRendersNatively(t *testing.T) {
+	m := NewModel(nil)
+	path := structuredSessionPath(t)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg, ok := m.openLogCmd(domain.LogSession{Path: path, Tool: claudeCodeTool}, "proj")().(textViewContentMsg)
+	if !ok || msg.err != nil {
+		t.Fatalf("openLogCmd() = %#v", msg)
+	}
+	if msg.log == nil || len(msg.log.blocks) == 0 {
+		t.Fatal("Claude Code session should be decoded into blocks")
+	}
+	if msg.fileSize != info.Size() {
+		t.Errorf("fileSize = %d, want %d", msg.fileSize, info.Size())
+	}
+	if msg.title != "proj - structured-session.jsonl" {
+		t.Errorf("title = %q", msg.title)
+	}
+}
+
+func TestModel_StructuredLog_OpensAtBottom(t *testing.T) {
+	m := createLogViewModel(t)
+
+	if m.viewMode != viewModeTextView || m.logDoc == nil {
+		t.Fatal("expected structured text view")
+	}
+	if !m.logAutoScroll || m.textViewScroll == 0 {
+		t.Errorf("log should open scrolled to the bottom, scroll = %d", m.textViewScroll)
+	}
+	if view := m.View(); !strings.Contains(view, "Prompts") {
+		t.Errorf("footer should show prompt navigation, got:\n%s", view)
+	}
+}
+
+func TestModel_StructuredLog_JumpBetweenPrompts(t *testing.T) {
+	m := createLogViewModel(t)
+	turns := m.logDoc.turnLines
+	contentHeight := m.height - statusBarHeight(m.height) - 2
+	maxScroll := len(m.textViewContent) - contentHeight
+	m.textViewScroll = 0
+
+	m = typeKeys(t, m, "]")
+	if m.textViewScroll != turns[0] {
+		t.Errorf("']' scroll = %d, want first prompt at %d", m.textViewScroll, turns[0])
+	}
+	if m.logAutoScroll {
+		t.Error("jumping should pause auto-scroll")
+	}
+
+	m = typeKeys(t, m, "]")
+	if want := min(turns[1], maxScroll); m.textViewScroll != want {
+		t.Errorf("']' scroll = %d, want second prompt at %d", m.textViewScroll, want)
+	}
+
+	m = typeKeys(t, m, "[")
+	if m.textViewScroll != turns[0] {
+		t.Errorf("'[' scroll = %d, want first prompt at %d", m.textViewScroll, turns[0])
+	}
+}
+
+func TestModel_StructuredLog_ToggleToolResults(t *testing.T) {
+	m := createLogViewModel(t)
+	lines := len(m.textViewContent)
+
+	m = typeKeys(t, m, "C")
+	if len(m.textViewContent) != lines+2 {
+		t.Errorf("'C' should expand every result, lines %d -> %d", lines, len(m.textViewContent))
+	}
+	m = typeKeys(t, m, "C")
+	if len(m.textViewContent) != lines {
+		t.Errorf("second 'C' should collapse them again, lines = %d, want %d", len(m.textViewContent), lines)
+	}
+
+	// 'c' toggles the first result on screen
+	m.textViewScroll = m.logDoc.turnLines[0]
+	m = typeKeys(t, m, "c")
+	if !m.logDoc.blocks[5].expanded {
+		t.Errorf("'c' should expand the Bash result on screen, blocks = %+v", m.logDoc.blocks)
+	}
+}
+
+func TestModel_StructuredLog_TailAppendsEntries(t *testing.T) {
+	m := createLogViewModel(t)
+	before := len(m.logDoc.blocks)
+
+	updated, _ := m.Update(logNewEntriesMsg{
+		newContent: `{"type":"user","message":{"content":"new prompt"}}` + "\n" + `{"type":"assistant","message":{"content":"partial`,
+		newOffset:  100,
+	})
+	m = updated.(Model)
+	if len(m.logDoc.blocks) != before+1 {
+		t.Fatalf("blocks = %d, want %d", len(m.logDoc.blocks), before+1)
+	}
+	if last := stripANSI(m.textViewContent[len(m.textViewContent)-1]); !strings.Contains(last, "new prompt") {
+		t.Errorf("last line = %q, want the new prompt", last)
+	}
+
+	contentHeight := m.height - statusBarHeight(m.height) - 2
+	if m.textViewScroll != len(m.textViewContent)-contentHeight {
+		t.Error("auto-scroll should follow new entries")
+	}
+
+	updated, _ = m.Update(logNewEntriesMsg{newContent: ` reply"}}` + "\n", offset: 100, newOffset: 120})
+	m = updated.(Model)
+	if len(m.logDoc.blocks) != before+2 {
+		t.Errorf("completed partial line should be decoded, blocks = %d", len(m.logDoc.blocks))
+	}
+}
+
+func TestModel_StructuredLog_SearchTypesBrackets(t *testing.T) {
+	m := createLogViewModel(t)
+	m = typeKeys(t, m, "/[c]")
+
+	if m.searchInput != "[c]" {
+		t.Errorf("searchInput = %q, want %q", m.searchInput, "[c]")
+	}
+}
+
+func TestModel_StructuredLog_EscapeClearsDocument(t *testing.T) {
+	m := createLogViewModel(t)
+	m = pressKey(t, m, tea.KeyEsc)
+
+	if m.logDoc != nil || m.viewMode != viewModeNormal {
+		t.Error("Esc should leave the log view and drop the document")
+	}
+}
@@ -0,0 +1,259 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
+)
+
+// Cross-session log search. The palette's "Search session logs" command
+// searches every session of the selected project through the log reader
+// registry (which streams the files) and lists the hits in an overlay.
+// Opening a hit shows its session in the log view, scrolled to the match
+// with the query set as the view's search.
+
+// logSearchLimit caps the hits collected by one search.
+const logSearchLimit = 200
+
+// logSearchResults is the state of the log search overlay.
+type logSearchResults struct {
+	project *domain.Project
+	query   string
+	hits    []domain.LogSearchHit // Newest session first
+	cursor  int
+	loading bool
+}
+
+// logJump is a search hit to scroll to once its session is shown.
+type logJump struct {
+	query  string
+	line   int   // 1-based line of the hit in the session file
+	offset int64 // Byte offset of the hit's line, where the log opens
+}
+
+// logSearchResultsMsg carries the hits of a cross-session search.
+type logSearchResultsMsg struct {
+	query string
+	hits  []domain.LogSearchHit
+	err   error
+}
+
+// paletteSearchLogs searches every session log of the selected project for query.
+func paletteSearchLogs(m Model, query string) (tea.Model, tea.Cmd) {
+	selected := m.currentList().SelectedProject()
+	if selected == nil || query == "" {
+		return m, nil
+	}
+	if m.logReaderRegistry == nil {
+		return m, func() tea.Msg {
+			return flashMsg{text: "Log viewing not available"}
+		}
+	}
+
+	m.showLogSearch = true
+	m.logSearch = logSearchResults{project: selected, query: query, loading: true}
+	registry := m.logReaderRegistry
+	path := selected.Path
+	return m, func() tea.Msg {
+		hits, err := registry.Search(context.Background(), path, query, logSearchLimit)
+		return logSearchResultsMsg{query: query, hits: hits, err: err}
+	}
+}
+
+// handleLogSearchResults shows the hits of a finished search.
+func (m Model) handleLogSearchResults(msg logSearchResultsMsg) (tea.Model, tea.Cmd) {
+	if !m.showLogSearch || msg.query != m.logSearch.query {
+		return m, nil // Discard - overlay was closed or a newer search started
+	}
+	if msg.err != nil {
+		slog.Warn("failed to search logs", "error", msg.err)
+		m.closeLogSearch()
+		return m, func() tea.Msg {
+			return flashMsg{text: "Log search failed"}
+		}
+	}
+	if len(msg.hits) == 0 {
+		m.closeLogSearch()
+		return m, func() tea.Msg {
+			return flashMsg{text: fmt.Sprintf("No log matches for %q", msg.query)}
+		}
+	}
+	m.logSearch.hits = msg.hits
+	m.logSearch.loading = false
+	return m, nil
+}
+
+// closeLogSearch hides the log search overlay.
+func (m *Model) closeLogSearch() {
+	m.showLogSearch = false
+	m.logSearch = logSearchResults{}
+}
+
+// handleLogSearchKeyMsg handles keyboard input in the log search overlay.
+func (m Model) handleLogSearchKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	msg, bound := m.keys.resolveKeyMsg(msg, scopeLog)
+	if !bound {
+		return m, nil
+	}
+
+	s := &m.logSearch
+	switch msg.String() {
+	case KeyEscape:
+		m.closeLogSearch()
+		return m, nil
+
+	case KeyDown, KeyDownArrow:
+		if s.cursor < len(s.hits)-1 {
+			s.cursor++
+		}
+		return m, nil
+
+	case KeyUp, KeyUpArrow:
+		if s.cursor > 0 {
+			s.cursor--
+		}
+		return m, nil
+
+	case "enter":
+		if s.cursor >= len(s.hits) {
+			return m, nil
+		}
+		hit, query, selected := s.hits[s.cursor], s.query, s.project
+		m.closeLogSearch()
+
+		// Same context as opening the log with Enter, so S picks sessions
+		m.currentLogProject = selected
+		m.currentLogReaders = m.logReaderRegistry.GetReaders(context.Background(), selected.Path)
+		m.logSessions = nil
+		m.pendingLogJump = &logJump{query: query, line: hit.Line, offset: hit.Offset}
+		return m, m.openLogCmd(hit.Session, project.EffectiveName(selected))
+	}
+
+	return m, nil
+}
+
+// applyLogJump scrolls the freshly opened log view to a search hit and
+// makes the query the view's search, so n/N continue from the hit.
+// jq marks content pretty-printed by jq (one multi-line object per entry).
+func (m *Model) applyLogJump(jump logJump, jq bool) {
+	target := jump.line - 1
+	switch {
+	case m.logDoc != nil:
+		i := m.logDoc.blockForLine(jump.line)
+		if i < 0 {
+			target = len(m.textViewContent) - 1
+			break
+		}
+		// The match may be in the hidden lines of a collapsed tool result
+		blocks := m.logDoc.blocks
+		for j := i; j < len(blocks) && blocks[j].line == blocks[i].line; j++ {
+			if blocks[j].kind == logBlockToolResult {
+				blocks[j].expanded = true
+			}
+		}
+		m.textViewContent = m.logDoc.render(m.logWidth())
+		target = m.logDoc.blockLines[i]
+	case jq:
+		target = jqEntryLine(m.textViewContent, jump.line)
+	}
+
+	m.searchQuery = jump.query
+	m.searchMatches = m.findMatches(jump.query)
+	m.searchIndex = 0
+	for i, line := range m.searchMatches {
+		if line >= target {
+			m.searchIndex = i
+			target = line
+			break
+		}
+	}
+
+	contentHeight := m.height - statusBarHeight(m.height) - 2
+	maxScroll := max(len(m.textViewContent)-contentHeight, 0)
+	m.textViewScroll = min(max(target-contentHeight/2, 0), maxScroll)
+	m.logAutoScroll = false
+}
+
+// jqEntryLine returns the first line of the entry-th (1-based) top-level
+// object in jq output, or the last line if there are fewer objects.
+func jqEntryLine(lines []string, entry int) int {
+	seen := 0
+	for i, line := range lines {
+		if line == "{" {
+			seen++
+			if seen == entry {
+				return i
+			}
+		}
+	}
+	return max(len(lines)-1, 0)
+}
+
+// renderLogSearch renders the log search overlay.
+func (m Model) renderLogSearch(width, height int) string {
+	s := m.logSearch
+	title := titleStyle.Render(fmt.Sprintf("Log matches for %q", s.query))
+	rowWidth := min(width-8, 110) // Popup border and padding
+
+	if s.loading {
+		content := strings.Join([]string{title, "", hintStyle.Render("Searching all sessions..."), "", hintStyle.Render("[Esc] Cancel")}, "\n")
+		return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, popupStyle.Render(content))
+	}
+
+	// Reserve: title(1) + blank(1) + indicator(1) + blank(1) + footer(1) + border(2) + padding(2)
+	maxVisible := max(height-9, 3)
+	maxVisible = min(maxVisible, len(s.hits))
+	start := max(0, s.cursor-maxVisible+1)
+	end := min(len(s.hits), start+maxVisible)
+
+	// Label rows by tool when hits come from more than one tool
+	multiTool := false
+	for _, hit := range s.hits {
+		if hit.Session.Tool != s.hits[0].Session.Tool {
+			multiTool = true
+			break
+		}
+	}
+
+	lines := []string{title, ""}
+	for i := start; i < end; i++ {
+		hit := s.hits[i]
+		prefix := "  "
+		if i == s.cursor {
+			prefix = "> "
+		}
+		when := "           "
+		if !hit.Timestamp.IsZero() {
+			when = hit.Timestamp.Local().Format("01-02 15:04")
+		}
+		sessionID := hit.Session.ID
+		if len(sessionID) > 8 {
+			sessionID = sessionID[:8]
+		}
+		meta := fmt.Sprintf("%s  %-8s  %-9s", when, sessionID, hit.Type)
+		if multiTool {
+			meta = fmt.Sprintf("%s  %-11s  %-8s  %-9s", when, hit.Session.Tool, sessionID, hit.Type)
+		}
+		row := truncateToWidth(prefix+DimStyle.Render(meta)+"  "+hit.Snippet, rowWidth)
+		if i == s.cursor {
+			row = SelectedStyle.Render(stripANSI(row))
+		}
+		lines = append(lines, row)
+	}
+
+	indicator := fmt.Sprintf("  (%d/%d)", s.cursor+1, len(s.hits))
+	if len(s.hits) >= logSearchLimit {
+		indicator += fmt.Sprintf(" - first %d matches", logSearchLimit)
+	}
+	lines = append(lines, hintStyle.Render(indicator), "", hintStyle.Render("[Enter] Open  [Esc] Cancel"))
+
+	box := popupStyle.Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,195 @@
+package tui
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// mockLogRegistry implements ports.LogReaderRegistry with canned search hits.
+type mockLogRegistry struct {
+	hits      []domain.LogSearchHit
+	lastPath  string
+	lastQuery string
+}
+
+func (r *mockLogRegistry) Register(_ ports.LogReader)                               {}
+func (r *mockLogRegistry) GetReader(_ context.Context, _ string) ports.LogReader    { return nil }
+func (r *mockLogRegistry) GetReaders(_ context.Context, _ string) []ports.LogReader { return nil }
+func (r *mockLogRegistry) Readers() []ports.LogReader                               { return nil }
+func (r *mockLogRegistry) Search(_ context.Context, path, query string, _ int) ([]domain.LogSearchHit, error) {
+	r.lastPath, r.lastQuery = path, query
+	return r.hits, nil
+}
+
+// structuredHit returns a hit in the structured Claude Code fixture.
+func structuredHit(t *testing.T, line int, snippet string) domain.LogSearchHit {
+	t.Helper()
+	data, err := os.ReadFile(structuredSessionPath(t))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	return domain.LogSearchHit{
+		Session:   domain.LogSession{ID: "str-456", Path: structuredSessionPath(t), Tool: claudeCodeTool},
+		Line:      line,
+		Offset:    int64(len(strings.Join(lines[:line-1], ""))),
+		Timestamp: time.Date(2026, 1, 12, 10, 0, 9, 0, time.UTC),
+		Type:      "user",
+		Snippet:   snippet,
+	}
+}
+
+func TestLogSearch_PaletteSearchesSelectedProject(t *testing.T) {
+	m := createModelWithProjects(2)
+	registry := &mockLogRegistry{hits: []domain.LogSearchHit{structuredHit(t, 6, "FAIL github.com/example/parser")}}
+	m.SetLogReaderRegistry(registry)
+
+	m = openTestPalette(t, m, "search session logs")
+	m = pressKey(t, m, tea.KeyEnter)
+	m = typeKeys(t, m, "example/parser")
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	if !m.showLogSearch || !m.logSearch.loading {
+		t.Fatal("search should open the results overlay while loading")
+	}
+	if view := m.View(); !strings.Contains(view, "Searching all sessions") {
+		t.Errorf("expected loading hint, got:\n%s", view)
+	}
+
+	msg := cmd()
+	if registry.lastPath != "/path/a" || registry.lastQuery != "example/parser" {
+		t.Errorf("Search(%q, %q), want selected project path and query", registry.lastPath, registry.lastQuery)
+	}
+	updated, _ = m.Update(msg)
+	m = updated.(Model)
+
+	view := m.View()
+	for _, want := range []string{`Log matches for "example/parser"`, "str-456", "user", "FAIL github.com/example/parser", "(1/1)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("results overlay missing %q:\n%s", want, view)
+		}
+	}
+}
+
+func TestLogSearch_NoMatchesFlashes(t *testing.T) {
+	m := createModelWithProjects(1)
+	m.showLogSearch = true
+	m.logSearch = logSearchResults{query: "nothing", loading: true}
+
+	updated, cmd := m.Update(logSearchResultsMsg{query: "nothing"})
+	m = updated.(Model)
+	if m.showLogSearch {
+		t.Error("overlay should close when nothing matches")
+	}
+	if flash, ok := cmd().(flashMsg); !ok || !strings.Contains(flash.text, "No log matches") {
+		t.Errorf("expected no-match flash, got %#v", flash)
+	}
+}
+
+func TestLogSearch_StaleResultsDiscarded(t *testing.T) {
+	m := createModelWithProjects(1)
+	m.showLogSearch = true
+	m.logSearch = logSearchResults{query: "new", loading: true}
+
+	updated, _ := m.Update(logSearchResultsMsg{query: "old", hits: []domain.LogSearchHit{structuredHit(t, 3, "x")}})
+	m = updated.(Model)
+	if !m.logSearch.loading || len(m.logSearch.hits) != 0 {
+		t.Error("results of an earlier query should be discarded")
+	}
+}
+
+func TestLogSearch_EnterOpensSessionAtHit(t *testing.T) {
+	m := createModelWithProjects(1)
+	m.height = 20
+	m.SetLogReaderRegistry(&mockLogRegistry{})
+	m.showLogSearch = true
+	m.logSearch = logSearchResults{
+		project: m.projects[0],
+		query:   "example/parser",
+		hits:    []domain.LogSearchHit{structuredHit(t, 3, "The parser test"), structuredHit(t, 6, "FAIL github.com/example/parser")},
+	}
+
+	m = typeKeys(t, m, "j")
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.showLogSearch || m.currentLogProject != m.projects[0] {
+		t.Fatal("Enter should close the overlay and keep the project for the log view")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.viewMode != viewModeTextView || m.logDoc == nil {
+		t.Fatal("expected the session in the structured log view")
+	}
+	if m.searchQuery != "example/parser" || len(m.searchMatches) == 0 {
+		t.Fatalf("search = %q with %d matches, want the hit query", m.searchQuery, len(m.searchMatches))
+	}
+	if m.logAutoScroll {
+		t.Error("jumping to a hit should pause auto-scroll")
+	}
+
+	// The match is in a collapsed result's hidden lines, so the result is expanded
+	match := m.searchMatches[m.searchIndex]
+	contentHeight := m.height - statusBarHeight(m.height) - 2
+	if match < m.textViewScroll || match >= m.textViewScroll+contentHeight {
+		t.Errorf("match line %d not visible at scroll %d", match, m.textViewScroll)
+	}
+	if !strings.Contains(stripANSI(m.textViewContent[match]), "example/parser") {
+		t.Errorf("line %d = %q, want the match", match, stripANSI(m.textViewContent[match]))
+	}
+	if m.pendingLogJump != nil {
+		t.Error("jump should be consumed")
+	}
+}
+
+func TestModel_ApplyLogJump_PlainAndJQ(t *testing.T) {
+	m := NewModel(nil)
+	m.ready = true
+	m.width = 80
+	m.height = 20
+
+	var lines []string
+	for i := 0; i < 40; i++ {
+		lines = append(lines, "line", "{", `  "text": "needle"`, "}")
+	}
+	updated, _ := m.Update(textViewContentMsg{title: "codex", content: strings.Join(lines, "\n"), jq: true})
+	m = updated.(Model)
+	m.applyLogJump(logJump{query: "needle", line: 10}, true)
+
+	// The 10th entry starts on line 37; its match is on line 38
+	if got := m.searchMatches[m.searchIndex]; got != 38 {
+		t.Errorf("jq jump match = %d, want 38", got)
+	}
+
+	m.applyLogJump(logJump{query: "line", line: 21}, false)
+	if got := m.searchMatches[m.searchIndex]; got != 20 {
+		t.Errorf("plain jump match = %d, want line 21 (index 20)", got)
+	}
+}
+
+func TestJQEntryLine(t *testing.T) {
+	lines := []string{"{", `  "a": {`, "  }", "}", "{", "}"}
+	if got := jqEntryLine(lines, 2); got != 4 {
+		t.Errorf("jqEntryLine(2) = %d, want 4", got)
+	}
+	if got := jqEntryLine(lines, 5); got != len(lines)-1 {
+		t.Errorf("jqEntryLine(5) = %d, want last line", got)
+	}
+}
+
+func TestFindMatches_IgnoresStyling(t *testing.T) {
+	m := NewModel(nil)
+	m.textViewContent = []string{"\x1b[1mpar\x1b[0mser", "plain"}
+	if got := m.findMatches("parser"); len(got) != 1 || got[0] != 0 {
+		t.Errorf("findMatches() = %v, want [0]", got)
+	}
+}
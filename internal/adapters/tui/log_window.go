@@ -0,0 +1,285 @@
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Claude Code sessions can grow to hundreds of megabytes, so the log view
+// decodes a window of the file instead of the whole session: the last
+// entries when a log opens, the entries around a search hit when one is
+// opened, and further windows on request.
+
+const (
+	// logWindowEntries is the number of JSONL lines decoded when a log opens
+	// and each time earlier or later entries are loaded.
+	logWindowEntries = 500
+
+	// logWindowMaxBytes caps the bytes read for one window, so a few huge
+	// entries (large tool results) do not load megabytes at once. A window
+	// always holds at least one line.
+	logWindowMaxBytes = 4 << 20
+
+	// logScanChunk is the read size used when scanning for line boundaries.
+	logScanChunk = 64 * 1024
+)
+
+// logEarlierLoadedMsg carries entries decoded from just before the first
+// entry of the log view.
+type logEarlierLoadedMsg struct {
+	sessionPath string
+	end         int64        // Offset the entries end at (the document start when requested)
+	doc         *logDocument // Decoded entries
+	err         error
+}
+
+// openLogWindow decodes the last entries of a Claude Code session, or those
+// around jump when set. Returns the document and the file size, which is
+// where tailing continues.
+func openLogWindow(path string, jump *logJump) (*logDocument, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	size := info.Size()
+
+	// A hit that is no longer in the file opens on the tail
+	if jump != nil && jump.offset < size {
+		return openLogWindowAt(f, size, *jump)
+	}
+
+	start, err := windowStart(f, size, logWindowEntries, logWindowMaxBytes)
+	if err != nil {
+		return nil, 0, err
+	}
+	startLine, err := countLines(f, start)
+	if err != nil {
+		return nil, 0, err
+	}
+	content, err := readLogRange(f, start, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	doc := newLogDocumentAt(start, startLine)
+	doc.appendJSONL(content)
+	doc.end, doc.size = size, size
+	return doc, size, nil
+}
+
+// openLogWindowAt decodes the entries around a search hit: half a window
+// before its line and half a window from it.
+func openLogWindowAt(f *os.File, size int64, jump logJump) (*logDocument, int64, error) {
+	start, err := windowStart(f, jump.offset, logWindowEntries/2, logWindowMaxBytes/2)
+	if err != nil {
+		return nil, 0, err
+	}
+	end, err := windowEnd(f, jump.offset, size, logWindowEntries/2, logWindowMaxBytes/2)
+	if err != nil {
+		return nil, 0, err
+	}
+	content, err := readLogRange(f, start, end)
+	if err != nil {
+		return nil, 0, err
+	}
+	startLine := jump.line - 1 - strings.Count(content[:jump.offset-start], "\n")
+	doc := newLogDocumentAt(start, startLine)
+	doc.appendJSONL(content)
+	doc.end, doc.size = end, size
+	return doc, size, nil
+}
+
+// loadEarlierLogCmd decodes the window of entries before the first entry
+// of the structured log.
+func (m Model) loadEarlierLogCmd() tea.Cmd {
+	path, end, endLine := m.currentSessionPath, m.logDoc.start, m.logDoc.startLine
+	return func() tea.Msg {
+		f, err := os.Open(path)
+		if err != nil {
+			return logEarlierLoadedMsg{err: err}
+		}
+		defer f.Close()
+
+		start, err := windowStart(f, end, logWindowEntries, logWindowMaxBytes)
+		if err != nil {
+			return logEarlierLoadedMsg{err: err}
+		}
+		content, err := readLogRange(f, start, end)
+		if err != nil {
+			return logEarlierLoadedMsg{err: err}
+		}
+		doc := newLogDocumentAt(start, endLine-strings.Count(content, "\n"))
+		doc.appendJSONL(content)
+		return logEarlierLoadedMsg{sessionPath: path, end: end, doc: doc}
+	}
+}
+
+// loadLaterLogCmd reads the window of entries after the last entry of the
+// structured log. They are appended like tailed entries.
+func (m Model) loadLaterLogCmd() tea.Cmd {
+	path, from := m.currentSessionPath, m.logDoc.end
+	return func() tea.Msg {
+		f, err := os.Open(path)
+		if err != nil {
+			return logNewEntriesMsg{err: err}
+		}
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			return logNewEntriesMsg{err: err}
+		}
+		end, err := windowEnd(f, from, info.Size(), logWindowEntries, logWindowMaxBytes)
+		if err != nil {
+			return logNewEntriesMsg{err: err}
+		}
+		content, err := readLogRange(f, from, end)
+		if err != nil {
+			return logNewEntriesMsg{err: err}
+		}
+		return logNewEntriesMsg{newContent: content, offset: from, newOffset: end}
+	}
+}
+
+// handleLogEarlierLoaded prepends earlier entries to the structured log,
+// keeping the entries on screen in place.
+func (m Model) handleLogEarlierLoaded(msg logEarlierLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		return m, func() tea.Msg {
+			return flashMsg{text: "Failed to load earlier entries: " + msg.err.Error()}
+		}
+	}
+	// The view moved on (closed, other session, already loaded)
+	if m.viewMode != viewModeTextView || m.logDoc == nil ||
+		msg.sessionPath != m.currentSessionPath || msg.end != m.logDoc.start {
+		return m, nil
+	}
+
+	hadBlocks := len(m.logDoc.blocks) > 0
+	oldFirst := 0
+	if hadBlocks {
+		oldFirst = m.logDoc.blockLines[0]
+	}
+	added := len(msg.doc.blocks)
+	m.logDoc.prepend(msg.doc)
+	m.rerenderLog()
+	if hadBlocks && !m.logAutoScroll {
+		m.textViewScroll += m.logDoc.blockLines[added] - oldFirst
+	}
+	return m, nil
+}
+
+// windowStart returns the offset of the first of the last n lines before
+// end, which is a line start or the end of the file. Lines are added while
+// the window stays within maxBytes, but at least one line is included.
+func windowStart(r io.ReaderAt, end int64, n int, maxBytes int64) (int64, error) {
+	buf := make([]byte, logScanChunk)
+	start, lines := end, 0
+	for hi := end; hi > 0; {
+		lo := max(hi-logScanChunk, 0)
+		chunk := buf[:hi-lo]
+		if _, err := r.ReadAt(chunk, lo); err != nil && err != io.EOF {
+			return 0, fmt.Errorf("failed to read log: %w", err)
+		}
+		for i := len(chunk) - 1; i >= 0; i-- {
+			lineStart := lo + int64(i) + 1
+			if chunk[i] != '\n' || lineStart >= end {
+				continue
+			}
+			if end-lineStart > maxBytes && lines > 0 {
+				return start, nil
+			}
+			start, lines = lineStart, lines+1
+			if lines >= n {
+				return start, nil
+			}
+		}
+		hi = lo
+	}
+	// The first line of the file
+	if end > maxBytes && lines > 0 {
+		return start, nil
+	}
+	return 0, nil
+}
+
+// windowEnd returns the offset just after the first n lines from offset
+// from, which is a line start, or size when the file ends first. Lines are
+// added while the window stays within maxBytes, but at least one line is
+// included.
+func windowEnd(r io.ReaderAt, from, size int64, n int, maxBytes int64) (int64, error) {
+	buf := make([]byte, logScanChunk)
+	end, lines := from, 0
+	for lo := from; lo < size; lo += logScanChunk {
+		chunk := buf[:min(logScanChunk, size-lo)]
+		if _, err := r.ReadAt(chunk, lo); err != nil && err != io.EOF {
+			return 0, fmt.Errorf("failed to read log: %w", err)
+		}
+		for i, b := range chunk {
+			if b != '\n' {
+				continue
+			}
+			lineEnd := lo + int64(i) + 1
+			if lineEnd-from > maxBytes && lines > 0 {
+				return end, nil
+			}
+			end, lines = lineEnd, lines+1
+			if lines >= n {
+				return end, nil
+			}
+		}
+	}
+	// A last line without newline
+	if size-from > maxBytes && lines > 0 {
+		return end, nil
+	}
+	return size, nil
+}
+
+// countLines returns the number of lines that end before offset end.
+func countLines(r io.ReaderAt, end int64) (int, error) {
+	buf := make([]byte, logScanChunk)
+	count := 0
+	for lo := int64(0); lo < end; lo += logScanChunk {
+		chunk := buf[:min(logScanChunk, end-lo)]
+		if _, err := r.ReadAt(chunk, lo); err != nil && err != io.EOF {
+			return 0, fmt.Errorf("failed to read log: %w", err)
+		}
+		count += bytes.Count(chunk, []byte{'\n'})
+	}
+	return count, nil
+}
+
+// readLogRange reads bytes [start, end) of r into a string.
+func readLogRange(r io.ReaderAt, start, end int64) (string, error) {
+	var b strings.Builder
+	b.Grow(int(end - start))
+	if _, err := io.Copy(&b, io.NewSectionReader(r, start, end-start)); err != nil {
+		return "", fmt.Errorf("failed to read log: %w", err)
+	}
+	return b.String(), nil
+}
+
+// pagingHint returns the footer hint for the keys that load entries
+// outside the document, or "".
+func (d *logDocument) pagingHint() string {
+	switch earlier, later := d.start > 0, d.end < d.size; {
+	case earlier && later:
+		return "[{/}] Earlier/Later  "
+	case earlier:
+		return "[{] Earlier  "
+	case later:
+		return "[}] Later  "
+	}
+	return ""
+}
@@ -0,0 +1,210 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+var loadEarlierKey = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyLogLoadEarlier)}
+
+func TestWindowStart(t *testing.T) {
+	content := "aaa\nbbb\nccc\nddd\n"
+	r := strings.NewReader(content)
+	size := int64(len(content))
+
+	tests := []struct {
+		name     string
+		end      int64
+		n        int
+		maxBytes int64
+		want     int64
+	}{
+		{"last two lines", size, 2, 1 << 20, 8},
+		{"more lines than file", size, 10, 1 << 20, 0},
+		{"before a line start", 8, 1, 1 << 20, 4},
+		{"byte cap", size, 10, 9, 8},
+		{"at least one line", size, 10, 1, 12},
+		{"empty", 0, 10, 1 << 20, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := windowStart(r, tt.end, tt.n, tt.maxBytes)
+			if err != nil || got != tt.want {
+				t.Errorf("windowStart() = %d, %v, want %d", got, err, tt.want)
+			}
+		})
+	}
+
+	// A trailing line without newline counts as a line
+	partial := strings.NewReader("aaa\nbbb")
+	if got, _ := windowStart(partial, 7, 1, 1<<20); got != 4 {
+		t.Errorf("windowStart() = %d, want 4", got)
+	}
+}
+
+func TestCountLines(t *testing.T) {
+	content := strings.Repeat("x\n", logScanChunk) // Spans several chunks
+	got, err := countLines(strings.NewReader(content), int64(len(content)-2))
+	if err != nil || got != logScanChunk-1 {
+		t.Errorf("countLines() = %d, %v, want %d", got, err, logScanChunk-1)
+	}
+}
+
+// writePromptLog writes a Claude Code log of n user prompts "prompt 1".."prompt n".
+func writePromptLog(t *testing.T, n int) string {
+	t.Helper()
+	var b strings.Builder
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&b, `{"type":"user","message":{"content":"prompt %d"}}`+"\n", i)
+	}
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestOpenLogWindow_DecodesTail(t *testing.T) {
+	path := writePromptLog(t, logWindowEntries+20)
+	info, _ := os.Stat(path)
+
+	doc, size, err := openLogWindow(path, nil)
+	if err != nil {
+		t.Fatalf("openLogWindow() error = %v", err)
+	}
+	if size != info.Size() {
+		t.Errorf("size = %d, want %d", size, info.Size())
+	}
+	if len(doc.blocks) != logWindowEntries || doc.start == 0 || doc.startLine != 20 {
+		t.Fatalf("blocks = %d, start = %d, startLine = %d", len(doc.blocks), doc.start, doc.startLine)
+	}
+	if first := doc.blocks[0]; first.text != "prompt 21" || first.line != 21 {
+		t.Errorf("first block = %q on line %d, want prompt 21 on line 21", first.text, first.line)
+	}
+	if lines := plainLines(doc, 80); !strings.Contains(lines[0], "Earlier entries not loaded") {
+		t.Errorf("first line = %q, want the earlier entries hint", lines[0])
+	}
+}
+
+func TestModel_StructuredLog_LoadEarlier(t *testing.T) {
+	path := writePromptLog(t, logWindowEntries+20)
+	m := NewModel(nil)
+	m.ready = true
+	m.width = 80
+	m.height = 20
+	updated, _ := m.Update(m.openLogCmd(domain.LogSession{Path: path, Tool: claudeCodeTool}, "proj")())
+	m = updated.(Model)
+
+	m.textViewScroll = 0
+	m.logAutoScroll = false
+	row := m.logDoc.blockLines[0] - m.textViewScroll // Screen row of "prompt 21"
+
+	updated, cmd := m.Update(loadEarlierKey)
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("'{' should load earlier entries")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	if len(m.logDoc.blocks) != logWindowEntries+20 || m.logDoc.start != 0 {
+		t.Fatalf("blocks = %d, start = %d, want the whole session", len(m.logDoc.blocks), m.logDoc.start)
+	}
+	if first := m.logDoc.blocks[0]; first.text != "prompt 1" || first.line != 1 {
+		t.Errorf("first block = %q on line %d, want prompt 1 on line 1", first.text, first.line)
+	}
+	if got := m.logDoc.blockLines[20] - m.textViewScroll; got != row {
+		t.Errorf("prompt 21 moved from screen row %d to %d", row, got)
+	}
+
+	// Nothing left to load
+	if _, cmd := m.Update(loadEarlierKey); cmd == nil || cmd() != (flashMsg{text: "Start of session"}) {
+		t.Error("'{' at the start of the session should flash")
+	}
+}
+
+func TestLogDocument_PrependNamesToolResults(t *testing.T) {
+	doc := newLogDocumentAt(100, 1)
+	doc.appendJSONL(`{"type":"user","message":{"content":[{"type":"tool_result","tool_use_id":"t1","content":"ok"}]}}` + "\n")
+	if doc.blocks[0].tool != "" {
+		t.Fatalf("result of an unloaded call should be unnamed, got %q", doc.blocks[0].tool)
+	}
+
+	earlier := newLogDocumentAt(0, 0)
+	earlier.appendJSONL(`{"type":"assistant","message":{"content":[{"type":"tool_use","id":"t1","name":"Bash","input":{}}]}}` + "\n")
+	doc.prepend(earlier)
+
+	if len(doc.blocks) != 2 || doc.blocks[1].tool != "Bash" || doc.start != 0 {
+		t.Errorf("blocks = %+v, start = %d", doc.blocks, doc.start)
+	}
+}
+
+func TestModel_StructuredLog_OpensHitWindow(t *testing.T) {
+	n := logWindowEntries * 2
+	path := writePromptLog(t, n)
+	data, _ := os.ReadFile(path)
+	info, _ := os.Stat(path)
+	line := 10
+	offset := strings.Index(string(data), `"prompt 10"`) - len(`{"type":"user","message":{"content":`)
+
+	m := NewModel(nil)
+	m.ready = true
+	m.width = 80
+	m.height = 20
+	m.pendingLogJump = &logJump{query: "prompt 10", line: line, offset: int64(offset)}
+	updated, _ := m.Update(m.openLogCmd(domain.LogSession{Path: path, Tool: claudeCodeTool}, "proj")())
+	m = updated.(Model)
+
+	doc := m.logDoc
+	if doc.start != 0 || len(doc.blocks) != line-1+logWindowEntries/2 {
+		t.Fatalf("start = %d, blocks = %d, want the lines around the hit", doc.start, len(doc.blocks))
+	}
+	if m.logLastOffset != info.Size() || doc.end >= doc.size {
+		t.Errorf("tailing should continue from the file end %d (offset %d, window end %d)", info.Size(), m.logLastOffset, doc.end)
+	}
+	if i := doc.blockForLine(line); i < 0 || doc.blocks[i].text != "prompt 10" {
+		t.Errorf("block for line %d = %d, want prompt 10", line, i)
+	}
+	if got := stripANSI(m.textViewContent[len(m.textViewContent)-1]); !strings.Contains(got, "Later entries not loaded") {
+		t.Errorf("last line = %q, want the later entries hint", got)
+	}
+
+	// Tailed entries are not appended after a gap
+	tailed := `{"type":"user","message":{"content":"tailed"}}` + "\n"
+	f, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	_, _ = f.WriteString(tailed)
+	f.Close()
+	size := info.Size() + int64(len(tailed))
+	updated, _ = m.Update(logNewEntriesMsg{newContent: tailed, offset: info.Size(), newOffset: size})
+	m = updated.(Model)
+	if len(m.logDoc.blocks) != len(doc.blocks) || m.logDoc.size != size {
+		t.Fatalf("blocks = %d, size = %d: tailed entries should be skipped", len(m.logDoc.blocks), m.logDoc.size)
+	}
+
+	// } pages forward up to the tail, which tailing then continues from
+	for i := 0; m.logDoc.end < m.logDoc.size && i < 5; i++ {
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyLogLoadLater)})
+		m = updated.(Model)
+		if cmd == nil {
+			t.Fatal("'}' should load later entries")
+		}
+		updated, _ = m.Update(cmd())
+		m = updated.(Model)
+	}
+	if len(m.logDoc.blocks) != n+1 || m.logLastOffset != size {
+		t.Fatalf("blocks = %d, offset = %d, want all %d entries", len(m.logDoc.blocks), m.logLastOffset, n+1)
+	}
+	if last := m.logDoc.blocks[n-1]; last.text != fmt.Sprintf("prompt %d", n) || last.line != n {
+		t.Errorf("block %d = %q on line %d", n-1, last.text, last.line)
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyLogLoadLater)}); cmd == nil || cmd() != (flashMsg{text: "End of session"}) {
+		t.Error("'}' at the tail should flash")
+	}
+}
@@ -1,7 +1,9 @@
 package tui
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -29,6 +31,10 @@ import (
 // Story 12.2 AC2: Timeout for double-key detection (e.g., 'gg' for jump to top)
 const ggTimeoutMs = 500
 
+// workspaceScanInterval is how often config workspace_roots are re-scanned
+// for new projects (same default as the daemon).
+const workspaceScanInterval = 5 * time.Minute
+
 // Model represents the main TUI application state.
 type Model struct {
 	width            int  // Terminal width (from WindowSizeMsg)
@@ -71,12 +77,21 @@ type Model struct {
 
 	// Remove confirmation state (Story 3.9)
 	isConfirmingRemove bool
-	confirmTarget      *domain.Project // Project pending removal
+	confirmTargets     []*domain.Project // Projects pending removal (several for bulk remove)
+
+	// Command palette state
+	showPalette bool
+	palette     commandPalette
+
+	// Bulk selection state
+	marked     map[string]bool // IDs of projects marked for bulk actions; shared with list delegates
+	markAnchor string          // Last project toggled with KeyMark; KeyMarkRange marks from here
+	bulk       bulkJob         // Running bulk action
 
 	// Dependencies (injected)
-	repository       ports.ProjectRepository
-	detectionService ports.Detector        // Optional - may be nil if not wired
-	waitingDetector  ports.WaitingDetector // Story 4.5: Optional - for WAITING indicator
+	repository      ports.ProjectRepository
+	refresher       ports.ProjectRefresher // Optional - re-detection is disabled if nil
+	waitingDetector ports.WaitingDetector  // Story 4.5: Optional - for WAITING indicator
 
 	// Story 4.6: File watcher for real-time dashboard updates
 	fileWatcher          ports.FileWatcher
@@ -129,18 +144,43 @@ type Model struct {
 	activeSelectedIdx      int    // Preserve selection when switching views
 	justActivatedProjectID string // Track which project to select after activation (AC3)
 
+	// Waiting notifications (optional)
+	notificationService ports.NotificationService
+
+	// Workspace scanner (optional): re-scans config workspace_roots for new projects
+	projectScanner ports.ProjectScanner
+
+	// Attached daemon (optional): when set, the daemon owns watching,
+	// re-detection and hibernation; the TUI only reloads and forwards refreshes
+	daemon ports.DaemonController
+
+	// Git status (optional): inspected in the background on each project load.
+	// gitStatuses is keyed by project path and updated in place, since
+	// component callbacks hold a copy of the model.
+	gitInspector ports.GitInspector
+	gitStatuses  map[string]*domain.GitStatus
+
+	// Token usage (optional): read in the background on each project load,
+	// keyed by project path like gitStatuses
+	usageReader ports.UsageReader
+	usage       map[string]*domain.ProjectUsage
+
 	// Story 12.1: Log viewer state
 	logReaderRegistry  ports.LogReaderRegistry
-	currentLogReader   ports.LogReader     // Active log reader for the current project
+	currentLogReaders  []ports.LogReader   // Log readers that apply to the current project
 	currentLogProject  *domain.Project     // Project whose logs are being viewed
 	showSessionPicker  bool                // Whether session picker overlay is visible
 	logSessions        []domain.LogSession // Available sessions for session picker
 	sessionPickerIndex int                 // Selected index in session picker
+	showLogSearch      bool                // Whether the log search overlay is visible
+	logSearch          logSearchResults    // Cross-session log search hits
+	pendingLogJump     *logJump            // Search hit to scroll to when its session opens
 
 	// Story 12.1: Text view state (for displaying jq-formatted logs)
-	textViewContent []string // Lines of text to display
-	textViewTitle   string   // Title for the text view header
-	textViewScroll  int      // Current scroll position (line offset)
+	textViewContent []string     // Lines of text to display
+	textViewTitle   string       // Title for the text view header
+	textViewScroll  int          // Current scroll position (line offset)
+	logDoc          *logDocument // Structured Claude Code log; nil for jq/raw text
 
 	// Story 12.1: Flash message state (AC8)
 	flashMessage     string
@@ -151,6 +191,9 @@ type Model struct {
 	logAutoScroll      bool   // True = auto-scroll to latest, false = paused
 	logLastOffset      int64  // Last read offset for incremental reads
 	logTailActive      bool   // Whether tailing is active
+	// Session read through its reader (Aider sessions share one history
+	// file); re-read when the file grows instead of appending raw lines
+	logSession *domain.LogSession
 
 	// Story 12.2 AC2: Double-key detection for 'gg' jump to top
 	lastKeyPress string    // Last key pressed in text view
@@ -162,6 +205,29 @@ type Model struct {
 	searchInput   string // Text being typed (before Enter)
 	searchIndex   int    // Current match index (0-based)
 	searchMatches []int  // Line numbers with matches
+
+	// Project list search: '/' opens the filter bar
+	filterMode  bool   // Typing in the filter bar
+	filterQuery string // Active filter, reapplied after every project reload
+
+	// Project list ordering: 's' cycles sort mode, 'o' cycles grouping.
+	// Saved to config via configLoader when set.
+	sortMode     domain.SortMode
+	groupBy      domain.GroupBy
+	configLoader ports.ConfigLoader
+
+	// Key bindings: config overrides applied to DefaultKeyBindings.
+	// keyWarnings are shown once in the status bar on start.
+	keys        KeyBindings
+	keyWarnings []string
+
+	// Progress metrics (optional): sampled every metricsSampleInterval
+	// unless a daemon is attached; 'S' opens the stats view
+	metricsCollector ports.MetricsCollector
+	statsProject     *domain.Project      // Project shown in the stats view
+	stats            *domain.ProjectStats // nil while loading
+	statsErr         error
+	statsDays        int // Stats view range in days (0 = all time)
 }
 
 // resizeTickMsg is used for resize debouncing.
@@ -263,6 +329,21 @@ type hibernationCompleteMsg struct {
 	err   error
 }
 
+// gitStatusMsg carries freshly inspected git statuses keyed by project path.
+// Paths that are not repositories (or failed) map to nil.
+type gitStatusMsg struct {
+	statuses map[string]*domain.GitStatus
+}
+
+// workspaceScanTickMsg triggers a periodic scan of config workspace_roots.
+type workspaceScanTickMsg time.Time
+
+// workspaceScanCompleteMsg reports how many projects a workspace scan added.
+type workspaceScanCompleteMsg struct {
+	added int
+	err   error
+}
+
 // hibernationTickMsg triggers hourly auto-hibernation check (Story 11.2).
 type hibernationTickMsg time.Time
 
@@ -321,6 +402,13 @@ type projectCorruptionMsg struct {
 	projects []string // Names of corrupted projects
 }
 
+// claudeCodeTool is the LogReader tool name whose logs are rendered natively.
+const claudeCodeTool = "Claude Code"
+
+// aiderTool is the LogReader tool name whose sessions share one history file,
+// so they are read through the reader rather than from the file.
+const aiderTool = "Aider"
+
 // Story 12.1: Log session picker message types
 // logSessionsLoadedMsg signals sessions have been loaded for picker.
 type logSessionsLoadedMsg struct {
@@ -328,12 +416,24 @@ type logSessionsLoadedMsg struct {
 	err      error
 }
 
-// textViewContentMsg signals jq-formatted content is ready to display.
+// textViewContentMsg signals log content is ready to display.
 type textViewContentMsg struct {
 	title       string
 	content     string
-	sessionPath string // For live tailing (AC4)
-	fileSize    int64  // Initial file size for offset tracking
+	log         *logDocument       // Structured log rendered instead of content
+	jq          bool               // Content is jq output
+	sessionPath string             // For live tailing (AC4)
+	fileSize    int64              // Initial file size for offset tracking
+	session     *domain.LogSession // Set when content was read through the reader
+	err         error
+}
+
+// logSessionReadMsg carries a session re-read through its reader after its
+// file grew.
+type logSessionReadMsg struct {
+	sessionPath string
+	sessionID   string
+	content     string
 	err         error
 }
 
@@ -351,6 +451,7 @@ type logTailTickMsg time.Time
 // logNewEntriesMsg signals new entries were found during tailing.
 type logNewEntriesMsg struct {
 	newContent string
+	offset     int64 // File offset newContent was read from
 	newOffset  int64 // Updated file offset for next poll
 	err        error
 }
@@ -368,13 +469,20 @@ func NewModel(repo ports.ProjectRepository) Model {
 		statusBar:       components.NewStatusBarModel(0), // Width set in resizeTickMsg
 		detailLayout:    "horizontal",                    // Story 8.6: Default layout mode
 		maxContentWidth: defaults.MaxContentWidth,        // Story 8.10: Default from config
+		gitStatuses:     make(map[string]*domain.GitStatus),
+		usage:           make(map[string]*domain.ProjectUsage),
+		sortMode:        domain.SortByName,
+		groupBy:         domain.GroupByNone,
+		statsDays:       statsRanges[1],
+		keys:            DefaultKeyBindings(),
+		marked:          make(map[string]bool),
 	}
 }
 
-// SetDetectionService sets the detection service for refresh operations.
+// SetProjectRefresher sets the service that re-detects and saves projects on refresh.
 // This is optional - if not set, refresh will show "Detection service not available".
-func (m *Model) SetDetectionService(svc ports.Detector) {
-	m.detectionService = svc
+func (m *Model) SetProjectRefresher(refresher ports.ProjectRefresher) {
+	m.refresher = refresher
 }
 
 // SetWaitingDetector sets the waiting detector for WAITING indicators (Story 4.5).
@@ -415,6 +523,31 @@ func (m *Model) SetConfig(cfg *ports.Config) {
 	m.config = cfg
 	m.maxContentWidth = cfg.MaxContentWidth                  // Story 8.10
 	m.stageRefreshInterval = cfg.StageRefreshIntervalSeconds // Story 8.11
+
+	// Invalid values are reset by the loader; fall back to defaults regardless
+	if mode, err := domain.ParseSortMode(cfg.SortMode); err == nil {
+		m.sortMode = mode
+	}
+	if groupBy, err := domain.ParseGroupBy(cfg.GroupBy); err == nil {
+		m.groupBy = groupBy
+	}
+
+	m.keys, m.keyWarnings = KeyBindingsFromConfig(cfg.KeyBindings)
+	for _, w := range m.keyWarnings {
+		slog.Warn("invalid key binding", "warning", w)
+	}
+}
+
+// SetConfigLoader sets the loader used to save sort mode and grouping changes.
+// This is optional - if not set, changes last until the TUI exits.
+func (m *Model) SetConfigLoader(loader ports.ConfigLoader) {
+	m.configLoader = loader
+}
+
+// SetMetricsCollector sets the collector for progress metrics and the stats view.
+// This is optional - if not set, no metrics are sampled and 'S' does nothing.
+func (m *Model) SetMetricsCollector(collector ports.MetricsCollector) {
+	m.metricsCollector = collector
 }
 
 // SetHibernationService sets the hibernation service for auto-hibernation (Story 11.2).
@@ -435,6 +568,40 @@ func (m *Model) SetLogReaderRegistry(registry ports.LogReaderRegistry) {
 	m.logReaderRegistry = registry
 }
 
+// SetNotificationService sets the waiting notification dispatcher.
+// This is optional - if not set, no notifications are sent.
+func (m *Model) SetNotificationService(svc ports.NotificationService) {
+	m.notificationService = svc
+}
+
+// SetProjectScanner sets the scanner used to pick up new projects under
+// config workspace_roots. This is optional - if not set, no scans run.
+func (m *Model) SetProjectScanner(s ports.ProjectScanner) {
+	m.projectScanner = s
+}
+
+// SetDaemon attaches the TUI to a running daemon.
+// This is optional - if not set, the TUI does its own scanning.
+func (m *Model) SetDaemon(d ports.DaemonController) {
+	m.daemon = d
+}
+
+// SetGitInspector enables repository status in the detail panel and
+// counts new commits as project activity.
+// This is optional - if not set, no git information is shown.
+func (m *Model) SetGitInspector(g ports.GitInspector) {
+	m.gitInspector = g
+}
+
+// getGitStatus returns the last inspected git status for a project (nil if
+// unknown or not a repository). Used as the detail panel callback.
+func (m Model) getGitStatus(p *domain.Project) *domain.GitStatus {
+	if p == nil {
+		return nil
+	}
+	return m.gitStatuses[p.Path]
+}
+
 // isProjectWaiting wraps WaitingDetector.IsWaiting for component callbacks.
 // Uses context.Background() since Bubble Tea Render() doesn't provide ctx.
 // Story 4.5: Returns false if detector is nil.
@@ -502,9 +669,112 @@ func (m Model) Init() tea.Cmd {
 		m.checkAutoHibernationCmd(), // Story 11.2: Run FIRST before validation
 		m.validatePathsCmd(),
 		tickCmd(), // Start periodic timestamp refresh (Story 4.2, AC4)
+		m.scanWorkspacesCmd(),
+		m.workspaceScanTickCmd(),
+		m.collectMetricsCmd(),
+		m.metricsTickCmd(),
+		m.keyWarningCmd(),
 	)
 }
 
+// keyWarningCmd reports key binding problems from config in the status bar.
+// Returns nil if there are none.
+func (m Model) keyWarningCmd() tea.Cmd {
+	if len(m.keyWarnings) == 0 {
+		return nil
+	}
+	warning := "⚠ " + m.keyWarnings[0]
+	if n := len(m.keyWarnings) - 1; n > 0 {
+		warning += fmt.Sprintf(" (+%d more, see log)", n)
+	}
+	return func() tea.Msg {
+		return configWarningMsg{warning: warning}
+	}
+}
+
+// gitStatusCmd inspects the git repositories of all loaded projects.
+// Returns nil if no git inspector is set.
+func (m Model) gitStatusCmd() tea.Cmd {
+	if m.gitInspector == nil || len(m.projects) == 0 {
+		return nil
+	}
+	inspector := m.gitInspector
+	paths := make([]string, len(m.projects))
+	for i, p := range m.projects {
+		paths[i] = p.Path
+	}
+	return func() tea.Msg {
+		ctx := context.Background()
+		statuses := make(map[string]*domain.GitStatus, len(paths))
+		for _, path := range paths {
+			status, err := inspector.Inspect(ctx, path)
+			if err != nil {
+				slog.Debug("git inspection failed", "path", path, "error", err)
+			}
+			statuses[path] = status
+		}
+		return gitStatusMsg{statuses: statuses}
+	}
+}
+
+// handleGitStatus stores inspected statuses and records commits newer than
+// a project's last activity as activity (the file watcher ignores .git).
+// When attached to a daemon, the daemon records commit activity instead.
+func (m *Model) handleGitStatus(msg gitStatusMsg) {
+	for path, status := range msg.statuses {
+		if status == nil {
+			delete(m.gitStatuses, path)
+			continue
+		}
+		m.gitStatuses[path] = status
+	}
+
+	if m.daemon != nil {
+		return
+	}
+	now := time.Now()
+	for _, p := range m.projects {
+		status := m.gitStatuses[p.Path]
+		if status == nil || !status.LastCommitAt.After(p.LastActivityAt) || status.LastCommitAt.After(now) {
+			continue
+		}
+		m.handleFileEvent(fileEventMsg{Path: p.Path, Operation: ports.FileOpModify, Timestamp: status.LastCommitAt})
+	}
+}
+
+// workspaceRoots returns the configured workspace roots, or nil if scanning is disabled.
+func (m Model) workspaceRoots() []string {
+	if m.projectScanner == nil || m.config == nil {
+		return nil
+	}
+	return m.config.WorkspaceRoots
+}
+
+// scanWorkspacesCmd adds projects created under config workspace_roots.
+// Returns nil if no scanner or roots are configured.
+func (m Model) scanWorkspacesCmd() tea.Cmd {
+	roots := m.workspaceRoots()
+	if len(roots) == 0 {
+		return nil
+	}
+	scanner := m.projectScanner
+	return func() tea.Msg {
+		report, err := scanner.Scan(context.Background(), roots, ports.ScanOptions{MaxDepth: ports.DefaultScanDepth})
+		return workspaceScanCompleteMsg{added: report.Count(ports.ScanAdded), err: err}
+	}
+}
+
+// workspaceScanTickCmd schedules the next workspace scan.
+// Returns nil if no scanner or roots are configured.
+func (m Model) workspaceScanTickCmd() tea.Cmd {
+	if len(m.workspaceRoots()) == 0 {
+		return nil
+	}
+	return tea.Tick(workspaceScanInterval, func(t time.Time) tea.Msg {
+		return workspaceScanTickMsg(t)
+	})
+}
+
 // checkAutoHibernationCmd creates a command that checks for auto-hibernation (Story 11.2).
 // Returns nil if hibernation service is not set.
 func (m Model) checkAutoHibernationCmd() tea.Cmd {
@@ -517,6 +787,23 @@ func (m Model) checkAutoHibernationCmd() tea.Cmd {
 	}
 }
 
+// checkWaitingNotificationsCmd notifies for agents that started waiting.
+// Works on a snapshot so the check does not race with model updates.
+func (m Model) checkWaitingNotificationsCmd() tea.Cmd {
+	if m.notificationService == nil || len(m.projects) == 0 {
+		return nil
+	}
+	projects := make([]*domain.Project, len(m.projects))
+	for i, p := range m.projects {
+		snapshot := *p
+		projects[i] = &snapshot
+	}
+	return func() tea.Msg {
+		m.notificationService.CheckWaiting(context.Background(), projects)
+		return nil
+	}
+}
+
 // validatePathsCmd creates a command that validates all project paths.
 func (m Model) validatePathsCmd() tea.Cmd {
 	return func() tea.Msg {
@@ -648,6 +935,7 @@ func (m Model) pollLogEntriesCmd() tea.Cmd {
 
 		return logNewEntriesMsg{
 			newContent: string(newContent),
+			offset:     lastOffset,
 			newOffset:  info.Size(),
 		}
 	}
@@ -750,6 +1038,9 @@ func (m Model) startRefresh() (tea.Model, tea.Cmd) {
 
 // refreshProjectsCmd creates a command that rescans all projects (Story 3.6).
 func (m Model) refreshProjectsCmd() tea.Cmd {
+	if m.daemon != nil {
+		return m.daemonRefreshCmd()
+	}
 	return func() tea.Msg {
 		ctx := context.Background()
 		var refreshedCount, failedCount int
@@ -761,59 +1052,9 @@ func (m Model) refreshProjectsCmd() tea.Cmd {
 			default:
 			}
 
-			// Run detection with coexistence awareness (Story 14.5)
-			winner, allResults, err := m.detectionService.DetectWithCoexistenceSelection(ctx, project.Path)
+			currentProject, err := m.refresher.RefreshProject(ctx, project.ID, project.Path)
 			if err != nil {
-				slog.Debug("refresh detection failed", "project", project.Name, "error", err)
-				failedCount++
-				continue
-			}
-
-			// Reload project from DB to get current state (prevents overwriting CLI changes)
-			currentProject, findErr := m.repository.FindByID(ctx, project.ID)
-			if findErr != nil {
-				slog.Debug("refresh find failed", "project", project.Name, "error", findErr)
-				failedCount++
-				continue
-			}
-
-			// Determine primary result and populate coexistence fields (Story 14.5)
-			var primary *domain.DetectionResult
-			if winner != nil {
-				primary = winner
-				// Clear any previous coexistence warning
-				currentProject.CoexistenceWarning = false
-				currentProject.CoexistenceMessage = ""
-				currentProject.SecondaryMethod = ""
-				currentProject.SecondaryStage = domain.StageUnknown
-			} else if len(allResults) > 0 {
-				// Tie case - use first as primary (already sorted by most recent timestamp)
-				primary = allResults[0]
-				currentProject.CoexistenceWarning = primary.HasCoexistenceWarning()
-				currentProject.CoexistenceMessage = primary.CoexistenceMessage
-				if len(allResults) > 1 {
-					currentProject.SecondaryMethod = allResults[1].Method
-					currentProject.SecondaryStage = allResults[1].Stage
-				}
-			} else {
-				// No methodology detected - use unknown
-				unknownResult := domain.NewDetectionResult("unknown", domain.StageUnknown, domain.ConfidenceUncertain, "No methodology detected")
-				primary = &unknownResult
-				currentProject.CoexistenceWarning = false
-				currentProject.CoexistenceMessage = ""
-				currentProject.SecondaryMethod = ""
-				currentProject.SecondaryStage = domain.StageUnknown
-			}
-
-			// Update ONLY detection fields, preserve state/hibernation/favorites
-			currentProject.DetectedMethod = primary.Method
-			currentProject.CurrentStage = primary.Stage
-			currentProject.Confidence = primary.Confidence
-			currentProject.DetectionReasoning = primary.Reasoning
-			currentProject.UpdatedAt = time.Now()
-
-			if err := m.repository.Save(ctx, currentProject); err != nil {
-				slog.Debug("refresh save failed", "project", project.Name, "error", err)
+				slog.Debug("refresh failed", "project", project.Name, "error", err)
 				failedCount++
 				continue
 			}
@@ -833,6 +1074,20 @@ func (m Model) refreshProjectsCmd() tea.Cmd {
 	}
 }
 
+// daemonRefreshCmd asks the attached daemon to rescan instead of scanning here.
+func (m Model) daemonRefreshCmd() tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.daemon.Refresh(context.Background())
+		if err != nil {
+			return refreshCompleteMsg{err: fmt.Errorf("daemon refresh failed: %w", err)}
+		}
+		if result.Refreshed == 0 && result.Failed > 0 {
+			err = fmt.Errorf("all projects failed to refresh")
+		}
+		return refreshCompleteMsg{result.Refreshed, result.Failed, err}
+	}
+}
+
 // Update implements tea.Model. Handles messages and returns updated model.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -845,6 +1100,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.isConfirmingRemove {
 			return m.handleRemoveConfirmationKeyMsg(msg)
 		}
+		if m.showPalette {
+			return m.handlePaletteKeyMsg(msg)
+		}
 		// Route to validation handler when in validation mode
 		if m.viewMode == viewModeValidation {
 			return m.handleValidationKeyMsg(msg)
@@ -853,10 +1111,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.viewMode == viewModeTextView {
 			return m.handleTextViewKeyMsg(msg)
 		}
+		if m.viewMode == viewModeStats {
+			return m.handleStatsKeyMsg(msg)
+		}
+		if m.showLogSearch {
+			return m.handleLogSearchKeyMsg(msg)
+		}
 		// Story 12.1: Route to session picker handler when showing
 		if m.showSessionPicker {
 			return m.handleSessionPickerKeyMsg(msg)
 		}
+		if m.filterMode {
+			return m.handleFilterKeyMsg(msg)
+		}
 		return m.handleKeyMsg(msg)
 
 	case tea.WindowSizeMsg:
@@ -894,6 +1161,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Update status bar width with effective width (Story 3.4, 3.10)
 			m.statusBar.SetWidth(effectiveWidth)
 
+			// Re-wrap a structured log to the new width
+			if m.logDoc != nil {
+				m.rerenderLog()
+			}
+
 			// Calculate content height using helper (Story 3.10 AC5)
 			contentHeight := m.height - statusBarHeight(m.height)
 
@@ -908,12 +1180,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Create components with correct dimensions
 				m.projectList = components.NewProjectListModel(m.projects, effectiveWidth, contentHeight)
 				m.projectList.SetDelegateWaitingCallbacks(m.isProjectWaiting, m.getWaitingDuration)
+				m.projectList.SetDelegateAgentStateCallback(m.getAgentState)
+				m.projectList.SetMarked(m.marked)
+				m.applyListOrdering()
+				m.projectList.SetFilter(m.filterQuery)
 
 				m.detailPanel = components.NewDetailPanelModel(effectiveWidth, contentHeight)
 				m.detailPanel.SetProject(m.projectList.SelectedProject())
 				m.detailPanel.SetVisible(m.showDetailPanel)
 				m.detailPanel.SetWaitingCallbacks(m.isProjectWaiting, m.getWaitingDuration)
 				m.detailPanel.SetAgentStateCallback(m.getAgentState) // Story 15.7
+				m.detailPanel.SetGitStatusCallback(m.getGitStatus)
+				m.detailPanel.SetUsageCallback(m.getUsage)
 
 				// Update status bar counts
 				active, hibernated, waiting := components.CalculateCountsWithWaiting(m.projects, m.isProjectWaiting)
@@ -935,8 +1213,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Story 11.2: Start hourly hibernation timer (AC3)
 				hibernationCmd := m.hibernationTickCmd()
 
-				if watcherCmd != nil || stageCmd != nil || hibernationCmd != nil {
-					return m, tea.Batch(watcherCmd, stageCmd, hibernationCmd)
+				gitCmd := m.gitStatusCmd()
+				usageCmd := m.usageCmd()
+
+				if watcherCmd != nil || stageCmd != nil || hibernationCmd != nil || gitCmd != nil || usageCmd != nil {
+					return m, tea.Batch(watcherCmd, stageCmd, hibernationCmd, gitCmd, usageCmd)
 				}
 			}
 
@@ -1033,28 +1314,41 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			contentHeight := m.height - statusBarHeight(m.height)
 
-			// Hotfix: Preserve selection index across refresh
+			// Hotfix: Preserve selection across refresh. Sort modes such as
+			// recent activity may reorder projects, so prefer the same project.
 			prevIndex := m.projectList.Index()
+			var prevID string
+			if prev := m.projectList.SelectedProject(); prev != nil {
+				prevID = prev.ID
+			}
 
 			m.projectList = components.NewProjectListModel(m.projects, effectiveWidth, contentHeight)
 
+			// Story 4.5: Wire waiting callbacks to project list delegate
+			m.projectList.SetDelegateWaitingCallbacks(m.isProjectWaiting, m.getWaitingDuration)
+			m.projectList.SetDelegateAgentStateCallback(m.getAgentState)
+			m.projectList.SetMarked(m.marked)
+			m.applyListOrdering()
+
+			// Keep the search filter across reloads; it may hide some projects
+			m.projectList.SetFilter(m.filterQuery)
+
 			// Story 11.4: Select just-activated project (AC3)
 			if m.justActivatedProjectID != "" {
-				for i, p := range m.projects {
+				for i, p := range m.projectList.Projects() {
 					if p.ID == m.justActivatedProjectID {
 						m.projectList.SelectByIndex(i)
 						break
 					}
 				}
 				m.justActivatedProjectID = "" // Clear after use
-			} else if prevIndex >= 0 && prevIndex < len(m.projects) {
+			} else if i := indexOfProject(m.projectList.Projects(), prevID); i >= 0 {
+				m.projectList.SelectByIndex(i)
+			} else if prevIndex >= 0 && prevIndex < m.projectList.Len() {
 				// Restore selection (clamp to valid range)
 				m.projectList.SelectByIndex(prevIndex)
 			}
 
-			// Story 4.5: Wire waiting callbacks to project list delegate
-			m.projectList.SetDelegateWaitingCallbacks(m.isProjectWaiting, m.getWaitingDuration)
-
 			// Initialize detail panel with selected project
 			m.detailPanel = components.NewDetailPanelModel(effectiveWidth, contentHeight)
 			m.detailPanel.SetProject(m.projectList.SelectedProject())
@@ -1063,6 +1357,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Story 4.5: Wire waiting callbacks to detail panel
 			m.detailPanel.SetWaitingCallbacks(m.isProjectWaiting, m.getWaitingDuration)
 			m.detailPanel.SetAgentStateCallback(m.getAgentState) // Story 15.7
+			m.detailPanel.SetGitStatusCallback(m.getGitStatus)
+			m.detailPanel.SetUsageCallback(m.getUsage)
 
 			// Update status bar counts (Story 3.4, 4.5)
 			active, hibernated, waiting := components.CalculateCountsWithWaiting(m.projects, m.isProjectWaiting)
@@ -1077,8 +1373,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Story 11.2: Start hourly hibernation timer (AC3)
 			hibernationCmd := m.hibernationTickCmd()
 
-			if watcherCmd != nil || stageCmd != nil || hibernationCmd != nil {
-				return m, tea.Batch(watcherCmd, stageCmd, hibernationCmd)
+			gitCmd := m.gitStatusCmd()
+			usageCmd := m.usageCmd()
+
+			if watcherCmd != nil || stageCmd != nil || hibernationCmd != nil || gitCmd != nil || usageCmd != nil {
+				return m, tea.Batch(watcherCmd, stageCmd, hibernationCmd, gitCmd, usageCmd)
 			}
 		}
 		return m, nil
@@ -1216,7 +1515,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-		// Story 8.5: Re-sort list (triggers SortByName via SetProjects)
+		// Story 8.5: Re-sort list (SetProjects applies the active sort mode)
 		m.projectList.SetProjects(m.projects)
 
 		// Story 8.5: Restore selection by ID (project may have moved position)
@@ -1305,7 +1604,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Auto-cancel confirmation after 30 seconds (Story 3.9, AC4)
 		if m.isConfirmingRemove {
 			m.isConfirmingRemove = false
-			m.confirmTarget = nil
+			m.confirmTargets = nil
 		}
 		return m, nil
 
@@ -1324,9 +1623,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.statusBar.SetCounts(active, hibernated, waiting)
 		}
 
-		return m, tickCmd()
+		// Re-filter and re-sort so the "waiting" search token and waiting
+		// sort modes track state changes
+		if len(m.projects) > 0 {
+			m.projectList.RefreshWaiting()
+			m.detailPanel.SetProject(m.projectList.SelectedProject())
+		}
+
+		return m, tea.Batch(tickCmd(), m.checkWaitingNotificationsCmd())
 
 	case stageRefreshTickMsg:
+		// Attached to a daemon: it re-detects, we only pick up its writes
+		if m.daemon != nil {
+			return m, tea.Batch(m.loadProjectsCmd(), m.rescheduleStageTimer())
+		}
 		// Story 8.11: Periodic stage re-detection
 		// Skip if disabled, already refreshing, or no projects - but always reschedule
 		if m.stageRefreshInterval == 0 || m.isRefreshing || len(m.projects) == 0 {
@@ -1416,6 +1726,46 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Reload projects to update counts (silent - AC4)
 		return m, m.loadProjectsCmd()
 
+	case workspaceScanTickMsg:
+		return m, tea.Batch(m.scanWorkspacesCmd(), m.workspaceScanTickCmd())
+
+	case gitStatusMsg:
+		m.handleGitStatus(msg)
+		return m, nil
+
+	case usageMsg:
+		m.handleUsage(msg)
+		return m, nil
+
+	case metricsTickMsg:
+		return m, tea.Batch(m.collectMetricsCmd(), m.metricsTickCmd())
+
+	case metricsCollectedMsg:
+		if msg.err != nil {
+			slog.Warn("metrics collection failed", "error", msg.err)
+			return m, nil
+		}
+		// Keep an open stats view current
+		if m.viewMode == viewModeStats {
+			return m, m.loadStatsCmd()
+		}
+		return m, nil
+
+	case statsLoadedMsg:
+		m.handleStatsLoaded(msg)
+		return m, nil
+
+	case workspaceScanCompleteMsg:
+		if msg.err != nil {
+			slog.Warn("workspace scan failed", "error", msg.err)
+			return m, nil
+		}
+		if msg.added == 0 {
+			return m, nil
+		}
+		slog.Debug("workspace scan added projects", "count", msg.added)
+		return m, m.loadProjectsCmd()
+
 	case hibernationTickMsg:
 		// Story 11.2: Hourly auto-hibernation check (AC3)
 		if m.hibernationService == nil {
@@ -1444,6 +1794,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		contentHeight := m.height - statusBarHeight(m.height)
 		m.hibernatedList = components.NewProjectListModel(m.hibernatedProjects, effectiveWidth, contentHeight)
+		m.hibernatedList.SetMarked(m.marked)
 		// Update status bar with hibernated count for AC7
 		m.statusBar.SetHibernatedViewCount(len(m.hibernatedProjects))
 		return m, nil
@@ -1468,6 +1819,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.statusBar.SetInHibernatedView(false)
 		return m, m.loadProjectsCmd()
 
+	case bulkStepMsg:
+		return m.handleBulkStep(msg)
+
+	case projectRenamedMsg:
+		return m.handleProjectRenamed(msg)
+
 	case stateToggledMsg:
 		// Story 11.7: Handle state toggle result (AC3, AC4, AC5)
 		if msg.err != nil {
@@ -1535,8 +1892,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case logSearchResultsMsg:
+		return m.handleLogSearchResults(msg)
+
 	case textViewContentMsg:
 		// jq output is ready - display in text view
+		jump := m.pendingLogJump
+		m.pendingLogJump = nil
 		if msg.err != nil {
 			slog.Warn("failed to format log content", "error", msg.err)
 			return m, func() tea.Msg {
@@ -1545,11 +1907,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.viewMode = viewModeTextView
 		m.textViewTitle = msg.title
-		m.textViewContent = strings.Split(msg.content, "\n")
+		m.logDoc = msg.log
+		if m.logDoc != nil {
+			m.textViewContent = m.logDoc.render(m.logWidth())
+		} else {
+			m.textViewContent = strings.Split(msg.content, "\n")
+		}
 
 		// AC4: Store session path and offset for live tailing
 		m.currentSessionPath = msg.sessionPath
 		m.logLastOffset = msg.fileSize
+		m.logSession = msg.session
+		if jump != nil && msg.session != nil {
+			// Hit lines count the whole shared file; start at the
+			// session's first match
+			jump.line = 1
+		}
 
 		// AC3: Auto-scroll to latest by default
 		contentHeight := m.height - statusBarHeight(m.height) - 2
@@ -1559,11 +1932,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.textViewScroll = maxScroll // Start at bottom
 		m.logAutoScroll = true       // Enable auto-scroll
+		if jump != nil {
+			m.applyLogJump(*jump, msg.jq)
+		}
 
 		// AC4: Start live tailing (2s interval)
 		m.logTailActive = true
 		return m, m.logTailTickCmd()
 
+	case logEarlierLoadedMsg:
+		return m.handleLogEarlierLoaded(msg)
+
 	case logTailTickMsg:
 		// AC4: Poll for new log entries
 		if !m.logTailActive || m.viewMode != viewModeTextView || m.currentSessionPath == "" {
@@ -1581,13 +1960,32 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
-		// Update offset for next poll
-		m.logLastOffset = msg.newOffset
+		// Update offset for next poll. Loading later entries may have
+		// read past it already.
+		m.logLastOffset = max(m.logLastOffset, msg.newOffset)
 
 		if msg.newContent == "" {
 			return m, nil // No new content
 		}
 
+		// The file is shared with other sessions; read this one again
+		if m.logSession != nil {
+			return m, m.rereadLogSessionCmd(*m.logSession)
+		}
+
+		// Structured logs decode the new entries and lay out again. A window
+		// that ends before the tail (a search hit) skips them until later
+		// entries are loaded up to the tail.
+		if m.logDoc != nil {
+			m.logDoc.size = max(m.logDoc.size, msg.newOffset)
+			if msg.offset == m.logDoc.end {
+				m.logDoc.appendJSONL(msg.newContent)
+				m.logDoc.end = msg.newOffset
+			}
+			m.rerenderLog()
+			return m, nil
+		}
+
 		// Append new lines
 		newLines := strings.Split(msg.newContent, "\n")
 		m.textViewContent = append(m.textViewContent, newLines...)
@@ -1603,6 +2001,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case logSessionReadMsg:
+		if msg.err != nil {
+			slog.Debug("log session re-read failed", "error", msg.err)
+			return m, nil
+		}
+		if m.viewMode != viewModeTextView || m.logSession == nil ||
+			msg.sessionPath != m.logSession.Path || msg.sessionID != m.logSession.ID {
+			return m, nil
+		}
+		m.textViewContent = strings.Split(msg.content, "\n")
+		if m.logAutoScroll {
+			contentHeight := m.height - statusBarHeight(m.height) - 2
+			m.textViewScroll = max(len(m.textViewContent)-contentHeight, 0)
+		}
+		return m, nil
+
 	case flashMsg:
 		// AC8: Show flash message for no-logs case
 		m.flashMessage = msg.text
@@ -1673,6 +2087,9 @@ func (m Model) deleteProjectCmd(projectID string) tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
 		err := m.repository.Delete(ctx, projectID)
+		if err == nil {
+			m.deleteProjectStats(ctx, projectID)
+		}
 		return deleteProjectMsg{projectID: projectID, err: err}
 	}
 }
@@ -1704,8 +2121,7 @@ func (m Model) moveProjectCmd(project *domain.Project) tea.Cmd {
 		project.PathMissing = false
 		project.UpdatedAt = time.Now()
 
-		// NOTE: detectionService is optional until Epic 2 detection is wired.
-		// The Move action works without re-detection.
+		// NOTE: The Move action works without re-detection.
 
 		// Save to repository
 		if err := m.repository.Save(ctx, project); err != nil {
@@ -1736,6 +2152,11 @@ func (m Model) keepProjectCmd(project *domain.Project) tea.Cmd {
 
 // handleKeyMsg processes keyboard input.
 func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	msg, bound := m.keys.resolveKeyMsg(msg, scopeList)
+	if !bound {
+		return m, nil
+	}
+
 	// If help is showing, any key closes it (except '?' which toggles)
 	if m.showHelp {
 		if msg.String() == KeyHelp {
@@ -1747,7 +2168,16 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	return m.dispatchKey(msg)
+}
+
+// dispatchKey runs the project list action for a resolved key message, or
+// forwards the key to the list for navigation. The command palette calls it
+// with the action's default key.
+func (m Model) dispatchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
+	case KeyPalette:
+		return m.openPalette()
 	case KeyQuit:
 		// Story 4.6: Clean up file watcher on quit (AC10)
 		if m.watchCancel != nil {
@@ -1770,6 +2200,11 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.showHelp = !m.showHelp
 		return m, nil
 	case KeyEscape:
+		// Clear marks before leaving the view or clearing the filter
+		if len(m.markedProjects()) > 0 {
+			m.clearMarks()
+			return m, nil
+		}
 		// Story 11.4: Return from hibernated view (AC4)
 		if m.viewMode == viewModeHibernated {
 			m.viewMode = viewModeNormal
@@ -1780,10 +2215,14 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 		}
-		// No-op in normal mode
+		// Clear an applied search filter
+		if m.filterQuery != "" {
+			m.setProjectFilter("")
+		}
 		return m, nil
 	case KeyHibernated:
 		// Story 11.4: Toggle hibernated view (AC1, AC4)
+		m.clearMarks() // Marks belong to the view they were made in
 		if m.viewMode == viewModeHibernated {
 			// Return to active view
 			m.viewMode = viewModeNormal
@@ -1828,11 +2267,20 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.detailPanel.SetProject(m.hibernatedList.SelectedProject())
 		}
 		return m, nil
+	case KeySprint:
+		// The sprint tree is only drawn in the detail panel
+		if m.showDetailPanel {
+			m.detailPanel.SetSprintExpanded(!m.detailPanel.SprintExpanded())
+		}
+		return m, nil
 	case KeyRefresh:
-		if m.isRefreshing {
+		if m.isRefreshing || m.bulk.running() {
 			return m, nil // Ignore if already refreshing
 		}
-		if m.detectionService == nil {
+		if marked := m.markedProjects(); len(marked) > 0 {
+			return m.startBulkRedetect(marked)
+		}
+		if m.refresher == nil {
 			// No detection service - show message and return
 			m.refreshError = "Detection service not available"
 			return m, nil
@@ -1849,15 +2297,24 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.startNoteEditing()
 	case KeyFavorite:
 		// Story 3.8: Toggle favorite status for selected project
+		if m.bulk.running() {
+			return m, nil
+		}
+		if marked := m.markedProjects(); len(marked) > 0 {
+			return m.startBulk(bulkFavoriteAction(marked), marked)
+		}
 		if len(m.projects) == 0 {
 			return m, nil // No project to favorite
 		}
 		return m.toggleFavorite()
 	case KeyRemove:
 		// Story 3.9, 11.4 (AC5): Start remove confirmation for selected project
-		if m.isConfirmingRemove {
+		if m.isConfirmingRemove || m.bulk.running() {
 			return m, nil // Already confirming
 		}
+		if marked := m.markedProjects(); len(marked) > 0 {
+			return m.startBulkRemoveConfirmation(marked)
+		}
 		// Story 11.4: Allow removal in hibernated view
 		if m.viewMode == viewModeHibernated {
 			if len(m.hibernatedProjects) == 0 {
@@ -1872,6 +2329,12 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case KeyStateToggle:
 		// Story 11.7: Toggle project state with H key (AC1, AC2, AC7)
+		if m.bulk.running() {
+			return m, nil
+		}
+		if marked := m.markedProjects(); len(marked) > 0 {
+			return m.startBulkStateToggle(marked)
+		}
 		if m.viewMode == viewModeHibernated {
 			// In hibernated view: activate selected project (AC2)
 			if len(m.hibernatedProjects) == 0 {
@@ -1893,6 +2356,40 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, m.stateToggleCmd(selected.ID, project.EffectiveName(selected), true)
 
+	case KeyMark:
+		return m.toggleMark()
+
+	case KeyMarkRange:
+		return m.markRange()
+
+	case KeyMarkAll:
+		return m.markAll()
+
+	case KeySearch:
+		// Open the filter bar, editing any applied query
+		if m.viewMode == viewModeNormal && len(m.projects) > 0 {
+			m.filterMode = true
+		}
+		return m, nil
+
+	case KeySort:
+		if m.viewMode == viewModeNormal {
+			return m.cycleListOrdering(m.sortMode.Next(), m.groupBy)
+		}
+		return m, nil
+
+	case KeyGroup:
+		if m.viewMode == viewModeNormal {
+			return m.cycleListOrdering(m.sortMode, m.groupBy.Next())
+		}
+		return m, nil
+
+	case KeyStats:
+		if m.viewMode == viewModeNormal {
+			return m.openStatsView()
+		}
+		return m, nil
+
 	case KeyLogOpenView, "L":
 		// Story 12.2 AC1: 'L' key opens session picker from project list (case-insensitive)
 		if m.viewMode == viewModeNormal && len(m.projects) > 0 {
@@ -1922,6 +2419,124 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleFilterKeyMsg processes keyboard input while typing in the filter bar.
+// The list is filtered on every keystroke; Enter keeps the filter and returns
+// to navigation, Esc clears it.
+func (m Model) handleFilterKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.filterMode = false
+		if strings.TrimSpace(m.filterQuery) == "" {
+			m.setProjectFilter("")
+		}
+		return m, nil
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.filterMode = false
+		m.setProjectFilter("")
+		return m, nil
+	case tea.KeyBackspace:
+		if runes := []rune(m.filterQuery); len(runes) > 0 {
+			m.setProjectFilter(string(runes[:len(runes)-1]))
+		}
+		return m, nil
+	case tea.KeyUp, tea.KeyDown:
+		// Arrow keys move through matches without leaving the filter bar
+		var cmd tea.Cmd
+		m.projectList, cmd = m.projectList.Update(msg)
+		m.detailPanel.SetProject(m.projectList.SelectedProject())
+		return m, cmd
+	case tea.KeySpace:
+		m.setProjectFilter(m.filterQuery + " ")
+		return m, nil
+	case tea.KeyRunes:
+		m.setProjectFilter(m.filterQuery + string(msg.Runes))
+		return m, nil
+	}
+	return m, nil
+}
+
+// setProjectFilter applies a search query to the project list and keeps the
+// detail panel on the (possibly changed) selection.
+func (m *Model) setProjectFilter(query string) {
+	m.filterQuery = query
+	m.projectList.SetFilter(query)
+	m.detailPanel.SetProject(m.projectList.SelectedProject())
+}
+
+// applyListOrdering applies the sort mode, grouping and tag lookup to a
+// newly created project list.
+func (m *Model) applyListOrdering() {
+	m.projectList.SetTagLookup(m.projectTag)
+	m.projectList.SetOrdering(m.sortMode, m.groupBy)
+}
+
+// projectTag returns the user-defined tag for a project from config.
+func (m Model) projectTag(p *domain.Project) string {
+	if m.config == nil || p == nil {
+		return ""
+	}
+	return m.config.GetProjectTag(p.Path)
+}
+
+// indexOfProject returns the index of the project with the given ID, or -1.
+func indexOfProject(projects []*domain.Project, id string) int {
+	if id == "" {
+		return -1
+	}
+	for i, p := range projects {
+		if p.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// cycleListOrdering applies a new sort mode and grouping, shows it in the
+// status bar and saves it to config.
+func (m Model) cycleListOrdering(mode domain.SortMode, groupBy domain.GroupBy) (tea.Model, tea.Cmd) {
+	m.sortMode = mode
+	m.groupBy = groupBy
+	m.projectList.SetOrdering(mode, groupBy)
+	m.detailPanel.SetProject(m.projectList.SelectedProject())
+
+	text := fmt.Sprintf("Sort: %s · Group: %s", mode, groupBy)
+	return m, tea.Batch(
+		func() tea.Msg { return flashMsg{text: text} },
+		m.saveListOrderingCmd(mode, groupBy),
+	)
+}
+
+// saveListOrderingCmd saves sort mode and grouping to the user config.
+func (m Model) saveListOrderingCmd(mode domain.SortMode, groupBy domain.GroupBy) tea.Cmd {
+	return m.saveConfigCmd("sort mode", func(cfg *ports.Config) {
+		cfg.SortMode = string(mode)
+		cfg.GroupBy = string(groupBy)
+	})
+}
+
+// saveConfigCmd applies update to the user config and saves it. Loads first
+// so settings edited by hand since startup are not overwritten. what names
+// the setting in log messages.
+func (m Model) saveConfigCmd(what string, update func(cfg *ports.Config)) tea.Cmd {
+	if m.configLoader == nil {
+		return nil
+	}
+	loader := m.configLoader
+	return func() tea.Msg {
+		ctx := context.Background()
+		cfg, err := loader.Load(ctx)
+		if err != nil {
+			slog.Warn("failed to load config to save "+what, "error", err)
+			return nil
+		}
+		update(cfg)
+		if err := loader.Save(ctx, cfg); err != nil {
+			slog.Warn("failed to save "+what, "error", err)
+		}
+		return nil
+	}
+}
+
 // startNoteEditing opens the note editor for the selected project (Story 3.7).
 func (m Model) startNoteEditing() (tea.Model, tea.Cmd) {
 	selected := m.projectList.SelectedProject()
@@ -2045,10 +2660,13 @@ func (m Model) startRemoveConfirmation() (tea.Model, tea.Cmd) {
 	}
 
 	m.isConfirmingRemove = true
-	m.confirmTarget = selected
+	m.confirmTargets = []*domain.Project{selected}
+	return m, removeConfirmTimeoutCmd()
+}
 
-	// Start 30-second timeout timer (AC4)
-	return m, tea.Tick(30*time.Second, func(t time.Time) tea.Msg {
+// removeConfirmTimeoutCmd cancels the remove confirmation after 30 seconds (AC4).
+func removeConfirmTimeoutCmd() tea.Cmd {
+	return tea.Tick(30*time.Second, func(t time.Time) tea.Msg {
 		return removeConfirmTimeoutMsg{}
 	})
 }
@@ -2058,7 +2676,7 @@ func (m Model) handleRemoveConfirmationKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cm
 	// Handle Escape key by type (consistent with handleNoteEditingKeyMsg pattern)
 	if msg.Type == tea.KeyEsc {
 		m.isConfirmingRemove = false
-		m.confirmTarget = nil
+		m.confirmTargets = nil
 		return m, nil
 	}
 
@@ -2067,18 +2685,25 @@ func (m Model) handleRemoveConfirmationKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cm
 	case "y", "Y":
 		// Confirm removal
 		m.isConfirmingRemove = false
-		target := m.confirmTarget
-		m.confirmTarget = nil
+		targets := m.confirmTargets
+		m.confirmTargets = nil
+
+		if len(targets) > 1 {
+			return m.startBulk(bulkRemove, targets)
+		}
+		if len(targets) == 0 {
+			return m, nil
+		}
 
 		// Use shared EffectiveName for display (Story 3.9 code review fix)
-		projectName := project.EffectiveName(target)
+		projectName := project.EffectiveName(targets[0])
 
-		return m, m.removeProjectCmd(target.ID, projectName)
+		return m, m.removeProjectCmd(targets[0].ID, projectName)
 
 	case "n", "N":
 		// Cancel removal
 		m.isConfirmingRemove = false
-		m.confirmTarget = nil
+		m.confirmTargets = nil
 		return m, nil
 	}
 
@@ -2092,6 +2717,9 @@ func (m Model) removeProjectCmd(projectID, projectName string) tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
 		err := m.repository.Delete(ctx, projectID)
+		if err == nil {
+			m.deleteProjectStats(ctx, projectID)
+		}
 		return removeConfirmedMsg{projectID: projectID, projectName: projectName, err: err}
 	}
 }
@@ -2124,9 +2752,16 @@ func (m Model) View() string {
 	}
 
 	// Render remove confirmation dialog (overlays everything) (Story 3.9)
-	if m.isConfirmingRemove && m.confirmTarget != nil {
-		projectName := project.EffectiveName(m.confirmTarget)
-		return renderConfirmRemoveDialog(projectName, m.width, m.height)
+	if m.isConfirmingRemove && len(m.confirmTargets) > 0 {
+		names := make([]string, len(m.confirmTargets))
+		for i, p := range m.confirmTargets {
+			names[i] = project.EffectiveName(p)
+		}
+		return renderConfirmRemoveDialog(names, m.width, m.height)
+	}
+
+	if m.showPalette {
+		return m.renderPalette()
 	}
 
 	// Story 12.1: Render text view (full screen log display)
@@ -2134,6 +2769,23 @@ func (m Model) View() string {
 		return m.renderTextView()
 	}
 
+	if m.viewMode == viewModeStats {
+		return m.renderStatsView()
+	}
+
+	if m.showLogSearch {
+		effectiveWidth := m.width
+		if m.isWideWidth() {
+			effectiveWidth = m.maxContentWidth
+		}
+		contentHeight := m.height - statusBarHeight(m.height)
+		content := lipgloss.JoinVertical(lipgloss.Left, m.renderLogSearch(effectiveWidth, contentHeight), m.statusBar.View())
+		if m.isWideWidth() {
+			return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Top, content)
+		}
+		return content
+	}
+
 	// Story 12.1: Render session picker overlay
 	if m.showSessionPicker {
 		effectiveWidth := m.width
@@ -2193,6 +2845,12 @@ func (m Model) renderDashboard() string {
 		contentHeight-- // Reserve 1 more line for warning
 	}
 
+	// Reserve a line for the filter bar while searching or filtered
+	showFilterBar := m.filterMode || m.filterQuery != ""
+	if showFilterBar {
+		contentHeight--
+	}
+
 	// Create a copy with effective width for rendering
 	renderModel := m
 	renderModel.width = effectiveWidth
@@ -2201,6 +2859,10 @@ func (m Model) renderDashboard() string {
 
 	// Build output parts
 	var parts []string
+	if showFilterBar {
+		parts = append(parts, renderFilterBar(m.filterQuery, m.filterMode,
+			m.projectList.Len(), m.projectList.TotalLen(), effectiveWidth))
+	}
 	parts = append(parts, mainContent)
 
 	// Add narrow warning if applicable (Story 3.10 AC2)
@@ -2456,39 +3118,39 @@ func (m Model) handleEnterForLogs() (tea.Model, tea.Cmd) {
 		}
 	}
 
-	// Get a reader for this project
+	// Get every reader for this project (a project may use several tools)
 	ctx := context.Background()
-	reader := m.logReaderRegistry.GetReader(ctx, selected.Path)
-	if reader == nil {
+	readers := m.logReaderRegistry.GetReaders(ctx, selected.Path)
+	if len(readers) == 0 {
 		// AC8: No logs exist for this project - show flash message
 		return m, func() tea.Msg {
-			return flashMsg{text: "No Claude Code logs for this project"}
+			return flashMsg{text: "No agent logs for this project"}
 		}
 	}
 
-	// List sessions to find most recent
-	sessions, err := reader.ListSessions(ctx, selected.Path)
+	// List sessions across all tools to find most recent
+	sessions, err := listLogSessions(ctx, readers, selected.Path)
 	if err != nil || len(sessions) == 0 {
 		return m, func() tea.Msg {
 			return flashMsg{text: "No sessions found for this project"}
 		}
 	}
 
-	// Store project and reader for S key session picker (AC6)
+	// Store project and readers for S key session picker (AC6)
 	m.currentLogProject = selected
-	m.currentLogReader = reader
+	m.currentLogReaders = readers
 	m.logSessions = sessions
 
 	// Sessions are sorted newest-first, so first one is most recent
 	mostRecent := sessions[0]
-	return m, m.openLogWithJqCmd(mostRecent.Path, project.EffectiveName(selected))
+	return m, m.openLogCmd(mostRecent, project.EffectiveName(selected))
 }
 
 // handleShiftEnterForSessionPicker handles Shift+Enter to show session picker (AC6).
 // Can be called from normal view (Shift+Enter) or from text view (S key).
 func (m Model) handleShiftEnterForSessionPicker() (tea.Model, tea.Cmd) {
 	// If already in text view with a project, reuse current context
-	if m.currentLogProject != nil && m.currentLogReader != nil {
+	if m.currentLogProject != nil && len(m.currentLogReaders) > 0 {
 		m.showSessionPicker = true
 		m.sessionPickerIndex = 0
 		// Use cached sessions if available, otherwise reload
@@ -2515,18 +3177,18 @@ func (m Model) handleShiftEnterForSessionPicker() (tea.Model, tea.Cmd) {
 		}
 	}
 
-	// Get a reader for this project
+	// Get every reader for this project
 	ctx := context.Background()
-	reader := m.logReaderRegistry.GetReader(ctx, selected.Path)
-	if reader == nil {
+	readers := m.logReaderRegistry.GetReaders(ctx, selected.Path)
+	if len(readers) == 0 {
 		// AC8: No logs exist for this project - show flash message
 		return m, func() tea.Msg {
-			return flashMsg{text: "No Claude Code logs for this project"}
+			return flashMsg{text: "No agent logs for this project"}
 		}
 	}
 
 	// Store state for session picker
-	m.currentLogReader = reader
+	m.currentLogReaders = readers
 	m.currentLogProject = selected
 	m.showSessionPicker = true
 	m.sessionPickerIndex = 0
@@ -2536,10 +3198,36 @@ func (m Model) handleShiftEnterForSessionPicker() (tea.Model, tea.Cmd) {
 	return m, m.loadLogSessionsCmd(selected.Path)
 }
 
-// openLogWithJqCmd creates a command to format and display log content.
-// Pipeline priority: cclv (primary) -> jq (secondary) -> raw (fallback)
-func (m Model) openLogWithJqCmd(sessionPath, projectName string) tea.Cmd {
+// openLogCmd creates a command to format and display log content.
+// Claude Code logs are rendered natively (see logDocument), Aider sessions
+// are read through their reader, and other tools go through jq (Codex
+// JSONL) or fall back to raw.
+func (m Model) openLogCmd(session domain.LogSession, projectName string) tea.Cmd {
+	sessionPath := session.Path
+	jump := m.pendingLogJump
+	reader := m.logReaderFor(session.Tool)
 	return func() tea.Msg {
+		// Aider: all sessions share one history file, so show the
+		// session's own entries, titled by its ID
+		if session.Tool == aiderTool && reader != nil {
+			info, err := os.Stat(sessionPath)
+			if err != nil {
+				return textViewContentMsg{err: fmt.Errorf("failed to read log file: %w", err)}
+			}
+			content, err := readLogSessionContent(reader, session)
+			if err != nil {
+				return textViewContentMsg{err: fmt.Errorf("failed to read log file: %w", err)}
+			}
+			return textViewContentMsg{
+				title:       projectName + " - " + session.Tool + " " + session.ID,
+				content:     content,
+				jq:          true,
+				sessionPath: sessionPath,
+				fileSize:    info.Size(),
+				session:     &session,
+			}
+		}
+
 		// Extract session name from path for title
 		sessionName := filepath.Base(sessionPath)
 		if len(sessionName) > 40 {
@@ -2548,6 +3236,24 @@ func (m Model) openLogWithJqCmd(sessionPath, projectName string) tea.Cmd {
 		// Include project name in title: "ProjectName - session.jsonl"
 		title := projectName + " - " + sessionName
 
+		// Claude Code: decode the last entries, or those around a search
+		// hit, in-process (others load on request). Tailing continues from
+		// the end of the file.
+		if session.Tool == "" || session.Tool == claudeCodeTool {
+			doc, size, err := openLogWindow(sessionPath, jump)
+			if err != nil {
+				return textViewContentMsg{
+					err: fmt.Errorf("failed to read log file: %w", err),
+				}
+			}
+			return textViewContentMsg{
+				title:       title,
+				log:         doc,
+				sessionPath: sessionPath,
+				fileSize:    size,
+			}
+		}
+
 		// Get initial file size for offset tracking (AC4: live tailing)
 		info, statErr := os.Stat(sessionPath)
 		var fileSize int64
@@ -2555,26 +3261,14 @@ func (m Model) openLogWithJqCmd(sessionPath, projectName string) tea.Cmd {
 			fileSize = info.Size()
 		}
 
-		// Primary: Try cclv (Claude Code Log Viewer) first with color support for pipeline
-		cmd := exec.Command("cclv", "--color=always", sessionPath)
+		// Try jq for pretty-printing
+		cmd := exec.Command("jq", ".", sessionPath)
 		output, err := cmd.Output()
-		if err == nil {
-			return textViewContentMsg{
-				title:       title,
-				content:     string(output),
-				sessionPath: sessionPath,
-				fileSize:    fileSize,
-			}
-		}
-		slog.Debug("cclv not available, trying jq", "error", err)
-
-		// Secondary: Try jq for pretty-printing
-		cmd = exec.Command("jq", ".", sessionPath)
-		output, err = cmd.Output()
 		if err == nil {
 			return textViewContentMsg{
 				title:       title + " (jq)",
 				content:     string(output),
+				jq:          true,
 				sessionPath: sessionPath,
 				fileSize:    fileSize,
 			}
@@ -2598,24 +3292,104 @@ func (m Model) openLogWithJqCmd(sessionPath, projectName string) tea.Cmd {
 	}
 }
 
+// rereadLogSessionCmd reads a session through its reader again after its
+// file grew.
+func (m Model) rereadLogSessionCmd(session domain.LogSession) tea.Cmd {
+	reader := m.logReaderFor(session.Tool)
+	return func() tea.Msg {
+		if reader == nil {
+			return logSessionReadMsg{err: fmt.Errorf("no %s log reader", session.Tool)}
+		}
+		content, err := readLogSessionContent(reader, session)
+		return logSessionReadMsg{sessionPath: session.Path, sessionID: session.ID, content: content, err: err}
+	}
+}
+
+// logReaderFor returns the current project's reader for tool, or nil.
+func (m Model) logReaderFor(tool string) ports.LogReader {
+	for _, r := range m.currentLogReaders {
+		if r.Tool() == tool {
+			return r
+		}
+	}
+	return nil
+}
+
+// readLogSessionContent reads a session's entries and formats each one as
+// indented JSON, like jq output.
+func readLogSessionContent(reader ports.LogReader, session domain.LogSession) (string, error) {
+	entries, err := reader.ReadSession(context.Background(), session)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, e := range entries {
+		var buf bytes.Buffer
+		if json.Indent(&buf, e.RawJSON, "", "  ") != nil {
+			b.Write(e.RawJSON)
+		} else {
+			b.Write(buf.Bytes())
+		}
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
+
 // loadLogSessionsCmd creates a command to load available log sessions.
 func (m Model) loadLogSessionsCmd(projectPath string) tea.Cmd {
+	readers := m.currentLogReaders
 	return func() tea.Msg {
-		if m.currentLogReader == nil {
+		if len(readers) == 0 {
 			return logSessionsLoadedMsg{err: fmt.Errorf("no log reader available")}
 		}
 		ctx := context.Background()
-		sessions, err := m.currentLogReader.ListSessions(ctx, projectPath)
+		sessions, err := listLogSessions(ctx, readers, projectPath)
 		return logSessionsLoadedMsg{sessions: sessions, err: err}
 	}
 }
 
+// listLogSessions merges sessions from all readers, newest first.
+// A reader that fails is skipped; an error is returned only if every reader fails.
+func listLogSessions(ctx context.Context, readers []ports.LogReader, projectPath string) ([]domain.LogSession, error) {
+	var sessions []domain.LogSession
+	var lastErr error
+	failed := 0
+	for _, reader := range readers {
+		readerSessions, err := reader.ListSessions(ctx, projectPath)
+		if err != nil {
+			slog.Debug("failed to list log sessions", "tool", reader.Tool(), "error", err)
+			lastErr = err
+			failed++
+			continue
+		}
+		for _, s := range readerSessions {
+			if s.Tool == "" {
+				s.Tool = reader.Tool()
+			}
+			sessions = append(sessions, s)
+		}
+	}
+	if failed == len(readers) && lastErr != nil {
+		return nil, lastErr
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].StartTime.After(sessions[j].StartTime)
+	})
+	return sessions, nil
+}
+
 // handleSessionPickerKeyMsg handles keyboard input in session picker overlay.
 func (m Model) handleSessionPickerKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	msg, bound := m.keys.resolveKeyMsg(msg, scopeLog)
+	if !bound {
+		return m, nil
+	}
+
 	switch msg.String() {
 	case KeyEscape:
 		m.showSessionPicker = false
-		m.currentLogReader = nil
+		m.currentLogReaders = nil
 		m.currentLogProject = nil
 		return m, nil
 
@@ -2641,9 +3415,9 @@ func (m Model) handleSessionPickerKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				projectName = project.EffectiveName(m.currentLogProject)
 			}
 			m.showSessionPicker = false
-			m.currentLogReader = nil
+			m.currentLogReaders = nil
 			m.currentLogProject = nil
-			return m, m.openLogWithJqCmd(selected.Path, projectName)
+			return m, m.openLogCmd(selected, projectName)
 		}
 		return m, nil
 	}
@@ -2681,6 +3455,18 @@ func (m Model) renderSessionPicker(width, height int) string {
 		}
 	}
 
+	// Label rows by tool when sessions come from more than one tool
+	toolWidth := 0
+	multiTool := false
+	for _, session := range m.logSessions {
+		if session.Tool != m.logSessions[0].Tool {
+			multiTool = true
+		}
+		if w := lipgloss.Width(session.Tool); w > toolWidth {
+			toolWidth = w
+		}
+	}
+
 	var lines []string
 	lines = append(lines, "Select Session:")
 	lines = append(lines, "")
@@ -2700,8 +3486,14 @@ func (m Model) renderSessionPicker(width, height int) string {
 
 		timestamp := session.StartTime.Format("2006-01-02 15:04")
 		entryInfo := fmt.Sprintf("(%d entries)", session.EntryCount)
+		if cost := m.sessionCost(session); cost != "" {
+			entryInfo += "  " + cost
+		}
 
 		line := fmt.Sprintf("%s%s  %s  %s", prefix, displayID, timestamp, entryInfo)
+		if multiTool {
+			line = fmt.Sprintf("%s%-*s  %s  %s  %s", prefix, toolWidth, session.Tool, displayID, timestamp, entryInfo)
+		}
 		lines = append(lines, line)
 	}
 
@@ -2716,11 +3508,7 @@ func (m Model) renderSessionPicker(width, height int) string {
 	content := strings.Join(lines, "\n")
 
 	// Center the picker
-	box := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("39")).
-		Padding(1, 2).
-		Render(content)
+	box := popupStyle.Render(content)
 
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, box)
 }
@@ -2733,6 +3521,14 @@ func (m Model) handleTextViewKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		maxScroll = 0
 	}
 
+	// Search input reads raw keys
+	if !m.searchMode {
+		var bound bool
+		if msg, bound = m.keys.resolveKeyMsg(msg, scopeLog); !bound {
+			return m, nil
+		}
+	}
+
 	switch msg.String() {
 	case KeyEscape, KeyQuit:
 		// Story 12.2 AC3: If in search mode, exit search mode first
@@ -2749,11 +3545,13 @@ func (m Model) handleTextViewKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.textViewContent = nil
 		m.textViewTitle = ""
 		m.textViewScroll = 0
+		m.logDoc = nil
 		m.currentLogProject = nil
-		m.currentLogReader = nil
+		m.currentLogReaders = nil
 		m.logSessions = nil
 		m.logTailActive = false // Stop tailing
 		m.currentSessionPath = ""
+		m.logSession = nil
 		m.logAutoScroll = false
 		// Story 12.2 AC2: Reset double-key detection state
 		m.lastKeyPress = ""
@@ -2876,6 +3674,46 @@ func (m Model) handleTextViewKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.lastKeyPress = ""    // Reset gg detection
 		return m, nil
 
+	case KeyLogNextPrompt, KeyLogPrevPrompt: // Jump between user prompts
+		if m.searchMode {
+			m.searchInput += msg.String()
+			return m, nil
+		}
+		m.lastKeyPress = "" // Reset gg detection
+		return m.jumpToPrompt(msg.String() == KeyLogNextPrompt)
+
+	case KeyLogLoadEarlier, KeyLogLoadLater: // Decode the entries before/after those shown
+		if m.searchMode {
+			m.searchInput += msg.String()
+			return m, nil
+		}
+		m.lastKeyPress = "" // Reset gg detection
+		if m.logDoc == nil {
+			return m, nil
+		}
+		if msg.String() == KeyLogLoadLater {
+			if m.logDoc.end >= m.logDoc.size {
+				return m, func() tea.Msg {
+					return flashMsg{text: "End of session"}
+				}
+			}
+			return m, m.loadLaterLogCmd()
+		}
+		if m.logDoc.start == 0 {
+			return m, func() tea.Msg {
+				return flashMsg{text: "Start of session"}
+			}
+		}
+		return m, m.loadEarlierLogCmd()
+
+	case KeyLogToggleResult, KeyLogToggleResults: // Expand/collapse tool results
+		if m.searchMode {
+			m.searchInput += msg.String()
+			return m, nil
+		}
+		m.lastKeyPress = "" // Reset gg detection
+		return m.toggleToolResults(msg.String() == KeyLogToggleResults)
+
 	case KeyLogSession: // AC6: Open session picker from log view (S)
 		// If actively typing in search mode, add to input instead of action
 		if m.searchMode {
@@ -3029,6 +3867,7 @@ func (m Model) handleTextViewKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 // findMatches returns line numbers containing the search query (case-insensitive).
+// Styling is ignored, so structured logs match on their visible text.
 func (m Model) findMatches(query string) []int {
 	if query == "" {
 		return nil
@@ -3036,7 +3875,7 @@ func (m Model) findMatches(query string) []int {
 	lowerQuery := strings.ToLower(query)
 	var matches []int
 	for i, line := range m.textViewContent {
-		if strings.Contains(strings.ToLower(line), lowerQuery) {
+		if strings.Contains(strings.ToLower(stripANSI(line)), lowerQuery) {
 			matches = append(matches, i)
 		}
 	}
@@ -3122,10 +3961,7 @@ func (m Model) renderTextView() string {
 	contentHeight := m.height - statusBarHeight(m.height) - 2 // -2 for header/footer
 
 	// Header
-	header := lipgloss.NewStyle().
-		Bold(true).
-		Background(lipgloss.Color("236")).
-		Foreground(lipgloss.Color("255")).
+	header := headerStyle.
 		Width(effectiveWidth).
 		Render(" " + m.textViewTitle)
 
@@ -3196,12 +4032,12 @@ func (m Model) renderTextView() string {
 		// Have search results but not in input mode
 		matchCounter := fmt.Sprintf("%d/%d", m.searchIndex+1, len(m.searchMatches))
 		footerText = fmt.Sprintf(" [/] Search  [n/N] %s  [Esc] Clear  %d%%", matchCounter, scrollPercent)
+	} else if m.logDoc != nil {
+		footerText = fmt.Sprintf(" [j/k] Scroll  [[/]] Prompts  [c/C] Results  %s[/] Search  [Esc] Exit  %d%% ", m.logDoc.pagingHint(), scrollPercent)
 	} else {
 		footerText = fmt.Sprintf(" [j/k] Scroll  [gg/G] Top/Bottom  [/] Search  [Esc] Exit  %d%% ", scrollPercent)
 	}
-	footer := lipgloss.NewStyle().
-		Background(lipgloss.Color("236")).
-		Foreground(lipgloss.Color("244")).
+	footer := footerStyle.
 		Width(effectiveWidth).
 		Render(footerText)
 
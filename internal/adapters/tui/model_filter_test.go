@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// typeKeys sends each rune of s as a key press.
+func typeKeys(t *testing.T, m Model, s string) Model {
+	t.Helper()
+	for _, r := range s {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+		if r == ' ' {
+			msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+		}
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+	return m
+}
+
+func pressKey(t *testing.T, m Model, keyType tea.KeyType) Model {
+	t.Helper()
+	updated, _ := m.Update(tea.KeyMsg{Type: keyType})
+	return updated.(Model)
+}
+
+func TestModel_Filter_SlashEntersFilterMode(t *testing.T) {
+	m := createModelWithProjects(3)
+
+	m = typeKeys(t, m, "/")
+	if !m.filterMode {
+		t.Fatal("'/' should enter filter mode")
+	}
+
+	// Keys that are normally shortcuts are typed into the query
+	m = typeKeys(t, m, "qb")
+	if m.filterQuery != "qb" {
+		t.Errorf("filterQuery = %q, want %q", m.filterQuery, "qb")
+	}
+	if m.projectList.Len() != 0 {
+		t.Errorf("Len() = %d, want 0 matches for %q", m.projectList.Len(), "qb")
+	}
+}
+
+func TestModel_Filter_IncrementalAndBackspace(t *testing.T) {
+	m := createModelWithProjects(3) // a, b, c
+	m = typeKeys(t, m, "/b")
+	if m.projectList.Len() != 1 || m.projectList.SelectedProject().Name != "b" {
+		t.Fatalf("after typing 'b': Len = %d", m.projectList.Len())
+	}
+
+	m = pressKey(t, m, tea.KeyBackspace)
+	if m.filterQuery != "" || m.projectList.Len() != 3 {
+		t.Errorf("after backspace: query = %q, Len = %d", m.filterQuery, m.projectList.Len())
+	}
+}
+
+func TestModel_Filter_EnterKeepsFilterEscClears(t *testing.T) {
+	m := createModelWithProjects(3)
+	m = typeKeys(t, m, "/c")
+	m = pressKey(t, m, tea.KeyEnter)
+
+	if m.filterMode {
+		t.Error("Enter should leave filter mode")
+	}
+	if m.filterQuery != "c" || m.projectList.Len() != 1 {
+		t.Errorf("Enter should keep filter: query = %q, Len = %d", m.filterQuery, m.projectList.Len())
+	}
+
+	// Navigation keys work again once the filter is applied
+	m = typeKeys(t, m, "j")
+	if m.filterQuery != "c" {
+		t.Errorf("'j' after Enter changed query to %q", m.filterQuery)
+	}
+
+	m = pressKey(t, m, tea.KeyEsc)
+	if m.filterQuery != "" || m.projectList.Len() != 3 {
+		t.Errorf("Esc should clear filter: query = %q, Len = %d", m.filterQuery, m.projectList.Len())
+	}
+}
+
+func TestModel_Filter_EscWhileTypingClears(t *testing.T) {
+	m := createModelWithProjects(3)
+	m = typeKeys(t, m, "/a")
+	m = pressKey(t, m, tea.KeyEsc)
+
+	if m.filterMode || m.filterQuery != "" || m.projectList.Len() != 3 {
+		t.Errorf("Esc while typing: mode = %v, query = %q, Len = %d", m.filterMode, m.filterQuery, m.projectList.Len())
+	}
+}
+
+func TestModel_Filter_StructuredTokens(t *testing.T) {
+	m := createModelWithProjects(3)
+	m.projects[0].CurrentStage = domain.StageImplement
+	m.projects[0].DetectedMethod = "bmad"
+	m.projects[1].IsFavorite = true
+
+	m = typeKeys(t, m, "/stage:implement method:bmad")
+	if m.projectList.Len() != 1 || m.projectList.SelectedProject().Name != "a" {
+		t.Errorf("stage/method filter: Len = %d", m.projectList.Len())
+	}
+
+	m = pressKey(t, m, tea.KeyEsc)
+	m = typeKeys(t, m, "/fav")
+	if m.projectList.Len() != 1 || m.projectList.SelectedProject().Name != "b" {
+		t.Errorf("fav filter: Len = %d", m.projectList.Len())
+	}
+}
+
+func TestModel_Filter_PersistsAcrossReload(t *testing.T) {
+	m := createModelWithProjects(3)
+	m = typeKeys(t, m, "/b")
+	m = pressKey(t, m, tea.KeyEnter)
+
+	reloaded := []*domain.Project{
+		{ID: "a", Name: "a", Path: "/path/a"},
+		{ID: "b", Name: "b", Path: "/path/b"},
+		{ID: "bb", Name: "bb", Path: "/path/bb"},
+	}
+	updated, _ := m.Update(ProjectsLoadedMsg{projects: reloaded})
+	m = updated.(Model)
+
+	if m.filterQuery != "b" {
+		t.Errorf("filterQuery = %q after reload, want %q", m.filterQuery, "b")
+	}
+	if m.projectList.Len() != 2 || m.projectList.TotalLen() != 3 {
+		t.Errorf("Len/TotalLen = %d/%d after reload, want 2/3", m.projectList.Len(), m.projectList.TotalLen())
+	}
+}
+
+func TestModel_Filter_BarRendered(t *testing.T) {
+	m := createModelWithProjects(3)
+	m.height = 40
+	m = typeKeys(t, m, "/b")
+
+	view := m.View()
+	if !strings.Contains(view, "/b") || !strings.Contains(view, "1/3") {
+		t.Errorf("filter bar missing from view:\n%s", view)
+	}
+
+	m = pressKey(t, m, tea.KeyEsc)
+	if strings.Contains(m.View(), "1/3") {
+		t.Error("filter bar still shown after clearing filter")
+	}
+}
@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/tui/components"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// stubGitInspector returns statuses keyed by path; unknown paths are not repositories.
+type stubGitInspector struct {
+	statuses map[string]*domain.GitStatus
+}
+
+func (s *stubGitInspector) Inspect(_ context.Context, path string) (*domain.GitStatus, error) {
+	return s.statuses[path], nil
+}
+
+func newGitTestModel(projects ...*domain.Project) Model {
+	m := NewModel(&refreshMockRepository{projects: projects})
+	m.ready = true
+	m.width, m.height = 80, 40
+	m.projects = projects
+	m.projectList = components.NewProjectListModel(projects, m.width, m.height)
+	m.detailPanel = components.NewDetailPanelModel(m.width, m.height)
+	m.statusBar = components.NewStatusBarModel(m.width)
+	return m
+}
+
+func TestModel_GitStatusCmd_NilWithoutInspector(t *testing.T) {
+	m := newGitTestModel(&domain.Project{ID: "a", Path: "/p/a"})
+	if m.gitStatusCmd() != nil {
+		t.Error("expected nil cmd without git inspector")
+	}
+}
+
+func TestModel_GitStatusMsg_StoresStatuses(t *testing.T) {
+	lastActivity := time.Now()
+	repoProject := &domain.Project{ID: "a", Path: "/p/a", LastActivityAt: lastActivity}
+	plainProject := &domain.Project{ID: "b", Path: "/p/b", LastActivityAt: lastActivity}
+	m := newGitTestModel(repoProject, plainProject)
+	m.gitStatuses[plainProject.Path] = &domain.GitStatus{Branch: "stale"}
+	m.SetGitInspector(&stubGitInspector{statuses: map[string]*domain.GitStatus{
+		repoProject.Path: {Branch: "main", LastCommitAt: lastActivity.Add(-time.Hour)},
+	}})
+
+	cmd := m.gitStatusCmd()
+	if cmd == nil {
+		t.Fatal("expected git status cmd")
+	}
+	msg, ok := cmd().(gitStatusMsg)
+	if !ok {
+		t.Fatalf("expected gitStatusMsg, got %T", msg)
+	}
+	newModel, _ := m.Update(msg)
+	updated := newModel.(Model)
+
+	if s := updated.getGitStatus(repoProject); s == nil || s.Branch != "main" {
+		t.Errorf("getGitStatus = %+v, want branch main", s)
+	}
+	if s := updated.getGitStatus(plainProject); s != nil {
+		t.Errorf("non-repository should have no status, got %+v", s)
+	}
+	// Older commit is not activity
+	if !repoProject.LastActivityAt.Equal(lastActivity) {
+		t.Errorf("LastActivityAt changed to %v", repoProject.LastActivityAt)
+	}
+}
+
+func TestModel_GitStatusMsg_NewCommitIsActivity(t *testing.T) {
+	old := time.Now().Add(-2 * time.Hour)
+	committed := time.Now().Add(-time.Minute)
+	project := &domain.Project{ID: "a", Path: "/p/a", LastActivityAt: old}
+	msg := gitStatusMsg{statuses: map[string]*domain.GitStatus{
+		project.Path: {Branch: "main", LastCommitAt: committed},
+	}}
+
+	// Attached to a daemon: the daemon records commit activity
+	m := newGitTestModel(project)
+	m.SetDaemon(&fakeDaemonController{})
+	m.handleGitStatus(msg)
+	if !project.LastActivityAt.Equal(old) {
+		t.Errorf("attached TUI should not write activity, got %v", project.LastActivityAt)
+	}
+
+	m = newGitTestModel(project)
+	m.handleGitStatus(msg)
+	if !project.LastActivityAt.Equal(committed) {
+		t.Errorf("LastActivityAt = %v, want commit time %v", project.LastActivityAt, committed)
+	}
+}
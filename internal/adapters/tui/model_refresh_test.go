@@ -12,6 +12,7 @@ import (
 	"github.com/JeiKeiLim/vibe-dash/internal/adapters/tui/components"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/services"
 )
 
 // ============================================================================
@@ -39,6 +40,12 @@ func (m *refreshMockDetector) DetectWithCoexistenceSelection(ctx context.Context
 	return result, nil, err
 }
 
+// useDetector wires the shared refresh service over the model's repository
+// and detector, as the CLI does.
+func useDetector(m *Model, detector ports.Detector) {
+	m.SetProjectRefresher(services.NewRefreshService(m.repository, detector))
+}
+
 // refreshMockRepository implements ports.ProjectRepository for testing.
 type refreshMockRepository struct {
 	projects  []*domain.Project
@@ -145,7 +152,7 @@ func TestModel_RefreshKey_StartsRefresh(t *testing.T) {
 	m.projects = repo.projects
 	m.projectList = components.NewProjectListModel(m.projects, m.width, m.height)
 	m.statusBar = components.NewStatusBarModel(m.width)
-	m.SetDetectionService(&refreshMockDetector{
+	useDetector(&m, &refreshMockDetector{
 		detectFunc: func(ctx context.Context, path string) (*domain.DetectionResult, error) {
 			return &domain.DetectionResult{Method: "bmad", Stage: domain.StagePlan}, nil
 		},
@@ -179,7 +186,7 @@ func TestModel_RefreshKey_DisabledWithoutDetectionService(t *testing.T) {
 	m.height = 40
 	m.projects = repo.projects
 	m.projectList = components.NewProjectListModel(m.projects, m.width, m.height)
-	// detectionService is nil
+	// refresher is nil
 
 	// Press 'r' without detection service
 	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}}
@@ -209,7 +216,7 @@ func TestModel_RefreshKey_IgnoredWhenRefreshing(t *testing.T) {
 	m.height = 40
 	m.projects = repo.projects
 	m.isRefreshing = true // Already refreshing
-	m.SetDetectionService(&refreshMockDetector{})
+	useDetector(&m, &refreshMockDetector{})
 
 	// Press 'r' while already refreshing
 	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}}
@@ -377,22 +384,19 @@ func TestModel_ClearRefreshMsgMsg(t *testing.T) {
 	}
 }
 
-// TestModel_SetDetectionService verifies setter works correctly.
-func TestModel_SetDetectionService(t *testing.T) {
+// TestModel_SetProjectRefresher verifies setter works correctly.
+func TestModel_SetProjectRefresher(t *testing.T) {
 	m := NewModel(nil)
 
-	if m.detectionService != nil {
-		t.Error("expected detectionService to be nil initially")
+	if m.refresher != nil {
+		t.Error("expected refresher to be nil initially")
 	}
 
-	detector := &refreshMockDetector{}
-	m.SetDetectionService(detector)
+	refresher := services.NewRefreshService(&refreshMockRepository{}, &refreshMockDetector{})
+	m.SetProjectRefresher(refresher)
 
-	if m.detectionService == nil {
-		t.Error("expected detectionService to be set")
-	}
-	if m.detectionService != detector {
-		t.Error("expected detectionService to be the provided detector")
+	if m.refresher != refresher {
+		t.Error("expected refresher to be the provided service")
 	}
 }
 
@@ -683,7 +687,7 @@ func TestModel_RefreshWithCoexistence_ClearWinner(t *testing.T) {
 	m.projects = repo.projects
 	m.projectList = components.NewProjectListModel(m.projects, m.width, m.height)
 	m.statusBar = components.NewStatusBarModel(m.width)
-	m.SetDetectionService(&coexistenceMockDetector{
+	useDetector(&m, &coexistenceMockDetector{
 		detectWithCoexistenceFunc: func(ctx context.Context, path string) (*domain.DetectionResult, []*domain.DetectionResult, error) {
 			// Return clear winner (no coexistence)
 			winner := &domain.DetectionResult{Method: "speckit", Stage: domain.StagePlan}
@@ -728,7 +732,7 @@ func TestModel_RefreshWithCoexistence_TieCase(t *testing.T) {
 	m.projects = repo.projects
 	m.projectList = components.NewProjectListModel(m.projects, m.width, m.height)
 	m.statusBar = components.NewStatusBarModel(m.width)
-	m.SetDetectionService(&coexistenceMockDetector{
+	useDetector(&m, &coexistenceMockDetector{
 		detectWithCoexistenceFunc: func(ctx context.Context, path string) (*domain.DetectionResult, []*domain.DetectionResult, error) {
 			// Return tie (nil winner, multiple results with coexistence warning)
 			result1 := domain.NewDetectionResult("speckit", domain.StagePlan, domain.ConfidenceCertain, "plan.md found")
@@ -763,3 +767,92 @@ func TestModel_RefreshWithCoexistence_TieCase(t *testing.T) {
 		t.Errorf("expected SecondaryStage to be StageTasks, got: %s", m.projects[0].SecondaryStage)
 	}
 }
+
+// ============================================================================
+// Attached daemon: refresh is forwarded instead of scanning locally
+// ============================================================================
+
+// fakeDaemonController records refresh requests.
+type fakeDaemonController struct {
+	result    ports.RefreshResult
+	err       error
+	refreshes int
+}
+
+func (f *fakeDaemonController) Status(context.Context) (ports.DaemonStatus, error) {
+	return ports.DaemonStatus{}, nil
+}
+
+func (f *fakeDaemonController) Refresh(context.Context) (ports.RefreshResult, error) {
+	f.refreshes++
+	return f.result, f.err
+}
+
+func TestModel_RefreshWithDaemon_ForwardsToDaemon(t *testing.T) {
+	repo := &refreshMockRepository{
+		projects: []*domain.Project{{ID: "1", Path: "/test1", Name: "test1"}},
+	}
+	detector := &refreshMockDetector{
+		detectFunc: func(ctx context.Context, path string) (*domain.DetectionResult, error) {
+			t.Error("local detection must not run while attached to a daemon")
+			return nil, errors.New("unexpected")
+		},
+	}
+	daemon := &fakeDaemonController{result: ports.RefreshResult{Refreshed: 3, Failed: 1}}
+
+	m := NewModel(repo)
+	m.projects = repo.projects
+	useDetector(&m, detector)
+	m.SetDaemon(daemon)
+
+	msg := m.refreshProjectsCmd()()
+	complete, ok := msg.(refreshCompleteMsg)
+	if !ok {
+		t.Fatalf("expected refreshCompleteMsg, got %T", msg)
+	}
+	if complete.refreshedCount != 3 || complete.failedCount != 1 || complete.err != nil {
+		t.Errorf("unexpected result: %+v", complete)
+	}
+	if daemon.refreshes != 1 {
+		t.Errorf("daemon refreshes = %d, want 1", daemon.refreshes)
+	}
+}
+
+func TestModel_RefreshWithDaemon_Error(t *testing.T) {
+	m := NewModel(&refreshMockRepository{})
+	m.SetDaemon(&fakeDaemonController{err: errors.New("socket closed")})
+
+	complete, ok := m.refreshProjectsCmd()().(refreshCompleteMsg)
+	if !ok {
+		t.Fatal("expected refreshCompleteMsg")
+	}
+	if complete.err == nil || !strings.Contains(complete.err.Error(), "daemon refresh failed") {
+		t.Errorf("expected daemon refresh error, got %v", complete.err)
+	}
+}
+
+func TestModel_StageRefreshTick_WithDaemon_ReloadsOnly(t *testing.T) {
+	repo := &refreshMockRepository{
+		projects: []*domain.Project{{ID: "1", Path: "/test1", Name: "test1", State: domain.StateActive}},
+	}
+	daemon := &fakeDaemonController{}
+
+	m := NewModel(repo)
+	m.ready = true
+	m.width = 80
+	m.height = 40
+	m.projects = repo.projects
+	m.stageRefreshInterval = 30
+	m.SetDaemon(daemon)
+
+	newModel, cmd := m.Update(stageRefreshTickMsg(time.Now()))
+	if newModel.(Model).isRefreshing {
+		t.Error("stage tick must not start a local refresh while attached")
+	}
+	if cmd == nil {
+		t.Fatal("expected reload and reschedule commands")
+	}
+	if daemon.refreshes != 0 {
+		t.Errorf("daemon refreshes = %d, want 0 (daemon re-detects on its own schedule)", daemon.refreshes)
+	}
+}
@@ -41,8 +41,8 @@ func TestModel_RemoveKey_StartsConfirmation(t *testing.T) {
 	if !updated.isConfirmingRemove {
 		t.Error("expected isConfirmingRemove to be true")
 	}
-	if updated.confirmTarget == nil {
-		t.Error("expected confirmTarget to be set")
+	if len(updated.confirmTargets) == 0 {
+		t.Error("expected confirmTargets to be set")
 	}
 	// Assert: Timeout command returned
 	if cmd == nil {
@@ -86,7 +86,7 @@ func TestModel_RemoveConfirmation_ConfirmsWithY(t *testing.T) {
 	m.projectList = components.NewProjectListModel(repo.projects, 80, 24)
 	m.repository = repo
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Send 'y' key
 	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
@@ -114,7 +114,7 @@ func TestModel_RemoveConfirmation_CancelsWithN(t *testing.T) {
 	m.height = 40
 	m.projects = repo.projects
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Send 'n' key
 	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
@@ -141,7 +141,7 @@ func TestModel_RemoveConfirmation_CancelsWithEsc(t *testing.T) {
 	m.height = 40
 	m.projects = repo.projects
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Send Esc key
 	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
@@ -165,7 +165,7 @@ func TestModel_RemoveConfirmation_IgnoresOtherKeys(t *testing.T) {
 	m.height = 40
 	m.projects = repo.projects
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Send various other keys
 	otherKeys := []string{"q", "j", "k", "d", "f", "r", "a"}
@@ -192,7 +192,7 @@ func TestModel_RemoveConfirmation_TimeoutCancels(t *testing.T) {
 	m.height = 40
 	m.projects = repo.projects
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Send timeout message
 	newModel, _ := m.Update(removeConfirmTimeoutMsg{})
@@ -202,8 +202,8 @@ func TestModel_RemoveConfirmation_TimeoutCancels(t *testing.T) {
 	if updated.isConfirmingRemove {
 		t.Error("expected isConfirmingRemove to be false after timeout")
 	}
-	if updated.confirmTarget != nil {
-		t.Error("expected confirmTarget to be nil after timeout")
+	if len(updated.confirmTargets) != 0 {
+		t.Error("expected confirmTargets to be nil after timeout")
 	}
 }
 
@@ -314,12 +314,12 @@ func TestModel_RemoveKey_UsesDisplayNameInConfirmation(t *testing.T) {
 	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	updated := newModel.(Model)
 
-	// Assert: confirmTarget has display name accessible
-	if updated.confirmTarget == nil {
-		t.Fatal("expected confirmTarget to be set")
+	// Assert: confirmTargets has display name accessible
+	if len(updated.confirmTargets) == 0 {
+		t.Fatal("expected confirmTargets to be set")
 	}
-	if updated.confirmTarget.DisplayName != "My Custom Name" {
-		t.Errorf("expected DisplayName 'My Custom Name', got '%s'", updated.confirmTarget.DisplayName)
+	if updated.confirmTargets[0].DisplayName != "My Custom Name" {
+		t.Errorf("expected DisplayName 'My Custom Name', got '%s'", updated.confirmTargets[0].DisplayName)
 	}
 }
 
@@ -337,7 +337,7 @@ func TestModel_RemoveKey_IgnoredWhenAlreadyConfirming(t *testing.T) {
 	m.projectList = components.NewProjectListModel(repo.projects, 80, 24)
 	m.repository = repo
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Send 'x' key again
 	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
@@ -361,7 +361,7 @@ func TestModel_RemoveConfirmTimeoutMsg_IgnoredWhenNotConfirming(t *testing.T) {
 	m.width = 80
 	m.height = 40
 	m.isConfirmingRemove = false
-	m.confirmTarget = nil
+	m.confirmTargets = nil
 
 	// Action: Send timeout message
 	newModel, cmd := m.Update(removeConfirmTimeoutMsg{})
@@ -582,7 +582,7 @@ func TestModel_View_RendersConfirmationDialog(t *testing.T) {
 	m.statusBar = components.NewStatusBarModel(80)
 	m.detailPanel = components.NewDetailPanelModel(80, 24)
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Call View()
 	view := m.View()
@@ -619,7 +619,7 @@ func TestModel_View_RendersConfirmationDialog_WithDisplayName(t *testing.T) {
 	m.statusBar = components.NewStatusBarModel(80)
 	m.detailPanel = components.NewDetailPanelModel(80, 24)
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Call View()
 	view := m.View()
@@ -644,7 +644,7 @@ func TestModel_RemoveConfirmation_ConfirmsWithUppercaseY(t *testing.T) {
 	m.projectList = components.NewProjectListModel(repo.projects, 80, 24)
 	m.repository = repo
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Send uppercase 'Y' key
 	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
@@ -672,7 +672,7 @@ func TestModel_RemoveConfirmation_CancelsWithUppercaseN(t *testing.T) {
 	m.height = 40
 	m.projects = repo.projects
 	m.isConfirmingRemove = true
-	m.confirmTarget = project
+	m.confirmTargets = []*domain.Project{project}
 
 	// Action: Send uppercase 'N' key
 	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'N'}})
@@ -0,0 +1,130 @@
+package tui
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// memConfigLoader is an in-memory ports.ConfigLoader.
+type memConfigLoader struct {
+	cfg   *ports.Config
+	saves int
+}
+
+func (l *memConfigLoader) Load(context.Context) (*ports.Config, error) {
+	cp := *l.cfg
+	return &cp, nil
+}
+
+func (l *memConfigLoader) Save(_ context.Context, cfg *ports.Config) error {
+	l.cfg = cfg
+	l.saves++
+	return nil
+}
+
+// runCmd executes cmd and any batched commands, discarding tick commands'
+// messages. Used to run the async config save.
+func runCmd(cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+	select {
+	case msg := <-done:
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for _, c := range batch {
+				runCmd(c)
+			}
+		}
+	case <-time.After(100 * time.Millisecond):
+		// Timer-based command (e.g. flash clear); not needed
+	}
+}
+
+func TestModel_SortKeyCyclesAndSaves(t *testing.T) {
+	m := createModelWithProjects(3)
+	m.projects[0].LastActivityAt = time.Now().Add(-time.Hour)
+	m.projects[2].LastActivityAt = time.Now()
+	loader := &memConfigLoader{cfg: ports.NewConfig()}
+	m.SetConfigLoader(loader)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	m = updated.(Model)
+	if m.sortMode != domain.SortByWaiting || m.projectList.SortMode() != domain.SortByWaiting {
+		t.Fatalf("sortMode = %q, list = %q, want waiting", m.sortMode, m.projectList.SortMode())
+	}
+	runCmd(cmd)
+	if loader.saves != 1 || loader.cfg.SortMode != "waiting" {
+		t.Errorf("saves = %d, saved sort_mode = %q, want 1 and waiting", loader.saves, loader.cfg.SortMode)
+	}
+
+	// waiting -> recent: most recently active project first
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	m = updated.(Model)
+	if m.sortMode != domain.SortByRecent {
+		t.Fatalf("sortMode = %q, want recent", m.sortMode)
+	}
+	if got := m.projectList.Projects()[0].Name; got != "c" {
+		t.Errorf("first project = %q, want c", got)
+	}
+}
+
+func TestModel_GroupKeyCycles(t *testing.T) {
+	m := createModelWithProjects(2)
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	m = updated.(Model)
+	if m.groupBy != domain.GroupByMethod || m.projectList.GroupBy() != domain.GroupByMethod {
+		t.Errorf("groupBy = %q, want method", m.groupBy)
+	}
+	if m.projectList.Len() != 2 {
+		t.Errorf("Len() = %d, want 2 (headers not counted)", m.projectList.Len())
+	}
+}
+
+func TestModel_SetConfig_SortAndGroup(t *testing.T) {
+	cfg := ports.NewConfig()
+	cfg.SortMode = "stage"
+	cfg.GroupBy = "tag"
+
+	m := NewModel(nil)
+	m.SetConfig(cfg)
+	if m.sortMode != domain.SortByStage || m.groupBy != domain.GroupByTag {
+		t.Errorf("sortMode/groupBy = %q/%q, want stage/tag", m.sortMode, m.groupBy)
+	}
+
+	cfg.SortMode = "bogus"
+	m = NewModel(nil)
+	m.SetConfig(cfg)
+	if m.sortMode != domain.SortByName {
+		t.Errorf("invalid sort_mode: sortMode = %q, want name", m.sortMode)
+	}
+}
+
+func TestModel_SortPreservesSelectionAcrossReload(t *testing.T) {
+	m := createModelWithProjects(3)
+	m.sortMode = domain.SortByRecent
+	m.projectList.SetOrdering(domain.SortByRecent, domain.GroupByNone)
+	m.projectList.SelectByIndex(0) // a; moves to the end after reload
+	selected := m.projectList.SelectedProject().ID
+
+	now := time.Now()
+	reloaded := []*domain.Project{
+		{ID: "a", Name: "a", Path: "/path/a", LastActivityAt: now.Add(-2 * time.Hour)},
+		{ID: "b", Name: "b", Path: "/path/b", LastActivityAt: now.Add(-time.Hour)},
+		{ID: "c", Name: "c", Path: "/path/c", LastActivityAt: now},
+	}
+	updated, _ := m.Update(ProjectsLoadedMsg{projects: reloaded})
+	m = updated.(Model)
+
+	if got := m.projectList.SelectedProject().ID; got != selected {
+		t.Errorf("selected %q after reload, want %q", got, selected)
+	}
+}
@@ -2,7 +2,10 @@ package tui
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -275,19 +278,19 @@ func TestModel_View_HelpOverlay(t *testing.T) {
 func TestDefaultKeyBindings(t *testing.T) {
 	kb := DefaultKeyBindings()
 
-	if kb.Quit != "q" {
+	if kb.Quit.String() != "q" {
 		t.Errorf("Expected Quit to be 'q', got %q", kb.Quit)
 	}
-	if kb.ForceQuit != "ctrl+c" {
+	if kb.ForceQuit.String() != "ctrl+c" {
 		t.Errorf("Expected ForceQuit to be 'ctrl+c', got %q", kb.ForceQuit)
 	}
-	if kb.Help != "?" {
+	if kb.Help.String() != "?" {
 		t.Errorf("Expected Help to be '?', got %q", kb.Help)
 	}
-	if kb.Escape != "esc" {
+	if kb.Escape.String() != "esc" {
 		t.Errorf("Expected Escape to be 'esc', got %q", kb.Escape)
 	}
-	if kb.Detail != "d" {
+	if kb.Detail.String() != "d" {
 		t.Errorf("Expected Detail to be 'd', got %q", kb.Detail)
 	}
 }
@@ -3404,11 +3407,11 @@ func TestModel_RemoveInHibernatedView_AC5(t *testing.T) {
 	if !updated.isConfirmingRemove {
 		t.Error("expected isConfirmingRemove=true")
 	}
-	if updated.confirmTarget == nil {
-		t.Error("expected confirmTarget to be set")
+	if len(updated.confirmTargets) == 0 {
+		t.Error("expected confirmTargets to be set")
 	}
-	if updated.confirmTarget.ID != "h1" {
-		t.Errorf("expected confirmTarget.ID='h1', got '%s'", updated.confirmTarget.ID)
+	if updated.confirmTargets[0].ID != "h1" {
+		t.Errorf("expected confirmTargets[0].ID='h1', got '%s'", updated.confirmTargets[0].ID)
 	}
 }
 
@@ -4497,3 +4500,165 @@ func TestTextView_SearchMode_EnterExitsInputMode(t *testing.T) {
 		t.Errorf("'n' should navigate to next match after Enter, got index %d", m.searchIndex)
 	}
 }
+
+// mockLogReader implements ports.LogReader with canned sessions.
+type mockLogReader struct {
+	tool     string
+	sessions []domain.LogSession
+	entries  map[string][]domain.LogEntry // By session ID
+	err      error
+}
+
+func (r *mockLogReader) Tool() string                             { return r.tool }
+func (r *mockLogReader) CanRead(_ context.Context, _ string) bool { return true }
+func (r *mockLogReader) ReadSession(_ context.Context, session domain.LogSession) ([]domain.LogEntry, error) {
+	return r.entries[session.ID], nil
+}
+func (r *mockLogReader) TailSession(_ context.Context, _ domain.LogSession) (<-chan domain.LogEntry, error) {
+	return nil, nil
+}
+func (r *mockLogReader) ListSessions(_ context.Context, _ string) ([]domain.LogSession, error) {
+	return r.sessions, r.err
+}
+func (r *mockLogReader) SearchSession(_ context.Context, _ domain.LogSession, _ string, _ int) ([]domain.LogSearchHit, error) {
+	return nil, nil
+}
+
+func TestListLogSessions_MergesReadersNewestFirst(t *testing.T) {
+	base := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	claude := &mockLogReader{tool: "Claude Code", sessions: []domain.LogSession{
+		{ID: "c1", StartTime: base.Add(2 * time.Hour), Tool: "Claude Code"},
+		{ID: "c2", StartTime: base},
+	}}
+	aider := &mockLogReader{tool: "Aider", sessions: []domain.LogSession{
+		{ID: "a1", StartTime: base.Add(time.Hour)},
+	}}
+	broken := &mockLogReader{tool: "Codex", err: fmt.Errorf("boom")}
+
+	sessions, err := listLogSessions(context.Background(), []ports.LogReader{claude, broken, aider}, "/test")
+	if err != nil {
+		t.Fatalf("listLogSessions() error = %v", err)
+	}
+
+	wantIDs := []string{"c1", "a1", "c2"}
+	wantTools := []string{"Claude Code", "Aider", "Claude Code"}
+	if len(sessions) != len(wantIDs) {
+		t.Fatalf("got %d sessions, want %d", len(sessions), len(wantIDs))
+	}
+	for i := range wantIDs {
+		if sessions[i].ID != wantIDs[i] || sessions[i].Tool != wantTools[i] {
+			t.Errorf("sessions[%d] = %s/%s, want %s/%s", i, sessions[i].ID, sessions[i].Tool, wantIDs[i], wantTools[i])
+		}
+	}
+}
+
+func TestListLogSessions_AllReadersFail(t *testing.T) {
+	broken := &mockLogReader{tool: "Codex", err: fmt.Errorf("boom")}
+	if _, err := listLogSessions(context.Background(), []ports.LogReader{broken}, "/test"); err == nil {
+		t.Error("listLogSessions() should return error when every reader fails")
+	}
+}
+
+func TestRenderSessionPicker_LabelsToolsWhenMixed(t *testing.T) {
+	m := NewModel(nil)
+	m.logSessions = []domain.LogSession{
+		{ID: "claude-session", Tool: "Claude Code", StartTime: time.Now()},
+		{ID: "20260112-143000", Tool: "Aider", StartTime: time.Now()},
+	}
+
+	out := m.renderSessionPicker(100, 30)
+	if !strings.Contains(out, "Claude Code") || !strings.Contains(out, "Aider") {
+		t.Errorf("session picker should label sessions by tool, got:\n%s", out)
+	}
+
+	// Single-tool lists keep the original compact layout
+	m.logSessions = m.logSessions[:1]
+	out = m.renderSessionPicker(100, 30)
+	if strings.Contains(out, "Claude Code") {
+		t.Errorf("single-tool session picker should not repeat tool label, got:\n%s", out)
+	}
+}
+
+func TestModel_OpenLog_AiderSessionsShowOwnEntries(t *testing.T) {
+	// Both sessions live in the same history file
+	path := filepath.Join(t.TempDir(), ".aider.chat.history.md")
+	if err := os.WriteFile(path, []byte("# aider chat started at 2026-01-12 14:30:00\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	first := domain.LogSession{ID: "20260110-090000", Path: path, Tool: aiderTool}
+	second := domain.LogSession{ID: "20260112-143000", Path: path, Tool: aiderTool}
+	reader := &mockLogReader{tool: aiderTool, entries: map[string][]domain.LogEntry{
+		first.ID:  {domain.NewLogEntry(time.Time{}, "user", json.RawMessage(`{"type":"user","content":"Add a /version endpoint"}`), first.ID)},
+		second.ID: {domain.NewLogEntry(time.Time{}, "user", json.RawMessage(`{"type":"user","content":"Write a test"}`), second.ID)},
+	}}
+
+	m := NewModel(nil)
+	m.ready = true
+	m.width = 80
+	m.height = 20
+	m.currentLogReaders = []ports.LogReader{reader}
+
+	open := func(session domain.LogSession) Model {
+		t.Helper()
+		updated, _ := m.Update(m.openLogCmd(session, "proj")())
+		return updated.(Model)
+	}
+	got1, got2 := open(first), open(second)
+
+	content1, content2 := strings.Join(got1.textViewContent, "\n"), strings.Join(got2.textViewContent, "\n")
+	if !strings.Contains(content1, "Add a /version endpoint") || strings.Contains(content1, "Write a test") {
+		t.Errorf("first session content = %q", content1)
+	}
+	if !strings.Contains(content2, "Write a test") || strings.Contains(content2, "Add a /version endpoint") {
+		t.Errorf("second session content = %q", content2)
+	}
+	if got1.textViewTitle == got2.textViewTitle || !strings.Contains(got2.textViewTitle, second.ID) {
+		t.Errorf("titles = %q, %q, want one per session", got1.textViewTitle, got2.textViewTitle)
+	}
+
+	// Growth of the shared file re-reads the session instead of appending
+	// the raw lines
+	updated, cmd := got2.Update(logNewEntriesMsg{newContent: "#### Write a test\n", offset: got2.logLastOffset, newOffset: got2.logLastOffset + 18})
+	got2 = updated.(Model)
+	if cmd == nil {
+		t.Fatal("file growth should re-read the Aider session")
+	}
+	reader.entries[second.ID] = append(reader.entries[second.ID],
+		domain.NewLogEntry(time.Time{}, "assistant", json.RawMessage(`{"type":"assistant","content":"Added main_test.go"}`), second.ID))
+	updated, _ = got2.Update(cmd())
+	got2 = updated.(Model)
+	content2 = strings.Join(got2.textViewContent, "\n")
+	if !strings.Contains(content2, "Added main_test.go") || strings.Contains(content2, "####") {
+		t.Errorf("re-read content = %q", content2)
+	}
+}
+
+func TestModel_SprintKeyTogglesTree(t *testing.T) {
+	m := createModelWithProjects(1)
+	m.projects[0].Sprint = &domain.SprintProgress{Epics: []domain.SprintEpic{{ID: "1", Status: domain.WorkInProgress}}}
+
+	// Ignored while the detail panel is hidden
+	m.showDetailPanel = false
+	m = typeKeys(t, m, "e")
+	if m.detailPanel.SprintExpanded() {
+		t.Error("e should do nothing without the detail panel")
+	}
+
+	m = typeKeys(t, m, "d")
+	if !m.showDetailPanel {
+		t.Fatal("d should open the detail panel")
+	}
+	m = typeKeys(t, m, "e")
+	if !m.detailPanel.SprintExpanded() {
+		t.Error("e should expand the sprint tree")
+	}
+	m = typeKeys(t, m, "e")
+	if m.detailPanel.SprintExpanded() {
+		t.Error("second e should collapse the sprint tree")
+	}
+
+	names := strings.Join(paletteNames(openTestPalette(t, m, "sprint")), "|")
+	if !strings.Contains(names, "Expand/collapse sprint tree") {
+		t.Errorf("palette should offer the sprint tree toggle, got %s", names)
+	}
+}
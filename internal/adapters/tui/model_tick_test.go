@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"context"
 	"runtime"
 	"testing"
 	"time"
@@ -548,7 +549,7 @@ func TestStageRefreshTickMsg_TriggersRefresh(t *testing.T) {
 	}
 
 	// Mock detection service not needed - we just verify isRefreshing gets set
-	// The actual refresh would fail without detectionService but that's ok for this test
+	// The actual refresh would fail without a refresher but that's ok for this test
 
 	updated, cmd := m.Update(stageRefreshTickMsg(time.Now()))
 	model := updated.(Model)
@@ -659,3 +660,61 @@ func TestWatcherPlusTick_IntegrationCycle(t *testing.T) {
 		t.Error("project should be working after file event")
 	}
 }
+
+// =============================================================================
+// Workspace roots: periodic scan for new projects
+// =============================================================================
+
+// tickScanner counts scans and reports a fixed number of added projects.
+type tickScanner struct {
+	added int
+	calls int
+}
+
+func (s *tickScanner) Scan(_ context.Context, _ []string, _ ports.ScanOptions) (ports.ScanReport, error) {
+	s.calls++
+	outcomes := make([]ports.ScanOutcome, s.added)
+	for i := range outcomes {
+		outcomes[i].Status = ports.ScanAdded
+	}
+	return ports.ScanReport{Outcomes: outcomes}, nil
+}
+
+func TestWorkspaceScan_DisabledWithoutRoots(t *testing.T) {
+	m := NewModel(nil)
+	m.SetProjectScanner(&tickScanner{})
+	m.SetConfig(ports.NewConfig())
+
+	if cmd := m.scanWorkspacesCmd(); cmd != nil {
+		t.Error("scanWorkspacesCmd should be nil without workspace_roots")
+	}
+	if cmd := m.workspaceScanTickCmd(); cmd != nil {
+		t.Error("workspaceScanTickCmd should be nil without workspace_roots")
+	}
+}
+
+func TestWorkspaceScan_ReloadsWhenProjectsAdded(t *testing.T) {
+	scanner := &tickScanner{added: 2}
+	cfg := ports.NewConfig()
+	cfg.WorkspaceRoots = []string{"/work"}
+
+	m := NewModel(nil)
+	m.SetProjectScanner(scanner)
+	m.SetConfig(cfg)
+
+	cmd := m.scanWorkspacesCmd()
+	if cmd == nil {
+		t.Fatal("expected scan command with workspace_roots configured")
+	}
+	msg, ok := cmd().(workspaceScanCompleteMsg)
+	if !ok || msg.added != 2 || scanner.calls != 1 {
+		t.Fatalf("unexpected scan result: %+v (calls %d)", msg, scanner.calls)
+	}
+
+	if _, reload := m.Update(msg); reload == nil {
+		t.Error("expected project reload after scan added projects")
+	}
+	if _, reload := m.Update(workspaceScanCompleteMsg{}); reload != nil {
+		t.Error("expected no reload when nothing was added")
+	}
+}
@@ -0,0 +1,435 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/tui/components"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// maxPaletteRows is the number of commands shown at once in the palette.
+const maxPaletteRows = 10
+
+// paletteCommand is an action listed in the command palette. Commands with
+// a key dispatch into the same code path as pressing that key; the others
+// (rename, settings) exist only in the palette.
+type paletteCommand struct {
+	name   string                                         // Shown and fuzzy-matched, e.g. "Hibernate project"
+	key    string                                         // Default key of the action; "" for palette-only commands
+	prompt string                                         // Asks for an argument first, e.g. "New display name"
+	value  string                                         // Initial argument value
+	run    func(m Model, arg string) (tea.Model, tea.Cmd) // Palette-only commands
+}
+
+// paletteMatch is a command matching the palette query.
+type paletteMatch struct {
+	command   paletteCommand
+	positions map[int]bool // Matched rune positions in the name
+}
+
+// commandPalette is the state of the open command palette.
+type commandPalette struct {
+	input    textinput.Model
+	commands []paletteCommand // Commands available when the palette opened
+	matches  []paletteMatch   // Commands matching the query, best first
+	cursor   int
+	pending  *paletteCommand // Command waiting for its argument
+}
+
+// newCommandPalette creates a palette listing commands, with an empty query.
+func newCommandPalette(commands []paletteCommand, width int) commandPalette {
+	ti := textinput.New()
+	ti.Prompt = "> "
+	ti.Placeholder = "Type a command..."
+	ti.CharLimit = 100
+	ti.Width = paletteWidth(width) - 10 // Frame, prompt and cursor
+	ti.Focus()
+	p := commandPalette{input: ti, commands: commands}
+	p.matches = matchPaletteCommands(commands, "")
+	return p
+}
+
+// paletteCommands returns the commands available in the current view.
+// Commands acting on marked projects say so in their name.
+func (m Model) paletteCommands() []paletteCommand {
+	hibernatedView := m.viewMode == viewModeHibernated
+	selected := m.currentList().SelectedProject()
+	bulk := ""
+	if n := len(m.markedProjects()); n > 0 {
+		bulk = fmt.Sprintf(" (%d marked)", n)
+	}
+
+	var cmds []paletteCommand
+	add := func(name, key string) {
+		cmds = append(cmds, paletteCommand{name: name, key: key})
+	}
+
+	if selected != nil {
+		if hibernatedView {
+			add("Activate project"+bulk, KeyStateToggle)
+		} else {
+			add("Open log (latest session)", "enter")
+			add("Open log (pick session)", KeyLogOpenView)
+			cmds = append(cmds, paletteCommand{
+				name:   "Search session logs",
+				prompt: "Text to find in every session of this project",
+				run:    paletteSearchLogs,
+			})
+			add("Edit notes", KeyNotes)
+			add("Toggle favorite"+bulk, KeyFavorite)
+			add("Hibernate project"+bulk, KeyStateToggle)
+			add("Project stats", KeyStats)
+		}
+		add("Remove project"+bulk, KeyRemove)
+		cmds = append(cmds, paletteCommand{
+			name:   "Rename project",
+			prompt: "New display name (empty clears)",
+			value:  selected.DisplayName,
+			run:    paletteRename,
+		})
+		add("Mark/unmark project", KeyMark)
+		add("Mark/unmark all shown projects", KeyMarkAll)
+	}
+	if bulk != "" {
+		add("Clear marks", KeyEscape)
+	}
+	if !hibernatedView {
+		add("Refresh projects"+bulk, KeyRefresh)
+		if len(m.projects) > 0 {
+			add("Search projects", KeySearch)
+		}
+		add("Cycle sort mode", KeySort)
+		add("Cycle grouping", KeyGroup)
+		add("View hibernated projects", KeyHibernated)
+	} else {
+		add("Back to active projects", KeyHibernated)
+	}
+	add("Toggle detail panel", KeyDetail)
+	if m.showDetailPanel && selected != nil && selected.Sprint != nil {
+		add("Expand/collapse sprint tree", KeySprint)
+	}
+	cmds = append(cmds,
+		paletteCommand{name: "Toggle layout (horizontal/vertical)", run: paletteToggleLayout},
+		paletteCommand{
+			name:   "Set waiting threshold",
+			prompt: "Minutes before an idle agent shows WAITING (1 or more)",
+			value:  strconv.Itoa(m.effectiveConfig().AgentWaitingThresholdMinutes),
+			run:    paletteSetWaitingThreshold,
+		},
+		paletteCommand{
+			name:   "Set hibernation days",
+			prompt: "Days of inactivity before auto-hibernation (0 disables)",
+			value:  strconv.Itoa(m.effectiveConfig().HibernationDays),
+			run:    paletteSetHibernationDays,
+		},
+	)
+	add("Show help", KeyHelp)
+	add("Quit", KeyQuit)
+	return cmds
+}
+
+// matchPaletteCommands returns the commands whose name fuzzy-matches every
+// word of query, tightest matches first (ties keep the listed order).
+func matchPaletteCommands(commands []paletteCommand, query string) []paletteMatch {
+	words := strings.Fields(query)
+	type scored struct {
+		match paletteMatch
+		score int
+	}
+	var results []scored
+	for _, c := range commands {
+		positions := make(map[int]bool)
+		score := 0
+		ok := true
+		for _, word := range words {
+			matched, found := components.FuzzyMatch(word, c.name)
+			if !found {
+				ok = false
+				break
+			}
+			// Spread of the match: contiguous matches score lowest
+			score += matched[len(matched)-1] - matched[0] - (len(matched) - 1)
+			for _, pos := range matched {
+				positions[pos] = true
+			}
+		}
+		if ok {
+			results = append(results, scored{paletteMatch{c, positions}, score})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].score < results[j].score
+	})
+	matches := make([]paletteMatch, len(results))
+	for i, r := range results {
+		matches[i] = r.match
+	}
+	return matches
+}
+
+// openPalette opens the command palette for the current view.
+func (m Model) openPalette() (tea.Model, tea.Cmd) {
+	m.palette = newCommandPalette(m.paletteCommands(), m.width)
+	m.showPalette = true
+	return m, textinput.Blink
+}
+
+// closePalette closes the command palette.
+func (m *Model) closePalette() {
+	m.showPalette = false
+	m.palette = commandPalette{}
+}
+
+// handlePaletteKeyMsg processes keyboard input while the palette is open.
+func (m Model) handlePaletteKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := &m.palette
+	switch msg.Type {
+	case tea.KeyEsc:
+		if p.pending != nil {
+			// Back to the command list
+			p.pending = nil
+			p.input.Placeholder = "Type a command..."
+			p.input.SetValue("")
+			p.matches = matchPaletteCommands(p.commands, "")
+			p.cursor = 0
+			return m, nil
+		}
+		m.closePalette()
+		return m, nil
+	case tea.KeyEnter:
+		if p.pending != nil {
+			command := *p.pending
+			arg := strings.TrimSpace(p.input.Value())
+			m.closePalette()
+			return command.run(m, arg)
+		}
+		if len(p.matches) == 0 {
+			return m, nil
+		}
+		command := p.matches[p.cursor].command
+		if command.prompt != "" {
+			p.pending = &command
+			p.input.Placeholder = ""
+			p.input.SetValue(command.value)
+			p.input.CursorEnd()
+			return m, nil
+		}
+		m.closePalette()
+		if command.run != nil {
+			return command.run(m, "")
+		}
+		return m.dispatchKey(keyMsgFor(command.key))
+	case tea.KeyUp, tea.KeyCtrlP:
+		if p.pending == nil && p.cursor > 0 {
+			p.cursor--
+		}
+		return m, nil
+	case tea.KeyDown, tea.KeyCtrlN, tea.KeyTab:
+		if p.pending == nil && p.cursor < len(p.matches)-1 {
+			p.cursor++
+		}
+		return m, nil
+	}
+
+	query := p.input.Value()
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	if p.pending == nil && p.input.Value() != query {
+		p.matches = matchPaletteCommands(p.commands, p.input.Value())
+		p.cursor = 0
+	}
+	return m, cmd
+}
+
+// paletteWidth returns the palette box width for a terminal width.
+func paletteWidth(width int) int {
+	return min(max(width-4, 30), 64)
+}
+
+// renderPalette renders the command palette centered over the dashboard.
+func (m Model) renderPalette() string {
+	p := m.palette
+	boxWidth := paletteWidth(m.width)
+	innerWidth := boxWidth - popupStyle.GetHorizontalFrameSize()
+
+	lines := []string{titleStyle.Render("Command Palette"), ""}
+	if p.pending != nil {
+		lines = append(lines, p.pending.name+": "+hintStyle.Render(p.pending.prompt), p.input.View(), "",
+			hintStyle.Render("[Enter] Apply  [Esc] Back"))
+	} else {
+		lines = append(lines, p.input.View(), "")
+		if len(p.matches) == 0 {
+			lines = append(lines, hintStyle.Render("No matching commands"))
+		}
+		start := max(0, p.cursor-maxPaletteRows+1)
+		end := min(len(p.matches), start+maxPaletteRows)
+		for i := start; i < end; i++ {
+			lines = append(lines, m.renderPaletteRow(p.matches[i], i == p.cursor, innerWidth))
+		}
+		lines = append(lines, "", hintStyle.Render("[↑/↓] Select  [Enter] Run  [Esc] Close"))
+	}
+
+	box := popupStyle.Width(boxWidth).Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// renderPaletteRow renders one command: the name with matched characters
+// highlighted and its keys right-aligned.
+func (m Model) renderPaletteRow(match paletteMatch, selected bool, width int) string {
+	keys := ""
+	if match.command.key != "" {
+		keys = displayKeys(m.keys.keysFor(match.command.key))
+	}
+
+	var name strings.Builder
+	for i, r := range []rune(match.command.name) {
+		if match.positions[i] {
+			name.WriteString(matchStyle.Render(string(r)))
+		} else {
+			name.WriteRune(r)
+		}
+	}
+
+	prefix := "  "
+	if selected {
+		prefix = "> "
+	}
+	row := prefix + name.String()
+	if pad := width - lipgloss.Width(row) - lipgloss.Width(keys); pad > 0 {
+		row += strings.Repeat(" ", pad) + hintStyle.Render(keys)
+	}
+	if selected {
+		return SelectedStyle.Render(row)
+	}
+	return row
+}
+
+// effectiveConfig returns the config, or the defaults when none is set.
+func (m Model) effectiveConfig() *ports.Config {
+	if m.config == nil {
+		return ports.NewConfig()
+	}
+	return m.config
+}
+
+// projectRenamedMsg is sent after a display name is saved from the palette.
+type projectRenamedMsg struct {
+	name        string // Project name
+	displayName string // New display name; "" when cleared
+	err         error
+}
+
+// paletteRename sets or clears the selected project's display name,
+// like `vdash rename`.
+func paletteRename(m Model, displayName string) (tea.Model, tea.Cmd) {
+	selected := m.currentList().SelectedProject()
+	if selected == nil {
+		return m, nil
+	}
+	id := selected.ID
+	return m, func() tea.Msg {
+		ctx := context.Background()
+		p, err := m.repository.FindByID(ctx, id)
+		if err != nil {
+			return projectRenamedMsg{err: err}
+		}
+		p.DisplayName = displayName
+		p.UpdatedAt = time.Now()
+		if err := m.repository.Save(ctx, p); err != nil {
+			return projectRenamedMsg{name: p.Name, err: err}
+		}
+		return projectRenamedMsg{name: p.Name, displayName: displayName}
+	}
+}
+
+// handleProjectRenamed reports a rename and reloads the lists.
+func (m Model) handleProjectRenamed(msg projectRenamedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		slog.Warn("failed to rename project", "project", msg.name, "error", msg.err)
+		return m, flashCmd("✗ Rename failed")
+	}
+	text := fmt.Sprintf("✓ Renamed: %s → %s", msg.name, msg.displayName)
+	if msg.displayName == "" {
+		text = "✓ Cleared display name: " + msg.name
+	}
+	cmds := []tea.Cmd{flashCmd(text), m.loadProjectsCmd()}
+	if m.viewMode == viewModeHibernated {
+		cmds = append(cmds, m.loadHibernatedProjectsCmd())
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// paletteToggleLayout switches the detail panel between the horizontal and
+// vertical layouts and saves the choice to config.
+func paletteToggleLayout(m Model, _ string) (tea.Model, tea.Cmd) {
+	if m.isHorizontalLayout() {
+		m.detailLayout = "vertical"
+	} else {
+		m.detailLayout = "horizontal"
+	}
+	layout := m.detailLayout
+
+	// Story 8.12: The height hint only applies to the horizontal layout
+	contentHeight := m.height - statusBarHeight(m.height)
+	if m.isHorizontalLayout() && m.showDetailPanel && contentHeight < HorizontalDetailThreshold {
+		m.statusBar.SetHeightHint("[d] Detail hidden - insufficient height")
+	} else {
+		m.statusBar.SetHeightHint("")
+	}
+
+	if m.config != nil {
+		m.config.DetailLayout = layout
+	}
+	return m, tea.Batch(
+		flashCmd("Layout: "+layout),
+		m.saveConfigCmd("detail layout", func(cfg *ports.Config) { cfg.DetailLayout = layout }),
+	)
+}
+
+// paletteSetWaitingThreshold sets the global agent waiting threshold.
+// Per-project overrides (`vdash config set`) still take precedence. A global
+// 0 reads as unset and falls back to the default, so values start at 1.
+func paletteSetWaitingThreshold(m Model, arg string) (tea.Model, tea.Cmd) {
+	minutes, err := strconv.Atoi(arg)
+	if err != nil || minutes < 1 {
+		return m, flashCmd(fmt.Sprintf("✗ Invalid waiting threshold: %q", arg))
+	}
+	if m.config != nil {
+		m.config.AgentWaitingThresholdMinutes = minutes
+	}
+	return m, tea.Batch(
+		flashCmd(fmt.Sprintf("✓ Waiting threshold: %d min", minutes)),
+		m.saveConfigCmd("waiting threshold", func(cfg *ports.Config) { cfg.AgentWaitingThresholdMinutes = minutes }),
+	)
+}
+
+// paletteSetHibernationDays sets the global auto-hibernation period.
+// Per-project overrides (`vdash config set`) still take precedence.
+func paletteSetHibernationDays(m Model, arg string) (tea.Model, tea.Cmd) {
+	days, err := strconv.Atoi(arg)
+	if err != nil || days < 0 {
+		return m, flashCmd(fmt.Sprintf("✗ Invalid hibernation days: %q", arg))
+	}
+	if m.config != nil {
+		m.config.HibernationDays = days
+	}
+	return m, tea.Batch(
+		flashCmd(fmt.Sprintf("✓ Hibernation: %d days", days)),
+		m.saveConfigCmd("hibernation days", func(cfg *ports.Config) { cfg.HibernationDays = days }),
+	)
+}
+
+// flashCmd shows text in the status bar for a moment.
+func flashCmd(text string) tea.Cmd {
+	return func() tea.Msg { return flashMsg{text: text} }
+}
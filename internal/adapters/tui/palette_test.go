@@ -0,0 +1,285 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/tui/components"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// openTestPalette opens the palette with ':' and types query.
+func openTestPalette(t *testing.T, m Model, query string) Model {
+	t.Helper()
+	m = typeKeys(t, m, ":")
+	if !m.showPalette {
+		t.Fatal("':' should open the command palette")
+	}
+	return typeKeys(t, m, query)
+}
+
+// paletteNames returns the names of the palette's matching commands.
+func paletteNames(m Model) []string {
+	names := make([]string, len(m.palette.matches))
+	for i, match := range m.palette.matches {
+		names[i] = match.command.name
+	}
+	return names
+}
+
+func TestPalette_OpensWithColonAndCtrlP(t *testing.T) {
+	m := createModelWithProjects(2)
+
+	m = pressKey(t, m, tea.KeyCtrlP)
+	if !m.showPalette {
+		t.Fatal("ctrl+p should open the command palette")
+	}
+	if view := m.View(); !strings.Contains(view, "Command Palette") {
+		t.Errorf("View() should render the palette, got:\n%s", view)
+	}
+
+	m = pressKey(t, m, tea.KeyEsc)
+	if m.showPalette {
+		t.Error("Esc should close the palette")
+	}
+
+	m = typeKeys(t, m, ":")
+	if !m.showPalette {
+		t.Error("':' should open the command palette")
+	}
+}
+
+func TestPalette_FuzzyFilters(t *testing.T) {
+	m := createModelWithProjects(2)
+
+	m = openTestPalette(t, m, "hib proj")
+	names := paletteNames(m)
+	if len(names) == 0 || names[0] != "Hibernate project" {
+		t.Fatalf("first match = %v, want 'Hibernate project' first", names)
+	}
+
+	m = typeKeys(t, m, "xyzzy")
+	if len(m.palette.matches) != 0 {
+		t.Errorf("expected no matches, got %v", paletteNames(m))
+	}
+	if view := m.View(); !strings.Contains(view, "No matching commands") {
+		t.Error("expected 'No matching commands' hint")
+	}
+}
+
+func TestPalette_EnterDispatchesKeyAction(t *testing.T) {
+	m := createModelWithProjects(2)
+	before := m.showDetailPanel
+
+	m = openTestPalette(t, m, "detail panel")
+	m = pressKey(t, m, tea.KeyEnter)
+
+	if m.showPalette {
+		t.Error("palette should close after running a command")
+	}
+	if m.showDetailPanel == before {
+		t.Error("'Toggle detail panel' should toggle the detail panel like 'd'")
+	}
+}
+
+func TestPalette_DispatchIgnoresRebinding(t *testing.T) {
+	m := createModelWithProjects(2)
+	m.keys, _ = KeyBindingsFromConfig(map[string][]string{"detail": {"D"}})
+	before := m.showDetailPanel
+
+	m = openTestPalette(t, m, "detail panel")
+	if view := m.View(); !strings.Contains(view, "D") {
+		t.Errorf("palette should show the rebound key, got:\n%s", view)
+	}
+	m = pressKey(t, m, tea.KeyEnter)
+	if m.showDetailPanel == before {
+		t.Error("palette should run the action even when its default key is rebound")
+	}
+}
+
+func TestPalette_CursorMovesThroughMatches(t *testing.T) {
+	m := createModelWithProjects(2)
+	m = openTestPalette(t, m, "")
+
+	m = pressKey(t, m, tea.KeyDown)
+	m = pressKey(t, m, tea.KeyDown)
+	m = pressKey(t, m, tea.KeyUp)
+	if m.palette.cursor != 1 {
+		t.Errorf("cursor = %d, want 1", m.palette.cursor)
+	}
+
+	// Typing resets the cursor to the best match
+	m = typeKeys(t, m, "q")
+	if m.palette.cursor != 0 {
+		t.Errorf("cursor = %d, want 0 after typing", m.palette.cursor)
+	}
+}
+
+func TestPalette_HibernatedViewCommands(t *testing.T) {
+	m := createModelWithProjects(0)
+	hibernated := []*domain.Project{{ID: "h1", Name: "sleepy", State: domain.StateHibernated}}
+	m.viewMode = viewModeHibernated
+	m.hibernatedProjects = hibernated
+	m.hibernatedList = components.NewProjectListModel(hibernated, 80, 20)
+
+	names := strings.Join(paletteNames(openTestPalette(t, m, "")), "|")
+	if !strings.Contains(names, "Activate project") || !strings.Contains(names, "Back to active projects") {
+		t.Errorf("hibernated view commands = %s", names)
+	}
+	if strings.Contains(names, "Hibernate project") || strings.Contains(names, "Open log") {
+		t.Errorf("active view commands should not be listed in the hibernated view: %s", names)
+	}
+}
+
+func TestPalette_MarkedCountInBulkCommands(t *testing.T) {
+	m := createModelWithProjects(3)
+	m = typeKeys(t, m, "*")
+
+	names := strings.Join(paletteNames(openTestPalette(t, m, "")), "|")
+	if !strings.Contains(names, "Toggle favorite (3 marked)") || !strings.Contains(names, "Clear marks") {
+		t.Errorf("expected bulk command names, got %s", names)
+	}
+}
+
+func TestPalette_Rename(t *testing.T) {
+	m, repo := createBulkModel(2)
+
+	m = openTestPalette(t, m, "rename")
+	m = pressKey(t, m, tea.KeyEnter)
+	if m.palette.pending == nil {
+		t.Fatal("'Rename project' should prompt for a name")
+	}
+	if view := m.View(); !strings.Contains(view, "New display name") {
+		t.Errorf("expected rename prompt, got:\n%s", view)
+	}
+
+	m = typeKeys(t, m, "Client API")
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.showPalette {
+		t.Error("palette should close after applying")
+	}
+	msg, ok := cmd().(projectRenamedMsg)
+	if !ok || msg.err != nil {
+		t.Fatalf("expected successful projectRenamedMsg, got %#v", msg)
+	}
+	if repo.projects[0].DisplayName != "Client API" {
+		t.Errorf("DisplayName = %q, want %q", repo.projects[0].DisplayName, "Client API")
+	}
+
+	_, cmd = m.Update(msg)
+	if cmd == nil {
+		t.Error("rename should reload projects and flash feedback")
+	}
+}
+
+func TestPalette_EscFromPromptReturnsToList(t *testing.T) {
+	m := createModelWithProjects(2)
+	m = openTestPalette(t, m, "rename")
+	m = pressKey(t, m, tea.KeyEnter)
+
+	m = pressKey(t, m, tea.KeyEsc)
+	if !m.showPalette || m.palette.pending != nil {
+		t.Fatal("Esc in a prompt should return to the command list")
+	}
+	if len(m.palette.matches) != len(m.palette.commands) {
+		t.Errorf("query should be cleared, got %d of %d commands", len(m.palette.matches), len(m.palette.commands))
+	}
+}
+
+func TestPalette_SetWaitingThreshold(t *testing.T) {
+	m := createModelWithProjects(2)
+	cfg := ports.NewConfig()
+	loader := &memConfigLoader{cfg: ports.NewConfig()}
+	m.SetConfig(cfg)
+	m.SetConfigLoader(loader)
+
+	m = openTestPalette(t, m, "waiting threshold")
+	m = pressKey(t, m, tea.KeyEnter)
+	if got := m.palette.input.Value(); got != "10" {
+		t.Errorf("prompt value = %q, want current threshold 10", got)
+	}
+
+	// Invalid values are rejected
+	m = pressKey(t, m, tea.KeyBackspace)
+	m = pressKey(t, m, tea.KeyBackspace)
+	m = typeKeys(t, m, "-3")
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if cfg.AgentWaitingThresholdMinutes != 10 {
+		t.Errorf("threshold = %d, want unchanged 10", cfg.AgentWaitingThresholdMinutes)
+	}
+
+	// 0 would silently fall back to the default threshold
+	m = openTestPalette(t, m, "waiting threshold")
+	m = pressKey(t, m, tea.KeyEnter)
+	m.palette.input.SetValue("0")
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if cfg.AgentWaitingThresholdMinutes != 10 || loader.saves != 0 {
+		t.Errorf("threshold = %d after %d saves, want unchanged 10", cfg.AgentWaitingThresholdMinutes, loader.saves)
+	}
+
+	m = openTestPalette(t, m, "waiting threshold")
+	m = pressKey(t, m, tea.KeyEnter)
+	m.palette.input.SetValue("5")
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runCmd(cmd)
+	_ = updated
+
+	if cfg.AgentWaitingThresholdMinutes != 5 {
+		t.Errorf("threshold = %d, want 5", cfg.AgentWaitingThresholdMinutes)
+	}
+	if loader.cfg.AgentWaitingThresholdMinutes != 5 || loader.saves != 1 {
+		t.Errorf("saved threshold = %d after %d saves, want 5 after 1", loader.cfg.AgentWaitingThresholdMinutes, loader.saves)
+	}
+}
+
+func TestPalette_ToggleLayout(t *testing.T) {
+	m := createModelWithProjects(2)
+	loader := &memConfigLoader{cfg: ports.NewConfig()}
+	m.SetConfigLoader(loader)
+
+	m = openTestPalette(t, m, "toggle layout")
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	runCmd(cmd)
+
+	if m.detailLayout != "vertical" {
+		t.Errorf("detailLayout = %q, want vertical", m.detailLayout)
+	}
+	if loader.cfg.DetailLayout != "vertical" {
+		t.Errorf("saved layout = %q, want vertical", loader.cfg.DetailLayout)
+	}
+}
+
+func TestMatchPaletteCommands_Ranking(t *testing.T) {
+	commands := []paletteCommand{
+		{name: "Open log (pick session)"},
+		{name: "Toggle layout (horizontal/vertical)"},
+		{name: "Open log (latest session)"},
+		{name: "Quit"},
+	}
+
+	got := matchPaletteCommands(commands, "latest")
+	if len(got) != 1 || got[0].command.name != "Open log (latest session)" {
+		t.Errorf("matchPaletteCommands(latest) = %v", got)
+	}
+
+	// Contiguous matches rank above scattered ones; ties keep list order
+	got = matchPaletteCommands(commands, "ol")
+	var names []string
+	for _, match := range got {
+		names = append(names, match.command.name)
+	}
+	want := "Toggle layout (horizontal/vertical)|Open log (pick session)|Open log (latest session)"
+	if strings.Join(names, "|") != want {
+		t.Errorf("order = %v, want %s", names, want)
+	}
+	if !got[1].positions[0] || !got[1].positions[5] {
+		t.Errorf("positions = %v, want 'O' and 'l' highlighted", got[1].positions)
+	}
+}
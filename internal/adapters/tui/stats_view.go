@@ -0,0 +1,299 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/charts"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/project"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/timeformat"
+)
+
+// metricsSampleInterval is how often progress metrics are sampled when no
+// daemon is attached (same default as the daemon).
+const metricsSampleInterval = 5 * time.Minute
+
+// statsRanges are the stats view time ranges in days, cycled with 't'.
+// 0 means all recorded history.
+var statsRanges = []int{1, 7, 30, 0}
+
+// burndownHeight is the number of rows in the stats view burndown chart.
+const burndownHeight = 6
+
+// statsBarWidth is the maximum width of stats view duration bars.
+const statsBarWidth = 30
+
+// metricsTickMsg triggers periodic metrics sampling.
+type metricsTickMsg time.Time
+
+// metricsCollectedMsg reports the result of a metrics sample.
+type metricsCollectedMsg struct {
+	count int
+	err   error
+}
+
+// statsLoadedMsg carries aggregated stats for the stats view.
+type statsLoadedMsg struct {
+	projectID string
+	days      int
+	stats     *domain.ProjectStats
+	err       error
+}
+
+// collectMetricsCmd samples metrics for all active projects.
+// Returns nil if no collector is set or a daemon is attached (the daemon samples).
+func (m Model) collectMetricsCmd() tea.Cmd {
+	if m.metricsCollector == nil || m.daemon != nil {
+		return nil
+	}
+	collector := m.metricsCollector
+	return func() tea.Msg {
+		count, err := collector.Collect(context.Background())
+		return metricsCollectedMsg{count: count, err: err}
+	}
+}
+
+// metricsTickCmd schedules the next metrics sample.
+// Returns nil if no collector is set or a daemon is attached.
+func (m Model) metricsTickCmd() tea.Cmd {
+	if m.metricsCollector == nil || m.daemon != nil {
+		return nil
+	}
+	return tea.Tick(metricsSampleInterval, func(t time.Time) tea.Msg {
+		return metricsTickMsg(t)
+	})
+}
+
+// loadStatsCmd aggregates stats for the stats view project and range.
+func (m Model) loadStatsCmd() tea.Cmd {
+	if m.metricsCollector == nil || m.statsProject == nil {
+		return nil
+	}
+	collector := m.metricsCollector
+	projectID := m.statsProject.ID
+	days := m.statsDays
+	return func() tea.Msg {
+		var since time.Time
+		if days > 0 {
+			since = time.Now().AddDate(0, 0, -days)
+		}
+		stats, err := collector.ProjectStats(context.Background(), projectID, since)
+		return statsLoadedMsg{projectID: projectID, days: days, stats: stats, err: err}
+	}
+}
+
+// deleteProjectStats drops the metric samples of a removed project.
+// Failures are logged; the project itself is already removed.
+func (m Model) deleteProjectStats(ctx context.Context, projectID string) {
+	if m.metricsCollector == nil {
+		return
+	}
+	if err := m.metricsCollector.DeleteProjectStats(ctx, projectID); err != nil {
+		slog.Warn("failed to delete project metrics", "project_id", projectID, "error", err)
+	}
+}
+
+// openStatsView switches to the stats view for the selected project.
+func (m Model) openStatsView() (tea.Model, tea.Cmd) {
+	if m.metricsCollector == nil {
+		return m, func() tea.Msg { return flashMsg{text: "Metrics not available"} }
+	}
+	selected := m.projectList.SelectedProject()
+	if selected == nil {
+		return m, nil
+	}
+	m.viewMode = viewModeStats
+	m.statsProject = selected
+	m.stats = nil
+	m.statsErr = nil
+	return m, m.loadStatsCmd()
+}
+
+// handleStatsLoaded stores loaded stats, ignoring results for a project or
+// range that is no longer shown.
+func (m *Model) handleStatsLoaded(msg statsLoadedMsg) {
+	if m.viewMode != viewModeStats || m.statsProject == nil ||
+		msg.projectID != m.statsProject.ID || msg.days != m.statsDays {
+		return
+	}
+	m.stats = msg.stats
+	m.statsErr = msg.err
+}
+
+// handleStatsKeyMsg handles keys in the stats view.
+func (m Model) handleStatsKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	msg, bound := m.keys.resolveKeyMsg(msg, scopeStats)
+	if !bound {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case KeyEscape, KeyQuit, KeyStats:
+		m.viewMode = viewModeNormal
+		m.statsProject = nil
+		m.stats = nil
+		m.statsErr = nil
+		return m, nil
+	case KeyForceQuit:
+		return m, tea.Quit
+	case KeyStatsRange:
+		next := 0
+		for i, days := range statsRanges {
+			if days == m.statsDays {
+				next = (i + 1) % len(statsRanges)
+				break
+			}
+		}
+		m.statsDays = statsRanges[next]
+		m.stats = nil
+		m.statsErr = nil
+		return m, m.loadStatsCmd()
+	case KeyRefresh:
+		return m, m.loadStatsCmd()
+	}
+	return m, nil
+}
+
+// statsRangeLabel describes a stats range for the view header.
+func statsRangeLabel(days int) string {
+	switch days {
+	case 0:
+		return "all time"
+	case 1:
+		return "last 24 hours"
+	default:
+		return fmt.Sprintf("last %d days", days)
+	}
+}
+
+// renderStatsView renders the full-screen stats view: burndown, time in
+// stage, agent time and waiting time per day.
+func (m Model) renderStatsView() string {
+	effectiveWidth := m.width
+	if m.isWideWidth() {
+		effectiveWidth = m.maxContentWidth
+	}
+	contentHeight := m.height - statusBarHeight(m.height) - 2 // -2 for header/footer
+
+	name := ""
+	if m.statsProject != nil {
+		name = project.EffectiveName(m.statsProject)
+	}
+	header := headerStyle.
+		Width(effectiveWidth).
+		Render(fmt.Sprintf(" Stats: %s · %s", name, statsRangeLabel(m.statsDays)))
+
+	var lines []string
+	switch {
+	case m.statsErr != nil:
+		lines = []string{"", WarningStyle.Render("  Failed to load stats: " + m.statsErr.Error())}
+	case m.stats == nil:
+		lines = []string{"", DimStyle.Render("  Loading...")}
+	case m.stats.Samples == 0:
+		lines = []string{"", DimStyle.Render("  No metrics recorded in this range yet.")}
+	default:
+		lines = renderStatsLines(m.stats, effectiveWidth)
+	}
+
+	if len(lines) > contentHeight {
+		lines = lines[:contentHeight]
+	}
+	for i, line := range lines {
+		if visibleWidth(line) > effectiveWidth {
+			lines[i] = truncateToWidth(line, effectiveWidth)
+		}
+	}
+	for len(lines) < contentHeight {
+		lines = append(lines, "")
+	}
+
+	footer := footerStyle.
+		Width(effectiveWidth).
+		Render(" [t] Range  [r] Reload  [Esc] Back")
+
+	statsContent := lipgloss.JoinVertical(lipgloss.Left, header, strings.Join(lines, "\n"), footer)
+	combined := lipgloss.JoinVertical(lipgloss.Left, statsContent, m.statusBar.View())
+
+	if m.isWideWidth() {
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Top, combined)
+	}
+	return combined
+}
+
+// renderStatsLines renders the chart sections of a non-empty stats view.
+func renderStatsLines(s *domain.ProjectStats, width int) []string {
+	var lines []string
+
+	if len(s.Burndown) > 0 {
+		lines = append(lines, "", titleStyle.Render(fmt.Sprintf("  Burndown  %d/%d done, %d remaining",
+			s.Progress.Done(), s.Progress.Total(), s.Progress.Remaining())))
+		remaining := make([]int, len(s.Burndown))
+		for i, b := range s.Burndown {
+			remaining[i] = b.Remaining
+		}
+		for _, row := range charts.Columns(remaining, max(width-4, 1), burndownHeight) {
+			lines = append(lines, "  "+row)
+		}
+	}
+
+	if len(s.TimeInStage) > 0 {
+		rows := make([]statsBarRow, len(s.TimeInStage))
+		for i, d := range s.TimeInStage {
+			rows[i] = statsBarRow{d.Stage.String(), d.Duration}
+		}
+		lines = append(lines, renderStatsBars("Time in stage", rows, width)...)
+	}
+	if len(s.AgentTime) > 0 {
+		rows := make([]statsBarRow, len(s.AgentTime))
+		for i, d := range s.AgentTime {
+			rows[i] = statsBarRow{d.Status.String(), d.Duration}
+		}
+		lines = append(lines, renderStatsBars("Agent", rows, width)...)
+	}
+	if len(s.WaitingByDay) > 0 {
+		rows := make([]statsBarRow, len(s.WaitingByDay))
+		for i, d := range s.WaitingByDay {
+			rows[i] = statsBarRow{d.Day.Format("Mon 01-02"), d.Duration}
+		}
+		lines = append(lines, renderStatsBars("Waiting per day", rows, width)...)
+	}
+
+	return lines
+}
+
+// statsBarRow is one labelled duration bar.
+type statsBarRow struct {
+	label    string
+	duration time.Duration
+}
+
+// renderStatsBars renders a titled section of bars scaled to the longest row.
+func renderStatsBars(title string, rows []statsBarRow, width int) []string {
+	var longest time.Duration
+	labelWidth := 0
+	for _, r := range rows {
+		longest = max(longest, r.duration)
+		labelWidth = max(labelWidth, len(r.label))
+	}
+	// "  label  bar  duration": keep room for the duration text
+	barWidth := min(statsBarWidth, width-labelWidth-16)
+	if barWidth < 1 {
+		barWidth = 1
+	}
+
+	lines := []string{"", titleStyle.Render("  " + title)}
+	for _, r := range rows {
+		bar := charts.Bar(float64(r.duration), float64(longest), barWidth)
+		pad := strings.Repeat(" ", barWidth-len([]rune(bar)))
+		lines = append(lines, fmt.Sprintf("    %-*s  %s%s  %s", labelWidth, r.label, bar, pad,
+			DimStyle.Render(timeformat.FormatWaitingDuration(r.duration, true))))
+	}
+	return lines
+}
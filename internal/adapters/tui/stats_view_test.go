@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// stubMetricsCollector is a ports.MetricsCollector returning fixed stats.
+type stubMetricsCollector struct {
+	stats     domain.ProjectStats
+	collects  int
+	lastSince time.Time
+	deleted   []string
+}
+
+func (c *stubMetricsCollector) Collect(context.Context) (int, error) {
+	c.collects++
+	return 1, nil
+}
+
+func (c *stubMetricsCollector) ProjectStats(_ context.Context, projectID string, since time.Time) (*domain.ProjectStats, error) {
+	c.lastSince = since
+	s := c.stats
+	s.ProjectID = projectID
+	return &s, nil
+}
+
+func (c *stubMetricsCollector) DeleteProjectStats(_ context.Context, projectID string) error {
+	c.deleted = append(c.deleted, projectID)
+	return nil
+}
+
+func sampleStats() domain.ProjectStats {
+	at := time.Date(2026, 5, 1, 10, 0, 0, 0, time.Local)
+	return domain.ProjectStats{
+		Samples:  20,
+		Progress: domain.WorkProgress{StoriesDone: 3, StoriesTotal: 5},
+		Burndown: []domain.BurndownPoint{
+			{At: at, Done: 1, Total: 5, Remaining: 4},
+			{At: at.Add(time.Hour), Done: 3, Total: 5, Remaining: 2},
+		},
+		TimeInStage: []domain.StageDuration{{Stage: domain.StageImplement, Duration: 90 * time.Minute}},
+		AgentTime: []domain.AgentDuration{
+			{Status: domain.AgentWorking, Duration: time.Hour},
+			{Status: domain.AgentWaitingForUser, Duration: 30 * time.Minute},
+		},
+		WaitingByDay: []domain.DailyDuration{{Day: time.Date(2026, 5, 1, 0, 0, 0, 0, time.Local), Duration: 30 * time.Minute}},
+	}
+}
+
+// pressStatsKey sends a rune key and runs the returned command once, feeding its
+// message back into the model.
+func pressStatsKey(t *testing.T, m Model, key rune) Model {
+	t.Helper()
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+	m = updated.(Model)
+	if cmd != nil {
+		if msg := cmd(); msg != nil {
+			updated, _ = m.Update(msg)
+			m = updated.(Model)
+		}
+	}
+	return m
+}
+
+func TestModel_StatsViewOpensAndRenders(t *testing.T) {
+	m := createModelWithProjects(2)
+	collector := &stubMetricsCollector{stats: sampleStats()}
+	m.SetMetricsCollector(collector)
+
+	m = pressStatsKey(t, m, 'S')
+	if m.viewMode != viewModeStats || m.statsProject == nil || m.statsProject.ID != "a" {
+		t.Fatalf("viewMode = %v, statsProject = %v; want stats view for a", m.viewMode, m.statsProject)
+	}
+	if m.stats == nil || m.stats.ProjectID != "a" {
+		t.Fatalf("stats not loaded: %+v", m.stats)
+	}
+
+	view := m.View()
+	for _, want := range []string{"Stats: a · last 7 days", "Burndown  3/5 done, 2 remaining", "Time in stage", "Implement", "1h 30m", "Waiting per day", "[t] Range"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+
+	m = pressStatsKey(t, m, 'q')
+	if m.viewMode != viewModeNormal || m.statsProject != nil {
+		t.Errorf("q should return to normal view, got %v", m.viewMode)
+	}
+}
+
+func TestModel_StatsViewRangeCycles(t *testing.T) {
+	m := createModelWithProjects(1)
+	collector := &stubMetricsCollector{stats: sampleStats()}
+	m.SetMetricsCollector(collector)
+	m = pressStatsKey(t, m, 'S')
+
+	want := []int{30, 0, 1, 7}
+	for _, days := range want {
+		m = pressStatsKey(t, m, 't')
+		if m.statsDays != days {
+			t.Fatalf("statsDays = %d, want %d", m.statsDays, days)
+		}
+		if days == 0 && !collector.lastSince.IsZero() {
+			t.Errorf("all time should query from zero, got %v", collector.lastSince)
+		}
+	}
+	if !strings.Contains(m.View(), "last 7 days") {
+		t.Error("header should show the current range")
+	}
+}
+
+func TestModel_StatsViewEmpty(t *testing.T) {
+	m := createModelWithProjects(1)
+	m.SetMetricsCollector(&stubMetricsCollector{})
+	m = pressStatsKey(t, m, 'S')
+
+	if !strings.Contains(m.View(), "No metrics recorded") {
+		t.Errorf("expected empty-state message:\n%s", m.View())
+	}
+}
+
+func TestModel_StatsIgnoresStaleResults(t *testing.T) {
+	m := createModelWithProjects(2)
+	m.SetMetricsCollector(&stubMetricsCollector{})
+	m.viewMode = viewModeStats
+	m.statsProject = m.projects[0]
+
+	updated, _ := m.Update(statsLoadedMsg{projectID: "b", days: m.statsDays, stats: &domain.ProjectStats{Samples: 9}})
+	if updated.(Model).stats != nil {
+		t.Error("stats for another project should be ignored")
+	}
+}
+
+func TestModel_StatsKeyWithoutCollector(t *testing.T) {
+	m := createModelWithProjects(1)
+	m = pressStatsKey(t, m, 'S')
+	if m.viewMode != viewModeNormal {
+		t.Errorf("viewMode = %v, want normal when metrics are unavailable", m.viewMode)
+	}
+}
+
+func TestModel_MetricsSampling(t *testing.T) {
+	m := createModelWithProjects(1)
+	if m.collectMetricsCmd() != nil || m.metricsTickCmd() != nil {
+		t.Error("no commands expected without a collector")
+	}
+
+	collector := &stubMetricsCollector{}
+	m.SetMetricsCollector(collector)
+	if msg := m.collectMetricsCmd()(); msg.(metricsCollectedMsg).count != 1 || collector.collects != 1 {
+		t.Errorf("collect msg = %+v, collects = %d", msg, collector.collects)
+	}
+	if m.metricsTickCmd() == nil {
+		t.Error("expected metrics tick without a daemon")
+	}
+
+	m.SetDaemon(&fakeDaemonController{})
+	if m.collectMetricsCmd() != nil || m.metricsTickCmd() != nil {
+		t.Error("the attached daemon samples metrics; TUI should not")
+	}
+}
@@ -1,9 +1,6 @@
 package tui
 
 import (
-	"github.com/charmbracelet/lipgloss"
-	"github.com/muesli/termenv"
-
 	"github.com/JeiKeiLim/vibe-dash/internal/shared/styles"
 )
 
@@ -24,15 +21,25 @@ var (
 
 	// hintStyle is used for dimmed help text.
 	hintStyle = styles.HintStyle
+
+	// popupStyle is the accent-bordered box of pickers.
+	popupStyle = styles.PopupStyle
+
+	// matchStyle highlights characters matched by a fuzzy search.
+	matchStyle = styles.MatchStyle
+
+	// headerStyle and footerStyle are the title and key hint bars of
+	// full-screen views (text view, stats view).
+	headerStyle = styles.HeaderStyle
+	footerStyle = styles.FooterStyle
 )
 
 // ============================================================================
 // Dashboard Component Styles (re-exported from shared package)
-// Uses 16-color ANSI palette for maximum terminal compatibility.
+// Colors come from the active theme (see styles.ApplyTheme).
 // ============================================================================
 
 // SelectedStyle is used for the currently selected row in lists.
-// Uses cyan background for visibility on both dark and light themes.
 var SelectedStyle = styles.SelectedStyle
 
 // WaitingStyle is used ONLY for the WAITING indicator (killer feature).
@@ -60,9 +67,30 @@ var WarningStyle = styles.WarningStyle
 var DimStyle = styles.DimStyle
 
 // BorderStyle is used for panel boundaries with square corners.
-// Uses ANSI color 8 (bright black/gray) for 16-color palette compatibility.
 var BorderStyle = styles.BorderStyle
 
+// syncStyles re-copies the re-exported styles after a theme change
+// (styles.ApplyTheme or styles.DisableColor). Called by Run before rendering.
+func syncStyles() {
+	UseColor = styles.UseColor
+	boxStyle = styles.BoxStyle
+	titleStyle = styles.TitleStyle
+	hintStyle = styles.HintStyle
+	popupStyle = styles.PopupStyle
+	matchStyle = styles.MatchStyle
+	headerStyle = styles.HeaderStyle
+	footerStyle = styles.FooterStyle
+	SelectedStyle = styles.SelectedStyle
+	WaitingStyle = styles.WaitingStyle
+	RecentStyle = styles.RecentStyle
+	ActiveStyle = styles.ActiveStyle
+	UncertainStyle = styles.UncertainStyle
+	FavoriteStyle = styles.FavoriteStyle
+	WarningStyle = styles.WarningStyle
+	DimStyle = styles.DimStyle
+	BorderStyle = styles.BorderStyle
+}
+
 // ============================================================================
 // Style Helper Functions
 // ============================================================================
@@ -94,9 +122,3 @@ func ApplyIndicator(indicatorType string, text string) string {
 		return text
 	}
 }
-
-func init() {
-	if !UseColor {
-		lipgloss.SetColorProfile(termenv.Ascii)
-	}
-}
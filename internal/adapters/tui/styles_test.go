@@ -296,7 +296,7 @@ func TestStylesRenderConsistently(t *testing.T) {
 // verifies the logic pattern rather than runtime re-initialization.
 func TestNoColorBehavior(t *testing.T) {
 	// When NO_COLOR is set, UseColor should be false
-	// and lipgloss.SetColorProfile(termenv.Ascii) should be called in init()
+	// and styles.DisableColor should have set lipgloss.SetColorProfile(termenv.Ascii)
 
 	// We can verify the logic pattern:
 	noColorSet := os.Getenv("NO_COLOR") != ""
@@ -309,7 +309,7 @@ func TestNoColorBehavior(t *testing.T) {
 			UseColor, expectedUseColor, os.Getenv("NO_COLOR"), os.Getenv("TERM"))
 	}
 
-	// When UseColor is false, the init() function calls lipgloss.SetColorProfile(termenv.Ascii)
+	// When UseColor is false, styles.DisableColor calls lipgloss.SetColorProfile(termenv.Ascii)
 	// which strips ANSI codes from all style renders.
 	// This is verified by running: NO_COLOR=1 go test -v ./internal/adapters/tui/...
 	// and observing that TestStylesProduceANSIWhenColorEnabled is skipped.
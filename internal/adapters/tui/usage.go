@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/usageformat"
+)
+
+// usageWindowDays is the window of token usage shown in the detail panel
+// and session picker (same default as 'vdash usage').
+const usageWindowDays = 30
+
+// usageMsg carries freshly read token usage keyed by project path.
+// Projects whose usage could not be read are left out.
+type usageMsg struct {
+	usage map[string]*domain.ProjectUsage
+}
+
+// SetUsageReader enables token usage and estimated cost in the detail
+// panel and session picker.
+// This is optional - if not set, no usage is shown.
+func (m *Model) SetUsageReader(r ports.UsageReader) {
+	m.usageReader = r
+}
+
+// getUsage returns the last read usage for a project (nil if unknown).
+// Used as the detail panel callback.
+func (m Model) getUsage(p *domain.Project) *domain.ProjectUsage {
+	if p == nil {
+		return nil
+	}
+	return m.usage[p.Path]
+}
+
+// usageCmd reads the token usage of all loaded projects. The reader caches
+// parsed logs, so repeated loads only parse newly appended lines.
+// Returns nil if no usage reader is set.
+func (m Model) usageCmd() tea.Cmd {
+	if m.usageReader == nil || len(m.projects) == 0 {
+		return nil
+	}
+	reader := m.usageReader
+	projects := append([]*domain.Project(nil), m.projects...)
+	return func() tea.Msg {
+		ctx := context.Background()
+		since := time.Now().AddDate(0, 0, -usageWindowDays)
+		usage := make(map[string]*domain.ProjectUsage, len(projects))
+		for _, p := range projects {
+			u, err := reader.ProjectUsage(ctx, p, since)
+			if err != nil {
+				slog.Debug("usage read failed", "path", p.Path, "error", err)
+				continue
+			}
+			usage[p.Path] = u
+		}
+		return usageMsg{usage: usage}
+	}
+}
+
+// handleUsage stores read usage.
+func (m *Model) handleUsage(msg usageMsg) {
+	for path, u := range msg.usage {
+		m.usage[path] = u
+	}
+}
+
+// sessionCost returns the estimated cost of a session in the picker, or ""
+// if its usage is unknown.
+func (m Model) sessionCost(session domain.LogSession) string {
+	u := m.getUsage(m.currentLogProject)
+	if u == nil {
+		return ""
+	}
+	s := u.Session(session.Path)
+	if s == nil {
+		return ""
+	}
+	return usageformat.FormatCost(s.Cost)
+}
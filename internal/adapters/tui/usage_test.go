@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// stubUsageReader returns usage keyed by project path.
+type stubUsageReader struct {
+	usage     map[string]*domain.ProjectUsage
+	lastSince time.Time
+}
+
+func (s *stubUsageReader) ProjectUsage(_ context.Context, p *domain.Project, since time.Time) (*domain.ProjectUsage, error) {
+	s.lastSince = since
+	if u, ok := s.usage[p.Path]; ok {
+		return u, nil
+	}
+	return &domain.ProjectUsage{ProjectID: p.ID, Since: since}, nil
+}
+
+func TestModel_UsageCmd_NilWithoutReader(t *testing.T) {
+	m := newGitTestModel(&domain.Project{ID: "a", Path: "/p/a"})
+	if m.usageCmd() != nil {
+		t.Error("expected nil cmd without usage reader")
+	}
+}
+
+func TestModel_UsageMsg_StoresUsage(t *testing.T) {
+	project := &domain.Project{ID: "a", Path: "/p/a"}
+	m := newGitTestModel(project)
+	reader := &stubUsageReader{usage: map[string]*domain.ProjectUsage{
+		project.Path: {
+			Tokens:   domain.TokenUsage{Output: 1000},
+			Cost:     0.42,
+			Sessions: []domain.SessionUsage{{SessionID: "s1", Path: "/logs/s1.jsonl", Cost: 0.42}},
+		},
+	}}
+	m.SetUsageReader(reader)
+
+	cmd := m.usageCmd()
+	if cmd == nil {
+		t.Fatal("expected usage cmd")
+	}
+	msg, ok := cmd().(usageMsg)
+	if !ok {
+		t.Fatalf("expected usageMsg, got %T", msg)
+	}
+	if want := time.Now().AddDate(0, 0, -usageWindowDays); reader.lastSince.Sub(want).Abs() > time.Minute {
+		t.Errorf("since = %v, want %d days ago", reader.lastSince, usageWindowDays)
+	}
+	newModel, _ := m.Update(msg)
+	updated := newModel.(Model)
+
+	if u := updated.getUsage(project); u == nil || u.Cost != 0.42 {
+		t.Errorf("getUsage = %+v, want cost 0.42", u)
+	}
+
+	// The session picker shows the cost of sessions with usage
+	updated.currentLogProject = project
+	if got := updated.sessionCost(domain.LogSession{ID: "s1", Path: "/logs/s1.jsonl"}); got != "$0.42" {
+		t.Errorf("sessionCost = %q, want $0.42", got)
+	}
+	if got := updated.sessionCost(domain.LogSession{ID: "s2", Path: "/logs/s2.jsonl"}); got != "" {
+		t.Errorf("sessionCost of unknown session = %q, want empty", got)
+	}
+
+	updated.showSessionPicker = true
+	updated.logSessions = []domain.LogSession{{ID: "s1", Path: "/logs/s1.jsonl", EntryCount: 12}}
+	if view := updated.renderSessionPicker(80, 20); !strings.Contains(view, "(12 entries)  $0.42") {
+		t.Errorf("session picker should show the cost, got:\n%s", view)
+	}
+}
@@ -19,6 +19,7 @@ const (
 	viewModeValidation
 	viewModeHibernated // Story 11.4: Hibernated projects view
 	viewModeTextView   // Story 12.1: Scrollable text view for logs
+	viewModeStats      // Progress metrics for the selected project
 )
 
 // InvalidProject represents a project with an inaccessible path
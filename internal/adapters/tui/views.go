@@ -90,43 +90,60 @@ func renderHelpOverlay(width, height int, cfg *ports.Config) string {
 		cfg = ports.NewConfig()
 	}
 
-	// Unicode arrows: \u2193 renders as ↓, \u2191 renders as ↑
+	// Effective bindings: config keybindings applied to the defaults
+	kb, _ := KeyBindingsFromConfig(cfg.KeyBindings)
+
 	content := strings.Join([]string{
 		"",
 		"Navigation",
 		">        Selection indicator (focused)",
-		"j/\u2193     Move down",
-		"k/\u2191     Move up",
+		helpLine(joinKeys(kb.Down, kb.DownArrow), "Move down"),
+		helpLine(joinKeys(kb.Up, kb.UpArrow), "Move up"),
+		helpLine(kb.Search, "Search projects"),
+		helpLine(kb.Palette, "Command palette"),
 		"",
 		"Actions",
 		"Enter    View logs (latest session)",
-		"L        View logs (pick session)",
-		"Shift+\u21b5 View logs (pick session)",
-		"d        Toggle detail panel",
-		"f        Toggle favorite",
-		"n        Edit notes",
-		"x        Remove project",
-		"H        Hibernate/Activate",
-		"a        Add project",
-		"r        Refresh/rescan",
+		helpLine(joinKeys(kb.LogOpenView, Keys{"L"}), "View logs (pick session)"),
+		helpLine(kb.ShiftEnter, "View logs (pick session)"),
+		helpLine(kb.Detail, "Toggle detail panel"),
+		helpLine(kb.Sprint, "Expand/collapse sprint tree"),
+		helpLine(kb.Favorite, "Toggle favorite"),
+		helpLine(kb.Notes, "Edit notes"),
+		helpLine(kb.Remove, "Remove project"),
+		helpLine(kb.StateToggle, "Hibernate/Activate"),
+		helpLine(kb.Add, "Add project"),
+		helpLine(kb.Refresh, "Refresh/rescan"),
+		"",
+		"Selection (actions apply to marked projects)",
+		helpLine(kb.Mark, "Mark/unmark project"),
+		helpLine(kb.MarkRange, "Mark range from last mark"),
+		helpLine(kb.MarkAll, "Mark/unmark all shown"),
 		"",
 		"Views",
-		"h        View hibernated projects",
+		helpLine(kb.Hibernated, "View hibernated projects"),
+		helpLine(kb.Sort, "Cycle sort mode"),
+		helpLine(kb.Group, "Cycle grouping"),
+		helpLine(kb.Stats, "Project stats ("+displayKeys(kb.StatsRange)+": range)"),
 		"",
 		"Log View (when viewing logs)",
-		"G        Jump to end, resume auto-scroll",
+		helpLine(kb.LogJumpEnd, "Jump to end, resume auto-scroll"),
 		"gg       Jump to top (double 'g')",
 		"Ctrl+D   Half-page down",
 		"Ctrl+U   Half-page up",
 		"/        Search logs",
 		"n/N      Next/prev match",
-		"S        Pick different session",
-		"q/Esc    Return to project list",
+		helpLine(joinKeys(kb.LogPrevPrompt, kb.LogNextPrompt), "Prev/next prompt (Claude Code)"),
+		helpLine(kb.LogToggleResult, "Expand/collapse tool result"),
+		helpLine(kb.LogToggleResults, "Expand/collapse all results"),
+		helpLine(joinKeys(kb.LogLoadEarlier, kb.LogLoadLater), "Load earlier/later entries (Claude Code)"),
+		helpLine(kb.LogSession, "Pick different session"),
+		helpLine(joinKeys(kb.Quit, kb.Escape), "Return to project list"),
 		"",
 		"General",
-		"?        Show this help",
-		"q        Quit",
-		"Esc      Cancel/close",
+		helpLine(kb.Help, "Show this help"),
+		helpLine(kb.Quit, "Quit"),
+		helpLine(kb.Escape, "Cancel/close"),
 		"",
 		"━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━",
 		"Settings",
@@ -164,6 +181,52 @@ func renderHelpOverlay(width, height int, cfg *ports.Config) string {
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, box)
 }
 
+// keyDisplayNames are help overlay labels for named keys.
+var keyDisplayNames = map[string]string{
+	"down":        "\u2193", // ↓
+	"up":          "\u2191", // ↑
+	"left":        "\u2190", // ←
+	"right":       "\u2192", // →
+	"esc":         "Esc",
+	"enter":       "Enter",
+	"shift+enter": "Shift+\u21b5", // Shift+↵
+	"tab":         "Tab",
+	" ":           "Space",
+}
+
+// displayKeys formats keys for the help overlay: "j/↓", "Ctrl+N".
+func displayKeys(keys Keys) string {
+	labels := make([]string, len(keys))
+	for i, k := range keys {
+		if label, ok := keyDisplayNames[k]; ok {
+			labels[i] = label
+			continue
+		}
+		// "ctrl+n" -> "Ctrl+N"; single keys keep their case
+		if mod, key, ok := strings.Cut(k, "+"); ok && len(mod) > 1 {
+			labels[i] = strings.ToUpper(mod[:1]) + mod[1:] + "+" + strings.ToUpper(key)
+			continue
+		}
+		labels[i] = k
+	}
+	return strings.Join(labels, "/")
+}
+
+// joinKeys returns the keys of a followed by the keys of b.
+func joinKeys(a, b Keys) Keys {
+	joined := make(Keys, 0, len(a)+len(b))
+	return append(append(joined, a...), b...)
+}
+
+// helpLine formats one help overlay row with keys padded to a fixed column.
+func helpLine(keys Keys, desc string) string {
+	label := displayKeys(keys)
+	if pad := 9 - lipgloss.Width(label); pad > 0 {
+		return label + strings.Repeat(" ", pad) + desc
+	}
+	return label + " " + desc
+}
+
 // renderTooSmallView renders a message when terminal is too small.
 func renderTooSmallView(width, height int) string {
 	msg := fmt.Sprintf("Terminal too small. Minimum %dx%d required.\nCurrent: %dx%d",
@@ -222,9 +285,15 @@ func renderNoteEditor(projectName string, input textinput.Model, width, height i
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, box)
 }
 
+// maxRemoveDialogNames caps how many project names the remove dialog lists
+// before collapsing the rest into "…and N more".
+const maxRemoveDialogNames = 5
+
 // renderConfirmRemoveDialog renders the inline remove confirmation dialog (Story 3.9).
+// A single name asks about that project; several names (bulk remove) are
+// aggregated into one prompt listing the projects.
 // Follows renderNoteEditor pattern for dialog styling and centering.
-func renderConfirmRemoveDialog(projectName string, width, height int) string {
+func renderConfirmRemoveDialog(projectNames []string, width, height int) string {
 	// Dialog dimensions - cap width at 60
 	dialogWidth := width - 4
 	if dialogWidth < 30 {
@@ -238,13 +307,21 @@ func renderConfirmRemoveDialog(projectName string, width, height int) string {
 	title := titleStyle.Render("Confirm Removal")
 
 	// Content
-	content := strings.Join([]string{
-		"",
-		fmt.Sprintf("Remove '%s' from tracking?", projectName),
-		"",
-		hintStyle.Render("[y] Yes  [n] No  [Esc] Cancel"),
-		"",
-	}, "\n")
+	body := []string{""}
+	if len(projectNames) == 1 {
+		body = append(body, fmt.Sprintf("Remove '%s' from tracking?", projectNames[0]))
+	} else {
+		body = append(body, fmt.Sprintf("Remove %d projects from tracking?", len(projectNames)), "")
+		for i, name := range projectNames {
+			if i == maxRemoveDialogNames {
+				body = append(body, hintStyle.Render(fmt.Sprintf("  …and %d more", len(projectNames)-i)))
+				break
+			}
+			body = append(body, "  • "+name)
+		}
+	}
+	body = append(body, "", hintStyle.Render("[y] Yes  [n] No  [Esc] Cancel"), "")
+	content := strings.Join(body, "\n")
 
 	// Dialog box style (same as help overlay)
 	box := boxStyle.
@@ -278,15 +355,32 @@ func formatMaxWidth(maxWidth int) string {
 
 // renderNarrowWarning renders the narrow terminal warning bar (Story 3.10 AC2).
 func renderNarrowWarning(width int) string {
-	// Use yellow (ANSI 3) for warning text, consistent with WarningStyle
-	warningStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("3")). // Yellow (ANSI 3)
-		Bold(true)
-
-	warning := warningStyle.Render(NarrowWarning())
+	warning := WarningStyle.Render(NarrowWarning())
 	return lipgloss.PlaceHorizontal(width, lipgloss.Center, warning)
 }
 
+// renderFilterBar renders the project list search bar: the query, a cursor
+// while typing, and the number of matching projects.
+func renderFilterBar(query string, typing bool, matched, total, width int) string {
+	left := "/" + query
+	hint := fmt.Sprintf("%d/%d", matched, total)
+	if typing {
+		left += "█" // Block cursor
+		hint = "enter apply · esc clear  " + hint
+	} else {
+		hint = "esc clear  " + hint
+	}
+	if matched == 0 {
+		left = WarningStyle.Render(left)
+	}
+
+	gap := width - lipgloss.Width(left) - lipgloss.Width(hint)
+	if gap < 1 {
+		gap = 1
+	}
+	return left + strings.Repeat(" ", gap) + DimStyle.Render(hint)
+}
+
 // renderHibernatedEmptyView renders the empty state for hibernated projects view (Story 11.4 AC6).
 func renderHibernatedEmptyView(width, height int) string {
 	content := strings.Join([]string{
@@ -218,7 +218,7 @@ func TestRenderHelpOverlay_ConfigPathsStillPresent(t *testing.T) {
 // ============================================================================
 
 func TestRenderConfirmRemoveDialog_ContainsProjectName(t *testing.T) {
-	output := renderConfirmRemoveDialog("my-project", 80, 24)
+	output := renderConfirmRemoveDialog([]string{"my-project"}, 80, 24)
 
 	if !strings.Contains(output, "my-project") {
 		t.Error("expected dialog to contain project name")
@@ -235,7 +235,7 @@ func TestRenderConfirmRemoveDialog_ContainsProjectName(t *testing.T) {
 }
 
 func TestRenderConfirmRemoveDialog_ContainsTitle(t *testing.T) {
-	output := renderConfirmRemoveDialog("test-project", 80, 24)
+	output := renderConfirmRemoveDialog([]string{"test-project"}, 80, 24)
 
 	if !strings.Contains(output, "Confirm Removal") {
 		t.Error("expected dialog to contain 'Confirm Removal' title")
@@ -243,7 +243,7 @@ func TestRenderConfirmRemoveDialog_ContainsTitle(t *testing.T) {
 }
 
 func TestRenderConfirmRemoveDialog_ContainsHints(t *testing.T) {
-	output := renderConfirmRemoveDialog("test-project", 80, 24)
+	output := renderConfirmRemoveDialog([]string{"test-project"}, 80, 24)
 
 	hints := []string{"[y]", "Yes", "[n]", "No", "[Esc]", "Cancel"}
 	for _, hint := range hints {
@@ -266,7 +266,7 @@ func TestRenderConfirmRemoveDialog_WidthConstraints(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			output := renderConfirmRemoveDialog("test", tt.width, 24)
+			output := renderConfirmRemoveDialog([]string{"test"}, tt.width, 24)
 			// Dialog should render without panic
 			if len(output) == 0 {
 				t.Error("expected non-empty output")
@@ -287,7 +287,7 @@ func TestRenderConfirmRemoveDialog_CenteredInTerminal(t *testing.T) {
 	}
 
 	for _, size := range sizes {
-		result := renderConfirmRemoveDialog("test-project", size.width, size.height)
+		result := renderConfirmRemoveDialog([]string{"test-project"}, size.width, size.height)
 
 		// Output should exist and contain key elements
 		if !strings.Contains(result, "Confirm Removal") {
@@ -314,7 +314,7 @@ func TestRenderConfirmRemoveDialog_EdgeCase_ZeroDimensions(t *testing.T) {
 
 	for _, tc := range edgeCases {
 		// Should not panic
-		result := renderConfirmRemoveDialog("test", tc.width, tc.height)
+		result := renderConfirmRemoveDialog([]string{"test"}, tc.width, tc.height)
 
 		// Should still contain content (lipgloss handles small sizes gracefully)
 		if len(result) == 0 {
@@ -325,7 +325,7 @@ func TestRenderConfirmRemoveDialog_EdgeCase_ZeroDimensions(t *testing.T) {
 
 func TestRenderConfirmRemoveDialog_LongProjectName(t *testing.T) {
 	longName := "this-is-a-very-long-project-name-that-should-still-render-correctly"
-	output := renderConfirmRemoveDialog(longName, 80, 24)
+	output := renderConfirmRemoveDialog([]string{longName}, 80, 24)
 
 	// Dialog should render without panic even with long names
 	if len(output) == 0 {
@@ -342,6 +342,26 @@ func TestRenderConfirmRemoveDialog_LongProjectName(t *testing.T) {
 	}
 }
 
+func TestRenderConfirmRemoveDialog_MultipleProjects(t *testing.T) {
+	names := []string{"alpha", "beta", "gamma", "delta", "epsilon", "zeta", "eta"}
+	output := renderConfirmRemoveDialog(names, 80, 30)
+
+	if !strings.Contains(output, "Remove 7 projects from tracking?") {
+		t.Error("expected aggregated prompt with project count")
+	}
+	for _, name := range names[:maxRemoveDialogNames] {
+		if !strings.Contains(output, name) {
+			t.Errorf("expected dialog to list %q", name)
+		}
+	}
+	if strings.Contains(output, "zeta") {
+		t.Error("expected names beyond the cap to be collapsed")
+	}
+	if !strings.Contains(output, "…and 2 more") {
+		t.Error("expected '…and 2 more' summary line")
+	}
+}
+
 // ============================================================================
 // Story 3.10: Narrow Warning Tests
 // ============================================================================
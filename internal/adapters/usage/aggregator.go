@@ -0,0 +1,222 @@
+package usage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/agentdetectors"
+	"github.com/JeiKeiLim/vibe-dash/internal/adapters/logreaders"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// Compile-time interface check
+var _ ports.UsageReader = (*Aggregator)(nil)
+
+// usageMarker prefilters lines: only assistant replies carry token usage.
+var usageMarker = []byte(`"usage"`)
+
+// Aggregator reads token usage from a project's Claude Code session logs
+// (including agent-*.jsonl sub-agent sessions, which are billed too).
+// Each log is parsed once; later calls only read lines appended since.
+type Aggregator struct {
+	cachePath string
+	prices    domain.PriceTable
+	logDir    func(projectPath string) string // Overridable for tests
+
+	mu    sync.Mutex
+	cache *usageCache // Loaded on first use
+}
+
+// NewAggregator creates an Aggregator caching parsed logs at cachePath
+// (empty = no persistent cache) and pricing usage with prices.
+func NewAggregator(cachePath string, prices domain.PriceTable) *Aggregator {
+	return &Aggregator{
+		cachePath: cachePath,
+		prices:    prices,
+		logDir:    logreaders.PathToClaudeDir,
+	}
+}
+
+// ProjectUsage aggregates the usage of the project's sessions on or after
+// the day containing since.
+func (a *Aggregator) ProjectUsage(ctx context.Context, project *domain.Project, since time.Time) (*domain.ProjectUsage, error) {
+	if project == nil {
+		return nil, fmt.Errorf("project is nil")
+	}
+
+	sessions, err := a.readSessions(ctx, a.logDir(project.Path))
+	if err != nil {
+		return nil, err
+	}
+	usage := domain.ComputeProjectUsage(project.ID, sessions, since, a.prices)
+	return &usage, nil
+}
+
+// readSessions brings the cache up to date with the logs in dir and
+// returns their usage.
+func (a *Aggregator) readSessions(ctx context.Context, dir string) ([]domain.SessionUsage, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Claude logs directory: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.cache == nil {
+		a.cache = loadCache(a.cachePath)
+	}
+
+	changed := false
+	present := make(map[string]bool)
+	var sessions []domain.SessionUsage
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".jsonl") {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		path := filepath.Join(dir, entry.Name())
+		present[path] = true
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		cached := a.cache.Files[path]
+		if cached == nil || cached.Size != info.Size() || !cached.ModTime.Equal(info.ModTime()) {
+			updated, err := a.update(ctx, path, info, cached)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				slog.Debug("skipping unreadable session log", "path", path, "error", err)
+				continue
+			}
+			a.cache.Files[path] = updated
+			cached = updated
+			changed = true
+		}
+		sessions = append(sessions, cached.sessionUsage(path))
+	}
+
+	// Forget logs deleted from this directory
+	for path := range a.cache.Files {
+		if filepath.Dir(path) == dir && !present[path] {
+			delete(a.cache.Files, path)
+			changed = true
+		}
+	}
+
+	if changed && a.cachePath != "" {
+		if err := a.cache.save(a.cachePath); err != nil {
+			slog.Warn("failed to save usage cache", "error", err)
+		}
+	}
+	return sessions, nil
+}
+
+// update parses the lines of path appended since cached was recorded.
+// A log that shrank or was rewritten in place is parsed from the start.
+func (a *Aggregator) update(ctx context.Context, path string, info os.FileInfo, cached *fileUsage) (*fileUsage, error) {
+	var fu fileUsage
+	if cached != nil && info.Size() > cached.Size {
+		fu = *cached
+		fu.Buckets = append([]cachedBucket(nil), cached.Buckets...)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if _, err := file.Seek(fu.Offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(file)
+	for n := 0; ; n++ {
+		if n%1000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			break // A trailing partial line is read again once complete
+		}
+		if err != nil {
+			return nil, err
+		}
+		fu.Offset += int64(len(line))
+		if bytes.Contains(line, usageMarker) {
+			fu.addLine(line, info.ModTime())
+		}
+	}
+
+	fu.Size = info.Size()
+	fu.ModTime = info.ModTime()
+	return &fu, nil
+}
+
+// addLine records the usage of one assistant log entry. Claude Code logs
+// each content block of a reply as its own entry sharing the reply's
+// message ID, so a reply's usage is replaced, not summed, until the next
+// reply starts.
+func (f *fileUsage) addLine(line []byte, modTime time.Time) {
+	entry, err := agentdetectors.ParseClaudeLine(bytes.TrimSpace(line))
+	if err != nil || !entry.IsAssistant() || entry.Usage.IsZero() {
+		return
+	}
+
+	at := entry.Timestamp
+	if at.IsZero() {
+		at = modTime
+	}
+	if f.Start.IsZero() || at.Before(f.Start) {
+		f.Start = at
+	}
+	if at.After(f.End) {
+		f.End = at
+	}
+
+	reply := pendingReply{
+		MessageID: entry.MessageID,
+		Model:     entry.Model,
+		Day:       at.Local().Format(cacheDayLayout),
+		Tokens: domain.TokenUsage{
+			Input:      entry.Usage.InputTokens,
+			Output:     entry.Usage.OutputTokens,
+			CacheRead:  entry.Usage.CacheReadInputTokens,
+			CacheWrite: entry.Usage.CacheCreationInputTokens,
+		},
+	}
+	if f.Pending != nil && reply.MessageID != "" && f.Pending.MessageID == reply.MessageID {
+		reply.Day = f.Pending.Day // A reply counts on the day it started
+		f.Pending = &reply
+		return
+	}
+	if f.Pending != nil {
+		f.add(f.Pending.Day, f.Pending.Model, f.Pending.Tokens)
+	}
+	f.Pending = &reply
+}
+
+// sessionIDFromPath returns the session ID of a log file (UUID.jsonl).
+func sessionIDFromPath(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), ".jsonl")
+}
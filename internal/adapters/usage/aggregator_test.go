@@ -0,0 +1,186 @@
+package usage
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+const sessionFile = "usage-session.jsonl"
+
+// fixtureLogDir copies the usage fixture logs into a temp dir, so tests can
+// append to them.
+func fixtureLogDir(t *testing.T) string {
+	t.Helper()
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("failed to get caller info")
+	}
+	src := filepath.Join(filepath.Dir(filename), "..", "..", "..", "test", "fixtures", "claude-usage")
+	dir := t.TempDir()
+	for _, name := range []string{sessionFile, "agent-usage-sub.jsonl"} {
+		data, err := os.ReadFile(filepath.Join(src, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// newTestAggregator returns an aggregator reading logDir for any project.
+func newTestAggregator(t *testing.T, logDir, cachePath string) *Aggregator {
+	t.Helper()
+	a := NewAggregator(cachePath, DefaultPrices())
+	a.logDir = func(string) string { return logDir }
+	return a
+}
+
+func projectUsage(t *testing.T, a *Aggregator) *domain.ProjectUsage {
+	t.Helper()
+	usage, err := a.ProjectUsage(context.Background(), &domain.Project{ID: "p1", Path: "/work"}, time.Time{})
+	if err != nil {
+		t.Fatalf("ProjectUsage() error = %v", err)
+	}
+	return usage
+}
+
+func appendLine(t *testing.T, path, line string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(line); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertCost(t *testing.T, what string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("%s cost = %v, want %v", what, got, want)
+	}
+}
+
+func TestAggregator_ProjectUsage(t *testing.T) {
+	dir := fixtureLogDir(t)
+	usage := projectUsage(t, newTestAggregator(t, dir, ""))
+
+	if len(usage.Sessions) != 2 {
+		t.Fatalf("got %d sessions, want main and sub-agent", len(usage.Sessions))
+	}
+	main := usage.Session(filepath.Join(dir, sessionFile))
+	if main == nil {
+		t.Fatal("main session missing")
+	}
+	if main.SessionID != "usage-session" || main.Messages != 3 {
+		t.Errorf("main session = %q with %d replies, want 3 (content blocks of a reply count once)", main.SessionID, main.Messages)
+	}
+	// Sonnet (14 in, 420 out, 2200 cache read, 200 cache write) plus haiku
+	// (100 in, 50 out); msg_01 counts once, with its last block's usage
+	want := domain.TokenUsage{Input: 114, Output: 470, CacheRead: 2200, CacheWrite: 200}
+	if main.Tokens != want {
+		t.Errorf("main tokens = %+v, want %+v", main.Tokens, want)
+	}
+	assertCost(t, "main", main.Cost, 0.007752+0.00035)
+	assertCost(t, "project", usage.Cost, 0.007752+0.00035+0.0225)
+
+	if len(usage.ByDay) != 2 {
+		t.Fatalf("ByDay = %+v, want 2 days", usage.ByDay)
+	}
+	if usage.ByModel[0].Model != "claude-opus-4-1-20250805" || len(usage.Unpriced) != 0 {
+		t.Errorf("ByModel[0] = %q, Unpriced = %v; want opus first, all priced", usage.ByModel[0].Model, usage.Unpriced)
+	}
+	for _, m := range usage.ByModel {
+		if m.Model == "<synthetic>" {
+			t.Error("replies without tokens should not be counted")
+		}
+	}
+}
+
+func TestAggregator_NoLogs(t *testing.T) {
+	a := newTestAggregator(t, filepath.Join(t.TempDir(), "missing"), "")
+	usage := projectUsage(t, a)
+	if len(usage.Sessions) != 0 || usage.Cost != 0 {
+		t.Errorf("expected empty usage, got %+v", usage)
+	}
+}
+
+func TestAggregator_IncrementalCache(t *testing.T) {
+	dir := fixtureLogDir(t)
+	cachePath := filepath.Join(t.TempDir(), CacheFileName)
+	path := filepath.Join(dir, sessionFile)
+
+	first := projectUsage(t, newTestAggregator(t, dir, cachePath))
+	if _, err := os.Stat(cachePath); err != nil {
+		t.Fatalf("cache not written: %v", err)
+	}
+
+	// Change already-parsed bytes without changing size or mod time: a new
+	// aggregator must use the cache instead of parsing the file again
+	info, _ := os.Stat(path)
+	data, _ := os.ReadFile(path)
+	data = bytes.Replace(data, []byte(`"input_tokens":100,`), []byte(`"input_tokens":900,`), 1)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	cached := projectUsage(t, newTestAggregator(t, dir, cachePath))
+	assertCost(t, "cached", cached.Cost, first.Cost)
+
+	// A partial line is not consumed until it is complete
+	a := newTestAggregator(t, dir, cachePath)
+	reply := `{"type":"assistant","timestamp":"2026-03-03T12:01:00.000Z","message":{"id":"msg_04","model":"claude-haiku-4-5-20251001","role":"assistant","content":[],"usage":{"input_tokens":1000,"output_tokens":10}}}`
+	appendLine(t, path, reply[:40])
+	assertCost(t, "partial", projectUsage(t, a).Cost, first.Cost)
+
+	// Only the appended lines are parsed: the edited tokens stay unseen
+	appendLine(t, path, reply[40:]+"\n")
+	usage := projectUsage(t, a)
+	assertCost(t, "appended", usage.Cost, first.Cost+0.00105)
+
+	// The next content block of the same reply replaces its usage
+	appendLine(t, path, `{"type":"assistant","timestamp":"2026-03-03T12:01:01.000Z","message":{"id":"msg_04","model":"claude-haiku-4-5-20251001","role":"assistant","content":[],"usage":{"input_tokens":1000,"output_tokens":90}}}`+"\n")
+	usage = projectUsage(t, newTestAggregator(t, dir, cachePath))
+	assertCost(t, "continued reply", usage.Cost, first.Cost+0.00145)
+	if got := usage.Session(path).Messages; got != 4 {
+		t.Errorf("messages = %d, want 4", got)
+	}
+
+	// A log rewritten smaller is parsed again from the start
+	if err := os.WriteFile(path, data[:bytes.IndexByte(data, '\n')+1], 0o644); err != nil {
+		t.Fatal(err)
+	}
+	usage = projectUsage(t, a)
+	if usage.Session(path) != nil {
+		t.Error("truncated log without replies should have no usage")
+	}
+}
+
+func TestAggregator_SinceFiltersDays(t *testing.T) {
+	dir := fixtureLogDir(t)
+	a := newTestAggregator(t, dir, "")
+	since := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+
+	usage, err := a.ProjectUsage(context.Background(), &domain.Project{ID: "p1", Path: "/work"}, since)
+	if err != nil {
+		t.Fatalf("ProjectUsage() error = %v", err)
+	}
+	if len(usage.Sessions) != 1 || len(usage.ByDay) != 1 {
+		t.Errorf("got %d sessions over %d days, want the haiku reply only", len(usage.Sessions), len(usage.ByDay))
+	}
+	assertCost(t, "since", usage.Cost, 0.00035)
+}
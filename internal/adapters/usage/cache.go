@@ -0,0 +1,139 @@
+package usage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// CacheFileName is the parsed usage cache in the vibe-dash directory.
+const CacheFileName = "usage-cache.json"
+
+// cacheVersion is bumped when the cache layout or parsing rules change;
+// caches of other versions are discarded and logs re-parsed.
+const cacheVersion = 1
+
+// cacheDayLayout is the format of a bucket's local calendar day.
+const cacheDayLayout = "2006-01-02"
+
+// usageCache maps session log paths to their parsed usage.
+type usageCache struct {
+	Version int                   `json:"version"`
+	Files   map[string]*fileUsage `json:"files"`
+}
+
+// fileUsage is the usage parsed from the first Offset bytes of a log.
+type fileUsage struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	Offset  int64     `json:"offset"` // Just past the last complete line parsed
+
+	Start   time.Time      `json:"start"`
+	End     time.Time      `json:"end"`
+	Buckets []cachedBucket `json:"buckets,omitempty"`
+
+	// The last reply is only committed to Buckets when the next one starts,
+	// since its content blocks may continue past Offset.
+	Pending *pendingReply `json:"pending,omitempty"`
+}
+
+// cachedBucket is one model's usage on one day.
+type cachedBucket struct {
+	Day      string            `json:"day"` // Local date, cacheDayLayout
+	Model    string            `json:"model"`
+	Tokens   domain.TokenUsage `json:"tokens"`
+	Messages int               `json:"messages"`
+}
+
+// pendingReply is the latest usage of an assistant reply still being read.
+type pendingReply struct {
+	MessageID string            `json:"message_id"`
+	Model     string            `json:"model"`
+	Day       string            `json:"day"`
+	Tokens    domain.TokenUsage `json:"tokens"`
+}
+
+// add commits a reply's usage to its day and model bucket.
+func (f *fileUsage) add(day, model string, tokens domain.TokenUsage) {
+	for i := range f.Buckets {
+		b := &f.Buckets[i]
+		if b.Day == day && b.Model == model {
+			b.Tokens = b.Tokens.Add(tokens)
+			b.Messages++
+			return
+		}
+	}
+	f.Buckets = append(f.Buckets, cachedBucket{Day: day, Model: model, Tokens: tokens, Messages: 1})
+}
+
+// sessionUsage converts the cached usage, including the pending reply,
+// to a domain session.
+func (f *fileUsage) sessionUsage(path string) domain.SessionUsage {
+	s := domain.SessionUsage{
+		SessionID: sessionIDFromPath(path),
+		Path:      path,
+		StartTime: f.Start,
+		EndTime:   f.End,
+	}
+	buckets := f.Buckets
+	if f.Pending != nil {
+		view := fileUsage{Buckets: append([]cachedBucket(nil), f.Buckets...)}
+		view.add(f.Pending.Day, f.Pending.Model, f.Pending.Tokens)
+		buckets = view.Buckets
+	}
+	for _, b := range buckets {
+		day, err := time.ParseInLocation(cacheDayLayout, b.Day, time.Local)
+		if err != nil {
+			continue
+		}
+		s.Buckets = append(s.Buckets, domain.UsageBucket{Day: day, Model: b.Model, Tokens: b.Tokens, Messages: b.Messages})
+	}
+	return s
+}
+
+// loadCache reads the cache at path. A missing, unreadable or outdated
+// cache yields an empty one.
+func loadCache(path string) *usageCache {
+	empty := &usageCache{Version: cacheVersion, Files: make(map[string]*fileUsage)}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return empty
+	}
+	var c usageCache
+	if err := json.Unmarshal(data, &c); err != nil || c.Version != cacheVersion || c.Files == nil {
+		return empty
+	}
+	return &c
+}
+
+// save writes the cache atomically (temp file + rename), so a concurrent
+// reader never sees a partial file.
+func (c *usageCache) save(path string) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode usage cache: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create usage cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), CacheFileName+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write usage cache: %w", err)
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write usage cache: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write usage cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Package usage computes token usage and estimated cost from Claude Code
+// session logs, caching parsed totals in ~/.vibe-dash/usage-cache.json.
+package usage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// PricesFileName is the user-editable price table in the vibe-dash directory.
+const PricesFileName = "prices.yaml"
+
+const pricesHeader = `# Model prices in USD per million tokens, used to estimate the cost of
+# agent sessions ('vdash usage' and the dashboard detail panel).
+# A model ID is priced by its longest matching prefix. Entries here override
+# the built-in defaults; add models or adjust prices as they change.
+`
+
+// priceEntry is one model's prices in prices.yaml.
+type priceEntry struct {
+	Input      float64 `yaml:"input"`
+	Output     float64 `yaml:"output"`
+	CacheRead  float64 `yaml:"cache_read"`
+	CacheWrite float64 `yaml:"cache_write"`
+}
+
+// pricesFile is the layout of prices.yaml.
+type pricesFile struct {
+	Models map[string]priceEntry `yaml:"models"`
+}
+
+// DefaultPrices returns the built-in Anthropic API list prices.
+func DefaultPrices() domain.PriceTable {
+	return domain.PriceTable{
+		"claude-opus-4":     {Input: 15, Output: 75, CacheRead: 1.5, CacheWrite: 18.75},
+		"claude-opus-4-5":   {Input: 5, Output: 25, CacheRead: 0.5, CacheWrite: 6.25},
+		"claude-sonnet-4":   {Input: 3, Output: 15, CacheRead: 0.3, CacheWrite: 3.75},
+		"claude-haiku-4-5":  {Input: 1, Output: 5, CacheRead: 0.1, CacheWrite: 1.25},
+		"claude-3-7-sonnet": {Input: 3, Output: 15, CacheRead: 0.3, CacheWrite: 3.75},
+		"claude-3-5-sonnet": {Input: 3, Output: 15, CacheRead: 0.3, CacheWrite: 3.75},
+		"claude-3-5-haiku":  {Input: 0.8, Output: 4, CacheRead: 0.08, CacheWrite: 1},
+		"claude-3-opus":     {Input: 15, Output: 75, CacheRead: 1.5, CacheWrite: 18.75},
+		"claude-3-haiku":    {Input: 0.25, Output: 1.25, CacheRead: 0.03, CacheWrite: 0.3},
+	}
+}
+
+// LoadPrices reads the price table at path on top of the defaults.
+// A missing file is created with the defaults so users have a template to edit.
+func LoadPrices(path string) (domain.PriceTable, error) {
+	prices := DefaultPrices()
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := writePrices(path, prices); err != nil {
+			return prices, err
+		}
+		return prices, nil
+	}
+	if err != nil {
+		return prices, fmt.Errorf("failed to read prices %s: %w", path, err)
+	}
+
+	var f pricesFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return prices, fmt.Errorf("invalid prices %s: %w", path, err)
+	}
+	for model, p := range f.Models {
+		prices[model] = domain.ModelPrice(p)
+	}
+	return prices, nil
+}
+
+// writePrices writes prices to path as a commented prices.yaml.
+func writePrices(path string, prices domain.PriceTable) error {
+	f := pricesFile{Models: make(map[string]priceEntry, len(prices))}
+	for model, p := range prices {
+		f.Models[model] = priceEntry(p)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(pricesHeader)
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(f); err != nil {
+		return fmt.Errorf("failed to encode prices: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create prices directory: %w", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write prices %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package usage
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadPrices_WritesDefaultsWhenMissing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PricesFileName)
+
+	prices, err := LoadPrices(path)
+	if err != nil {
+		t.Fatalf("LoadPrices() error = %v", err)
+	}
+	if len(prices) != len(DefaultPrices()) {
+		t.Errorf("got %d prices, want the defaults", len(prices))
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("prices file not written: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "# Model prices") || !strings.Contains(string(data), "claude-sonnet-4:") {
+		t.Errorf("unexpected prices file:\n%s", data)
+	}
+
+	// The written file reads back to the same table
+	again, err := LoadPrices(path)
+	if err != nil || again["claude-3-5-haiku"] != prices["claude-3-5-haiku"] {
+		t.Errorf("round trip = %+v, %v", again["claude-3-5-haiku"], err)
+	}
+}
+
+func TestLoadPrices_OverridesDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PricesFileName)
+	content := `models:
+  claude-sonnet-4:
+    input: 2
+    output: 10
+  my-local-model:
+    input: 0.1
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	prices, err := LoadPrices(path)
+	if err != nil {
+		t.Fatalf("LoadPrices() error = %v", err)
+	}
+	if p := prices["claude-sonnet-4"]; p.Input != 2 || p.Output != 10 || p.CacheRead != 0 {
+		t.Errorf("claude-sonnet-4 = %+v, want the file's prices", p)
+	}
+	if _, ok := prices.Lookup("my-local-model-v2"); !ok {
+		t.Error("models added in the file should be priced")
+	}
+	if _, ok := prices["claude-opus-4"]; !ok {
+		t.Error("defaults not in the file should be kept")
+	}
+}
+
+func TestLoadPrices_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PricesFileName)
+	if err := os.WriteFile(path, []byte("models: [not, a, map"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	prices, err := LoadPrices(path)
+	if err == nil {
+		t.Fatal("expected error for invalid YAML")
+	}
+	if len(prices) != len(DefaultPrices()) {
+		t.Error("defaults should be returned with the error")
+	}
+}
@@ -0,0 +1,321 @@
+// Package webhooks delivers project history events to configured HTTP endpoints.
+//
+// The Dispatcher is registered as a ports.ProjectEventListener. Each webhook
+// has its own bounded queue and worker, so a slow or failing endpoint delays
+// only its own deliveries and never the caller that recorded the event.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/apitypes"
+)
+
+const (
+	// DefaultQueueSize is the number of pending events kept per webhook.
+	// Events beyond this are dropped (and logged) instead of blocking.
+	DefaultQueueSize = 64
+
+	// deliveryTimeout bounds a single HTTP attempt.
+	deliveryTimeout = 10 * time.Second
+
+	// maxBackoff caps the exponential retry delay.
+	maxBackoff = time.Minute
+)
+
+// Request headers sent with each delivery.
+const (
+	HeaderEvent     = "X-Vdash-Event"     // Webhook event name
+	HeaderDelivery  = "X-Vdash-Delivery"  // Delivery ID (same as payload delivery_id)
+	HeaderSignature = "X-Vdash-Signature" // "sha256=<hex HMAC of body>" when a secret is set
+)
+
+// Dispatcher queues history events and POSTs them to matching webhooks.
+type Dispatcher struct {
+	repo            ports.ProjectRepository
+	waitingDetector ports.WaitingDetector
+	client          *http.Client
+	queueSize       int
+	hooks           []*hook
+
+	ctx    context.Context // Cancelled by Close to abort in-flight deliveries
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// hook is one configured webhook with its queue.
+type hook struct {
+	cfg   ports.WebhookConfig
+	name  string
+	queue chan domain.ProjectEvent
+}
+
+// Compile-time interface compliance check
+var _ ports.ProjectEventListener = (*Dispatcher)(nil)
+
+// Option is a functional option for configuring Dispatcher.
+type Option func(*Dispatcher)
+
+// WithWaitingDetector enables is_waiting/waiting_duration_minutes in payloads.
+func WithWaitingDetector(d ports.WaitingDetector) Option {
+	return func(disp *Dispatcher) {
+		disp.waitingDetector = d
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for deliveries (for testing).
+func WithHTTPClient(c *http.Client) Option {
+	return func(disp *Dispatcher) {
+		disp.client = c
+	}
+}
+
+// WithQueueSize sets the per-webhook queue capacity. Values < 1 are ignored.
+func WithQueueSize(n int) Option {
+	return func(disp *Dispatcher) {
+		if n > 0 {
+			disp.queueSize = n
+		}
+	}
+}
+
+// NewDispatcher creates a dispatcher and starts one worker per webhook.
+// repo is used to load the project summary sent with each event.
+// Call Close to stop the workers.
+func NewDispatcher(webhooks []ports.WebhookConfig, repo ports.ProjectRepository, opts ...Option) *Dispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		repo:      repo,
+		client:    &http.Client{},
+		queueSize: DefaultQueueSize,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	for _, cfg := range webhooks {
+		h := &hook{
+			cfg:   cfg,
+			name:  hookName(cfg),
+			queue: make(chan domain.ProjectEvent, d.queueSize),
+		}
+		d.hooks = append(d.hooks, h)
+
+		d.wg.Add(1)
+		go d.run(h)
+	}
+	return d
+}
+
+// OnProjectEvent queues the event for every webhook whose filter matches.
+// Never blocks: when a webhook's queue is full the event is dropped for it.
+func (d *Dispatcher) OnProjectEvent(event domain.ProjectEvent) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return
+	}
+
+	name := apitypes.WebhookEventName(event)
+	for _, h := range d.hooks {
+		if !MatchEvent(h.cfg.Events, name) {
+			continue
+		}
+		select {
+		case h.queue <- event:
+		default:
+			slog.Warn("webhook queue full, dropping event", "webhook", h.name, "event", name)
+		}
+	}
+}
+
+// Close stops accepting events and waits for queued deliveries to finish.
+// If ctx expires first, in-flight deliveries are aborted and ctx.Err() is returned.
+func (d *Dispatcher) Close(ctx context.Context) error {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return nil
+	}
+	d.closed = true
+	for _, h := range d.hooks {
+		close(h.queue)
+	}
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		d.cancel()
+		return nil
+	case <-ctx.Done():
+		d.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// MatchEvent reports whether an event name passes a webhook's filter.
+// An empty filter or "*" matches everything; "agent.*" matches by prefix.
+// Matching is case-insensitive.
+func MatchEvent(filter []string, event string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	event = strings.ToLower(event)
+	for _, f := range filter {
+		f = strings.ToLower(strings.TrimSpace(f))
+		switch {
+		case f == "*" || f == event:
+			return true
+		case strings.HasSuffix(f, ".*") && strings.HasPrefix(event, strings.TrimSuffix(f, "*")):
+			return true
+		}
+	}
+	return false
+}
+
+// run delivers a webhook's events in order until its queue is closed.
+func (d *Dispatcher) run(h *hook) {
+	defer d.wg.Done()
+	for event := range h.queue {
+		if d.ctx.Err() != nil {
+			continue // Closing with an expired deadline: drain without sending
+		}
+		d.deliver(h, event)
+	}
+}
+
+// deliver sends one event, retrying transient failures with exponential backoff.
+func (d *Dispatcher) deliver(h *hook, event domain.ProjectEvent) {
+	project, err := d.repo.FindByID(d.ctx, event.ProjectID)
+	if err != nil {
+		slog.Debug("webhook skipped, project not loadable", "webhook", h.name, "project", event.ProjectID, "error", err)
+		return
+	}
+
+	payload := apitypes.NewWebhookPayload(newDeliveryID(), event, apitypes.NewProjectSummary(d.ctx, project, d.waitingDetector, nil))
+	body, err := json.Marshal(payload)
+	if err != nil {
+		slog.Warn("webhook payload encoding failed", "webhook", h.name, "error", err)
+		return
+	}
+
+	base := time.Duration(h.cfg.RetryBackoffSeconds) * time.Second
+	for attempt := 0; ; attempt++ {
+		retry, err := d.post(h, payload, body)
+		if err == nil {
+			return
+		}
+		if !retry || attempt >= h.cfg.MaxRetries {
+			slog.Warn("webhook delivery failed",
+				"webhook", h.name, "event", payload.Event, "attempts", attempt+1, "error", err)
+			return
+		}
+
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-time.After(backoffDelay(base, attempt)):
+		}
+	}
+}
+
+// post performs one delivery attempt. retry reports whether a failure is
+// worth retrying (network errors, 429 and 5xx responses).
+func (d *Dispatcher) post(h *hook, payload apitypes.WebhookPayload, body []byte) (retry bool, err error) {
+	ctx, cancel := context.WithTimeout(d.ctx, deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "vibe-dash-webhook/"+apitypes.APIVersion)
+	req.Header.Set(HeaderEvent, payload.Event)
+	req.Header.Set(HeaderDelivery, payload.DeliveryID)
+	if h.cfg.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(h.cfg.Secret, body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("endpoint returned %s", resp.Status)
+	default:
+		return false, fmt.Errorf("endpoint returned %s", resp.Status)
+	}
+}
+
+// Sign returns the X-Vdash-Signature value for body: "sha256=" followed by
+// the hex HMAC-SHA256 of body keyed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// backoffDelay returns base doubled once per previous retry, capped at maxBackoff.
+func backoffDelay(base time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 0; i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
+
+// hookName labels a webhook in logs: its name, else the URL host.
+func hookName(cfg ports.WebhookConfig) string {
+	if cfg.Name != "" {
+		return cfg.Name
+	}
+	if u, err := url.Parse(cfg.URL); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return cfg.URL
+}
+
+// newDeliveryID returns a random 16-byte hex identifier.
+func newDeliveryID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,274 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/apitypes"
+	"github.com/JeiKeiLim/vibe-dash/internal/shared/testhelpers"
+)
+
+// receivedRequest is one delivery captured by testReceiver.
+type receivedRequest struct {
+	header  http.Header
+	body    []byte
+	payload apitypes.WebhookPayload
+}
+
+// testReceiver is an httptest endpoint that records deliveries and replies
+// with the status codes in statuses (200 once exhausted).
+type testReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []receivedRequest
+	statuses []int
+	got      chan struct{}
+}
+
+func newTestReceiver(t *testing.T, statuses ...int) *testReceiver {
+	t.Helper()
+	r := &testReceiver{statuses: statuses, got: make(chan struct{}, 100)}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		var payload apitypes.WebhookPayload
+		_ = json.Unmarshal(body, &payload)
+
+		r.mu.Lock()
+		r.requests = append(r.requests, receivedRequest{header: req.Header.Clone(), body: body, payload: payload})
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.mu.Unlock()
+
+		w.WriteHeader(status)
+		r.got <- struct{}{}
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// waitFor blocks until n requests have arrived or the test times out.
+func (r *testReceiver) waitFor(t *testing.T, n int) []receivedRequest {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-r.got:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for request %d of %d", i+1, n)
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+func newTestProject() *domain.Project {
+	return &domain.Project{
+		ID:             domain.GenerateID("/work/alpha"),
+		Name:           "alpha",
+		Path:           "/work/alpha",
+		CurrentStage:   domain.StageImplement,
+		DetectedMethod: "speckit",
+		State:          domain.StateActive,
+		LastActivityAt: time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func newTestDispatcher(t *testing.T, hooks []ports.WebhookConfig, opts ...Option) (*Dispatcher, *domain.Project) {
+	t.Helper()
+	p := newTestProject()
+	repo := testhelpers.NewMockRepository().WithProjects([]*domain.Project{p})
+	d := NewDispatcher(hooks, repo, opts...)
+	t.Cleanup(func() { _ = d.Close(context.Background()) })
+	return d, p
+}
+
+func TestDispatcher_DeliversPayload(t *testing.T) {
+	recv := newTestReceiver(t)
+	d, p := newTestDispatcher(t, []ports.WebhookConfig{{URL: recv.URL, Secret: "s3cret"}})
+
+	at := time.Date(2026, 1, 11, 14, 22, 0, 0, time.UTC)
+	d.OnProjectEvent(domain.NewProjectEvent(p.ID, domain.EventAgentStateChanged, "Working", "Waiting", "Claude Code", at))
+
+	req := recv.waitFor(t, 1)[0]
+	got := req.payload
+	if got.APIVersion != "v1" || got.Event != "agent.waiting" || got.OccurredAt != "2026-01-11T14:22:00Z" {
+		t.Errorf("unexpected payload header fields: %+v", got)
+	}
+	if got.From == nil || *got.From != "Working" || got.To == nil || *got.To != "Waiting" {
+		t.Errorf("unexpected from/to: %v/%v", got.From, got.To)
+	}
+	if got.Project.Name != "alpha" || got.Project.Stage != "implement" {
+		t.Errorf("unexpected project summary: %+v", got.Project)
+	}
+	if got.DeliveryID == "" || req.header.Get(HeaderDelivery) != got.DeliveryID {
+		t.Errorf("delivery id mismatch: header %q, body %q", req.header.Get(HeaderDelivery), got.DeliveryID)
+	}
+	if req.header.Get(HeaderEvent) != "agent.waiting" {
+		t.Errorf("%s = %q", HeaderEvent, req.header.Get(HeaderEvent))
+	}
+	if sig := req.header.Get(HeaderSignature); sig != Sign("s3cret", req.body) {
+		t.Errorf("signature %q does not match body", sig)
+	}
+}
+
+func TestDispatcher_NoSecretNoSignature(t *testing.T) {
+	recv := newTestReceiver(t)
+	d, p := newTestDispatcher(t, []ports.WebhookConfig{{URL: recv.URL}})
+
+	d.OnProjectEvent(domain.NewProjectEvent(p.ID, domain.EventHibernated, "active", "hibernated", "", time.Now()))
+
+	req := recv.waitFor(t, 1)[0]
+	if req.header.Get(HeaderSignature) != "" {
+		t.Error("expected no signature without a secret")
+	}
+	if req.payload.Event != "project.hibernated" {
+		t.Errorf("event = %q, want project.hibernated", req.payload.Event)
+	}
+}
+
+func TestDispatcher_EventFilter(t *testing.T) {
+	recv := newTestReceiver(t)
+	d, p := newTestDispatcher(t, []ports.WebhookConfig{{URL: recv.URL, Events: []string{"stage.changed"}}})
+
+	d.OnProjectEvent(domain.NewProjectEvent(p.ID, domain.EventAgentStateChanged, "", "Working", "", time.Now()))
+	d.OnProjectEvent(domain.NewProjectEvent(p.ID, domain.EventStageChanged, "Plan", "Implement", "", time.Now()))
+
+	if err := d.Close(context.Background()); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+	reqs := recv.waitFor(t, 1)
+	if len(reqs) != 1 || reqs[0].payload.Event != "stage.changed" {
+		t.Errorf("expected only stage.changed, got %d requests", len(reqs))
+	}
+}
+
+func TestDispatcher_RetriesTransientFailures(t *testing.T) {
+	recv := newTestReceiver(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	d, p := newTestDispatcher(t, []ports.WebhookConfig{{URL: recv.URL, MaxRetries: 3}})
+
+	d.OnProjectEvent(domain.NewProjectEvent(p.ID, domain.EventStageChanged, "Plan", "Tasks", "", time.Now()))
+
+	reqs := recv.waitFor(t, 3)
+	if reqs[0].payload.DeliveryID != reqs[2].payload.DeliveryID {
+		t.Error("retries should reuse the delivery id")
+	}
+}
+
+func TestDispatcher_GivesUpAfterMaxRetries(t *testing.T) {
+	recv := newTestReceiver(t, 500, 500, 500, 500)
+	d, p := newTestDispatcher(t, []ports.WebhookConfig{{URL: recv.URL, MaxRetries: 1}})
+
+	d.OnProjectEvent(domain.NewProjectEvent(p.ID, domain.EventStageChanged, "Plan", "Tasks", "", time.Now()))
+	if err := d.Close(context.Background()); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+
+	if reqs := recv.waitFor(t, 2); len(reqs) != 2 {
+		t.Errorf("expected 2 attempts, got %d", len(reqs))
+	}
+}
+
+func TestDispatcher_NoRetryOnClientError(t *testing.T) {
+	recv := newTestReceiver(t, http.StatusBadRequest)
+	d, p := newTestDispatcher(t, []ports.WebhookConfig{{URL: recv.URL, MaxRetries: 3}})
+
+	d.OnProjectEvent(domain.NewProjectEvent(p.ID, domain.EventStageChanged, "Plan", "Tasks", "", time.Now()))
+	if err := d.Close(context.Background()); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+
+	if reqs := recv.waitFor(t, 1); len(reqs) != 1 {
+		t.Errorf("expected a single attempt, got %d", len(reqs))
+	}
+}
+
+func TestDispatcher_SlowEndpointDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	d, p := newTestDispatcher(t, []ports.WebhookConfig{{URL: slow.URL}}, WithQueueSize(1))
+
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		d.OnProjectEvent(domain.NewProjectEvent(p.ID, domain.EventStageChanged, "Plan", "Tasks", "", time.Now()))
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("OnProjectEvent blocked for %v", elapsed)
+	}
+
+	// Close with an expired deadline aborts the stuck delivery
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := d.Close(ctx); err == nil {
+		t.Error("expected Close to report the expired deadline")
+	}
+}
+
+func TestDispatcher_IgnoresEventsAfterClose(t *testing.T) {
+	d, p := newTestDispatcher(t, []ports.WebhookConfig{{URL: "http://127.0.0.1:1"}})
+	if err := d.Close(context.Background()); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+	// Must not panic on a closed queue
+	d.OnProjectEvent(domain.NewProjectEvent(p.ID, domain.EventStageChanged, "Plan", "Tasks", "", time.Now()))
+}
+
+func TestMatchEvent(t *testing.T) {
+	tests := []struct {
+		filter []string
+		event  string
+		want   bool
+	}{
+		{nil, "agent.waiting", true},
+		{[]string{"*"}, "stage.changed", true},
+		{[]string{"agent.waiting"}, "agent.waiting", true},
+		{[]string{"Agent.Waiting"}, "agent.waiting", true},
+		{[]string{"agent.waiting"}, "agent.working", false},
+		{[]string{"agent.*"}, "agent.working", true},
+		{[]string{"agent.*"}, "agentx.working", false},
+		{[]string{"stage.changed", "project.hibernated"}, "project.hibernated", true},
+	}
+	for _, tt := range tests {
+		if got := MatchEvent(tt.filter, tt.event); got != tt.want {
+			t.Errorf("MatchEvent(%v, %q) = %v, want %v", tt.filter, tt.event, got, tt.want)
+		}
+	}
+}
+
+func TestBackoffDelay(t *testing.T) {
+	base := 2 * time.Second
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 2 * time.Second},
+		{1, 4 * time.Second},
+		{2, 8 * time.Second},
+		{10, maxBackoff},
+	}
+	for _, tt := range tests {
+		if got := backoffDelay(base, tt.attempt); got != tt.want {
+			t.Errorf("backoffDelay(%v, %d) = %v, want %v", base, tt.attempt, got, tt.want)
+		}
+	}
+	if got := backoffDelay(0, 3); got != 0 {
+		t.Errorf("zero base should not wait, got %v", got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
 )
 
@@ -117,6 +118,50 @@ func (l *ViperLoader) Save(ctx context.Context, config *ports.Config) error {
 	}
 	l.v.Set("settings.max_content_width", config.MaxContentWidth)                  // Story 8.10
 	l.v.Set("settings.stage_refresh_interval", config.StageRefreshIntervalSeconds) // Story 8.11
+	l.v.Set("settings.sort_mode", config.SortMode)
+	l.v.Set("settings.group_by", config.GroupBy)
+	l.v.Set("settings.theme", config.Theme)
+
+	// Notifications (only the command when set, to keep the file tidy)
+	l.v.Set("notifications.enabled", config.Notifications.Enabled)
+	l.v.Set("notifications.bell", config.Notifications.Bell)
+	l.v.Set("notifications.desktop", config.Notifications.Desktop)
+	if config.Notifications.Command != "" {
+		l.v.Set("notifications.command", config.Notifications.Command)
+	}
+
+	// Workspace roots (only when configured)
+	if len(config.WorkspaceRoots) > 0 {
+		l.v.Set("workspace_roots", config.WorkspaceRoots)
+	}
+
+	// Webhooks (only when configured, so the default file stays tidy)
+	if len(config.Webhooks) > 0 {
+		webhooks := make([]map[string]interface{}, 0, len(config.Webhooks))
+		for _, wh := range config.Webhooks {
+			entry := map[string]interface{}{
+				"url":                   wh.URL,
+				"max_retries":           wh.MaxRetries,
+				"retry_backoff_seconds": wh.RetryBackoffSeconds,
+			}
+			if wh.Name != "" {
+				entry["name"] = wh.Name
+			}
+			if len(wh.Events) > 0 {
+				entry["events"] = wh.Events
+			}
+			if wh.Secret != "" {
+				entry["secret"] = wh.Secret
+			}
+			webhooks = append(webhooks, entry)
+		}
+		l.v.Set("webhooks", webhooks)
+	}
+
+	// Key bindings (only when overridden)
+	if len(config.KeyBindings) > 0 {
+		l.v.Set("keybindings", config.KeyBindings)
+	}
 
 	// Projects - directory_name as key, do NOT write deprecated fields (Subtask 2.4)
 	projects := make(map[string]interface{})
@@ -131,6 +176,9 @@ func (l *ViperLoader) Save(ctx context.Context, config *ports.Config) error {
 		if pc.IsFavorite {
 			projectData["favorite"] = pc.IsFavorite
 		}
+		if pc.Tag != "" {
+			projectData["tag"] = pc.Tag
+		}
 		// NOTE: HibernationDays and AgentWaitingThresholdMinutes are NOT written
 		// These are deprecated - use per-project config files instead (Story 3.5.3)
 		projects[dirName] = projectData
@@ -167,6 +215,35 @@ settings:
   # use_emoji: true  # true = force emoji, false = force fallback, omit = auto-detect
   # max_content_width: %d  # 0 = unlimited, >0 = cap content width (default: 120)
   # stage_refresh_interval: 30  # seconds, 0 = disabled (default: 30)
+  # sort_mode: name  # name, waiting, recent, stage, method, waiting-duration
+  # group_by: none   # none, method, stage, tag (set "tag:" on project entries)
+  # theme: dark      # dark, light, high-contrast, solarized, monochrome, or a file in ~/.vibe-dash/themes/
+
+# Alerts when an agent has been waiting for agent_waiting_threshold_minutes
+notifications:
+  enabled: false
+  bell: true      # terminal bell
+  desktop: true   # notify-send (Linux) / osascript (macOS)
+  # command: 'say "{{.Project}} is waiting"'  # {{.Project}} {{.Path}} {{.Tool}} {{.Duration}} {{.Minutes}}
+
+# Directories scanned for new projects (see 'vdash scan')
+# workspace_roots:
+#   - ~/work
+#   - ~/oss
+
+# HTTP endpoints called on project/agent events (POST, JSON body)
+# webhooks:
+#   - url: https://example.com/hooks/vdash
+#     events: [agent.waiting, stage.changed, project.hibernated]  # omit for all
+#     secret: change-me          # HMAC-SHA256 signature in X-Vdash-Signature
+#     max_retries: 3
+#     retry_backoff_seconds: 2   # doubles per retry
+
+# Dashboard key overrides: action → key or list of keys (see '?' in the dashboard)
+# keybindings:
+#   down: [j, ctrl+n]
+#   up: [k, ctrl+p]
+#   hibernated: l
 
 # Projects map: directory_name → project info
 # Keys are subdirectory names under ~/.vibe-dash/
@@ -218,6 +295,36 @@ func (l *ViperLoader) mapViperToConfig() *ports.Config {
 	if l.v.IsSet("settings.stage_refresh_interval") {
 		cfg.StageRefreshIntervalSeconds = l.v.GetInt("settings.stage_refresh_interval")
 	}
+	if l.v.IsSet("settings.sort_mode") {
+		cfg.SortMode = l.v.GetString("settings.sort_mode")
+	}
+	if l.v.IsSet("settings.group_by") {
+		cfg.GroupBy = l.v.GetString("settings.group_by")
+	}
+	if l.v.IsSet("settings.theme") {
+		cfg.Theme = l.v.GetString("settings.theme")
+	}
+
+	// Notifications
+	if l.v.IsSet("notifications.enabled") {
+		cfg.Notifications.Enabled = l.v.GetBool("notifications.enabled")
+	}
+	if l.v.IsSet("notifications.bell") {
+		cfg.Notifications.Bell = l.v.GetBool("notifications.bell")
+	}
+	if l.v.IsSet("notifications.desktop") {
+		cfg.Notifications.Desktop = l.v.GetBool("notifications.desktop")
+	}
+	if l.v.IsSet("notifications.command") {
+		cfg.Notifications.Command = l.v.GetString("notifications.command")
+	}
+
+	if l.v.IsSet("workspace_roots") {
+		cfg.WorkspaceRoots = l.v.GetStringSlice("workspace_roots")
+	}
+
+	cfg.Webhooks = l.mapWebhooks()
+	cfg.KeyBindings = l.mapKeyBindings()
 
 	// Map projects if present
 	// In v2 format, the map key IS the directory_name (Subtask 2.2)
@@ -241,6 +348,9 @@ func (l *ViperLoader) mapViperToConfig() *ports.Config {
 		if fav, ok := projectData["favorite"].(bool); ok {
 			pc.IsFavorite = fav
 		}
+		if tag, ok := projectData["tag"].(string); ok {
+			pc.Tag = tag
+		}
 		// Handle optional overrides (pointer fields) - DEPRECATED but kept for backward compatibility
 		// Log warning when deprecated fields are read (Subtask 5.5)
 		if hd, ok := projectData["hibernation_days"].(int); ok {
@@ -260,6 +370,78 @@ func (l *ViperLoader) mapViperToConfig() *ports.Config {
 	return cfg
 }
 
+// webhookEntry is the YAML shape of one webhooks list item.
+// Retry fields are pointers so omitted values can take defaults.
+type webhookEntry struct {
+	Name                string   `mapstructure:"name"`
+	URL                 string   `mapstructure:"url"`
+	Events              []string `mapstructure:"events"`
+	Secret              string   `mapstructure:"secret"`
+	MaxRetries          *int     `mapstructure:"max_retries"`
+	RetryBackoffSeconds *int     `mapstructure:"retry_backoff_seconds"`
+}
+
+// mapWebhooks reads the "webhooks" list into WebhookConfig entries.
+// A malformed list is logged and ignored; omitted retry settings get defaults.
+func (l *ViperLoader) mapWebhooks() []ports.WebhookConfig {
+	if !l.v.IsSet("webhooks") {
+		return nil
+	}
+
+	var entries []webhookEntry
+	if err := l.v.UnmarshalKey("webhooks", &entries); err != nil {
+		slog.Warn("invalid webhooks section, ignoring", "path", l.configPath, "error", err)
+		return nil
+	}
+
+	webhooks := make([]ports.WebhookConfig, 0, len(entries))
+	for _, e := range entries {
+		wh := ports.WebhookConfig{
+			Name:                e.Name,
+			URL:                 e.URL,
+			Events:              e.Events,
+			Secret:              e.Secret,
+			MaxRetries:          ports.DefaultWebhookMaxRetries,
+			RetryBackoffSeconds: ports.DefaultWebhookRetryBackoffSeconds,
+		}
+		if e.MaxRetries != nil {
+			wh.MaxRetries = *e.MaxRetries
+		}
+		if e.RetryBackoffSeconds != nil {
+			wh.RetryBackoffSeconds = *e.RetryBackoffSeconds
+		}
+		webhooks = append(webhooks, wh)
+	}
+	return webhooks
+}
+
+// mapKeyBindings reads the "keybindings" section. Each action maps to a
+// single key or a list of keys. Returns nil if the section is absent.
+func (l *ViperLoader) mapKeyBindings() map[string][]string {
+	if !l.v.IsSet("keybindings") {
+		return nil
+	}
+
+	bindings := make(map[string][]string)
+	for action, v := range l.v.GetStringMap("keybindings") {
+		switch keys := v.(type) {
+		case string:
+			bindings[action] = []string{keys}
+		case []string:
+			bindings[action] = keys
+		case []interface{}:
+			list := make([]string, 0, len(keys))
+			for _, k := range keys {
+				list = append(list, fmt.Sprint(k))
+			}
+			bindings[action] = list
+		default:
+			slog.Warn("invalid key binding, ignoring", "path", l.configPath, "action", action, "value", v)
+		}
+	}
+	return bindings
+}
+
 // fixInvalidValues corrects invalid config values by replacing with defaults.
 // Logs a warning for each corrected value with path context (AC1, AC2).
 func (l *ViperLoader) fixInvalidValues(cfg *ports.Config) *ports.Config {
@@ -315,6 +497,19 @@ func (l *ViperLoader) fixInvalidValues(cfg *ports.Config) *ports.Config {
 		}
 	}
 
+	// Drop invalid webhooks rather than calling an unintended endpoint
+	valid := cfg.Webhooks[:0]
+	for i, wh := range cfg.Webhooks {
+		if err := wh.Validate(); err != nil {
+			slog.Warn("invalid webhook, ignoring",
+				"path", l.configPath,
+				"index", i, "error", err)
+			continue
+		}
+		valid = append(valid, wh)
+	}
+	cfg.Webhooks = valid
+
 	// Fix invalid storage_version (Subtask 2.6)
 	if cfg.StorageVersion != currentStorageVersion {
 		slog.Warn("invalid storage_version, using default",
@@ -342,6 +537,21 @@ func (l *ViperLoader) fixInvalidValues(cfg *ports.Config) *ports.Config {
 		cfg.MaxContentWidth = defaults.MaxContentWidth
 	}
 
+	if _, err := domain.ParseSortMode(cfg.SortMode); err != nil {
+		slog.Warn("invalid sort_mode, using default",
+			"path", l.configPath,
+			"invalid_value", cfg.SortMode,
+			"default_value", defaults.SortMode)
+		cfg.SortMode = defaults.SortMode
+	}
+	if _, err := domain.ParseGroupBy(cfg.GroupBy); err != nil {
+		slog.Warn("invalid group_by, using default",
+			"path", l.configPath,
+			"invalid_value", cfg.GroupBy,
+			"default_value", defaults.GroupBy)
+		cfg.GroupBy = defaults.GroupBy
+	}
+
 	// Fix invalid stage_refresh_interval (Story 8.11)
 	if cfg.StageRefreshIntervalSeconds < 0 {
 		slog.Warn("invalid stage_refresh_interval, using default",
@@ -1388,3 +1388,299 @@ func TestViperLoader_Save_StageRefreshInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestViperLoader_Load_Notifications(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	validConfig := `storage_version: 2
+
+settings:
+  hibernation_days: 14
+
+notifications:
+  enabled: true
+  bell: false
+  command: "say {{.Project}} is waiting"
+
+projects: {}
+`
+	if err := os.WriteFile(configPath, []byte(validConfig), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	cfg, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	want := ports.NotificationConfig{Enabled: true, Bell: false, Desktop: true, Command: "say {{.Project}} is waiting"}
+	if cfg.Notifications != want {
+		t.Errorf("Notifications = %+v, want %+v", cfg.Notifications, want)
+	}
+}
+
+func TestViperLoader_Save_Notifications(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+
+	cfg := ports.NewConfig()
+	cfg.Notifications = ports.NotificationConfig{Enabled: true, Desktop: false, Bell: true, Command: "notify.sh {{.Project}}"}
+
+	if err := NewViperLoader(configPath).Save(context.Background(), cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	cfg2, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg2.Notifications != cfg.Notifications {
+		t.Errorf("Notifications = %+v, want %+v", cfg2.Notifications, cfg.Notifications)
+	}
+}
+
+func TestViperLoader_Load_Webhooks(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+
+	validConfig := `storage_version: 2
+
+webhooks:
+  - url: https://chat.example.com/hooks/vdash
+    events: [agent.waiting, stage.changed]
+    secret: s3cret
+    max_retries: 5
+  - name: local
+    url: http://127.0.0.1:9000/events
+    retry_backoff_seconds: 0
+  - url: ftp://not-http.example.com
+
+projects: {}
+`
+	if err := os.WriteFile(configPath, []byte(validConfig), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	cfg, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	// Invalid URL entry is dropped
+	if len(cfg.Webhooks) != 2 {
+		t.Fatalf("expected 2 webhooks, got %d: %+v", len(cfg.Webhooks), cfg.Webhooks)
+	}
+	first := cfg.Webhooks[0]
+	if first.URL != "https://chat.example.com/hooks/vdash" || first.Secret != "s3cret" || first.MaxRetries != 5 {
+		t.Errorf("unexpected first webhook: %+v", first)
+	}
+	if len(first.Events) != 2 || first.Events[0] != "agent.waiting" {
+		t.Errorf("unexpected events: %v", first.Events)
+	}
+	if first.RetryBackoffSeconds != ports.DefaultWebhookRetryBackoffSeconds {
+		t.Errorf("RetryBackoffSeconds = %d, want default", first.RetryBackoffSeconds)
+	}
+	second := cfg.Webhooks[1]
+	if second.Name != "local" || second.RetryBackoffSeconds != 0 || second.MaxRetries != ports.DefaultWebhookMaxRetries {
+		t.Errorf("unexpected second webhook: %+v", second)
+	}
+}
+
+func TestViperLoader_Save_Webhooks(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+
+	cfg := ports.NewConfig()
+	cfg.Webhooks = []ports.WebhookConfig{{
+		Name:                "team",
+		URL:                 "https://chat.example.com/hooks/vdash",
+		Events:              []string{"agent.*"},
+		Secret:              "s3cret",
+		MaxRetries:          2,
+		RetryBackoffSeconds: 4,
+	}}
+
+	if err := NewViperLoader(configPath).Save(context.Background(), cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	cfg2, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(cfg2.Webhooks) != 1 {
+		t.Fatalf("expected 1 webhook, got %d", len(cfg2.Webhooks))
+	}
+	got := cfg2.Webhooks[0]
+	want := cfg.Webhooks[0]
+	if got.Name != want.Name || got.URL != want.URL || got.Secret != want.Secret ||
+		got.MaxRetries != want.MaxRetries || got.RetryBackoffSeconds != want.RetryBackoffSeconds ||
+		len(got.Events) != 1 || got.Events[0] != "agent.*" {
+		t.Errorf("Webhooks[0] = %+v, want %+v", got, want)
+	}
+}
+
+func TestViperLoader_WorkspaceRoots(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+
+	content := `storage_version: 2
+
+workspace_roots:
+  - ~/work
+  - /srv/oss
+
+projects: {}
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	cfg, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(cfg.WorkspaceRoots) != 2 || cfg.WorkspaceRoots[0] != "~/work" || cfg.WorkspaceRoots[1] != "/srv/oss" {
+		t.Fatalf("WorkspaceRoots = %v", cfg.WorkspaceRoots)
+	}
+
+	// Round trip through Save
+	savePath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := NewViperLoader(savePath).Save(context.Background(), cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	cfg2, err := NewViperLoader(savePath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(cfg2.WorkspaceRoots) != 2 || cfg2.WorkspaceRoots[0] != "~/work" {
+		t.Errorf("WorkspaceRoots after save = %v", cfg2.WorkspaceRoots)
+	}
+}
+
+func TestViperLoader_SortGroupAndTags(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+
+	cfg := ports.NewConfig()
+	cfg.SortMode = "waiting"
+	cfg.GroupBy = "tag"
+	cfg.SetProjectEntry("api", "/work/api", "", false)
+	pc := cfg.Projects["api"]
+	pc.Tag = "clients"
+	cfg.Projects["api"] = pc
+
+	if err := NewViperLoader(configPath).Save(context.Background(), cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	cfg2, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg2.SortMode != "waiting" || cfg2.GroupBy != "tag" {
+		t.Errorf("SortMode/GroupBy = %q/%q, want waiting/tag", cfg2.SortMode, cfg2.GroupBy)
+	}
+	if got := cfg2.GetProjectTag("/work/api"); got != "clients" {
+		t.Errorf("GetProjectTag() = %q, want clients", got)
+	}
+}
+
+func TestViperLoader_Load_InvalidSortAndGroup(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+
+	content := `storage_version: 2
+
+settings:
+  sort_mode: size
+  group_by: owner
+
+projects: {}
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	cfg, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.SortMode != "name" || cfg.GroupBy != "none" {
+		t.Errorf("SortMode/GroupBy = %q/%q, want defaults name/none", cfg.SortMode, cfg.GroupBy)
+	}
+}
+
+func TestViperLoader_KeyBindings(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+
+	content := `storage_version: 2
+
+keybindings:
+  down: [j, ctrl+n]
+  hibernated: L
+  help: "?"
+
+projects: {}
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	cfg, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := cfg.KeyBindings["down"]; len(got) != 2 || got[0] != "j" || got[1] != "ctrl+n" {
+		t.Errorf("down = %v, want [j ctrl+n]", got)
+	}
+	if got := cfg.KeyBindings["hibernated"]; len(got) != 1 || got[0] != "L" {
+		t.Errorf("hibernated = %v, want [L] (case preserved)", got)
+	}
+	if got := cfg.KeyBindings["help"]; len(got) != 1 || got[0] != "?" {
+		t.Errorf("help = %v, want [?]", got)
+	}
+
+	// Round trip through Save
+	savePath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := NewViperLoader(savePath).Save(context.Background(), cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	cfg2, err := NewViperLoader(savePath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := cfg2.KeyBindings["down"]; len(got) != 2 || got[1] != "ctrl+n" {
+		t.Errorf("down after save = %v", got)
+	}
+}
+
+func TestViperLoader_KeyBindingsAbsent(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+
+	cfg, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.KeyBindings != nil {
+		t.Errorf("KeyBindings = %v, want nil for default config", cfg.KeyBindings)
+	}
+}
+
+func TestViperLoader_Theme(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+
+	cfg, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Theme != "dark" {
+		t.Errorf("default Theme = %q, want dark", cfg.Theme)
+	}
+
+	cfg.Theme = "solarized"
+	if err := NewViperLoader(configPath).Save(context.Background(), cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	cfg2, err := NewViperLoader(configPath).Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg2.Theme != "solarized" {
+		t.Errorf("Theme after save = %q, want solarized", cfg2.Theme)
+	}
+}
@@ -7,13 +7,21 @@ import (
 
 // DetectionResult represents the result of detecting a project's workflow methodology and stage
 type DetectionResult struct {
-	Method             string     // "speckit", "bmad", "unknown"
-	Stage              Stage      // Detected stage
-	Confidence         Confidence // How certain the detection is
-	Reasoning          string     // Human-readable explanation (FR11, FR26)
-	ArtifactTimestamp  time.Time  // Most recent artifact modification time (zero if unknown)
-	CoexistenceWarning bool       // True when multiple methodologies have similar timestamps
-	CoexistenceMessage string     // Warning message for TUI display
+	Method             string          // "speckit", "bmad", "unknown"
+	Stage              Stage           // Detected stage (coarse, shared across methods)
+	MethodStage        MethodStage     // Stage in the method's own taxonomy (zero if not mapped)
+	Confidence         Confidence      // How certain the detection is
+	Reasoning          string          // Human-readable explanation (FR11, FR26)
+	ArtifactTimestamp  time.Time       // Most recent artifact modification time (zero if unknown)
+	CoexistenceWarning bool            // True when multiple methodologies have similar timestamps
+	CoexistenceMessage string          // Warning message for TUI display
+	TasksDone          int             // Checked tasks of the detected feature
+	TasksTotal         int             // All tasks of the detected feature (0 if not tracked)
+	NextTask           string          // First open task, e.g. "T012 Create User model"
+	NextTaskPhase      string          // Heading NextTask is listed under (empty if none)
+	TaskPhases         []TaskPhase     // Task completion per heading, in file order (nil if not grouped)
+	Sprint             *SprintProgress // BMAD epics and stories (nil for other methods)
+	ActiveChange       string          // OpenSpec change the stage belongs to (empty for other methods)
 }
 
 // NewDetectionResult creates a new DetectionResult with the given values
@@ -70,3 +78,46 @@ func (dr DetectionResult) WithCoexistenceWarning(msg string) DetectionResult {
 func (dr DetectionResult) HasCoexistenceWarning() bool {
 	return dr.CoexistenceWarning
 }
+
+// WithTasks returns a copy with task completion of the detected work set.
+func (dr DetectionResult) WithTasks(done, total int, next string) DetectionResult {
+	dr.TasksDone = done
+	dr.TasksTotal = total
+	dr.NextTask = next
+	return dr
+}
+
+// WithTaskPhases returns a copy with the per-heading task completion and the
+// heading of the next open task set.
+func (dr DetectionResult) WithTaskPhases(phases []TaskPhase, nextPhase string) DetectionResult {
+	dr.TaskPhases = phases
+	dr.NextTaskPhase = nextPhase
+	return dr
+}
+
+// WithMethodStage returns a copy with the method's own stage set. Stage is
+// replaced by the method stage's coarse stage so both stay consistent.
+func (dr DetectionResult) WithMethodStage(s MethodStage) DetectionResult {
+	dr.MethodStage = s
+	if !s.IsZero() {
+		dr.Stage = s.Coarse
+	}
+	return dr
+}
+
+// WithActiveChange returns a copy with the ID of the change being worked on set.
+func (dr DetectionResult) WithActiveChange(id string) DetectionResult {
+	dr.ActiveChange = id
+	return dr
+}
+
+// WithSprint returns a copy with the structured sprint state set.
+func (dr DetectionResult) WithSprint(s *SprintProgress) DetectionResult {
+	dr.Sprint = s
+	return dr
+}
+
+// HasTasks returns true if the detector counted tasks.
+func (dr DetectionResult) HasTasks() bool {
+	return dr.TasksTotal > 0
+}
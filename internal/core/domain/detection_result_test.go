@@ -339,3 +339,72 @@ func TestDetectionResult_CoexistenceWarning_PreservesOtherFields(t *testing.T) {
 		t.Errorf("ArtifactTimestamp = %v, want %v", modified.ArtifactTimestamp, timestamp)
 	}
 }
+
+func TestDetectionResult_WithTasks(t *testing.T) {
+	original := NewDetectionResult("speckit", StageTasks, ConfidenceCertain, "tasks.md exists")
+	if original.HasTasks() {
+		t.Error("new result should have no tasks")
+	}
+
+	modified := original.WithTasks(12, 40, "T013 Add login endpoint")
+	if !modified.HasTasks() || modified.TasksDone != 12 || modified.TasksTotal != 40 {
+		t.Errorf("tasks = %d/%d, want 12/40", modified.TasksDone, modified.TasksTotal)
+	}
+	if modified.NextTask != "T013 Add login endpoint" {
+		t.Errorf("NextTask = %q", modified.NextTask)
+	}
+	if modified.Stage != StageTasks || original.TasksTotal != 0 {
+		t.Error("WithTasks must return a modified copy only")
+	}
+}
+
+func TestDetectionResult_WithTaskPhases(t *testing.T) {
+	original := NewDetectionResult("speckit", StageImplement, ConfidenceCertain, "implement.md exists")
+	phases := []TaskPhase{{Name: "Phase 1: Setup", Done: 2, Total: 2}, {Name: "Phase 2: US1", Done: 1, Total: 4, Parallel: 2}}
+
+	modified := original.WithTaskPhases(phases, "Phase 2: US1")
+	if len(modified.TaskPhases) != 2 || modified.TaskPhases[1].Parallel != 2 {
+		t.Errorf("TaskPhases = %+v", modified.TaskPhases)
+	}
+	if modified.NextTaskPhase != "Phase 2: US1" {
+		t.Errorf("NextTaskPhase = %q, want Phase 2: US1", modified.NextTaskPhase)
+	}
+	if original.TaskPhases != nil || original.NextTaskPhase != "" {
+		t.Error("WithTaskPhases must return a modified copy only")
+	}
+}
+
+func TestDetectionResult_WithSprint(t *testing.T) {
+	original := NewDetectionResult("bmad", StageImplement, ConfidenceCertain, "Story 1.1 being implemented")
+	sprint := &SprintProgress{Epics: []SprintEpic{{ID: "1", Status: WorkInProgress}}}
+
+	modified := original.WithSprint(sprint)
+	if modified.Sprint != sprint {
+		t.Errorf("Sprint = %+v, want %+v", modified.Sprint, sprint)
+	}
+	if original.Sprint != nil {
+		t.Error("WithSprint must return a modified copy only")
+	}
+}
+
+func TestDetectionResult_WithMethodStage(t *testing.T) {
+	original := NewDetectionResult("bmad", StageUnknown, ConfidenceCertain, "Story 1.1 in code review")
+	review := MethodStage{ID: "code-review", Label: "Code review", Ordinal: 6, Coarse: StageTasks}
+
+	modified := original.WithMethodStage(review)
+	if modified.MethodStage != review {
+		t.Errorf("MethodStage = %+v, want %+v", modified.MethodStage, review)
+	}
+	if modified.Stage != StageTasks {
+		t.Errorf("Stage = %v, want coarse stage %v", modified.Stage, StageTasks)
+	}
+	if !original.MethodStage.IsZero() {
+		t.Error("WithMethodStage must return a modified copy only")
+	}
+
+	// A zero stage keeps the coarse stage already detected
+	kept := NewDetectionResult("bmad", StagePlan, ConfidenceLikely, "").WithMethodStage(MethodStage{})
+	if kept.Stage != StagePlan {
+		t.Errorf("Stage = %v, want %v kept", kept.Stage, StagePlan)
+	}
+}
@@ -15,4 +15,6 @@ var (
 	ErrInvalidStateTransition  = errors.New("invalid state transition")
 	ErrFavoriteCannotHibernate = errors.New("favorite projects cannot be hibernated")
 	ErrInvalidAgentStatus      = errors.New("invalid agent status")
+	ErrInvalidSortMode         = errors.New("invalid sort mode")
+	ErrInvalidGroupBy          = errors.New("invalid group by")
 )
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// shortSHALength is the abbreviated commit hash length used for display.
+const shortSHALength = 7
+
+// GitStatus is a point-in-time view of the git repository containing a project.
+// It is computed on demand and never persisted.
+type GitStatus struct {
+	Branch   string // Current branch name; empty when HEAD is detached
+	HeadSHA  string // Commit HEAD points at; empty on an unborn branch
+	Detached bool   // HEAD points directly at a commit
+
+	Upstream string // Tracking ref, e.g. "origin/main"; empty when none is configured
+	Ahead    int    // Commits on HEAD not on Upstream
+	Behind   int    // Commits on Upstream not on HEAD
+
+	Dirty     int // Tracked files with staged or unstaged changes
+	Untracked int // Untracked paths (an untracked directory counts once)
+
+	LastCommitSubject string    // First line of the HEAD commit message
+	LastCommitAt      time.Time // Committer time of the HEAD commit
+
+	Worktrees []GitWorktree // Linked worktrees, excluding the main one
+}
+
+// GitWorktree is a linked worktree of a repository.
+type GitWorktree struct {
+	Path   string // Absolute worktree directory
+	Branch string // Checked-out branch; empty when detached
+}
+
+// IsClean returns true if there are no modified or untracked files.
+func (s GitStatus) IsClean() bool {
+	return s.Dirty == 0 && s.Untracked == 0
+}
+
+// ShortSHA returns the abbreviated HEAD commit hash.
+func (s GitStatus) ShortSHA() string {
+	if len(s.HeadSHA) > shortSHALength {
+		return s.HeadSHA[:shortSHALength]
+	}
+	return s.HeadSHA
+}
+
+// BranchLabel returns the branch name, or "detached@<sha>" when detached.
+func (s GitStatus) BranchLabel() string {
+	if s.Detached {
+		return fmt.Sprintf("detached@%s", s.ShortSHA())
+	}
+	return s.Branch
+}
+
+// ChangeSummary returns "clean" or e.g. "3 modified, 1 untracked".
+func (s GitStatus) ChangeSummary() string {
+	if s.IsClean() {
+		return "clean"
+	}
+	var parts []string
+	if s.Dirty > 0 {
+		parts = append(parts, fmt.Sprintf("%d modified", s.Dirty))
+	}
+	if s.Untracked > 0 {
+		parts = append(parts, fmt.Sprintf("%d untracked", s.Untracked))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "testing"
+
+func TestGitStatus_BranchLabel(t *testing.T) {
+	if got := (GitStatus{Branch: "main"}).BranchLabel(); got != "main" {
+		t.Errorf("BranchLabel = %q, want main", got)
+	}
+	detached := GitStatus{Detached: true, HeadSHA: "0123456789abcdef0123456789abcdef01234567"}
+	if got := detached.BranchLabel(); got != "detached@0123456" {
+		t.Errorf("BranchLabel = %q, want detached@0123456", got)
+	}
+	if got := (GitStatus{Detached: true}).BranchLabel(); got != "detached@" {
+		t.Errorf("BranchLabel without SHA = %q", got)
+	}
+}
+
+func TestGitStatus_ChangeSummary(t *testing.T) {
+	tests := []struct {
+		status GitStatus
+		want   string
+	}{
+		{GitStatus{}, "clean"},
+		{GitStatus{Dirty: 3}, "3 modified"},
+		{GitStatus{Untracked: 1}, "1 untracked"},
+		{GitStatus{Dirty: 2, Untracked: 4}, "2 modified, 4 untracked"},
+	}
+	for _, tt := range tests {
+		if got := tt.status.ChangeSummary(); got != tt.want {
+			t.Errorf("ChangeSummary(%+v) = %q, want %q", tt.status, got, tt.want)
+		}
+		if tt.status.IsClean() != (tt.want == "clean") {
+			t.Errorf("IsClean(%+v) mismatch", tt.status)
+		}
+	}
+}
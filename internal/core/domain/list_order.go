@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SortMode selects the order projects are listed in.
+type SortMode string
+
+const (
+	SortByName            SortMode = "name"             // Favorites first, then alphabetical (default)
+	SortByWaiting         SortMode = "waiting"          // Waiting projects first, then alphabetical
+	SortByRecent          SortMode = "recent"           // Most recent activity first
+	SortByStage           SortMode = "stage"            // Furthest workflow stage first
+	SortByMethod          SortMode = "method"           // Alphabetical by methodology, unknown last
+	SortByWaitingDuration SortMode = "waiting-duration" // Longest waiting first
+)
+
+// SortModes lists every sort mode in the order the dashboard cycles through them.
+var SortModes = []SortMode{SortByName, SortByWaiting, SortByRecent, SortByStage, SortByMethod, SortByWaitingDuration}
+
+// ParseSortMode converts string to SortMode. Case-insensitive; "" is SortByName.
+func ParseSortMode(s string) (SortMode, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return SortByName, nil
+	}
+	for _, m := range SortModes {
+		if string(m) == s {
+			return m, nil
+		}
+	}
+	return SortByName, fmt.Errorf("%w: %q", ErrInvalidSortMode, s)
+}
+
+// Next returns the sort mode after m in SortModes, wrapping around.
+func (m SortMode) Next() SortMode {
+	return nextOf(SortModes, m)
+}
+
+// GroupBy selects how the dashboard groups projects under headers.
+type GroupBy string
+
+const (
+	GroupByNone   GroupBy = "none"   // Flat list (default)
+	GroupByMethod GroupBy = "method" // One group per detected methodology
+	GroupByStage  GroupBy = "stage"  // One group per workflow stage
+	GroupByTag    GroupBy = "tag"    // One group per user-defined project tag
+)
+
+// GroupByModes lists every grouping in the order the dashboard cycles through them.
+var GroupByModes = []GroupBy{GroupByNone, GroupByMethod, GroupByStage, GroupByTag}
+
+// ParseGroupBy converts string to GroupBy. Case-insensitive; "" is GroupByNone.
+func ParseGroupBy(s string) (GroupBy, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return GroupByNone, nil
+	}
+	for _, g := range GroupByModes {
+		if string(g) == s {
+			return g, nil
+		}
+	}
+	return GroupByNone, fmt.Errorf("%w: %q", ErrInvalidGroupBy, s)
+}
+
+// Next returns the grouping after g in GroupByModes, wrapping around.
+func (g GroupBy) Next() GroupBy {
+	return nextOf(GroupByModes, g)
+}
+
+func nextOf[T comparable](values []T, current T) T {
+	for i, v := range values {
+		if v == current {
+			return values[(i+1)%len(values)]
+		}
+	}
+	return values[0]
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseSortMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    SortMode
+		wantErr bool
+	}{
+		{"", SortByName, false},
+		{"name", SortByName, false},
+		{" Waiting ", SortByWaiting, false},
+		{"recent", SortByRecent, false},
+		{"stage", SortByStage, false},
+		{"method", SortByMethod, false},
+		{"waiting-duration", SortByWaitingDuration, false},
+		{"size", SortByName, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSortMode(tt.input)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseSortMode(%q) = %q, %v; want %q, err=%v", tt.input, got, err, tt.want, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidSortMode) {
+			t.Errorf("ParseSortMode(%q) error = %v, want ErrInvalidSortMode", tt.input, err)
+		}
+	}
+}
+
+func TestSortMode_NextCyclesAllModes(t *testing.T) {
+	m := SortByName
+	for i := 0; i < len(SortModes); i++ {
+		if m != SortModes[i] {
+			t.Fatalf("step %d: got %q, want %q", i, m, SortModes[i])
+		}
+		m = m.Next()
+	}
+	if m != SortByName {
+		t.Errorf("Next() did not wrap to %q, got %q", SortByName, m)
+	}
+	if got := SortMode("bogus").Next(); got != SortByName {
+		t.Errorf("Next() of unknown mode = %q, want %q", got, SortByName)
+	}
+}
+
+func TestParseGroupBy(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    GroupBy
+		wantErr bool
+	}{
+		{"", GroupByNone, false},
+		{"none", GroupByNone, false},
+		{"Method", GroupByMethod, false},
+		{"stage", GroupByStage, false},
+		{"tag", GroupByTag, false},
+		{"owner", GroupByNone, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseGroupBy(tt.input)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseGroupBy(%q) = %q, %v; want %q, err=%v", tt.input, got, err, tt.want, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidGroupBy) {
+			t.Errorf("ParseGroupBy(%q) error = %v, want ErrInvalidGroupBy", tt.input, err)
+		}
+	}
+}
+
+func TestGroupBy_Next(t *testing.T) {
+	if got := GroupByNone.Next(); got != GroupByMethod {
+		t.Errorf("GroupByNone.Next() = %q, want %q", got, GroupByMethod)
+	}
+	if got := GroupByTag.Next(); got != GroupByNone {
+		t.Errorf("GroupByTag.Next() = %q, want %q", got, GroupByNone)
+	}
+}
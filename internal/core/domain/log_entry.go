@@ -42,6 +42,31 @@ type LogSession struct {
 
 	// Summary is an optional session summary extracted from log content
 	Summary string
+
+	// Tool is the agentic tool that produced the session (e.g., "Claude Code", "Codex").
+	// Set by the LogReader so merged session lists can be labelled.
+	Tool string
+}
+
+// LogSearchHit is a log entry whose text matches a search query.
+type LogSearchHit struct {
+	// Session is the session containing the entry
+	Session LogSession
+
+	// Line is the 1-based line of the entry in the session file
+	Line int
+
+	// Offset is the byte offset of the entry's line in the session file
+	Offset int64
+
+	// Timestamp of the entry (zero if the log records none)
+	Timestamp time.Time
+
+	// Type of entry (e.g., "user", "assistant", "tool")
+	Type string
+
+	// Snippet is the matching text on a single line, trimmed around the match
+	Snippet string
 }
 
 // NewLogEntry creates a new LogEntry with the given values.
@@ -0,0 +1,69 @@
+package domain
+
+import "strings"
+
+// MethodStage is one stage of a methodology's own workflow, as declared by
+// its detector (e.g., BMAD "solutioning" or "code-review"). Stages are
+// persisted by ID; Coarse places them on the shared Stage scale so projects
+// of different methodologies can be sorted and filtered together.
+type MethodStage struct {
+	ID      string // Stable identifier persisted as current_stage, "code-review"
+	Label   string // Display name, "Code review"
+	Ordinal int    // 1-based position in the methodology's stage list
+	Done    bool   // Reaching this stage means the workflow is finished
+	Coarse  Stage  // Normalized stage for cross-methodology sorting and filtering
+}
+
+// IsZero reports whether no methodology stage is set.
+func (s MethodStage) IsZero() bool {
+	return s.ID == ""
+}
+
+// NewMethodStages builds an ordered stage list, numbering Ordinal from 1 in
+// the given order. Detectors declare their taxonomy with it.
+func NewMethodStages(stages ...MethodStage) []MethodStage {
+	list := make([]MethodStage, len(stages))
+	for i, s := range stages {
+		s.Ordinal = i + 1
+		list[i] = s
+	}
+	return list
+}
+
+// DefaultMethodStages returns the coarse stages as a stage list
+// (specify, plan, tasks, implement), for methodologies that follow them
+// directly.
+func DefaultMethodStages() []MethodStage {
+	return NewMethodStages(
+		MethodStage{ID: "specify", Label: StageSpecify.String(), Coarse: StageSpecify},
+		MethodStage{ID: "plan", Label: StagePlan.String(), Coarse: StagePlan},
+		MethodStage{ID: "tasks", Label: StageTasks.String(), Coarse: StageTasks},
+		MethodStage{ID: "implement", Label: StageImplement.String(), Coarse: StageImplement},
+	)
+}
+
+// FindMethodStage returns the stage with the given ID (case-insensitive).
+func FindMethodStage(stages []MethodStage, id string) (MethodStage, bool) {
+	id = strings.ToLower(strings.TrimSpace(id))
+	for _, s := range stages {
+		if s.ID == id {
+			return s, true
+		}
+	}
+	return MethodStage{}, false
+}
+
+// MethodStageFor returns the first stage of the list whose coarse stage is
+// stage, for detectors that derive their stage on the coarse scale.
+// Returns the zero MethodStage for StageUnknown or when none matches.
+func MethodStageFor(stages []MethodStage, stage Stage) MethodStage {
+	if stage == StageUnknown {
+		return MethodStage{}
+	}
+	for _, s := range stages {
+		if s.Coarse == stage {
+			return s
+		}
+	}
+	return MethodStage{}
+}
@@ -0,0 +1,87 @@
+package domain
+
+import "testing"
+
+func TestNewMethodStages_NumbersOrdinals(t *testing.T) {
+	stages := NewMethodStages(
+		MethodStage{ID: "draft", Label: "Draft", Coarse: StageSpecify},
+		MethodStage{ID: "build", Label: "Build", Coarse: StageImplement},
+		MethodStage{ID: "shipped", Label: "Shipped", Done: true, Coarse: StageImplement},
+	)
+
+	for i, s := range stages {
+		if s.Ordinal != i+1 {
+			t.Errorf("stages[%d].Ordinal = %d, want %d", i, s.Ordinal, i+1)
+		}
+	}
+	if !stages[2].Done {
+		t.Error("Done flag must be preserved")
+	}
+}
+
+func TestDefaultMethodStages(t *testing.T) {
+	stages := DefaultMethodStages()
+	want := []struct {
+		id     string
+		label  string
+		coarse Stage
+	}{
+		{"specify", "Specify", StageSpecify},
+		{"plan", "Plan", StagePlan},
+		{"tasks", "Tasks", StageTasks},
+		{"implement", "Implement", StageImplement},
+	}
+
+	if len(stages) != len(want) {
+		t.Fatalf("len = %d, want %d", len(stages), len(want))
+	}
+	for i, w := range want {
+		s := stages[i]
+		if s.ID != w.id || s.Label != w.label || s.Coarse != w.coarse || s.Ordinal != i+1 || s.Done {
+			t.Errorf("stages[%d] = %+v, want %s/%s/%v", i, s, w.id, w.label, w.coarse)
+		}
+	}
+}
+
+func TestFindMethodStage(t *testing.T) {
+	stages := DefaultMethodStages()
+
+	tests := []struct {
+		id     string
+		wantOK bool
+		want   Stage
+	}{
+		{"plan", true, StagePlan},
+		{" Tasks ", true, StageTasks},
+		{"IMPLEMENT", true, StageImplement},
+		{"review", false, StageUnknown},
+		{"", false, StageUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, ok := FindMethodStage(stages, tt.id)
+			if ok != tt.wantOK || got.Coarse != tt.want {
+				t.Errorf("FindMethodStage(%q) = %+v, %v; want %v, %v", tt.id, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMethodStageFor(t *testing.T) {
+	stages := NewMethodStages(
+		MethodStage{ID: "proposal", Label: "Proposal", Coarse: StageSpecify},
+		MethodStage{ID: "review", Label: "Review", Coarse: StageTasks},
+		MethodStage{ID: "archive", Label: "Archive", Coarse: StageTasks},
+	)
+
+	if got := MethodStageFor(stages, StageTasks); got.ID != "review" {
+		t.Errorf("MethodStageFor(Tasks) = %q, want first match %q", got.ID, "review")
+	}
+	if got := MethodStageFor(stages, StagePlan); !got.IsZero() {
+		t.Errorf("MethodStageFor(Plan) = %+v, want zero", got)
+	}
+	if got := MethodStageFor(stages, StageUnknown); !got.IsZero() {
+		t.Errorf("MethodStageFor(Unknown) = %+v, want zero", got)
+	}
+}
@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// MetricsMaxSampleGap caps how long a sample's stage and agent state are
+// assumed to last. Gaps longer than this (vdash not running) are not counted
+// as time in stage or waiting time.
+const MetricsMaxSampleGap = 15 * time.Minute
+
+// WorkProgress counts completed work items for a project's methodology:
+// BMAD stories from sprint-status.yaml and Speckit tasks from tasks.md.
+// Zero totals mean the source was not found.
+type WorkProgress struct {
+	StoriesDone  int
+	StoriesTotal int
+	TasksDone    int
+	TasksTotal   int
+}
+
+// Done returns completed stories plus completed tasks.
+func (p WorkProgress) Done() int {
+	return p.StoriesDone + p.TasksDone
+}
+
+// Total returns all stories plus all tasks.
+func (p WorkProgress) Total() int {
+	return p.StoriesTotal + p.TasksTotal
+}
+
+// Remaining returns the number of work items not yet done.
+func (p WorkProgress) Remaining() int {
+	return p.Total() - p.Done()
+}
+
+// MetricSample is one periodic observation of a project, stored in the
+// metrics database. Aggregates are computed from raw samples on read.
+type MetricSample struct {
+	ProjectID string
+	SampledAt time.Time
+	Stage     Stage
+	Agent     AgentStatus
+	Progress  WorkProgress
+}
+
+// BurndownPoint is the work progress at a point in time.
+type BurndownPoint struct {
+	At        time.Time
+	Done      int
+	Total     int
+	Remaining int
+}
+
+// StageDuration is the time a project spent in a stage.
+type StageDuration struct {
+	Stage    Stage
+	Duration time.Duration
+}
+
+// AgentDuration is the time a project's agent spent in a status.
+type AgentDuration struct {
+	Status   AgentStatus
+	Duration time.Duration
+}
+
+// DailyDuration is a duration accumulated over one local calendar day.
+type DailyDuration struct {
+	Day      time.Time // Local midnight
+	Duration time.Duration
+}
+
+// ProjectStats aggregates a project's metric samples over a time range.
+type ProjectStats struct {
+	ProjectID string
+	Since     time.Time
+	Until     time.Time
+	Samples   int
+
+	Progress     WorkProgress    // Latest sampled progress
+	Burndown     []BurndownPoint // One point per progress change, oldest first
+	TimeInStage  []StageDuration // Stages with recorded time, in workflow order
+	AgentTime    []AgentDuration // Agent statuses with recorded time, in status order
+	WaitingByDay []DailyDuration // Agent waiting time per day, oldest first
+}
+
+// WaitingTime returns the total time the agent spent waiting for the user.
+func (s ProjectStats) WaitingTime() time.Duration {
+	for _, d := range s.AgentTime {
+		if d.Status == AgentWaitingForUser {
+			return d.Duration
+		}
+	}
+	return 0
+}
+
+// ComputeProjectStats aggregates samples (any order) taken between since and
+// until. Each sample's state lasts until the next sample or until, capped at
+// MetricsMaxSampleGap.
+func ComputeProjectStats(projectID string, samples []MetricSample, since, until time.Time) ProjectStats {
+	stats := ProjectStats{ProjectID: projectID, Since: since, Until: until, Samples: len(samples)}
+	if len(samples) == 0 {
+		return stats
+	}
+
+	sorted := make([]MetricSample, len(samples))
+	copy(sorted, samples)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SampledAt.Before(sorted[j].SampledAt) })
+
+	stageTime := make(map[Stage]time.Duration)
+	agentTime := make(map[AgentStatus]time.Duration)
+	waitingByDay := make(map[time.Time]time.Duration)
+
+	for i, s := range sorted {
+		end := until
+		if i+1 < len(sorted) {
+			end = sorted[i+1].SampledAt
+		}
+		if limit := s.SampledAt.Add(MetricsMaxSampleGap); end.After(limit) {
+			end = limit
+		}
+		if d := end.Sub(s.SampledAt); d > 0 {
+			stageTime[s.Stage] += d
+			agentTime[s.Agent] += d
+			if s.Agent == AgentWaitingForUser {
+				addByDay(waitingByDay, s.SampledAt, end)
+			}
+		}
+
+		if s.Progress.Total() > 0 {
+			last := len(stats.Burndown) - 1
+			if last < 0 || stats.Burndown[last].Done != s.Progress.Done() || stats.Burndown[last].Total != s.Progress.Total() {
+				stats.Burndown = append(stats.Burndown, BurndownPoint{
+					At:        s.SampledAt,
+					Done:      s.Progress.Done(),
+					Total:     s.Progress.Total(),
+					Remaining: s.Progress.Remaining(),
+				})
+			}
+		}
+	}
+	stats.Progress = sorted[len(sorted)-1].Progress
+
+	for _, stage := range []Stage{StageSpecify, StagePlan, StageTasks, StageImplement, StageUnknown} {
+		if d := stageTime[stage]; d > 0 {
+			stats.TimeInStage = append(stats.TimeInStage, StageDuration{Stage: stage, Duration: d})
+		}
+	}
+	for _, status := range []AgentStatus{AgentWorking, AgentWaitingForUser, AgentInactive, AgentUnknown} {
+		if d := agentTime[status]; d > 0 {
+			stats.AgentTime = append(stats.AgentTime, AgentDuration{Status: status, Duration: d})
+		}
+	}
+	for day, d := range waitingByDay {
+		stats.WaitingByDay = append(stats.WaitingByDay, DailyDuration{Day: day, Duration: d})
+	}
+	sort.Slice(stats.WaitingByDay, func(i, j int) bool { return stats.WaitingByDay[i].Day.Before(stats.WaitingByDay[j].Day) })
+
+	return stats
+}
+
+// addByDay splits [start, end) at local midnights and adds each part to its day.
+func addByDay(days map[time.Time]time.Duration, start, end time.Time) {
+	for start.Before(end) {
+		local := start.Local()
+		day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.Local)
+		next := day.AddDate(0, 0, 1)
+		if next.After(end) {
+			next = end
+		}
+		days[day] += next.Sub(start)
+		start = next
+	}
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkProgress_Counts(t *testing.T) {
+	p := WorkProgress{StoriesDone: 3, StoriesTotal: 10, TasksDone: 1, TasksTotal: 2}
+	if p.Done() != 4 || p.Total() != 12 || p.Remaining() != 8 {
+		t.Errorf("Done/Total/Remaining = %d/%d/%d, want 4/12/8", p.Done(), p.Total(), p.Remaining())
+	}
+}
+
+func TestComputeProjectStats_Empty(t *testing.T) {
+	now := time.Now()
+	stats := ComputeProjectStats("p", nil, now.Add(-time.Hour), now)
+	if stats.Samples != 0 || stats.TimeInStage != nil || stats.Burndown != nil {
+		t.Errorf("empty stats = %+v", stats)
+	}
+}
+
+func TestComputeProjectStats_Durations(t *testing.T) {
+	start := time.Date(2026, 5, 1, 10, 0, 0, 0, time.Local)
+	at := func(min int) time.Time { return start.Add(time.Duration(min) * time.Minute) }
+
+	// Out of order on purpose; ComputeProjectStats sorts
+	samples := []MetricSample{
+		{SampledAt: at(10), Stage: StageImplement, Agent: AgentWaitingForUser},
+		{SampledAt: at(0), Stage: StagePlan, Agent: AgentWorking},
+		{SampledAt: at(5), Stage: StagePlan, Agent: AgentWorking},
+		// 2h gap: only MetricsMaxSampleGap after at(15) counts
+		{SampledAt: at(15), Stage: StageImplement, Agent: AgentWorking},
+		{SampledAt: at(135), Stage: StageImplement, Agent: AgentWaitingForUser},
+	}
+	stats := ComputeProjectStats("p", samples, start, at(140))
+
+	if stats.Samples != 5 {
+		t.Errorf("Samples = %d, want 5", stats.Samples)
+	}
+	wantStages := []StageDuration{
+		{StagePlan, 10 * time.Minute},
+		{StageImplement, 5*time.Minute + MetricsMaxSampleGap + 5*time.Minute},
+	}
+	if len(stats.TimeInStage) != len(wantStages) {
+		t.Fatalf("TimeInStage = %v, want %v", stats.TimeInStage, wantStages)
+	}
+	for i, want := range wantStages {
+		if stats.TimeInStage[i] != want {
+			t.Errorf("TimeInStage[%d] = %v, want %v", i, stats.TimeInStage[i], want)
+		}
+	}
+	if got := stats.WaitingTime(); got != 10*time.Minute {
+		t.Errorf("WaitingTime() = %v, want 10m", got)
+	}
+	if len(stats.WaitingByDay) != 1 || stats.WaitingByDay[0].Duration != 10*time.Minute {
+		t.Errorf("WaitingByDay = %v, want one day of 10m", stats.WaitingByDay)
+	}
+}
+
+func TestComputeProjectStats_WaitingSplitAtMidnight(t *testing.T) {
+	start := time.Date(2026, 5, 1, 23, 55, 0, 0, time.Local)
+	samples := []MetricSample{{SampledAt: start, Agent: AgentWaitingForUser}}
+	stats := ComputeProjectStats("p", samples, start, start.Add(10*time.Minute))
+
+	if len(stats.WaitingByDay) != 2 {
+		t.Fatalf("WaitingByDay = %v, want 2 days", stats.WaitingByDay)
+	}
+	if stats.WaitingByDay[0].Duration != 5*time.Minute || stats.WaitingByDay[1].Duration != 5*time.Minute {
+		t.Errorf("WaitingByDay = %v, want 5m + 5m", stats.WaitingByDay)
+	}
+}
+
+func TestComputeProjectStats_Burndown(t *testing.T) {
+	start := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	samples := []MetricSample{
+		{SampledAt: start, Progress: WorkProgress{StoriesDone: 1, StoriesTotal: 5}},
+		{SampledAt: start.Add(time.Minute), Progress: WorkProgress{StoriesDone: 1, StoriesTotal: 5}}, // Unchanged: no point
+		{SampledAt: start.Add(2 * time.Minute), Progress: WorkProgress{StoriesDone: 3, StoriesTotal: 5}},
+		{SampledAt: start.Add(3 * time.Minute)}, // No progress source: no point
+	}
+	stats := ComputeProjectStats("p", samples, start, start.Add(5*time.Minute))
+
+	if len(stats.Burndown) != 2 {
+		t.Fatalf("Burndown = %v, want 2 points", stats.Burndown)
+	}
+	if stats.Burndown[1].Remaining != 2 || stats.Burndown[1].Done != 3 {
+		t.Errorf("Burndown[1] = %+v, want 3 done, 2 remaining", stats.Burndown[1])
+	}
+	if stats.Progress.Total() != 0 {
+		t.Errorf("Progress = %+v, want latest sample (empty)", stats.Progress)
+	}
+}
@@ -10,14 +10,22 @@ import (
 
 // Project represents a development project being tracked
 type Project struct {
-	ID                 string     // Unique identifier (path hash, 16 hex chars)
-	Name               string     // Derived from directory name
-	Path               string     // Canonical absolute path
-	DisplayName        string     // Optional user-set nickname (FR5)
-	DetectedMethod     string     // "speckit", "bmad", "unknown"
-	CurrentStage       Stage      // Current workflow stage
-	Confidence         Confidence // Detection confidence level (FR12)
-	DetectionReasoning string     // Human-readable detection explanation (FR11, FR26)
+	ID                 string          // Unique identifier (path hash, 16 hex chars)
+	Name               string          // Derived from directory name
+	Path               string          // Canonical absolute path
+	DisplayName        string          // Optional user-set nickname (FR5)
+	DetectedMethod     string          // "speckit", "bmad", "unknown"
+	CurrentStage       Stage           // Current workflow stage (coarse, shared across methods)
+	MethodStage        MethodStage     // Current stage in the method's own taxonomy (zero if not mapped)
+	Confidence         Confidence      // Detection confidence level (FR12)
+	DetectionReasoning string          // Human-readable detection explanation (FR11, FR26)
+	TasksDone          int             // Checked tasks of the detected feature
+	TasksTotal         int             // All tasks of the detected feature (0 if not tracked)
+	NextTask           string          // First open task of the detected feature
+	NextTaskPhase      string          // Heading NextTask is listed under (empty if none)
+	TaskPhases         []TaskPhase     // Task completion per heading (nil if not grouped)
+	Sprint             *SprintProgress // BMAD epics and stories (nil for other methods)
+	ActiveChange       string          // OpenSpec change the stage belongs to (empty for other methods)
 	// Coexistence fields for Story 14.5 (runtime-only, not persisted)
 	CoexistenceWarning bool         // True when multiple methodologies with similar timestamps
 	CoexistenceMessage string       // Warning text for display
@@ -104,6 +112,61 @@ func (p *Project) DaysSinceHibernated() int {
 	return int(duration.Hours() / 24)
 }
 
+// ApplyDetection copies detection results onto the project, leaving state,
+// favorites and notes untouched. Arguments follow DetectWithCoexistenceSelection
+// (Story 14.5):
+//   - winner set: winner is primary, coexistence fields are cleared
+//   - tie (winner nil): first result is primary, the runner-up becomes secondary
+//   - nothing detected: method "unknown"
+func (p *Project) ApplyDetection(winner *DetectionResult, allResults []*DetectionResult) {
+	var primary *DetectionResult
+	if winner != nil {
+		primary = winner
+		p.CoexistenceWarning = false
+		p.CoexistenceMessage = ""
+		p.SecondaryMethod = ""
+		p.SecondaryStage = StageUnknown
+	} else if len(allResults) > 0 {
+		// Tie case - use first as primary (already sorted by most recent timestamp)
+		primary = allResults[0]
+		p.CoexistenceWarning = primary.HasCoexistenceWarning()
+		p.CoexistenceMessage = primary.CoexistenceMessage
+		if len(allResults) > 1 {
+			p.SecondaryMethod = allResults[1].Method
+			p.SecondaryStage = allResults[1].Stage
+		}
+	} else {
+		unknownResult := NewDetectionResult("unknown", StageUnknown, ConfidenceUncertain, "No methodology detected")
+		primary = &unknownResult
+		p.CoexistenceWarning = false
+		p.CoexistenceMessage = ""
+		p.SecondaryMethod = ""
+		p.SecondaryStage = StageUnknown
+	}
+
+	p.DetectedMethod = primary.Method
+	p.CurrentStage = primary.Stage
+	p.MethodStage = primary.MethodStage
+	p.Confidence = primary.Confidence
+	p.DetectionReasoning = primary.Reasoning
+	p.TasksDone = primary.TasksDone
+	p.TasksTotal = primary.TasksTotal
+	p.NextTask = primary.NextTask
+	p.NextTaskPhase = primary.NextTaskPhase
+	p.TaskPhases = primary.TaskPhases
+	p.Sprint = primary.Sprint
+	p.ActiveChange = primary.ActiveChange
+}
+
+// StageLabel returns the display name of the current stage: the method's own
+// label when mapped, otherwise the coarse stage name.
+func (p *Project) StageLabel() string {
+	if p.MethodStage.Label != "" {
+		return p.MethodStage.Label
+	}
+	return p.CurrentStage.String()
+}
+
 // Validate checks Project invariants. Use after modification.
 func (p *Project) Validate() error {
 	if p.Path == "" {
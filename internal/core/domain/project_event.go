@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// EventType identifies a kind of project history event.
+// Stored as text in the project_events table, so values must stay stable.
+type EventType string
+
+const (
+	EventProjectAdded      EventType = "project_added"       // First save of a project
+	EventStageChanged      EventType = "stage_changed"       // Detected stage moved (e.g., Plan → Implement)
+	EventMethodChanged     EventType = "method_changed"      // Detection method switched (e.g., speckit → bmad)
+	EventHibernated        EventType = "hibernated"          // Project moved to hibernated state
+	EventActivated         EventType = "activated"           // Project moved back to active state
+	EventAgentStateChanged EventType = "agent_state_changed" // Agent status changed (e.g., Working → Waiting)
+)
+
+// ErrInvalidEventType is returned when parsing an unknown event type string
+var ErrInvalidEventType = errors.New("invalid event type")
+
+// AllEventTypes returns every event type in display order.
+func AllEventTypes() []EventType {
+	return []EventType{
+		EventProjectAdded,
+		EventStageChanged,
+		EventMethodChanged,
+		EventHibernated,
+		EventActivated,
+		EventAgentStateChanged,
+	}
+}
+
+// ParseEventType converts string to EventType. Case-insensitive.
+// Accepts short aliases: "stage", "method", "agent", "added".
+func ParseEventType(s string) (EventType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "project_added", "added":
+		return EventProjectAdded, nil
+	case "stage_changed", "stage":
+		return EventStageChanged, nil
+	case "method_changed", "method":
+		return EventMethodChanged, nil
+	case "hibernated":
+		return EventHibernated, nil
+	case "activated":
+		return EventActivated, nil
+	case "agent_state_changed", "agent":
+		return EventAgentStateChanged, nil
+	default:
+		return "", ErrInvalidEventType
+	}
+}
+
+// ProjectEvent is one entry in a project's append-only activity history.
+// From/To hold the before/after values for transitions (empty when not applicable).
+type ProjectEvent struct {
+	ID         int64 // Assigned by storage (0 before insert)
+	ProjectID  string
+	Type       EventType
+	From       string
+	To         string
+	Detail     string // Free-form context (e.g., detection reasoning, agent tool)
+	OccurredAt time.Time
+}
+
+// NewProjectEvent creates a new ProjectEvent with the given values.
+func NewProjectEvent(projectID string, eventType EventType, from, to, detail string, occurredAt time.Time) ProjectEvent {
+	return ProjectEvent{
+		ProjectID:  projectID,
+		Type:       eventType,
+		From:       from,
+		To:         to,
+		Detail:     detail,
+		OccurredAt: occurredAt,
+	}
+}
+
+// ProjectChangeEvents compares two snapshots of a project and returns the
+// history events implied by the change. before may be nil for a new project.
+// State changes are not diffed here; StateService records them explicitly.
+func ProjectChangeEvents(before, after *Project, at time.Time) []ProjectEvent {
+	if after == nil {
+		return nil
+	}
+
+	if before == nil {
+		return []ProjectEvent{NewProjectEvent(after.ID, EventProjectAdded, "", after.StageLabel(), after.DetectedMethod, at)}
+	}
+
+	var events []ProjectEvent
+	if before.DetectedMethod != after.DetectedMethod {
+		events = append(events, NewProjectEvent(after.ID, EventMethodChanged, before.DetectedMethod, after.DetectedMethod, "", at))
+	}
+	if stageChanged(before, after) {
+		events = append(events, NewProjectEvent(after.ID, EventStageChanged, before.StageLabel(), after.StageLabel(), after.DetectionReasoning, at))
+	}
+	return events
+}
+
+// stageChanged reports whether the project moved to another stage: a different
+// coarse stage, or another stage of the method's own taxonomy (e.g., BMAD
+// development -> code-review). A method stage appearing on a project stored
+// without one (before stage taxonomies existed) is not a change.
+func stageChanged(before, after *Project) bool {
+	if before.CurrentStage != after.CurrentStage {
+		return true
+	}
+	return !before.MethodStage.IsZero() && before.MethodStage.ID != after.MethodStage.ID
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseEventType(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    EventType
+		wantErr bool
+	}{
+		{"project_added", EventProjectAdded, false},
+		{"added", EventProjectAdded, false},
+		{"stage", EventStageChanged, false},
+		{"STAGE_CHANGED", EventStageChanged, false},
+		{"method", EventMethodChanged, false},
+		{" hibernated ", EventHibernated, false},
+		{"activated", EventActivated, false},
+		{"agent", EventAgentStateChanged, false},
+		{"agent_state_changed", EventAgentStateChanged, false},
+		{"", "", true},
+		{"deleted", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseEventType(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidEventType) {
+					t.Errorf("ParseEventType(%q) error = %v, want ErrInvalidEventType", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseEventType(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseEventType(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllEventTypes_Parseable(t *testing.T) {
+	for _, et := range AllEventTypes() {
+		got, err := ParseEventType(string(et))
+		if err != nil || got != et {
+			t.Errorf("ParseEventType(%q) = %q, %v", et, got, err)
+		}
+	}
+}
+
+func TestProjectChangeEvents(t *testing.T) {
+	at := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+	base := Project{ID: "p1", CurrentStage: StagePlan, DetectedMethod: "speckit"}
+
+	t.Run("nil after", func(t *testing.T) {
+		if events := ProjectChangeEvents(&base, nil, at); events != nil {
+			t.Errorf("expected nil, got %+v", events)
+		}
+	})
+
+	t.Run("new project", func(t *testing.T) {
+		events := ProjectChangeEvents(nil, &base, at)
+		if len(events) != 1 {
+			t.Fatalf("expected 1 event, got %d", len(events))
+		}
+		e := events[0]
+		if e.Type != EventProjectAdded || e.ProjectID != "p1" || e.To != "Plan" || e.Detail != "speckit" || !e.OccurredAt.Equal(at) {
+			t.Errorf("unexpected event: %+v", e)
+		}
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		after := base
+		after.State = StateHibernated // state is recorded by StateService, not diffed
+		if events := ProjectChangeEvents(&base, &after, at); len(events) != 0 {
+			t.Errorf("expected no events, got %+v", events)
+		}
+	})
+
+	t.Run("stage and method changed", func(t *testing.T) {
+		after := base
+		after.CurrentStage = StageImplement
+		after.DetectedMethod = "bmad"
+		after.DetectionReasoning = "sprint-status.yaml found"
+
+		events := ProjectChangeEvents(&base, &after, at)
+		if len(events) != 2 {
+			t.Fatalf("expected 2 events, got %d", len(events))
+		}
+		if events[0].Type != EventMethodChanged || events[0].From != "speckit" || events[0].To != "bmad" {
+			t.Errorf("unexpected method event: %+v", events[0])
+		}
+		if events[1].Type != EventStageChanged || events[1].From != "Plan" || events[1].To != "Implement" || events[1].Detail != "sprint-status.yaml found" {
+			t.Errorf("unexpected stage event: %+v", events[1])
+		}
+	})
+
+	t.Run("method stage changed within coarse stage", func(t *testing.T) {
+		before := Project{ID: "p1", CurrentStage: StageImplement, DetectedMethod: "bmad",
+			MethodStage: MethodStage{ID: "development", Label: "Development", Coarse: StageImplement}}
+		after := before
+		after.MethodStage = MethodStage{ID: "retrospective", Label: "Retrospective", Coarse: StageImplement}
+
+		events := ProjectChangeEvents(&before, &after, at)
+		if len(events) != 1 || events[0].Type != EventStageChanged || events[0].From != "Development" || events[0].To != "Retrospective" {
+			t.Errorf("unexpected events: %+v", events)
+		}
+	})
+
+	t.Run("method stage first mapped", func(t *testing.T) {
+		// Project stored before stage taxonomies: same coarse stage is not a change
+		after := base
+		after.MethodStage = MethodStage{ID: "plan", Label: "Plan", Coarse: StagePlan}
+		if events := ProjectChangeEvents(&base, &after, at); len(events) != 0 {
+			t.Errorf("expected no events, got %+v", events)
+		}
+	})
+}
@@ -355,3 +355,94 @@ func TestProject_DaysSinceHibernated(t *testing.T) {
 		})
 	}
 }
+
+func TestProject_ApplyDetection(t *testing.T) {
+	speckit := NewDetectionResult("speckit", StagePlan, ConfidenceCertain, "plan.md exists").WithTasks(3, 10, "T004 Add model").
+		WithTaskPhases([]TaskPhase{{Name: "Phase 1: Setup", Done: 3, Total: 3}, {Name: "Phase 2: Core", Total: 7}}, "Phase 2: Core")
+	bmad := NewDetectionResult("bmad", StageImplement, ConfidenceLikely, "sprint-status.yaml").
+		WithSprint(&SprintProgress{Epics: []SprintEpic{{ID: "1", Status: WorkInProgress}}})
+	tied := speckit.WithCoexistenceWarning("speckit and bmad both active")
+
+	t.Run("winner clears coexistence", func(t *testing.T) {
+		p, _ := NewProject("/home/user/project", "")
+		p.CoexistenceWarning = true
+		p.CoexistenceMessage = "old"
+		p.SecondaryMethod = "bmad"
+		p.SecondaryStage = StageTasks
+
+		p.ApplyDetection(&speckit, []*DetectionResult{&speckit, &bmad})
+
+		if p.DetectedMethod != "speckit" || p.CurrentStage != StagePlan || p.Confidence != ConfidenceCertain {
+			t.Errorf("unexpected primary: %s/%s (%s)", p.DetectedMethod, p.CurrentStage, p.Confidence)
+		}
+		if p.DetectionReasoning != "plan.md exists" {
+			t.Errorf("DetectionReasoning = %q", p.DetectionReasoning)
+		}
+		if p.TasksDone != 3 || p.TasksTotal != 10 || p.NextTask != "T004 Add model" {
+			t.Errorf("tasks = %d/%d next %q, want 3/10 next T004", p.TasksDone, p.TasksTotal, p.NextTask)
+		}
+		if p.NextTaskPhase != "Phase 2: Core" || len(p.TaskPhases) != 2 {
+			t.Errorf("phases = %+v next in %q, want 2 phases next in Phase 2", p.TaskPhases, p.NextTaskPhase)
+		}
+		if p.CoexistenceWarning || p.CoexistenceMessage != "" || p.SecondaryMethod != "" || p.SecondaryStage != StageUnknown {
+			t.Errorf("coexistence fields not cleared: %+v", p)
+		}
+	})
+
+	t.Run("tie keeps runner-up as secondary", func(t *testing.T) {
+		p, _ := NewProject("/home/user/project", "")
+		p.ApplyDetection(nil, []*DetectionResult{&tied, &bmad})
+
+		if p.DetectedMethod != "speckit" {
+			t.Errorf("DetectedMethod = %q, want speckit", p.DetectedMethod)
+		}
+		if !p.CoexistenceWarning || p.CoexistenceMessage != "speckit and bmad both active" {
+			t.Errorf("expected coexistence warning, got %v %q", p.CoexistenceWarning, p.CoexistenceMessage)
+		}
+		if p.SecondaryMethod != "bmad" || p.SecondaryStage != StageImplement {
+			t.Errorf("secondary = %s/%s, want bmad/Implement", p.SecondaryMethod, p.SecondaryStage)
+		}
+	})
+
+	t.Run("bmad winner carries sprint", func(t *testing.T) {
+		p, _ := NewProject("/home/user/project", "")
+		p.ApplyDetection(&bmad, []*DetectionResult{&bmad})
+
+		if p.Sprint != bmad.Sprint || p.TasksTotal != 0 {
+			t.Errorf("sprint = %+v tasks %d, want bmad sprint and no tasks", p.Sprint, p.TasksTotal)
+		}
+	})
+
+	t.Run("nothing detected", func(t *testing.T) {
+		p, _ := NewProject("/home/user/project", "")
+		p.Notes = "keep me"
+		p.IsFavorite = true
+		p.TasksTotal = 5
+		p.Sprint = &SprintProgress{}
+		p.MethodStage = MethodStage{ID: "plan", Label: "Plan", Coarse: StagePlan}
+
+		p.ApplyDetection(nil, nil)
+
+		if p.DetectedMethod != "unknown" || p.CurrentStage != StageUnknown || p.Confidence != ConfidenceUncertain {
+			t.Errorf("unexpected result: %s/%s (%s)", p.DetectedMethod, p.CurrentStage, p.Confidence)
+		}
+		if p.TasksTotal != 0 || p.Sprint != nil || !p.MethodStage.IsZero() {
+			t.Errorf("TasksTotal = %d, Sprint = %+v, MethodStage = %+v, want cleared", p.TasksTotal, p.Sprint, p.MethodStage)
+		}
+		if p.Notes != "keep me" || !p.IsFavorite {
+			t.Error("ApplyDetection must not touch user fields")
+		}
+	})
+}
+
+func TestProject_StageLabel(t *testing.T) {
+	p := Project{CurrentStage: StageTasks}
+	if got := p.StageLabel(); got != "Tasks" {
+		t.Errorf("StageLabel() = %q, want coarse fallback %q", got, "Tasks")
+	}
+
+	p.MethodStage = MethodStage{ID: "code-review", Label: "Code review", Coarse: StageTasks}
+	if got := p.StageLabel(); got != "Code review" {
+		t.Errorf("StageLabel() = %q, want %q", got, "Code review")
+	}
+}
@@ -0,0 +1,153 @@
+package domain
+
+import "fmt"
+
+// WorkStatus is the normalized status of a sprint epic, story or
+// retrospective. Spelling variants ("WIP", "completed", "in_review") are
+// mapped to these values by the detector.
+type WorkStatus string
+
+const (
+	WorkBacklog    WorkStatus = "backlog"
+	WorkDrafted    WorkStatus = "drafted"
+	WorkReady      WorkStatus = "ready-for-dev"
+	WorkInProgress WorkStatus = "in-progress"
+	WorkReview     WorkStatus = "review"
+	WorkDone       WorkStatus = "done"
+	WorkDeferred   WorkStatus = "deferred"
+	WorkUnknown    WorkStatus = "unknown" // Empty or unrecognized status
+)
+
+// IsActive returns true for work being implemented or reviewed.
+func (s WorkStatus) IsActive() bool {
+	return s == WorkInProgress || s == WorkReview
+}
+
+// SprintStory is one story of an epic: "8-3-stage-display: review".
+type SprintStory struct {
+	Key       string     // Sprint status key, "8-3-stage-display"
+	ID        string     // Dotted story number, "8.3"
+	Status    WorkStatus // Normalized status
+	RawStatus string     // Status as written, kept for unknown values
+}
+
+// SprintEpic is an epic with its stories in natural order.
+type SprintEpic struct {
+	Key           string     // Sprint status key, "epic-4-5"
+	ID            string     // Dotted epic number, "4.5"
+	Status        WorkStatus // Normalized status
+	Stories       []SprintStory
+	Retrospective WorkStatus // Status of "epic-N-retrospective", empty if not listed
+}
+
+// StoryCounts returns the done and total stories of the epic.
+func (e SprintEpic) StoryCounts() (done, total int) {
+	for _, s := range e.Stories {
+		if s.Status == WorkDone {
+			done++
+		}
+	}
+	return done, len(e.Stories)
+}
+
+// SprintFocusKind names what a BMAD stage was derived from.
+type SprintFocusKind string
+
+const (
+	FocusStory         SprintFocusKind = "story"         // The most advanced story of the current epic
+	FocusEpic          SprintFocusKind = "epic"          // An epic without a story to pick
+	FocusRetrospective SprintFocusKind = "retrospective" // A running retrospective after all epics are done
+	FocusComplete      SprintFocusKind = "complete"      // All epics done
+	FocusPlanning      SprintFocusKind = "planning"      // All epics still in backlog
+)
+
+// SprintFocus is the sprint item the current stage was derived from.
+type SprintFocus struct {
+	Kind   SprintFocusKind
+	Epic   string     // Dotted epic number, empty for complete and planning
+	Story  string     // Dotted story number, story focus only
+	Status WorkStatus // Status that decided the stage
+}
+
+// SprintWarningKind classifies data problems found in sprint-status.yaml.
+type SprintWarningKind string
+
+const (
+	WarningEmptyStatus   SprintWarningKind = "empty-status"   // Key with no status value
+	WarningOrphanStory   SprintWarningKind = "orphan-story"   // Story without a matching epic
+	WarningUnknownStatus SprintWarningKind = "unknown-status" // Story status that is not recognized
+)
+
+// SprintWarning is one data problem in sprint-status.yaml.
+type SprintWarning struct {
+	Kind   SprintWarningKind
+	Key    string // Sprint status key the warning is about
+	Detail string // Orphan story number or unknown status value
+}
+
+// String returns the warning as shown in detection reasoning:
+// "orphan story 3.1", "empty status for 5-1-setup".
+func (w SprintWarning) String() string {
+	switch w.Kind {
+	case WarningEmptyStatus:
+		return "empty status for " + w.Key
+	case WarningOrphanStory:
+		return "orphan story " + w.Detail
+	case WarningUnknownStatus:
+		return fmt.Sprintf("unknown status '%s' for %s", w.Detail, w.Key)
+	default:
+		return string(w.Kind) + " " + w.Key
+	}
+}
+
+// SprintProgress is the structured state of a BMAD sprint: the epic and
+// story tree, the item the stage was derived from and data warnings.
+type SprintProgress struct {
+	Epics    []SprintEpic
+	Focus    *SprintFocus // nil when no item decided the stage
+	Warnings []SprintWarning
+}
+
+// StoryCounts returns done and total stories, excluding deferred epics and
+// stories.
+func (s *SprintProgress) StoryCounts() (done, total int) {
+	for _, e := range s.Epics {
+		if e.Status == WorkDeferred {
+			continue
+		}
+		for _, story := range e.Stories {
+			if story.Status == WorkDeferred {
+				continue
+			}
+			total++
+			if story.Status == WorkDone {
+				done++
+			}
+		}
+	}
+	return done, total
+}
+
+// EpicCounts returns done and total epics, excluding deferred ones.
+func (s *SprintProgress) EpicCounts() (done, total int) {
+	for _, e := range s.Epics {
+		if e.Status == WorkDeferred {
+			continue
+		}
+		total++
+		if e.Status == WorkDone {
+			done++
+		}
+	}
+	return done, total
+}
+
+// Epic returns the epic with the dotted ID, or nil.
+func (s *SprintProgress) Epic(id string) *SprintEpic {
+	for i := range s.Epics {
+		if s.Epics[i].ID == id {
+			return &s.Epics[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package domain
+
+import "testing"
+
+func testSprint() *SprintProgress {
+	return &SprintProgress{
+		Epics: []SprintEpic{
+			{ID: "1", Status: WorkDone, Stories: []SprintStory{{ID: "1.1", Status: WorkDone}, {ID: "1.2", Status: WorkDone}}},
+			{ID: "2", Status: WorkInProgress, Stories: []SprintStory{{ID: "2.1", Status: WorkDone}, {ID: "2.2", Status: WorkReview}, {ID: "2.3", Status: WorkDeferred}}},
+			{ID: "3", Status: WorkDeferred, Stories: []SprintStory{{ID: "3.1", Status: WorkBacklog}}},
+			{ID: "4", Status: WorkBacklog},
+		},
+	}
+}
+
+func TestSprintProgress_Counts(t *testing.T) {
+	s := testSprint()
+	if done, total := s.StoryCounts(); done != 3 || total != 4 {
+		t.Errorf("StoryCounts() = %d/%d, want 3/4", done, total)
+	}
+	if done, total := s.EpicCounts(); done != 1 || total != 3 {
+		t.Errorf("EpicCounts() = %d/%d, want 1/3", done, total)
+	}
+	if done, total := s.Epics[1].StoryCounts(); done != 1 || total != 3 {
+		t.Errorf("SprintEpic.StoryCounts() = %d/%d, want 1/3", done, total)
+	}
+}
+
+func TestSprintProgress_Epic(t *testing.T) {
+	s := testSprint()
+	if e := s.Epic("2"); e == nil || e.Status != WorkInProgress {
+		t.Errorf("Epic(2) = %+v, want in-progress epic", e)
+	}
+	if e := s.Epic("9"); e != nil {
+		t.Errorf("Epic(9) = %+v, want nil", e)
+	}
+}
+
+func TestWorkStatus_IsActive(t *testing.T) {
+	for _, s := range []WorkStatus{WorkInProgress, WorkReview} {
+		if !s.IsActive() {
+			t.Errorf("%q.IsActive() = false, want true", s)
+		}
+	}
+	for _, s := range []WorkStatus{WorkBacklog, WorkDrafted, WorkReady, WorkDone, WorkDeferred, WorkUnknown} {
+		if s.IsActive() {
+			t.Errorf("%q.IsActive() = true, want false", s)
+		}
+	}
+}
+
+func TestSprintWarning_String(t *testing.T) {
+	tests := []struct {
+		w    SprintWarning
+		want string
+	}{
+		{SprintWarning{Kind: WarningEmptyStatus, Key: "5-1-setup"}, "empty status for 5-1-setup"},
+		{SprintWarning{Kind: WarningOrphanStory, Key: "3-1-x", Detail: "3.1"}, "orphan story 3.1"},
+		{SprintWarning{Kind: WarningUnknownStatus, Key: "2-1-x", Detail: "blocked"}, "unknown status 'blocked' for 2-1-x"},
+	}
+	for _, tt := range tests {
+		if got := tt.w.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "strings"
+
+// TaskPhase is the task completion of one heading of a task list:
+// "Phase 3: User Story 1" with 4 of 9 tasks done.
+type TaskPhase struct {
+	Name     string // Heading text, "Phase 3: User Story 1"
+	Done     int
+	Total    int
+	Parallel int // Open tasks marked [P], which can run alongside each other
+}
+
+// IsDone returns true once every task of the phase is checked.
+func (p TaskPhase) IsDone() bool {
+	return p.Total > 0 && p.Done >= p.Total
+}
+
+// ShortPhaseName returns a phase heading up to its first colon: "Phase 3"
+// for "Phase 3: User Story 1". Headings without a colon are returned whole.
+func ShortPhaseName(name string) string {
+	if short, _, ok := strings.Cut(name, ":"); ok && strings.TrimSpace(short) != "" {
+		return strings.TrimSpace(short)
+	}
+	return name
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "testing"
+
+func TestTaskPhase_IsDone(t *testing.T) {
+	tests := []struct {
+		name  string
+		phase TaskPhase
+		want  bool
+	}{
+		{"all checked", TaskPhase{Done: 3, Total: 3}, true},
+		{"some open", TaskPhase{Done: 2, Total: 3}, false},
+		{"no tasks", TaskPhase{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.phase.IsDone(); got != tt.want {
+				t.Errorf("IsDone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortPhaseName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Phase 3: User Story 1 - Login (Priority: P1)", "Phase 3"},
+		{"Setup", "Setup"},
+		{": untitled", ": untitled"},
+	}
+	for _, tt := range tests {
+		if got := ShortPhaseName(tt.name); got != tt.want {
+			t.Errorf("ShortPhaseName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
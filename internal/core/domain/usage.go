@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// TokenUsage counts the tokens billed for agent model calls.
+type TokenUsage struct {
+	Input      int64 // Uncached input tokens
+	Output     int64
+	CacheRead  int64 // Input tokens served from the prompt cache
+	CacheWrite int64 // Input tokens written to the prompt cache
+}
+
+// Total returns all tokens of every kind.
+func (u TokenUsage) Total() int64 {
+	return u.Input + u.Output + u.CacheRead + u.CacheWrite
+}
+
+// IsZero returns true if no tokens were counted.
+func (u TokenUsage) IsZero() bool {
+	return u == TokenUsage{}
+}
+
+// Add returns the sum of u and o.
+func (u TokenUsage) Add(o TokenUsage) TokenUsage {
+	return TokenUsage{
+		Input:      u.Input + o.Input,
+		Output:     u.Output + o.Output,
+		CacheRead:  u.CacheRead + o.CacheRead,
+		CacheWrite: u.CacheWrite + o.CacheWrite,
+	}
+}
+
+// ModelPrice is a model's price in USD per million tokens.
+type ModelPrice struct {
+	Input      float64
+	Output     float64
+	CacheRead  float64
+	CacheWrite float64
+}
+
+// Cost returns the estimated cost of u in USD.
+func (p ModelPrice) Cost(u TokenUsage) float64 {
+	return (float64(u.Input)*p.Input +
+		float64(u.Output)*p.Output +
+		float64(u.CacheRead)*p.CacheRead +
+		float64(u.CacheWrite)*p.CacheWrite) / 1_000_000
+}
+
+// PriceTable maps model ID prefixes to prices, e.g. "claude-sonnet-4"
+// prices "claude-sonnet-4-5-20250929".
+type PriceTable map[string]ModelPrice
+
+// Lookup returns the price of the longest prefix matching model.
+func (t PriceTable) Lookup(model string) (ModelPrice, bool) {
+	best := ""
+	for prefix := range t {
+		if strings.HasPrefix(model, prefix) && len(prefix) > len(best) {
+			best = prefix
+		}
+	}
+	if best == "" {
+		return ModelPrice{}, false
+	}
+	return t[best], true
+}
+
+// UsageBucket is the usage of one model on one local calendar day.
+type UsageBucket struct {
+	Day      time.Time // Local midnight
+	Model    string
+	Tokens   TokenUsage
+	Messages int // Assistant replies
+}
+
+// SessionUsage is the usage recorded in one session log.
+type SessionUsage struct {
+	SessionID string
+	Path      string
+	StartTime time.Time
+	EndTime   time.Time // Last entry; sessions sort by this
+	Buckets   []UsageBucket
+
+	// Set by ComputeProjectUsage from the buckets in range
+	Tokens   TokenUsage
+	Cost     float64
+	Messages int
+}
+
+// ModelUsage is the usage of one model.
+type ModelUsage struct {
+	Model  string
+	Tokens TokenUsage
+	Cost   float64
+	Priced bool // False when the price table has no entry (Cost is 0)
+}
+
+// DailyUsage is the usage accumulated over one local calendar day.
+type DailyUsage struct {
+	Day    time.Time // Local midnight
+	Tokens TokenUsage
+	Cost   float64
+}
+
+// ProjectUsage aggregates the token usage and estimated cost of a
+// project's agent sessions over a time range.
+type ProjectUsage struct {
+	ProjectID string
+	Since     time.Time
+
+	Sessions []SessionUsage // Sessions with usage in range, newest first
+	ByDay    []DailyUsage   // Oldest first
+	ByModel  []ModelUsage   // Most expensive first
+	Tokens   TokenUsage
+	Cost     float64
+	Unpriced []string // Models missing from the price table
+}
+
+// Day returns the usage on the local calendar day containing t.
+func (u ProjectUsage) Day(t time.Time) DailyUsage {
+	day := localMidnight(t)
+	for _, d := range u.ByDay {
+		if d.Day.Equal(day) {
+			return d
+		}
+	}
+	return DailyUsage{Day: day}
+}
+
+// Session returns the usage of the session log at path, or nil.
+func (u ProjectUsage) Session(path string) *SessionUsage {
+	for i := range u.Sessions {
+		if u.Sessions[i].Path == path {
+			return &u.Sessions[i]
+		}
+	}
+	return nil
+}
+
+// ComputeProjectUsage prices the buckets of sessions dated on or after the
+// day containing since (zero since = all time) and aggregates them per
+// session, day and model.
+func ComputeProjectUsage(projectID string, sessions []SessionUsage, since time.Time, prices PriceTable) ProjectUsage {
+	usage := ProjectUsage{ProjectID: projectID, Since: since}
+	var from time.Time
+	if !since.IsZero() {
+		from = localMidnight(since)
+	}
+
+	byDay := make(map[time.Time]*DailyUsage)
+	byModel := make(map[string]*ModelUsage)
+
+	for _, s := range sessions {
+		s.Tokens, s.Cost, s.Messages = TokenUsage{}, 0, 0
+		for _, b := range s.Buckets {
+			if b.Day.Before(from) || b.Tokens.IsZero() {
+				continue
+			}
+			price, priced := prices.Lookup(b.Model)
+			cost := price.Cost(b.Tokens)
+
+			s.Tokens = s.Tokens.Add(b.Tokens)
+			s.Cost += cost
+			s.Messages += b.Messages
+
+			d, ok := byDay[b.Day]
+			if !ok {
+				d = &DailyUsage{Day: b.Day}
+				byDay[b.Day] = d
+			}
+			d.Tokens = d.Tokens.Add(b.Tokens)
+			d.Cost += cost
+
+			m, ok := byModel[b.Model]
+			if !ok {
+				m = &ModelUsage{Model: b.Model, Priced: priced}
+				byModel[b.Model] = m
+			}
+			m.Tokens = m.Tokens.Add(b.Tokens)
+			m.Cost += cost
+		}
+		if s.Tokens.IsZero() {
+			continue
+		}
+		usage.Sessions = append(usage.Sessions, s)
+		usage.Tokens = usage.Tokens.Add(s.Tokens)
+		usage.Cost += s.Cost
+	}
+
+	sort.SliceStable(usage.Sessions, func(i, j int) bool {
+		return usage.Sessions[i].EndTime.After(usage.Sessions[j].EndTime)
+	})
+	for _, d := range byDay {
+		usage.ByDay = append(usage.ByDay, *d)
+	}
+	sort.Slice(usage.ByDay, func(i, j int) bool { return usage.ByDay[i].Day.Before(usage.ByDay[j].Day) })
+	for _, m := range byModel {
+		usage.ByModel = append(usage.ByModel, *m)
+		if !m.Priced {
+			usage.Unpriced = append(usage.Unpriced, m.Model)
+		}
+	}
+	sort.Slice(usage.ByModel, func(i, j int) bool {
+		if usage.ByModel[i].Cost != usage.ByModel[j].Cost {
+			return usage.ByModel[i].Cost > usage.ByModel[j].Cost
+		}
+		return usage.ByModel[i].Model < usage.ByModel[j].Model
+	})
+	sort.Strings(usage.Unpriced)
+	return usage
+}
+
+// localMidnight returns the start of the local calendar day containing t.
+func localMidnight(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPriceTable_LookupLongestPrefix(t *testing.T) {
+	prices := PriceTable{
+		"claude-opus-4":   {Input: 15},
+		"claude-opus-4-5": {Input: 5},
+	}
+
+	tests := []struct {
+		model string
+		want  float64
+		ok    bool
+	}{
+		{"claude-opus-4-1-20250805", 15, true},
+		{"claude-opus-4-5-20251101", 5, true},
+		{"claude-haiku-4-5", 0, false},
+		{"<synthetic>", 0, false},
+	}
+	for _, tt := range tests {
+		price, ok := prices.Lookup(tt.model)
+		if ok != tt.ok || price.Input != tt.want {
+			t.Errorf("Lookup(%q) = %v, %v; want input %v, %v", tt.model, price.Input, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestModelPrice_Cost(t *testing.T) {
+	price := ModelPrice{Input: 3, Output: 15, CacheRead: 0.3, CacheWrite: 3.75}
+	usage := TokenUsage{Input: 1_000_000, Output: 100_000, CacheRead: 2_000_000, CacheWrite: 400_000}
+
+	// 3 + 1.5 + 0.6 + 1.5
+	if got := price.Cost(usage); math.Abs(got-6.6) > 1e-9 {
+		t.Errorf("Cost() = %v, want 6.6", got)
+	}
+	if usage.Total() != 3_500_000 {
+		t.Errorf("Total() = %d, want 3500000", usage.Total())
+	}
+}
+
+func TestComputeProjectUsage(t *testing.T) {
+	day1 := time.Date(2026, 5, 1, 0, 0, 0, 0, time.Local)
+	day2 := day1.AddDate(0, 0, 1)
+	prices := PriceTable{"claude-sonnet-4": {Input: 3, Output: 15}}
+
+	sessions := []SessionUsage{
+		{
+			SessionID: "old",
+			Path:      "/logs/old.jsonl",
+			EndTime:   day1.Add(10 * time.Hour),
+			Buckets: []UsageBucket{
+				{Day: day1, Model: "claude-sonnet-4-5", Tokens: TokenUsage{Input: 1_000_000}, Messages: 2},
+			},
+		},
+		{
+			SessionID: "new",
+			Path:      "/logs/new.jsonl",
+			EndTime:   day2.Add(9 * time.Hour),
+			Buckets: []UsageBucket{
+				{Day: day1, Model: "claude-sonnet-4-5", Tokens: TokenUsage{Output: 100_000}, Messages: 1},
+				{Day: day2, Model: "claude-sonnet-4-5", Tokens: TokenUsage{Output: 200_000}, Messages: 3},
+				{Day: day2, Model: "mystery-model", Tokens: TokenUsage{Input: 500}, Messages: 1},
+			},
+		},
+		{SessionID: "empty", Path: "/logs/empty.jsonl", EndTime: day2.Add(11 * time.Hour)},
+	}
+
+	usage := ComputeProjectUsage("p1", sessions, time.Time{}, prices)
+	if len(usage.Sessions) != 2 || usage.Sessions[0].SessionID != "new" {
+		t.Fatalf("sessions = %+v, want new then old (empty dropped)", usage.Sessions)
+	}
+	if got := usage.Sessions[0]; math.Abs(got.Cost-4.5) > 1e-9 || got.Messages != 5 {
+		t.Errorf("new session cost = %v, messages = %d; want 4.5, 5", got.Cost, got.Messages)
+	}
+	if math.Abs(usage.Cost-7.5) > 1e-9 || usage.Tokens.Total() != 1_300_500 {
+		t.Errorf("total = %v (%d tokens), want 7.5", usage.Cost, usage.Tokens.Total())
+	}
+	if len(usage.ByDay) != 2 || !usage.ByDay[0].Day.Equal(day1) || math.Abs(usage.ByDay[0].Cost-4.5) > 1e-9 {
+		t.Errorf("ByDay = %+v", usage.ByDay)
+	}
+	if len(usage.ByModel) != 2 || usage.ByModel[0].Model != "claude-sonnet-4-5" || usage.ByModel[1].Priced {
+		t.Errorf("ByModel = %+v", usage.ByModel)
+	}
+	if len(usage.Unpriced) != 1 || usage.Unpriced[0] != "mystery-model" {
+		t.Errorf("Unpriced = %v", usage.Unpriced)
+	}
+	if got := usage.Day(day2.Add(15 * time.Hour)); math.Abs(got.Cost-3) > 1e-9 {
+		t.Errorf("Day(day2) cost = %v, want 3", got.Cost)
+	}
+	if usage.Session("/logs/old.jsonl") == nil || usage.Session("/logs/empty.jsonl") != nil {
+		t.Error("Session() should find sessions with usage only")
+	}
+
+	// Since drops earlier days, and sessions left without usage
+	recent := ComputeProjectUsage("p1", sessions, day2.Add(8*time.Hour), prices)
+	if len(recent.Sessions) != 1 || math.Abs(recent.Cost-3) > 1e-9 || len(recent.ByDay) != 1 {
+		t.Errorf("since day2: sessions = %d, cost = %v, days = %d", len(recent.Sessions), recent.Cost, len(recent.ByDay))
+	}
+}
@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 )
@@ -46,6 +47,37 @@ type Config struct {
 	// Default: 30. Set to 0 to disable periodic stage detection.
 	StageRefreshIntervalSeconds int
 
+	// SortMode orders the dashboard and `vdash list` (see domain.SortModes).
+	// Default: "name". Changed from the dashboard with the sort key.
+	SortMode string
+
+	// GroupBy groups the dashboard under headers: none, method, stage or tag.
+	// Default: "none". Changed from the dashboard with the group key.
+	GroupBy string
+
+	// Theme selects the color theme: a built-in name (dark, light, high-contrast,
+	// solarized, monochrome), a file name in ~/.vibe-dash/themes/ (without
+	// .yaml) or a path to a theme file. Default: "dark". NO_COLOR and
+	// --no-color override it with monochrome rendering.
+	Theme string
+
+	// Notifications configures alerts when an agent starts waiting for input.
+	// Disabled by default.
+	Notifications NotificationConfig
+
+	// WorkspaceRoots are directories (e.g. ~/work) re-scanned for new projects
+	// by the dashboard and daemon, as `vdash scan` does. Empty by default.
+	WorkspaceRoots []string
+
+	// Webhooks are HTTP endpoints called when project history events occur.
+	// Empty by default.
+	Webhooks []WebhookConfig
+
+	// KeyBindings overrides TUI keys per action (e.g. "down": ["j", "ctrl+n"]).
+	// Actions not listed keep their default keys. Action names and conflicts
+	// are checked by the TUI, which warns and keeps going. Empty by default.
+	KeyBindings map[string][]string
+
 	// Projects contains per-project configuration overrides
 	// Key is the directory name (v2 format uses directory_name as map key)
 	Projects map[string]ProjectConfig
@@ -70,6 +102,10 @@ type ProjectConfig struct {
 	// IsFavorite marks the project as always visible regardless of activity (FR30)
 	IsFavorite bool
 
+	// Tag is a user-defined label used to group the dashboard by tag.
+	// Empty means untagged.
+	Tag string
+
 	// HibernationDays overrides the global setting for this project
 	// nil means use global setting
 	// DEPRECATED: Use per-project config file instead (Story 3.5.3)
@@ -81,6 +117,56 @@ type ProjectConfig struct {
 	AgentWaitingThresholdMinutes *int
 }
 
+// NotificationConfig selects the sinks used when an agent starts waiting.
+// A notification fires once the agent has waited for the project's effective
+// agent waiting threshold; a threshold of 0 disables it for that project.
+type NotificationConfig struct {
+	// Enabled turns notifications on. Default: false.
+	Enabled bool
+
+	// Bell rings the terminal bell. Default: true.
+	Bell bool
+
+	// Desktop shows a desktop notification (notify-send on Linux, osascript on macOS).
+	// Default: true.
+	Desktop bool
+
+	// Command is a shell command template run for each notification, e.g.
+	// `say "{{.Project}} is waiting"`. Empty disables it. Default: "".
+	Command string
+}
+
+// Webhook retry defaults, applied when a webhook entry omits them.
+const (
+	DefaultWebhookMaxRetries          = 3
+	DefaultWebhookRetryBackoffSeconds = 2
+)
+
+// WebhookConfig defines one webhook endpoint.
+// Event names are "<subject>.<change>" (e.g., "agent.waiting", "stage.changed",
+// "project.hibernated"); "agent.*" matches every agent status and "*" matches all.
+type WebhookConfig struct {
+	// Name labels the webhook in logs. Default: the URL host.
+	Name string
+
+	// URL is the http(s) endpoint that receives POSTed JSON payloads.
+	URL string
+
+	// Events filters which events are delivered. Empty means all events.
+	Events []string
+
+	// Secret, when set, signs each body with HMAC-SHA256
+	// (X-Vdash-Signature: sha256=<hex>).
+	Secret string
+
+	// MaxRetries is the number of retries after a failed delivery. Default: 3.
+	MaxRetries int
+
+	// RetryBackoffSeconds is the delay before the first retry; it doubles
+	// on each further retry. Default: 2.
+	RetryBackoffSeconds int
+}
+
 // NewConfig creates a Config with default values.
 // Call this instead of creating Config{} directly to ensure defaults are set.
 func NewConfig() *Config {
@@ -93,6 +179,10 @@ func NewConfig() *Config {
 		DetailLayout:                 "horizontal",
 		MaxContentWidth:              120, // Story 8.10: default cap for readability
 		StageRefreshIntervalSeconds:  30,  // Story 8.11: default 30s for stage re-detection
+		SortMode:                     string(domain.SortByName),
+		GroupBy:                      string(domain.GroupByNone),
+		Theme:                        "dark",
+		Notifications:                NotificationConfig{Bell: true, Desktop: true},
 		Projects:                     make(map[string]ProjectConfig),
 	}
 }
@@ -140,6 +230,14 @@ func (c *Config) GetDirForPath(canonicalPath string) string {
 	return ""
 }
 
+// GetProjectTag returns the user-defined tag for a project path, or "".
+func (c *Config) GetProjectTag(path string) string {
+	if dirName := c.GetDirForPath(path); dirName != "" {
+		return c.Projects[dirName].Tag
+	}
+	return ""
+}
+
 // GetDirectoryName returns the directory name for a project path.
 // Wrapper around GetDirForPath with bool return for convenience.
 func (c *Config) GetDirectoryName(path string) (string, bool) {
@@ -159,6 +257,7 @@ func (c *Config) GetProjectPath(directoryName string) (string, bool) {
 }
 
 // SetProjectEntry adds or updates a project entry in the config.
+// An existing entry keeps its tag.
 func (c *Config) SetProjectEntry(directoryName, path, displayName string, favorite bool) {
 	if c.Projects == nil {
 		c.Projects = make(map[string]ProjectConfig)
@@ -168,6 +267,7 @@ func (c *Config) SetProjectEntry(directoryName, path, displayName string, favori
 		DirectoryName: directoryName,
 		DisplayName:   displayName,
 		IsFavorite:    favorite,
+		Tag:           c.Projects[directoryName].Tag,
 	}
 }
 
@@ -220,6 +320,19 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("%w: stage_refresh_interval must be >= 0, got %d", domain.ErrConfigInvalid, c.StageRefreshIntervalSeconds)
 	}
 
+	if _, err := domain.ParseSortMode(c.SortMode); err != nil {
+		return fmt.Errorf("%w: sort_mode: %s", domain.ErrConfigInvalid, err.Error())
+	}
+	if _, err := domain.ParseGroupBy(c.GroupBy); err != nil {
+		return fmt.Errorf("%w: group_by: %s", domain.ErrConfigInvalid, err.Error())
+	}
+
+	for i, wh := range c.Webhooks {
+		if err := wh.Validate(); err != nil {
+			return fmt.Errorf("%w: webhooks[%d]: %s", domain.ErrConfigInvalid, i, err.Error())
+		}
+	}
+
 	// Validate per-project overrides
 	for projectID, pc := range c.Projects {
 		if pc.HibernationDays != nil && *pc.HibernationDays < 0 {
@@ -235,6 +348,22 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// Validate checks a single webhook entry. The error message does not
+// include domain.ErrConfigInvalid so callers can add their own context.
+func (w WebhookConfig) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an absolute http(s) URL, got %q", w.URL)
+	}
+	if w.MaxRetries < 0 {
+		return fmt.Errorf("max_retries must be >= 0, got %d", w.MaxRetries)
+	}
+	if w.RetryBackoffSeconds < 0 {
+		return fmt.Errorf("retry_backoff_seconds must be >= 0, got %d", w.RetryBackoffSeconds)
+	}
+	return nil
+}
+
 // ConfigLoader defines the interface for loading and saving application configuration.
 // Implementations handle the underlying storage mechanism (YAML files, environment, etc.).
 //
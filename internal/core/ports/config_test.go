@@ -929,3 +929,71 @@ func TestNewConfig_StageRefreshIntervalSeconds_Default(t *testing.T) {
 		t.Errorf("StageRefreshIntervalSeconds = %d, want 30", config.StageRefreshIntervalSeconds)
 	}
 }
+
+func TestConfig_Validate_Webhooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook ports.WebhookConfig
+		wantErr bool
+	}{
+		{"https url is valid", ports.WebhookConfig{URL: "https://example.com/hook"}, false},
+		{"http url is valid", ports.WebhookConfig{URL: "http://127.0.0.1:9000"}, false},
+		{"empty url is invalid", ports.WebhookConfig{}, true},
+		{"non-http scheme is invalid", ports.WebhookConfig{URL: "ftp://example.com"}, true},
+		{"relative url is invalid", ports.WebhookConfig{URL: "/hook"}, true},
+		{"negative retries is invalid", ports.WebhookConfig{URL: "https://example.com", MaxRetries: -1}, true},
+		{"negative backoff is invalid", ports.WebhookConfig{URL: "https://example.com", RetryBackoffSeconds: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ports.NewConfig()
+			config.Webhooks = []ports.WebhookConfig{tt.webhook}
+			err := config.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrConfigInvalid) {
+				t.Errorf("Validate() error should wrap domain.ErrConfigInvalid, got %v", err)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate_SortModeAndGroupBy(t *testing.T) {
+	cfg := ports.NewConfig()
+	cfg.SortMode = "recent"
+	cfg.GroupBy = "stage"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() error = %v for valid sort/group", err)
+	}
+
+	cfg.SortMode = "size"
+	if err := cfg.Validate(); !errors.Is(err, domain.ErrConfigInvalid) {
+		t.Errorf("Validate() error = %v, want ErrConfigInvalid for sort_mode", err)
+	}
+
+	cfg.SortMode = "name"
+	cfg.GroupBy = "owner"
+	if err := cfg.Validate(); !errors.Is(err, domain.ErrConfigInvalid) {
+		t.Errorf("Validate() error = %v, want ErrConfigInvalid for group_by", err)
+	}
+}
+
+func TestConfig_GetProjectTag(t *testing.T) {
+	cfg := ports.NewConfig()
+	cfg.Projects["api"] = ports.ProjectConfig{Path: "/work/api", DirectoryName: "api", Tag: "clients"}
+
+	if got := cfg.GetProjectTag("/work/api"); got != "clients" {
+		t.Errorf("GetProjectTag() = %q, want clients", got)
+	}
+	if got := cfg.GetProjectTag("/work/other"); got != "" {
+		t.Errorf("GetProjectTag(unknown) = %q, want empty", got)
+	}
+
+	// Re-registering an entry keeps its tag
+	cfg.SetProjectEntry("api", "/work/api", "API", true)
+	if got := cfg.GetProjectTag("/work/api"); got != "clients" {
+		t.Errorf("GetProjectTag() after SetProjectEntry = %q, want clients", got)
+	}
+}
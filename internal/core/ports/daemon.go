@@ -0,0 +1,54 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// DaemonStatus describes a running background daemon (vdash daemon).
+// Zero timestamps mean the action has not run yet.
+type DaemonStatus struct {
+	PID                    int
+	StartedAt              time.Time
+	Projects               int // Tracked projects (active and hibernated)
+	WatchedPaths           int // Paths successfully watched for file activity
+	LastRefreshAt          time.Time
+	LastHibernationCheckAt time.Time
+	LastActivityAt         time.Time // Last file event attributed to a project
+}
+
+// RefreshResult reports the outcome of a detection pass over all projects.
+type RefreshResult struct {
+	Refreshed int
+	Failed    int
+}
+
+// DaemonController is what clients (TUI, CLI) can ask of a running daemon.
+// Implemented in-process by the daemon service and over the Unix socket by
+// the daemon client, so callers need not know which one they hold.
+type DaemonController interface {
+	// Status returns the daemon's current status.
+	Status(ctx context.Context) (DaemonStatus, error)
+
+	// Refresh runs stage detection for all active projects now and waits for it.
+	Refresh(ctx context.Context) (RefreshResult, error)
+}
+
+// ActivityFeed streams the file activity a running daemon attributes to
+// projects, so other front ends (vdash serve) can show it without watching
+// files and writing activity themselves.
+type ActivityFeed interface {
+	// Activity returns file events in tracked projects until ctx is cancelled
+	// or the daemon exits, then closes the channel. Events are dropped for
+	// readers that fall behind.
+	Activity(ctx context.Context) (<-chan FileEvent, error)
+}
+
+// Daemon keeps detection running in the background without the TUI.
+type Daemon interface {
+	DaemonController
+
+	// Run watches files and periodically re-detects and hibernates projects
+	// until ctx is cancelled. Returns nil on cancellation.
+	Run(ctx context.Context) error
+}
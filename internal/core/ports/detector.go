@@ -68,6 +68,12 @@ type MethodDetector interface {
 	// Used for logging and to populate DetectionResult.Method.
 	Name() string
 
+	// Stages returns the methodology's ordered stage taxonomy. Detection
+	// results report their MethodStage from this list; each stage maps onto
+	// the coarse domain.Stage for cross-methodology sorting and filtering.
+	// Returns nil if the methodology only uses the coarse stages.
+	Stages() []domain.MethodStage
+
 	// CanDetect performs a quick check to determine if this detector
 	// can potentially handle the given path. This is a lightweight check
 	// that may look for specific file patterns or directory structures
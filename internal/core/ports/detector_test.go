@@ -13,6 +13,8 @@ type mockDetector struct{}
 
 func (m *mockDetector) Name() string { return "mock" }
 
+func (m *mockDetector) Stages() []domain.MethodStage { return domain.DefaultMethodStages() }
+
 func (m *mockDetector) CanDetect(ctx context.Context, path string) bool {
 	return path != ""
 }
@@ -35,6 +37,15 @@ func TestMethodDetector_InterfaceCompliance(t *testing.T) {
 		}
 	})
 
+	t.Run("Stages returns ordered taxonomy", func(t *testing.T) {
+		stages := d.Stages()
+		for i, s := range stages {
+			if s.Ordinal != i+1 {
+				t.Errorf("Stages()[%d].Ordinal = %d, want %d", i, s.Ordinal, i+1)
+			}
+		}
+	})
+
 	t.Run("CanDetect accepts context and path", func(t *testing.T) {
 		ctx := context.Background()
 		result := d.CanDetect(ctx, "/some/path")
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// GitInspector reads version control state for project directories.
+// Implemented by adapters/git, which reads .git directly without network access.
+type GitInspector interface {
+	// Inspect returns the status of the git repository containing path.
+	// Returns (nil, nil) when path is not inside a git repository.
+	Inspect(ctx context.Context, path string) (*domain.GitStatus, error)
+}
@@ -39,21 +39,28 @@ type LogReader interface {
 	// Returns an error if the log directory exists but cannot be read.
 	ListSessions(ctx context.Context, projectPath string) ([]domain.LogSession, error)
 
-	// ReadSession reads all entries from a session file.
+	// ReadSession reads all entries of a session listed by ListSessions.
+	// Readers whose sessions share a file return only the session's entries.
 	// Invalid JSON lines are skipped (logged at debug level) rather than failing.
 	//
 	// Returns partial results if some entries fail to parse.
 	// Returns an error if the session file cannot be opened.
-	ReadSession(ctx context.Context, sessionPath string) ([]domain.LogEntry, error)
+	ReadSession(ctx context.Context, session domain.LogSession) ([]domain.LogEntry, error)
 
-	// TailSession streams new log entries as they are written.
+	// TailSession streams new entries of a session as they are written.
 	// The implementation polls at a reasonable interval (e.g., 2 seconds).
 	//
 	// Caller MUST cancel ctx when done reading to stop the polling goroutine.
 	// The returned channel is closed when ctx is cancelled or on error.
 	//
 	// Returns an error if the session file cannot be opened initially.
-	TailSession(ctx context.Context, sessionPath string) (<-chan domain.LogEntry, error)
+	TailSession(ctx context.Context, session domain.LogSession) (<-chan domain.LogEntry, error)
+
+	// SearchSession returns the entries of a session whose text contains
+	// query (case-insensitive), oldest first, stopping after limit hits
+	// (0 = no limit). The file is streamed line by line, so large sessions
+	// are never loaded into memory.
+	SearchSession(ctx context.Context, session domain.LogSession, query string, limit int) ([]domain.LogSearchHit, error)
 }
 
 // LogReaderRegistry coordinates log reading across multiple LogReaders.
@@ -70,6 +77,16 @@ type LogReaderRegistry interface {
 	// or nil if no reader can handle this project.
 	GetReader(ctx context.Context, projectPath string) LogReader
 
+	// GetReaders returns every reader where CanRead() returns true, in
+	// registration order. Projects driven by more than one tool (e.g., Claude
+	// Code and Aider) return multiple readers. Returns nil if none apply.
+	GetReaders(ctx context.Context, projectPath string) []LogReader
+
 	// Readers returns all registered log readers.
 	Readers() []LogReader
+
+	// Search searches every session of every reader that applies to the
+	// project, newest session first, and returns at most limit hits
+	// (0 = no limit).
+	Search(ctx context.Context, projectPath, query string, limit int) ([]domain.LogSearchHit, error)
 }
@@ -0,0 +1,48 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// MetricsRepository stores raw metric samples in a database separate from
+// project state (~/.vibe-dash/metrics.db), so the metrics feature can be
+// removed without touching core storage.
+type MetricsRepository interface {
+	// RecordSamples appends samples. SampledAt defaults to now when zero.
+	RecordSamples(ctx context.Context, samples []domain.MetricSample) error
+
+	// FindSamples returns a project's samples taken at or after since,
+	// oldest first. Returns an empty slice (not nil) if none match.
+	FindSamples(ctx context.Context, projectID string, since time.Time) ([]domain.MetricSample, error)
+
+	// DeleteProjectSamples removes all samples for a project.
+	DeleteProjectSamples(ctx context.Context, projectID string) error
+
+	// DeleteSamplesBefore removes every project's samples taken before
+	// before. Returns the number of samples removed.
+	DeleteSamplesBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// ProgressReader counts completed work items (BMAD stories, Speckit tasks)
+// for a project from its methodology artifacts.
+type ProgressReader interface {
+	// ReadProgress returns the project's progress. Returns a zero
+	// WorkProgress (not an error) when the methodology has no countable items.
+	ReadProgress(ctx context.Context, project *domain.Project) (domain.WorkProgress, error)
+}
+
+// MetricsCollector samples projects periodically and aggregates stats.
+type MetricsCollector interface {
+	// Collect records one sample per active project.
+	// Returns the number of samples recorded.
+	Collect(ctx context.Context) (int, error)
+
+	// ProjectStats aggregates a project's samples taken at or after since.
+	ProjectStats(ctx context.Context, projectID string, since time.Time) (*domain.ProjectStats, error)
+
+	// DeleteProjectStats removes a project's samples when it is removed.
+	DeleteProjectStats(ctx context.Context, projectID string) error
+}
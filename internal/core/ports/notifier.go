@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// Notification describes an agent that is waiting for user input.
+type Notification struct {
+	ProjectName string        // Effective (display) name
+	ProjectPath string        // Canonical project path
+	Tool        string        // Agent tool (e.g., "Claude Code"); empty if unknown
+	WaitingFor  time.Duration // How long the agent has been waiting
+}
+
+// Notifier delivers a Notification to one sink (terminal bell, desktop, command).
+// Implementations must respect ctx cancellation and should not block for long.
+type Notifier interface {
+	// Name returns a short identifier for logging (e.g., "bell", "desktop").
+	Name() string
+
+	// Notify delivers the notification. Errors are logged by the caller.
+	Notify(ctx context.Context, n Notification) error
+}
+
+// NotificationService notifies when a project's agent starts waiting.
+// Called periodically with the current project list; de-duplicates so each
+// Working → Waiting transition notifies at most once.
+type NotificationService interface {
+	// CheckWaiting evaluates projects and dispatches notifications for agents
+	// that have newly started waiting. Returns the number of notifications sent.
+	CheckWaiting(ctx context.Context, projects []*domain.Project) int
+}
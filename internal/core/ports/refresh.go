@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// ProjectRefresher re-runs methodology detection for a project and saves the
+// result. The TUI and the daemon share one implementation so both record the
+// same agent state history.
+type ProjectRefresher interface {
+	// RefreshProject re-detects the project at path and saves it, keeping
+	// user fields (favorite, notes, state) changed since it was loaded.
+	// Returns the saved project.
+	RefreshProject(ctx context.Context, projectID, path string) (*domain.Project, error)
+}
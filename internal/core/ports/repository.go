@@ -74,3 +74,36 @@ type ProjectRepository interface {
 	// Config.yaml is preserved - only state.db files are affected.
 	ResetAll(ctx context.Context) (int, error)
 }
+
+// EventFilter narrows a project history query.
+// Zero values mean "no constraint".
+type EventFilter struct {
+	Types []domain.EventType // Only these event types (empty = all)
+	Since time.Time          // Only events at or after this time
+	Limit int                // Most recent N events (0 = unlimited)
+}
+
+// ProjectEventRepository persists the append-only project activity history
+// (stage transitions, method switches, hibernation, agent state changes).
+// Events are never updated; they are removed only with their project.
+type ProjectEventRepository interface {
+	// AppendEvent records a history event for event.ProjectID.
+	// Returns domain.ErrProjectNotFound if the project is not tracked.
+	AppendEvent(ctx context.Context, event domain.ProjectEvent) error
+
+	// FindEvents returns events for a project in chronological order (oldest first).
+	// When filter.Limit is set, the most recent Limit events are returned.
+	// Returns an empty slice (not nil) if the project has no matching events.
+	FindEvents(ctx context.Context, projectID string, filter EventFilter) ([]domain.ProjectEvent, error)
+
+	// LastEvent returns the most recent event of the given type,
+	// or nil (not error) if none has been recorded.
+	LastEvent(ctx context.Context, projectID string, eventType domain.EventType) (*domain.ProjectEvent, error)
+}
+
+// ProjectEventListener is told about each history event after it is recorded.
+// OnProjectEvent runs on the recording goroutine (e.g., inside a repository
+// Save), so implementations must return quickly and hand slow work off.
+type ProjectEventListener interface {
+	OnProjectEvent(event domain.ProjectEvent)
+}
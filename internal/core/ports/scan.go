@@ -0,0 +1,82 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// DefaultScanDepth is how many directory levels below a root are searched
+// (~/work/repo is depth 1, ~/work/client/repo is depth 2).
+const DefaultScanDepth = 3
+
+// ProjectCandidate is a directory that looks like a project.
+type ProjectCandidate struct {
+	// Path is the canonical absolute path of the directory
+	Path string
+
+	// Markers lists why the directory matched, e.g. ".git", ".bmad", "claude-logs"
+	Markers []string
+}
+
+// ProjectDiscoverer finds candidate project directories under workspace roots.
+// Implemented by adapters/filesystem.
+type ProjectDiscoverer interface {
+	// Discover walks each root up to maxDepth levels and returns candidates
+	// sorted by path. A matched directory is not searched further, so nested
+	// repositories inside a project are not reported separately.
+	// Roots that do not exist are skipped with a warning, not an error.
+	Discover(ctx context.Context, roots []string, maxDepth int) ([]ProjectCandidate, error)
+}
+
+// ScanStatus is the outcome of a single scan candidate.
+type ScanStatus string
+
+const (
+	ScanAdded          ScanStatus = "added"           // New project saved
+	ScanWouldAdd       ScanStatus = "would_add"       // Dry run: would be saved
+	ScanAlreadyTracked ScanStatus = "already_tracked" // Path is already a project
+	ScanFailed         ScanStatus = "failed"          // Could not be added
+)
+
+// ScanOutcome reports what happened to one candidate.
+type ScanOutcome struct {
+	Candidate ProjectCandidate
+	Status    ScanStatus
+	Project   *domain.Project // Set for added/would-add/already-tracked
+	Methods   []string        // Methodologies found by DetectMultiple
+	Err       error           // Set when Status is ScanFailed
+}
+
+// ScanOptions controls a workspace scan.
+type ScanOptions struct {
+	// MaxDepth is the search depth below each root (0 = the roots only)
+	MaxDepth int
+
+	// DryRun reports what would be added without saving anything
+	DryRun bool
+}
+
+// ScanReport is the result of a workspace scan, one outcome per candidate.
+type ScanReport struct {
+	Outcomes []ScanOutcome
+}
+
+// Count returns the number of outcomes with the given status.
+func (r ScanReport) Count(status ScanStatus) int {
+	n := 0
+	for _, o := range r.Outcomes {
+		if o.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// ProjectScanner discovers and bulk-adds projects under workspace roots.
+type ProjectScanner interface {
+	// Scan discovers candidates under roots, detects their methodology and
+	// adds those not already tracked. Individual failures are reported in
+	// the outcomes; an error is returned only if discovery itself fails.
+	Scan(ctx context.Context, roots []string, opts ScanOptions) (ScanReport, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// UsageReader computes token usage and estimated cost from agent session logs.
+// Implemented by adapters/usage, which reads Claude Code logs and caches
+// parsed totals so unchanged logs are not read again.
+type UsageReader interface {
+	// ProjectUsage aggregates the usage of a project's sessions on or after
+	// the day containing since (zero since = all time).
+	// Returns an empty ProjectUsage (not an error) when the project has no logs.
+	ProjectUsage(ctx context.Context, project *domain.Project, since time.Time) (*domain.ProjectUsage, error)
+}
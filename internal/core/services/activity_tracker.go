@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
@@ -68,7 +69,54 @@ func (t *ActivityTracker) handleEvent(ctx context.Context, event ports.FileEvent
 
 	if err := t.repo.UpdateLastActivity(ctx, project.ID, event.Timestamp); err != nil {
 		slog.Warn("failed to update last activity", "project_id", project.ID, "error", err)
+		return
+	}
+
+	// Keep the cache current so CommitEvents does not report the same commit twice
+	t.mu.Lock()
+	if event.Timestamp.After(project.LastActivityAt) {
+		project.LastActivityAt = event.Timestamp
+	}
+	t.mu.Unlock()
+}
+
+// CommitEvents inspects each cached project's repository and returns a
+// synthetic FileEvent (at the project root, timestamped with the commit time)
+// for every project whose HEAD commit is newer than its LastActivityAt.
+// The file watcher ignores .git, so this is how commits count as activity.
+// Commits dated in the future (clock skew) are ignored.
+func (t *ActivityTracker) CommitEvents(ctx context.Context, inspector ports.GitInspector) []ports.FileEvent {
+	t.mu.RLock()
+	type candidate struct {
+		path         string
+		lastActivity time.Time
+	}
+	candidates := make([]candidate, 0, len(t.projects))
+	for path, p := range t.projects {
+		candidates = append(candidates, candidate{path, p.LastActivityAt})
+	}
+	t.mu.RUnlock()
+
+	var events []ports.FileEvent
+	now := time.Now()
+	for _, c := range candidates {
+		select {
+		case <-ctx.Done():
+			return events
+		default:
+		}
+
+		status, err := inspector.Inspect(ctx, c.path)
+		if err != nil {
+			slog.Debug("git inspection failed", "path", c.path, "error", err)
+			continue
+		}
+		if status == nil || !status.LastCommitAt.After(c.lastActivity) || status.LastCommitAt.After(now) {
+			continue
+		}
+		events = append(events, ports.FileEvent{Path: c.path, Operation: ports.FileOpModify, Timestamp: status.LastCommitAt})
 	}
+	return events
 }
 
 // findProjectForPath matches event path to project using path prefix.
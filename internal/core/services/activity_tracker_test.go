@@ -361,3 +361,49 @@ func TestActivityTracker_RepositoryError_GracefulHandling(t *testing.T) {
 		t.Error("expected no activity update when repo returns error")
 	}
 }
+
+// stubGitInspector returns statuses keyed by path; unknown paths are not repositories.
+type stubGitInspector struct {
+	statuses map[string]*domain.GitStatus
+}
+
+func (s *stubGitInspector) Inspect(_ context.Context, path string) (*domain.GitStatus, error) {
+	return s.statuses[path], nil
+}
+
+func TestActivityTracker_CommitEvents(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockProjectRepo()
+	tracker := NewActivityTracker(repo)
+	tracker.SetProjects([]*domain.Project{
+		{ID: "new-commit", Path: "/p/new-commit", LastActivityAt: base},
+		{ID: "old-commit", Path: "/p/old-commit", LastActivityAt: base},
+		{ID: "future", Path: "/p/future", LastActivityAt: base},
+		{ID: "no-repo", Path: "/p/no-repo", LastActivityAt: base},
+	})
+	inspector := &stubGitInspector{statuses: map[string]*domain.GitStatus{
+		"/p/new-commit": {LastCommitAt: base.Add(time.Hour)},
+		"/p/old-commit": {LastCommitAt: base.Add(-time.Hour)},
+		"/p/future":     {LastCommitAt: time.Now().Add(24 * time.Hour)},
+	}}
+
+	events := tracker.CommitEvents(context.Background(), inspector)
+	got := make(map[string]time.Time)
+	for _, ev := range events {
+		got[ev.Path] = ev.Timestamp
+	}
+	if len(got) != 1 || !got["/p/new-commit"].Equal(base.Add(time.Hour)) {
+		t.Fatalf("events = %v, want only new-commit at its commit time", got)
+	}
+
+	// Once recorded, the same commit is not reported again
+	for _, ev := range events {
+		tracker.handleEvent(context.Background(), ev)
+	}
+	if ts, ok := repo.getLastActivity("new-commit"); !ok || !ts.Equal(base.Add(time.Hour)) {
+		t.Errorf("last activity = %v, %v", ts, ok)
+	}
+	if again := tracker.CommitEvents(context.Background(), inspector); len(again) != 0 {
+		t.Errorf("expected no repeated events, got %v", again)
+	}
+}
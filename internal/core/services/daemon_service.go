@@ -0,0 +1,491 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+const (
+	// DefaultDaemonHibernationInterval matches the TUI's hourly check (Story 11.2).
+	DefaultDaemonHibernationInterval = time.Hour
+
+	// DefaultWorkspaceScanInterval is how often workspace_roots are re-scanned
+	// for newly created projects.
+	DefaultWorkspaceScanInterval = 5 * time.Minute
+
+	// DefaultDaemonReloadInterval is how often the project list is re-read so
+	// projects added or removed via the CLI are watched without a restart.
+	DefaultDaemonReloadInterval = 30 * time.Second
+
+	// DefaultDaemonNotificationInterval matches the TUI's 5s waiting check.
+	DefaultDaemonNotificationInterval = 5 * time.Second
+
+	// activitySubscriberBuffer is the per-subscriber activity buffer; events
+	// are dropped for slower subscribers.
+	activitySubscriberBuffer = 32
+)
+
+// Compile-time interface compliance checks
+var (
+	_ ports.Daemon       = (*DaemonService)(nil)
+	_ ports.ActivityFeed = (*DaemonService)(nil)
+)
+
+// DaemonService runs what the TUI otherwise does while it is open: file
+// watching (ActivityTracker + auto-activation), periodic stage re-detection,
+// auto-hibernation and waiting notifications. All writes go through the
+// repository.
+//
+// Thread Safety: Status, Refresh and Activity may be called from other
+// goroutines while Run is active.
+type DaemonService struct {
+	repo        ports.ProjectRepository
+	refresher   *RefreshService
+	hibernation ports.HibernationService // Optional: auto-hibernation disabled if nil
+	state       ports.StateActivator     // Optional: auto-activation disabled if nil
+	watcher     ports.FileWatcher        // Optional: file watching disabled if nil
+	git         ports.GitInspector       // Optional: commits are not activity if nil
+	tracker     *ActivityTracker
+
+	scanner        ports.ProjectScanner // Optional: workspace scanning disabled if nil
+	workspaceRoots []string
+
+	metrics         ports.MetricsCollector // Optional: metrics sampling disabled if nil
+	metricsInterval time.Duration
+
+	notifications        ports.NotificationService // Optional: waiting notifications disabled if nil
+	notificationInterval time.Duration
+
+	stageInterval       time.Duration // 0 disables periodic re-detection
+	hibernationInterval time.Duration
+	reloadInterval      time.Duration
+	scanInterval        time.Duration
+
+	refreshReq chan chan ports.RefreshResult
+
+	mu          sync.RWMutex
+	status      ports.DaemonStatus
+	subscribers map[chan ports.FileEvent]struct{} // Activity streams
+}
+
+// DaemonOption is a functional option for configuring DaemonService.
+type DaemonOption func(*DaemonService)
+
+// WithDaemonHibernation enables periodic auto-hibernation.
+func WithDaemonHibernation(h ports.HibernationService) DaemonOption {
+	return func(d *DaemonService) {
+		d.hibernation = h
+	}
+}
+
+// WithDaemonStateActivator enables auto-activation of hibernated projects on file activity (Story 11.3).
+func WithDaemonStateActivator(s ports.StateActivator) DaemonOption {
+	return func(d *DaemonService) {
+		d.state = s
+	}
+}
+
+// WithDaemonFileWatcher enables file watching for last-activity updates.
+func WithDaemonFileWatcher(w ports.FileWatcher) DaemonOption {
+	return func(d *DaemonService) {
+		d.watcher = w
+	}
+}
+
+// WithDaemonGitInspector counts new commits as project activity,
+// checked at startup, on each stage refresh and on manual refresh.
+func WithDaemonGitInspector(g ports.GitInspector) DaemonOption {
+	return func(d *DaemonService) {
+		d.git = g
+	}
+}
+
+// WithWorkspaceScan adds projects created under roots (config workspace_roots)
+// at startup and every interval (0 = DefaultWorkspaceScanInterval).
+func WithWorkspaceScan(scanner ports.ProjectScanner, roots []string, interval time.Duration) DaemonOption {
+	return func(d *DaemonService) {
+		if len(roots) == 0 {
+			return
+		}
+		d.scanner = scanner
+		d.workspaceRoots = roots
+		if interval > 0 {
+			d.scanInterval = interval
+		}
+	}
+}
+
+// WithDaemonMetrics samples progress metrics at startup and every interval
+// (0 = DefaultMetricsInterval).
+func WithDaemonMetrics(m ports.MetricsCollector, interval time.Duration) DaemonOption {
+	return func(d *DaemonService) {
+		d.metrics = m
+		if interval > 0 {
+			d.metricsInterval = interval
+		}
+	}
+}
+
+// WithDaemonNotifications checks active projects for newly waiting agents
+// every interval (0 = DefaultDaemonNotificationInterval), as the TUI does.
+func WithDaemonNotifications(n ports.NotificationService, interval time.Duration) DaemonOption {
+	return func(d *DaemonService) {
+		d.notifications = n
+		if interval > 0 {
+			d.notificationInterval = interval
+		}
+	}
+}
+
+// WithStageRefreshInterval sets the re-detection interval (0 disables it).
+func WithStageRefreshInterval(interval time.Duration) DaemonOption {
+	return func(d *DaemonService) {
+		d.stageInterval = interval
+	}
+}
+
+// WithHibernationInterval sets how often auto-hibernation runs.
+func WithHibernationInterval(interval time.Duration) DaemonOption {
+	return func(d *DaemonService) {
+		if interval > 0 {
+			d.hibernationInterval = interval
+		}
+	}
+}
+
+// WithReloadInterval sets how often the project list is re-read.
+func WithReloadInterval(interval time.Duration) DaemonOption {
+	return func(d *DaemonService) {
+		if interval > 0 {
+			d.reloadInterval = interval
+		}
+	}
+}
+
+// NewDaemonService creates a daemon that writes through repo and uses
+// refresher for stage detection.
+func NewDaemonService(repo ports.ProjectRepository, refresher *RefreshService, opts ...DaemonOption) *DaemonService {
+	d := &DaemonService{
+		repo:                 repo,
+		refresher:            refresher,
+		tracker:              NewActivityTracker(repo),
+		hibernationInterval:  DefaultDaemonHibernationInterval,
+		reloadInterval:       DefaultDaemonReloadInterval,
+		scanInterval:         DefaultWorkspaceScanInterval,
+		metricsInterval:      DefaultMetricsInterval,
+		notificationInterval: DefaultDaemonNotificationInterval,
+		refreshReq:           make(chan chan ports.RefreshResult),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Status returns the daemon's current status.
+func (d *DaemonService) Status(_ context.Context) (ports.DaemonStatus, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.status, nil
+}
+
+// Refresh asks the running daemon to re-detect all active projects and
+// waits for the result. Fails if Run is not active or ctx is cancelled.
+func (d *DaemonService) Refresh(ctx context.Context) (ports.RefreshResult, error) {
+	reply := make(chan ports.RefreshResult, 1)
+	select {
+	case d.refreshReq <- reply:
+	case <-ctx.Done():
+		return ports.RefreshResult{}, fmt.Errorf("daemon not accepting refresh: %w", ctx.Err())
+	}
+
+	select {
+	case result := <-reply:
+		return result, nil
+	case <-ctx.Done():
+		return ports.RefreshResult{}, ctx.Err()
+	}
+}
+
+// Run performs an initial hibernation check and refresh (like TUI startup),
+// then loops until ctx is cancelled.
+func (d *DaemonService) Run(ctx context.Context) error {
+	d.mu.Lock()
+	d.status = ports.DaemonStatus{PID: os.Getpid(), StartedAt: time.Now()}
+	d.mu.Unlock()
+
+	var watchedKey string
+	var eventCh <-chan ports.FileEvent
+	reload := func() {
+		projects, err := d.repo.FindAll(ctx)
+		if err != nil {
+			slog.Warn("daemon failed to load projects", "error", err)
+			return
+		}
+		d.tracker.SetProjects(projects)
+		d.mu.Lock()
+		d.status.Projects = len(projects)
+		d.mu.Unlock()
+
+		key := daemonWatchKey(projects)
+		if d.watcher == nil || key == watchedKey {
+			return
+		}
+		watchedKey, eventCh = key, nil
+		d.setWatchedPaths(0)
+		if key == "" {
+			return
+		}
+		paths := strings.Split(key, "\n")
+		ch, err := d.watcher.Watch(ctx, paths)
+		if err != nil {
+			slog.Warn("daemon file watching unavailable", "error", err)
+			return
+		}
+		eventCh = ch
+		failed := d.watcher.GetFailedPaths()
+		if len(failed) > 0 {
+			slog.Warn("some project paths could not be watched", "failed", len(failed), "total", len(paths))
+		}
+		d.setWatchedPaths(len(paths) - len(failed))
+	}
+
+	d.hibernate(ctx)
+	d.scanWorkspaces(ctx)
+	reload()
+	d.refreshActive(ctx)
+	d.recordCommitActivity(ctx)
+	d.collectMetrics(ctx)
+
+	var stageC <-chan time.Time
+	if d.stageInterval > 0 {
+		stageTicker := time.NewTicker(d.stageInterval)
+		defer stageTicker.Stop()
+		stageC = stageTicker.C
+	}
+	hibernationTicker := time.NewTicker(d.hibernationInterval)
+	defer hibernationTicker.Stop()
+	reloadTicker := time.NewTicker(d.reloadInterval)
+	defer reloadTicker.Stop()
+	var scanC <-chan time.Time
+	if d.scanner != nil {
+		scanTicker := time.NewTicker(d.scanInterval)
+		defer scanTicker.Stop()
+		scanC = scanTicker.C
+	}
+	var metricsC <-chan time.Time
+	if d.metrics != nil {
+		metricsTicker := time.NewTicker(d.metricsInterval)
+		defer metricsTicker.Stop()
+		metricsC = metricsTicker.C
+	}
+	var notifyC <-chan time.Time
+	if d.notifications != nil {
+		notifyTicker := time.NewTicker(d.notificationInterval)
+		defer notifyTicker.Stop()
+		notifyC = notifyTicker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-stageC:
+			d.refreshActive(ctx)
+			d.recordCommitActivity(ctx)
+		case <-hibernationTicker.C:
+			d.hibernate(ctx)
+			reload()
+		case <-reloadTicker.C:
+			reload()
+		case <-scanC:
+			if d.scanWorkspaces(ctx) > 0 {
+				reload()
+			}
+		case <-metricsC:
+			d.collectMetrics(ctx)
+		case <-notifyC:
+			d.checkWaiting(ctx)
+		case reply := <-d.refreshReq:
+			// Manual refresh also runs the hibernation check first (Story 11.2 AC3)
+			d.hibernate(ctx)
+			result := d.refreshActive(ctx)
+			d.recordCommitActivity(ctx)
+			reply <- result
+		case ev, ok := <-eventCh:
+			if !ok {
+				// Watcher closed; retry on the next reload
+				eventCh, watchedKey = nil, ""
+				d.setWatchedPaths(0)
+				continue
+			}
+			d.handleFileEvent(ctx, ev)
+		}
+	}
+}
+
+// refreshActive re-detects all active projects.
+func (d *DaemonService) refreshActive(ctx context.Context) ports.RefreshResult {
+	projects, err := d.repo.FindActive(ctx)
+	if err != nil {
+		slog.Warn("daemon failed to load active projects", "error", err)
+		return ports.RefreshResult{}
+	}
+
+	result := d.refresher.RefreshAll(ctx, projects)
+	slog.Debug("daemon refresh complete", "refreshed", result.Refreshed, "failed", result.Failed)
+
+	d.mu.Lock()
+	d.status.LastRefreshAt = time.Now()
+	d.mu.Unlock()
+	return result
+}
+
+// recordCommitActivity treats commits newer than a project's last activity
+// like file events, so they also auto-activate hibernated projects.
+func (d *DaemonService) recordCommitActivity(ctx context.Context) {
+	if d.git == nil {
+		return
+	}
+	for _, ev := range d.tracker.CommitEvents(ctx, d.git) {
+		d.handleFileEvent(ctx, ev)
+	}
+}
+
+// collectMetrics records a metrics sample for each active project if enabled.
+func (d *DaemonService) collectMetrics(ctx context.Context) {
+	if d.metrics == nil {
+		return
+	}
+	if _, err := d.metrics.Collect(ctx); err != nil {
+		slog.Warn("metrics collection failed", "error", err)
+	}
+}
+
+// checkWaiting notifies for agents of active projects that started waiting.
+// Projects are re-read so last-activity writes from the tracker are seen.
+func (d *DaemonService) checkWaiting(ctx context.Context) {
+	projects, err := d.repo.FindActive(ctx)
+	if err != nil {
+		slog.Warn("daemon failed to load projects for notifications", "error", err)
+		return
+	}
+	d.notifications.CheckWaiting(ctx, projects)
+}
+
+// scanWorkspaces adds new projects under the workspace roots if enabled.
+// Returns the number of projects added.
+func (d *DaemonService) scanWorkspaces(ctx context.Context) int {
+	if d.scanner == nil {
+		return 0
+	}
+	report, err := d.scanner.Scan(ctx, d.workspaceRoots, ports.ScanOptions{MaxDepth: ports.DefaultScanDepth})
+	if err != nil {
+		slog.Warn("workspace scan failed", "error", err)
+		return 0
+	}
+	added := report.Count(ports.ScanAdded)
+	if added > 0 {
+		slog.Info("workspace scan added projects", "count", added)
+	}
+	return added
+}
+
+// hibernate runs the auto-hibernation check if enabled.
+func (d *DaemonService) hibernate(ctx context.Context) {
+	if d.hibernation == nil {
+		return
+	}
+	count, err := d.hibernation.CheckAndHibernate(ctx)
+	if err != nil {
+		slog.Warn("auto-hibernation check failed", "error", err)
+	} else if count > 0 {
+		slog.Debug("auto-hibernated projects", "count", count)
+	}
+
+	d.mu.Lock()
+	d.status.LastHibernationCheckAt = time.Now()
+	d.mu.Unlock()
+}
+
+// handleFileEvent auto-activates a hibernated project (Story 11.3) and
+// records its activity via the ActivityTracker.
+func (d *DaemonService) handleFileEvent(ctx context.Context, ev ports.FileEvent) {
+	project := d.tracker.findProjectForPath(ev.Path)
+	if project == nil {
+		return
+	}
+
+	if project.State == domain.StateHibernated && d.state != nil {
+		if err := d.state.Activate(ctx, project.ID); err != nil {
+			if !errors.Is(err, domain.ErrInvalidStateTransition) {
+				slog.Warn("failed to auto-activate project", "project_id", project.ID, "error", err)
+			}
+		} else {
+			project.State = domain.StateActive
+			project.HibernatedAt = nil
+		}
+	}
+
+	d.tracker.handleEvent(ctx, ev)
+
+	d.mu.Lock()
+	if ev.Timestamp.After(d.status.LastActivityAt) { // Commit events may be older
+		d.status.LastActivityAt = ev.Timestamp
+	}
+	for ch := range d.subscribers {
+		select {
+		case ch <- ev:
+		default: // Subscriber is behind; drop
+		}
+	}
+	d.mu.Unlock()
+}
+
+// Activity streams the file events attributed to projects until ctx is
+// cancelled.
+func (d *DaemonService) Activity(ctx context.Context) (<-chan ports.FileEvent, error) {
+	ch := make(chan ports.FileEvent, activitySubscriberBuffer)
+	d.mu.Lock()
+	if d.subscribers == nil {
+		d.subscribers = make(map[chan ports.FileEvent]struct{})
+	}
+	d.subscribers[ch] = struct{}{}
+	d.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		d.mu.Lock()
+		delete(d.subscribers, ch)
+		close(ch)
+		d.mu.Unlock()
+	}()
+	return ch, nil
+}
+
+func (d *DaemonService) setWatchedPaths(n int) {
+	d.mu.Lock()
+	d.status.WatchedPaths = n
+	d.mu.Unlock()
+}
+
+// daemonWatchKey returns the sorted project paths joined by newlines,
+// used to detect when the watched set must change.
+func daemonWatchKey(projects []*domain.Project) string {
+	paths := make([]string, 0, len(projects))
+	for _, p := range projects {
+		paths = append(paths, p.Path)
+	}
+	sort.Strings(paths)
+	return strings.Join(paths, "\n")
+}
@@ -0,0 +1,429 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// chanWatcher is a FileWatcher whose events are pushed by the test.
+type chanWatcher struct {
+	mu      sync.Mutex
+	events  chan ports.FileEvent
+	watched []string
+}
+
+func newChanWatcher() *chanWatcher {
+	return &chanWatcher{events: make(chan ports.FileEvent, 10)}
+}
+
+func (w *chanWatcher) Watch(_ context.Context, paths []string) (<-chan ports.FileEvent, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.watched = append([]string(nil), paths...)
+	return w.events, nil
+}
+func (w *chanWatcher) GetFailedPaths() []string { return nil }
+func (w *chanWatcher) Close() error             { return nil }
+
+// countingHibernation counts CheckAndHibernate calls.
+type countingHibernation struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (h *countingHibernation) CheckAndHibernate(context.Context) (int, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.calls++
+	return 0, nil
+}
+
+func (h *countingHibernation) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.calls
+}
+
+// recordingActivator records activated project IDs.
+type recordingActivator struct {
+	mu        sync.Mutex
+	activated []string
+}
+
+func (a *recordingActivator) Hibernate(context.Context, string) error { return nil }
+func (a *recordingActivator) Activate(_ context.Context, id string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.activated = append(a.activated, id)
+	return nil
+}
+
+func (a *recordingActivator) ids() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]string(nil), a.activated...)
+}
+
+// startDaemon runs d in the background and returns a stop func that waits for Run to exit.
+func startDaemon(t *testing.T, d *DaemonService) func() {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- d.Run(ctx) }()
+	return func() {
+		cancel()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Run returned error: %v", err)
+			}
+		case <-time.After(2 * time.Second):
+			t.Error("Run did not exit after cancel")
+		}
+	}
+}
+
+// waitFor polls cond until it holds or the deadline passes.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestDaemonService_Refresh_RequiresRun(t *testing.T) {
+	d := NewDaemonService(newMockRefreshRepo(), NewRefreshService(newMockRefreshRepo(), &stubDetector{}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := d.Refresh(ctx); err == nil {
+		t.Error("expected error when daemon is not running")
+	}
+}
+
+func TestDaemonService_Run_InitialPassAndRefresh(t *testing.T) {
+	alpha := newRefreshTestProject(t, "/projects/alpha")
+	asleep := newRefreshTestProject(t, "/projects/asleep")
+	asleep.State = domain.StateHibernated
+	repo := newMockRefreshRepo(alpha, asleep)
+
+	plan := domain.NewDetectionResult("speckit", domain.StagePlan, domain.ConfidenceCertain, "plan.md")
+	refresher := NewRefreshService(repo, &stubDetector{results: map[string]*domain.DetectionResult{alpha.Path: &plan}})
+	hibernation := &countingHibernation{}
+	watcher := newChanWatcher()
+
+	d := NewDaemonService(repo, refresher,
+		WithDaemonHibernation(hibernation),
+		WithDaemonFileWatcher(watcher),
+	)
+	stop := startDaemon(t, d)
+	defer stop()
+
+	// Initial pass: hibernation check, watch all projects, refresh active only
+	waitFor(t, "initial refresh", func() bool {
+		s, _ := d.Status(context.Background())
+		return !s.LastRefreshAt.IsZero()
+	})
+	status, _ := d.Status(context.Background())
+	if status.Projects != 2 || status.WatchedPaths != 2 {
+		t.Errorf("status = %+v, want 2 projects and 2 watched paths", status)
+	}
+	if status.StartedAt.IsZero() || status.PID == 0 {
+		t.Errorf("status missing PID/start time: %+v", status)
+	}
+	if hibernation.count() != 1 {
+		t.Errorf("hibernation checks = %d, want 1", hibernation.count())
+	}
+	if repo.saveCount() != 1 {
+		t.Errorf("saves = %d, want 1 (hibernated project skipped)", repo.saveCount())
+	}
+
+	// Manual refresh runs hibernation first, then re-detects
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	result, err := d.Refresh(ctx)
+	if err != nil {
+		t.Fatalf("Refresh returned error: %v", err)
+	}
+	if result.Refreshed != 1 || result.Failed != 0 {
+		t.Errorf("Refresh = %+v, want 1 refreshed", result)
+	}
+	if hibernation.count() != 2 {
+		t.Errorf("hibernation checks = %d, want 2", hibernation.count())
+	}
+}
+
+func TestDaemonService_FileEvent_UpdatesActivityAndActivates(t *testing.T) {
+	asleep := newRefreshTestProject(t, "/projects/asleep")
+	asleep.State = domain.StateHibernated
+	repo := newMockRefreshRepo(asleep)
+	activator := &recordingActivator{}
+	watcher := newChanWatcher()
+
+	d := NewDaemonService(repo, NewRefreshService(repo, &stubDetector{}),
+		WithDaemonStateActivator(activator),
+		WithDaemonFileWatcher(watcher),
+	)
+	stop := startDaemon(t, d)
+	defer stop()
+
+	waitFor(t, "watch start", func() bool {
+		s, _ := d.Status(context.Background())
+		return s.WatchedPaths == 1
+	})
+
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	watcher.events <- ports.FileEvent{Path: "/projects/asleep/src/main.go", Operation: ports.FileOpModify, Timestamp: ts}
+
+	waitFor(t, "activity update", func() bool {
+		got, ok := repo.lastActivity(asleep.ID)
+		return ok && got.Equal(ts)
+	})
+	if ids := activator.ids(); len(ids) != 1 || ids[0] != asleep.ID {
+		t.Errorf("activated = %v, want [%s]", ids, asleep.ID)
+	}
+	status, _ := d.Status(context.Background())
+	if !status.LastActivityAt.Equal(ts) {
+		t.Errorf("LastActivityAt = %v, want %v", status.LastActivityAt, ts)
+	}
+
+	// Events outside any project are ignored
+	watcher.events <- ports.FileEvent{Path: "/elsewhere/file.txt", Operation: ports.FileOpModify, Timestamp: ts.Add(time.Hour)}
+	time.Sleep(20 * time.Millisecond)
+	if ids := activator.ids(); len(ids) != 1 {
+		t.Errorf("unexpected activation for unrelated path: %v", ids)
+	}
+}
+
+func TestDaemonService_Activity(t *testing.T) {
+	project := newRefreshTestProject(t, "/projects/app")
+	repo := newMockRefreshRepo(project)
+	watcher := newChanWatcher()
+	d := NewDaemonService(repo, NewRefreshService(repo, &stubDetector{}), WithDaemonFileWatcher(watcher))
+	stop := startDaemon(t, d)
+	defer stop()
+
+	waitFor(t, "watch start", func() bool {
+		s, _ := d.Status(context.Background())
+		return s.WatchedPaths == 1
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := d.Activity(ctx)
+	if err != nil {
+		t.Fatalf("Activity() error = %v", err)
+	}
+
+	// Only events in a project are relayed
+	watcher.events <- ports.FileEvent{Path: "/elsewhere/file.txt", Operation: ports.FileOpModify, Timestamp: time.Now()}
+	watcher.events <- ports.FileEvent{Path: "/projects/app/main.go", Operation: ports.FileOpCreate, Timestamp: time.Now()}
+	select {
+	case ev := <-events:
+		if ev.Path != "/projects/app/main.go" || ev.Operation != ports.FileOpCreate {
+			t.Errorf("event = %+v, want the project file", ev)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no activity relayed")
+	}
+
+	cancel()
+	waitFor(t, "stream close", func() bool {
+		select {
+		case _, ok := <-events:
+			return !ok
+		default:
+			return false
+		}
+	})
+}
+
+func TestDaemonService_ReloadPicksUpNewProjects(t *testing.T) {
+	repo := newMockRefreshRepo()
+	watcher := newChanWatcher()
+	d := NewDaemonService(repo, NewRefreshService(repo, &stubDetector{}),
+		WithDaemonFileWatcher(watcher),
+		WithReloadInterval(10*time.Millisecond),
+	)
+	stop := startDaemon(t, d)
+	defer stop()
+
+	p := newRefreshTestProject(t, "/projects/new")
+	if err := repo.Save(context.Background(), p); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	waitFor(t, "new project watched", func() bool {
+		s, _ := d.Status(context.Background())
+		return s.Projects == 1 && s.WatchedPaths == 1
+	})
+}
+
+func TestDaemonWatchKey_SortedAndStable(t *testing.T) {
+	a := newRefreshTestProject(t, "/b")
+	b := newRefreshTestProject(t, "/a")
+	if got := daemonWatchKey([]*domain.Project{a, b}); got != "/a\n/b" {
+		t.Errorf("daemonWatchKey = %q, want %q", got, "/a\n/b")
+	}
+	if got := daemonWatchKey(nil); got != "" {
+		t.Errorf("daemonWatchKey(nil) = %q, want empty", got)
+	}
+}
+
+// countingScanner counts scans and reports a fixed number of added projects.
+type countingScanner struct {
+	mu    sync.Mutex
+	calls int
+	roots []string
+}
+
+func (s *countingScanner) Scan(_ context.Context, roots []string, _ ports.ScanOptions) (ports.ScanReport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	s.roots = roots
+	return ports.ScanReport{Outcomes: []ports.ScanOutcome{{Status: ports.ScanAdded}}}, nil
+}
+
+func (s *countingScanner) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func TestDaemonService_WorkspaceScan(t *testing.T) {
+	repo := newMockRefreshRepo()
+	scanner := &countingScanner{}
+	d := NewDaemonService(repo, NewRefreshService(repo, &stubDetector{}),
+		WithWorkspaceScan(scanner, []string{"~/work"}, 10*time.Millisecond),
+	)
+	stop := startDaemon(t, d)
+	defer stop()
+
+	// Initial scan at startup, then periodic
+	waitFor(t, "periodic workspace scans", func() bool { return scanner.count() >= 2 })
+}
+
+func TestWithWorkspaceScan_NoRootsDisables(t *testing.T) {
+	d := NewDaemonService(newMockRefreshRepo(), nil, WithWorkspaceScan(&countingScanner{}, nil, 0))
+	if d.scanner != nil {
+		t.Error("scanner should stay nil without roots")
+	}
+}
+
+func TestDaemonService_CommitActivity(t *testing.T) {
+	asleep := newRefreshTestProject(t, "/projects/asleep")
+	asleep.State = domain.StateHibernated
+	asleep.LastActivityAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := newMockRefreshRepo(asleep)
+	activator := &recordingActivator{}
+
+	committed := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	inspector := &stubGitInspector{statuses: map[string]*domain.GitStatus{
+		asleep.Path: {Branch: "main", LastCommitAt: committed},
+	}}
+	d := NewDaemonService(repo, NewRefreshService(repo, &stubDetector{}),
+		WithDaemonStateActivator(activator),
+		WithDaemonGitInspector(inspector),
+	)
+	stop := startDaemon(t, d)
+	defer stop()
+
+	// Startup pass records the commit as activity and wakes the project
+	waitFor(t, "commit activity", func() bool {
+		got, ok := repo.lastActivity(asleep.ID)
+		return ok && got.Equal(committed)
+	})
+	if ids := activator.ids(); len(ids) != 1 || ids[0] != asleep.ID {
+		t.Errorf("activated = %v, want [%s]", ids, asleep.ID)
+	}
+}
+
+// countingCollector counts metrics collections.
+type countingCollector struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (c *countingCollector) Collect(context.Context) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	return 0, nil
+}
+
+func (c *countingCollector) ProjectStats(context.Context, string, time.Time) (*domain.ProjectStats, error) {
+	return &domain.ProjectStats{}, nil
+}
+
+func (c *countingCollector) DeleteProjectStats(context.Context, string) error { return nil }
+
+func (c *countingCollector) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
+
+// countingNotifier records the projects passed to each waiting check.
+type countingNotifier struct {
+	mu     sync.Mutex
+	checks [][]string // Project IDs per check
+}
+
+func (n *countingNotifier) CheckWaiting(_ context.Context, projects []*domain.Project) int {
+	ids := make([]string, 0, len(projects))
+	for _, p := range projects {
+		ids = append(ids, p.ID)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.checks = append(n.checks, ids)
+	return 0
+}
+
+func (n *countingNotifier) lastCheck() (int, []string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(n.checks) == 0 {
+		return 0, nil
+	}
+	return len(n.checks), n.checks[len(n.checks)-1]
+}
+
+func TestDaemonService_Notifications(t *testing.T) {
+	active := &domain.Project{ID: "a", Name: "alpha", Path: "/alpha", State: domain.StateActive}
+	asleep := &domain.Project{ID: "h", Name: "hib", Path: "/hib", State: domain.StateHibernated}
+	repo := newMockRefreshRepo(active, asleep)
+	notifier := &countingNotifier{}
+	d := NewDaemonService(repo, NewRefreshService(repo, &stubDetector{}),
+		WithDaemonNotifications(notifier, 10*time.Millisecond),
+	)
+	stop := startDaemon(t, d)
+	defer stop()
+
+	waitFor(t, "periodic waiting checks", func() bool { n, _ := notifier.lastCheck(); return n >= 2 })
+	if _, ids := notifier.lastCheck(); len(ids) != 1 || ids[0] != "a" {
+		t.Errorf("checked projects = %v, want only the active one", ids)
+	}
+}
+
+func TestDaemonService_Metrics(t *testing.T) {
+	repo := newMockRefreshRepo()
+	collector := &countingCollector{}
+	d := NewDaemonService(repo, NewRefreshService(repo, &stubDetector{}),
+		WithDaemonMetrics(collector, 10*time.Millisecond),
+	)
+	stop := startDaemon(t, d)
+	defer stop()
+
+	// Initial sample at startup, then periodic
+	waitFor(t, "periodic metrics collection", func() bool { return collector.count() >= 2 })
+}
@@ -65,7 +65,8 @@ type mockDetector struct {
 	detectErr    error
 }
 
-func (m *mockDetector) Name() string { return m.name }
+func (m *mockDetector) Name() string                 { return m.name }
+func (m *mockDetector) Stages() []domain.MethodStage { return nil }
 func (m *mockDetector) CanDetect(ctx context.Context, path string) bool {
 	return m.canDetect
 }
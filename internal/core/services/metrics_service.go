@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// DefaultMetricsInterval is how often the TUI or daemon samples metrics.
+// Must stay well under domain.MetricsMaxSampleGap so consecutive samples
+// are treated as continuous.
+const DefaultMetricsInterval = 5 * time.Minute
+
+// MetricsRetention is how long samples are kept. Collect prunes older ones,
+// so "all time" stats cover at most this period.
+const MetricsRetention = 365 * 24 * time.Hour
+
+// Compile-time interface compliance check
+var _ ports.MetricsCollector = (*MetricsService)(nil)
+
+// MetricsService samples each active project's stage, agent state and work
+// progress into the metrics store, and aggregates samples for display.
+// It only reads project state; removing it does not affect the dashboard.
+type MetricsService struct {
+	repo            ports.ProjectRepository
+	metrics         ports.MetricsRepository
+	waitingDetector ports.WaitingDetector // Optional: agent state is Unknown if nil
+	progress        ports.ProgressReader  // Optional: no burndown if nil
+	now             func() time.Time
+}
+
+// MetricsServiceOption is a functional option for configuring MetricsService.
+type MetricsServiceOption func(*MetricsService)
+
+// WithMetricsWaitingDetector samples agent state for time-waiting stats.
+func WithMetricsWaitingDetector(d ports.WaitingDetector) MetricsServiceOption {
+	return func(s *MetricsService) {
+		s.waitingDetector = d
+	}
+}
+
+// WithProgressReader samples story and task completion for burndown charts.
+func WithProgressReader(r ports.ProgressReader) MetricsServiceOption {
+	return func(s *MetricsService) {
+		s.progress = r
+	}
+}
+
+// NewMetricsService creates a MetricsService reading projects from repo and
+// writing samples to metrics.
+func NewMetricsService(repo ports.ProjectRepository, metrics ports.MetricsRepository, opts ...MetricsServiceOption) *MetricsService {
+	s := &MetricsService{
+		repo:    repo,
+		metrics: metrics,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Collect records one sample per active project and prunes samples older
+// than MetricsRetention. Progress read and prune errors are logged; the
+// sample is stored without progress.
+func (s *MetricsService) Collect(ctx context.Context) (int, error) {
+	projects, err := s.repo.FindActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load projects: %w", err)
+	}
+
+	now := s.now()
+	samples := make([]domain.MetricSample, 0, len(projects))
+	for _, p := range projects {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		default:
+		}
+
+		sample := domain.MetricSample{
+			ProjectID: p.ID,
+			SampledAt: now,
+			Stage:     p.CurrentStage,
+		}
+		if s.waitingDetector != nil {
+			sample.Agent = s.waitingDetector.AgentState(ctx, p).Status
+		}
+		if s.progress != nil {
+			progress, err := s.progress.ReadProgress(ctx, p)
+			if err != nil {
+				slog.Debug("failed to read project progress", "project", p.Name, "error", err)
+			}
+			sample.Progress = progress
+		}
+		samples = append(samples, sample)
+	}
+
+	if len(samples) == 0 {
+		return 0, nil
+	}
+	if err := s.metrics.RecordSamples(ctx, samples); err != nil {
+		return 0, err
+	}
+	if pruned, err := s.metrics.DeleteSamplesBefore(ctx, now.Add(-MetricsRetention)); err != nil {
+		slog.Warn("failed to prune metric samples", "error", err)
+	} else if pruned > 0 {
+		slog.Debug("pruned metric samples", "count", pruned)
+	}
+	return len(samples), nil
+}
+
+// ProjectStats aggregates a project's samples from since until now.
+func (s *MetricsService) ProjectStats(ctx context.Context, projectID string, since time.Time) (*domain.ProjectStats, error) {
+	samples, err := s.metrics.FindSamples(ctx, projectID, since)
+	if err != nil {
+		return nil, err
+	}
+	stats := domain.ComputeProjectStats(projectID, samples, since, s.now())
+	return &stats, nil
+}
+
+// DeleteProjectStats removes a removed project's samples.
+func (s *MetricsService) DeleteProjectStats(ctx context.Context, projectID string) error {
+	return s.metrics.DeleteProjectSamples(ctx, projectID)
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// memMetrics is an in-memory ports.MetricsRepository.
+type memMetrics struct {
+	samples []domain.MetricSample
+	err     error
+}
+
+func (m *memMetrics) RecordSamples(_ context.Context, samples []domain.MetricSample) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.samples = append(m.samples, samples...)
+	return nil
+}
+
+func (m *memMetrics) FindSamples(_ context.Context, projectID string, since time.Time) ([]domain.MetricSample, error) {
+	result := []domain.MetricSample{}
+	for _, s := range m.samples {
+		if s.ProjectID == projectID && !s.SampledAt.Before(since) {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *memMetrics) DeleteProjectSamples(_ context.Context, projectID string) error {
+	kept := m.samples[:0]
+	for _, s := range m.samples {
+		if s.ProjectID != projectID {
+			kept = append(kept, s)
+		}
+	}
+	m.samples = kept
+	return nil
+}
+
+func (m *memMetrics) DeleteSamplesBefore(_ context.Context, before time.Time) (int64, error) {
+	kept := m.samples[:0]
+	for _, s := range m.samples {
+		if !s.SampledAt.Before(before) {
+			kept = append(kept, s)
+		}
+	}
+	n := int64(len(m.samples) - len(kept))
+	m.samples = kept
+	return n, nil
+}
+
+// stubProgress returns fixed progress per project path.
+type stubProgress struct {
+	progress map[string]domain.WorkProgress
+	err      error
+}
+
+func (s *stubProgress) ReadProgress(_ context.Context, p *domain.Project) (domain.WorkProgress, error) {
+	return s.progress[p.Path], s.err
+}
+
+func TestMetricsService_Collect(t *testing.T) {
+	active := &domain.Project{ID: "a", Name: "a", Path: "/a", State: domain.StateActive, CurrentStage: domain.StageImplement}
+	hibernated := &domain.Project{ID: "h", Name: "h", Path: "/h", State: domain.StateHibernated}
+	repo := newMockRefreshRepo(active, hibernated)
+
+	agents := &mockAgentStates{states: make(map[string]domain.AgentState)}
+	agents.set("/a", domain.AgentWaitingForUser, time.Minute)
+	progress := &stubProgress{progress: map[string]domain.WorkProgress{"/a": {StoriesDone: 2, StoriesTotal: 5}}}
+	store := &memMetrics{}
+
+	svc := NewMetricsService(repo, store, WithMetricsWaitingDetector(agents), WithProgressReader(progress))
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	n, err := svc.Collect(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("Collect() = %d, %v; want 1 sample (hibernated skipped)", n, err)
+	}
+	got := store.samples[0]
+	if got.ProjectID != "a" || !got.SampledAt.Equal(now) || got.Stage != domain.StageImplement ||
+		got.Agent != domain.AgentWaitingForUser || got.Progress.StoriesDone != 2 {
+		t.Errorf("sample = %+v", got)
+	}
+}
+
+func TestMetricsService_Collect_PrunesExpiredSamples(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	repo := newMockRefreshRepo(&domain.Project{ID: "a", Path: "/a", State: domain.StateActive})
+	store := &memMetrics{samples: []domain.MetricSample{
+		{ProjectID: "a", SampledAt: now.Add(-MetricsRetention - time.Minute)},
+		{ProjectID: "gone", SampledAt: now.Add(-MetricsRetention - time.Hour)},
+		{ProjectID: "a", SampledAt: now.Add(-MetricsRetention + time.Minute)},
+	}}
+	svc := NewMetricsService(repo, store)
+	svc.now = func() time.Time { return now }
+
+	if _, err := svc.Collect(context.Background()); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(store.samples) != 2 || !store.samples[1].SampledAt.Equal(now) {
+		t.Errorf("samples = %+v, want the one within retention and the new one", store.samples)
+	}
+}
+
+func TestMetricsService_Collect_ProgressErrorStillSamples(t *testing.T) {
+	repo := newMockRefreshRepo(&domain.Project{ID: "a", Path: "/a", State: domain.StateActive})
+	store := &memMetrics{}
+	svc := NewMetricsService(repo, store, WithProgressReader(&stubProgress{err: errors.New("bad yaml")}))
+
+	if n, err := svc.Collect(context.Background()); err != nil || n != 1 {
+		t.Errorf("Collect() = %d, %v; want 1, nil", n, err)
+	}
+}
+
+func TestMetricsService_Collect_StoreError(t *testing.T) {
+	repo := newMockRefreshRepo(&domain.Project{ID: "a", Path: "/a", State: domain.StateActive})
+	svc := NewMetricsService(repo, &memMetrics{err: errors.New("disk full")})
+
+	if _, err := svc.Collect(context.Background()); err == nil {
+		t.Error("Collect() should return the store error")
+	}
+}
+
+func TestMetricsService_ProjectStats(t *testing.T) {
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store := &memMetrics{samples: []domain.MetricSample{
+		{ProjectID: "a", SampledAt: start.Add(-time.Hour), Stage: domain.StagePlan}, // Before since
+		{ProjectID: "a", SampledAt: start, Stage: domain.StageImplement},
+		{ProjectID: "b", SampledAt: start, Stage: domain.StagePlan},
+	}}
+	svc := NewMetricsService(newMockRefreshRepo(), store)
+	svc.now = func() time.Time { return start.Add(5 * time.Minute) }
+
+	stats, err := svc.ProjectStats(context.Background(), "a", start)
+	if err != nil {
+		t.Fatalf("ProjectStats() error: %v", err)
+	}
+	if stats.Samples != 1 || len(stats.TimeInStage) != 1 || stats.TimeInStage[0].Duration != 5*time.Minute {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestMetricsService_DeleteProjectStats(t *testing.T) {
+	store := &memMetrics{samples: []domain.MetricSample{{ProjectID: "a"}, {ProjectID: "b"}}}
+	svc := NewMetricsService(newMockRefreshRepo(), store)
+
+	if err := svc.DeleteProjectStats(context.Background(), "a"); err != nil {
+		t.Fatalf("DeleteProjectStats() error = %v", err)
+	}
+	if len(store.samples) != 1 || store.samples[0].ProjectID != "b" {
+		t.Errorf("samples = %+v, want only b", store.samples)
+	}
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/config"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// notifyTimeout bounds each sink so a hung command cannot stall the caller.
+const notifyTimeout = 10 * time.Second
+
+// waitingTrack is the per-project de-duplication state.
+type waitingTrack struct {
+	waiting  bool // Agent was waiting at the last check
+	notified bool // Notification already sent (or suppressed) for this waiting period
+}
+
+// NotificationService dispatches notifications when a project's agent moves
+// from working to waiting for user input. Each waiting period notifies at
+// most once, after the agent has waited for the project's effective waiting
+// threshold (project config → master config → default, via ConfigResolver).
+//
+// Agents already past the threshold on the first check are not notified, so
+// starting the dashboard does not produce a burst of stale alerts. Agents
+// first seen waiting below the threshold notify once they cross it.
+type NotificationService struct {
+	detector  ports.WaitingDetector
+	notifiers []ports.Notifier
+	config    *ports.Config
+	vibeHome  string // Base path for per-project configs (~/.vibe-dash)
+
+	mu      sync.Mutex
+	tracked map[string]waitingTrack // Keyed by project ID
+}
+
+// Compile-time interface compliance check
+var _ ports.NotificationService = (*NotificationService)(nil)
+
+// NewNotificationService creates a new NotificationService.
+// With no notifiers, CheckWaiting still tracks state but sends nothing.
+func NewNotificationService(
+	detector ports.WaitingDetector,
+	cfg *ports.Config,
+	vibeHome string,
+	notifiers ...ports.Notifier,
+) *NotificationService {
+	return &NotificationService{
+		detector:  detector,
+		notifiers: notifiers,
+		config:    cfg,
+		vibeHome:  vibeHome,
+		tracked:   make(map[string]waitingTrack),
+	}
+}
+
+// CheckWaiting evaluates projects and notifies for agents that started waiting.
+// Returns the number of projects notified.
+func (s *NotificationService) CheckWaiting(ctx context.Context, projects []*domain.Project) int {
+	if s.detector == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sent := 0
+	seen := make(map[string]bool, len(projects))
+	for _, p := range projects {
+		select {
+		case <-ctx.Done():
+			return sent
+		default:
+		}
+
+		seen[p.ID] = true
+		state := s.detector.AgentState(ctx, p)
+		prev, known := s.tracked[p.ID]
+
+		if !state.IsWaiting() {
+			s.tracked[p.ID] = waitingTrack{}
+			continue
+		}
+		firstSeen := !known
+		if !prev.waiting {
+			prev = waitingTrack{waiting: true}
+		}
+		if prev.notified {
+			continue
+		}
+
+		threshold := s.effectiveThreshold(ctx, p)
+		if threshold == 0 {
+			// Waiting detection disabled for this project
+			prev.notified = true
+			s.tracked[p.ID] = prev
+			continue
+		}
+		if state.Duration < time.Duration(threshold)*time.Minute {
+			s.tracked[p.ID] = prev
+			continue
+		}
+		if firstSeen {
+			// Already past the threshold when first seen - stale, not a new alert
+			prev.notified = true
+			s.tracked[p.ID] = prev
+			continue
+		}
+
+		s.dispatch(ctx, p, state)
+		prev.notified = true
+		s.tracked[p.ID] = prev
+		sent++
+	}
+
+	// Forget removed projects so a re-added project starts fresh
+	for id := range s.tracked {
+		if !seen[id] {
+			delete(s.tracked, id)
+		}
+	}
+
+	return sent
+}
+
+// dispatch sends a notification to every sink. Sink failures are logged only.
+func (s *NotificationService) dispatch(ctx context.Context, p *domain.Project, state domain.AgentState) {
+	name := p.Name
+	if p.DisplayName != "" {
+		name = p.DisplayName
+	}
+	n := ports.Notification{
+		ProjectName: name,
+		ProjectPath: p.Path,
+		Tool:        state.Tool,
+		WaitingFor:  state.Duration,
+	}
+
+	for _, notifier := range s.notifiers {
+		notifyCtx, cancel := context.WithTimeout(ctx, notifyTimeout)
+		if err := notifier.Notify(notifyCtx, n); err != nil {
+			slog.Warn("notification failed", "notifier", notifier.Name(), "project", p.Name, "error", err)
+		}
+		cancel()
+	}
+	slog.Debug("waiting notification sent", "project", p.Name, "waiting_for", state.Duration)
+}
+
+// effectiveThreshold returns the project's waiting threshold in minutes.
+// Priority: per-project config file > master config > default
+func (s *NotificationService) effectiveThreshold(ctx context.Context, p *domain.Project) int {
+	var projectData *ports.ProjectConfigData
+	if s.config != nil {
+		if dirName := s.config.GetDirForPath(p.Path); dirName != "" {
+			loader, err := config.NewProjectConfigLoader(filepath.Join(s.vibeHome, dirName))
+			if err == nil {
+				data, err := loader.Load(ctx)
+				if err != nil {
+					slog.Debug("failed to load per-project config, using global",
+						"project", p.Name, "error", err)
+				} else {
+					projectData = data
+				}
+			}
+		}
+	}
+	return config.NewConfigResolver(s.config, projectData).GetEffectiveWaitingThreshold()
+}
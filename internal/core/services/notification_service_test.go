@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// mockAgentStates returns a configurable AgentState per project path.
+type mockAgentStates struct {
+	states map[string]domain.AgentState
+}
+
+func (m *mockAgentStates) IsWaiting(ctx context.Context, p *domain.Project) bool {
+	return m.AgentState(ctx, p).IsWaiting()
+}
+func (m *mockAgentStates) WaitingDuration(ctx context.Context, p *domain.Project) time.Duration {
+	return m.AgentState(ctx, p).Duration
+}
+func (m *mockAgentStates) AgentState(_ context.Context, p *domain.Project) domain.AgentState {
+	return m.states[p.Path]
+}
+
+func (m *mockAgentStates) set(path string, status domain.AgentStatus, d time.Duration) {
+	m.states[path] = domain.NewAgentState("Claude Code", status, d, domain.ConfidenceCertain)
+}
+
+// recordingNotifier records notifications it receives.
+type recordingNotifier struct {
+	received []ports.Notification
+	err      error
+}
+
+func (r *recordingNotifier) Name() string { return "recording" }
+func (r *recordingNotifier) Notify(_ context.Context, n ports.Notification) error {
+	r.received = append(r.received, n)
+	return r.err
+}
+
+func newNotificationTest(t *testing.T, cfg *ports.Config) (*NotificationService, *mockAgentStates, *recordingNotifier, *domain.Project) {
+	t.Helper()
+	states := &mockAgentStates{states: make(map[string]domain.AgentState)}
+	rec := &recordingNotifier{}
+	project := &domain.Project{ID: "p1", Name: "alpha", DisplayName: "Alpha App", Path: "/work/alpha"}
+	return NewNotificationService(states, cfg, t.TempDir(), rec), states, rec, project
+}
+
+func TestNotificationService_NotifiesOnceOnTransition(t *testing.T) {
+	svc, states, rec, project := newNotificationTest(t, ports.NewConfig())
+	ctx := context.Background()
+	projects := []*domain.Project{project}
+
+	states.set(project.Path, domain.AgentWorking, 0)
+	if n := svc.CheckWaiting(ctx, projects); n != 0 {
+		t.Fatalf("expected no notification while working, got %d", n)
+	}
+
+	// Waiting but below the default 10 minute threshold
+	states.set(project.Path, domain.AgentWaitingForUser, 3*time.Minute)
+	if n := svc.CheckWaiting(ctx, projects); n != 0 {
+		t.Fatalf("expected no notification below threshold, got %d", n)
+	}
+
+	states.set(project.Path, domain.AgentWaitingForUser, 11*time.Minute)
+	if n := svc.CheckWaiting(ctx, projects); n != 1 {
+		t.Fatalf("expected 1 notification at threshold, got %d", n)
+	}
+
+	// Still waiting - de-duplicated
+	states.set(project.Path, domain.AgentWaitingForUser, 20*time.Minute)
+	if n := svc.CheckWaiting(ctx, projects); n != 0 {
+		t.Fatalf("expected no repeat notification, got %d", n)
+	}
+
+	if len(rec.received) != 1 {
+		t.Fatalf("expected 1 delivered notification, got %d", len(rec.received))
+	}
+	got := rec.received[0]
+	if got.ProjectName != "Alpha App" || got.ProjectPath != "/work/alpha" || got.Tool != "Claude Code" || got.WaitingFor != 11*time.Minute {
+		t.Errorf("unexpected notification: %+v", got)
+	}
+
+	// Back to work, then waiting again - notifies again
+	states.set(project.Path, domain.AgentWorking, 0)
+	svc.CheckWaiting(ctx, projects)
+	states.set(project.Path, domain.AgentWaitingForUser, 15*time.Minute)
+	if n := svc.CheckWaiting(ctx, projects); n != 1 {
+		t.Errorf("expected notification for new waiting period, got %d", n)
+	}
+}
+
+func TestNotificationService_AlreadyWaitingOnFirstCheck(t *testing.T) {
+	svc, states, rec, project := newNotificationTest(t, ports.NewConfig())
+
+	states.set(project.Path, domain.AgentWaitingForUser, time.Hour)
+	svc.CheckWaiting(context.Background(), []*domain.Project{project})
+	svc.CheckWaiting(context.Background(), []*domain.Project{project})
+
+	if len(rec.received) != 0 {
+		t.Errorf("expected no notification without an observed transition, got %d", len(rec.received))
+	}
+}
+
+func TestNotificationService_FirstSeenWaitingBelowThreshold(t *testing.T) {
+	// Agent started waiting just before vdash launched
+	svc, states, rec, project := newNotificationTest(t, ports.NewConfig())
+	ctx := context.Background()
+	projects := []*domain.Project{project}
+
+	states.set(project.Path, domain.AgentWaitingForUser, 2*time.Minute)
+	if n := svc.CheckWaiting(ctx, projects); n != 0 {
+		t.Fatalf("expected no notification below threshold, got %d", n)
+	}
+
+	states.set(project.Path, domain.AgentWaitingForUser, 10*time.Minute)
+	if n := svc.CheckWaiting(ctx, projects); n != 1 {
+		t.Fatalf("expected notification once the threshold is crossed, got %d", n)
+	}
+
+	states.set(project.Path, domain.AgentWaitingForUser, 15*time.Minute)
+	if n := svc.CheckWaiting(ctx, projects); n != 0 {
+		t.Errorf("expected no repeat notification, got %d", n)
+	}
+	if len(rec.received) != 1 {
+		t.Errorf("expected 1 delivered notification, got %d", len(rec.received))
+	}
+}
+
+func TestNotificationService_ThresholdZeroDisables(t *testing.T) {
+	cfg := ports.NewConfig()
+	cfg.AgentWaitingThresholdMinutes = 0
+	svc, states, rec, project := newNotificationTest(t, cfg)
+	projects := []*domain.Project{project}
+
+	states.set(project.Path, domain.AgentWorking, 0)
+	svc.CheckWaiting(context.Background(), projects)
+	states.set(project.Path, domain.AgentWaitingForUser, time.Hour)
+	svc.CheckWaiting(context.Background(), projects)
+
+	if len(rec.received) != 0 {
+		t.Errorf("expected no notification with threshold 0, got %d", len(rec.received))
+	}
+}
+
+func TestNotificationService_PerProjectThreshold(t *testing.T) {
+	vibeHome := t.TempDir()
+	projectDir := filepath.Join(vibeHome, "alpha")
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(projectDir, "config.yaml"), []byte("agent_waiting_threshold_minutes: 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := ports.NewConfig()
+	cfg.SetProjectEntry("alpha", "/work/alpha", "", false)
+
+	states := &mockAgentStates{states: make(map[string]domain.AgentState)}
+	rec := &recordingNotifier{}
+	svc := NewNotificationService(states, cfg, vibeHome, rec)
+	projects := []*domain.Project{{ID: "p1", Name: "alpha", Path: "/work/alpha"}}
+
+	states.set("/work/alpha", domain.AgentWorking, 0)
+	svc.CheckWaiting(context.Background(), projects)
+	states.set("/work/alpha", domain.AgentWaitingForUser, 2*time.Minute)
+
+	if n := svc.CheckWaiting(context.Background(), projects); n != 1 {
+		t.Errorf("expected per-project threshold of 1 minute to apply, got %d notifications", n)
+	}
+}
+
+func TestNotificationService_SinkErrorDoesNotRetry(t *testing.T) {
+	svc, states, rec, project := newNotificationTest(t, ports.NewConfig())
+	rec.err = errors.New("notify-send missing")
+	projects := []*domain.Project{project}
+
+	states.set(project.Path, domain.AgentWorking, 0)
+	svc.CheckWaiting(context.Background(), projects)
+	states.set(project.Path, domain.AgentWaitingForUser, time.Hour)
+	svc.CheckWaiting(context.Background(), projects)
+	svc.CheckWaiting(context.Background(), projects)
+
+	if len(rec.received) != 1 {
+		t.Errorf("expected a single attempt despite sink error, got %d", len(rec.received))
+	}
+}
+
+func TestNotificationService_NilDetector(t *testing.T) {
+	svc := NewNotificationService(nil, ports.NewConfig(), t.TempDir())
+	if n := svc.CheckWaiting(context.Background(), []*domain.Project{{ID: "p1"}}); n != 0 {
+		t.Errorf("expected 0 with nil detector, got %d", n)
+	}
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// Compile-time interface compliance check
+var _ ports.ProjectRefresher = (*RefreshService)(nil)
+
+// RefreshService re-runs methodology detection for projects and saves the
+// results. It backs both the TUI refresh and the daemon (Story 3.6, 8.11).
+type RefreshService struct {
+	repo            ports.ProjectRepository
+	detector        ports.Detector
+	events          ports.ProjectEventRepository // Optional: records agent state changes
+	waitingDetector ports.WaitingDetector        // Optional: required with events
+}
+
+// RefreshServiceOption is a functional option for configuring RefreshService.
+type RefreshServiceOption func(*RefreshService)
+
+// WithAgentStateRecording records agent_state_changed history events after
+// each refreshed project.
+func WithAgentStateRecording(events ports.ProjectEventRepository, waitingDetector ports.WaitingDetector) RefreshServiceOption {
+	return func(s *RefreshService) {
+		s.events = events
+		s.waitingDetector = waitingDetector
+	}
+}
+
+// NewRefreshService creates a new RefreshService.
+func NewRefreshService(repo ports.ProjectRepository, detector ports.Detector, opts ...RefreshServiceOption) *RefreshService {
+	s := &RefreshService{
+		repo:     repo,
+		detector: detector,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// RefreshProject re-detects one project and saves the result.
+// The project is reloaded before saving so concurrent CLI changes
+// (favorite, notes, state) are preserved. Returns the saved project.
+func (s *RefreshService) RefreshProject(ctx context.Context, projectID, path string) (*domain.Project, error) {
+	winner, allResults, err := s.detector.DetectWithCoexistenceSelection(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("detection failed: %w", err)
+	}
+
+	current, err := s.repo.FindByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	current.ApplyDetection(winner, allResults)
+	current.UpdatedAt = time.Now()
+
+	if err := s.repo.Save(ctx, current); err != nil {
+		return nil, err
+	}
+
+	if s.events != nil && s.waitingDetector != nil {
+		s.recordAgentStateChange(ctx, current)
+	}
+	return current, nil
+}
+
+// RefreshAll refreshes each project, continuing past individual failures.
+func (s *RefreshService) RefreshAll(ctx context.Context, projects []*domain.Project) ports.RefreshResult {
+	var result ports.RefreshResult
+	for _, p := range projects {
+		select {
+		case <-ctx.Done():
+			return result
+		default:
+		}
+
+		if _, err := s.RefreshProject(ctx, p.ID, p.Path); err != nil {
+			slog.Debug("refresh failed", "project", p.Name, "error", err)
+			result.Failed++
+			continue
+		}
+		result.Refreshed++
+	}
+	return result
+}
+
+// recordAgentStateChange appends an agent_state_changed event when the
+// detected agent status differs from the last recorded one.
+// Unknown is not recorded as a first state (no agent has been seen yet).
+func (s *RefreshService) recordAgentStateChange(ctx context.Context, p *domain.Project) {
+	state := s.waitingDetector.AgentState(ctx, p)
+	last, err := s.events.LastEvent(ctx, p.ID, domain.EventAgentStateChanged)
+	if err != nil {
+		slog.Debug("failed to read last agent event", "project", p.Name, "error", err)
+		return
+	}
+
+	previous := ""
+	if last != nil {
+		previous = last.To
+	}
+	current := state.Status.String()
+	if current == previous || (previous == "" && state.Status == domain.AgentUnknown) {
+		return
+	}
+
+	event := domain.NewProjectEvent(p.ID, domain.EventAgentStateChanged, previous, current, state.Tool, time.Now())
+	if err := s.events.AppendEvent(ctx, event); err != nil {
+		slog.Debug("failed to record agent event", "project", p.Name, "error", err)
+	}
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// mockRefreshRepo is a concurrency-safe repository for RefreshService and DaemonService tests.
+// FindByID returns copies so tests can observe what was saved.
+type mockRefreshRepo struct {
+	mu       sync.Mutex
+	projects map[string]*domain.Project
+	saves    int
+	activity map[string]time.Time
+}
+
+func newMockRefreshRepo(projects ...*domain.Project) *mockRefreshRepo {
+	r := &mockRefreshRepo{projects: make(map[string]*domain.Project), activity: make(map[string]time.Time)}
+	for _, p := range projects {
+		r.projects[p.ID] = p
+	}
+	return r
+}
+
+func (m *mockRefreshRepo) Save(_ context.Context, p *domain.Project) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp := *p
+	m.projects[p.ID] = &cp
+	m.saves++
+	return nil
+}
+
+func (m *mockRefreshRepo) FindByID(_ context.Context, id string) (*domain.Project, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p, ok := m.projects[id]
+	if !ok {
+		return nil, domain.ErrProjectNotFound
+	}
+	cp := *p
+	return &cp, nil
+}
+
+func (m *mockRefreshRepo) FindAll(context.Context) ([]*domain.Project, error) {
+	return m.filter(func(*domain.Project) bool { return true }), nil
+}
+
+func (m *mockRefreshRepo) FindActive(context.Context) ([]*domain.Project, error) {
+	return m.filter(func(p *domain.Project) bool { return p.State == domain.StateActive }), nil
+}
+
+func (m *mockRefreshRepo) FindHibernated(context.Context) ([]*domain.Project, error) {
+	return m.filter(func(p *domain.Project) bool { return p.State == domain.StateHibernated }), nil
+}
+
+func (m *mockRefreshRepo) filter(keep func(*domain.Project) bool) []*domain.Project {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*domain.Project
+	for _, p := range m.projects {
+		if keep(p) {
+			cp := *p
+			result = append(result, &cp)
+		}
+	}
+	return result
+}
+
+func (m *mockRefreshRepo) UpdateLastActivity(_ context.Context, id string, ts time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.activity[id] = ts
+	return nil
+}
+
+func (m *mockRefreshRepo) saveCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.saves
+}
+
+func (m *mockRefreshRepo) lastActivity(id string) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ts, ok := m.activity[id]
+	return ts, ok
+}
+
+// Unused interface methods - minimal implementation
+func (m *mockRefreshRepo) FindByPath(context.Context, string) (*domain.Project, error) {
+	return nil, domain.ErrProjectNotFound
+}
+func (m *mockRefreshRepo) Delete(context.Context, string) error { return nil }
+func (m *mockRefreshRepo) UpdateState(context.Context, string, domain.ProjectState) error {
+	return nil
+}
+func (m *mockRefreshRepo) ResetProject(context.Context, string) error { return nil }
+func (m *mockRefreshRepo) ResetAll(context.Context) (int, error)      { return 0, nil }
+
+// stubDetector returns a fixed result per path (errors for unknown paths).
+type stubDetector struct {
+	results map[string]*domain.DetectionResult
+}
+
+func (s *stubDetector) Detect(ctx context.Context, path string) (*domain.DetectionResult, error) {
+	winner, _, err := s.DetectWithCoexistenceSelection(ctx, path)
+	return winner, err
+}
+func (s *stubDetector) DetectMultiple(ctx context.Context, path string) ([]*domain.DetectionResult, error) {
+	_, all, err := s.DetectWithCoexistenceSelection(ctx, path)
+	return all, err
+}
+func (s *stubDetector) DetectWithCoexistenceSelection(_ context.Context, path string) (*domain.DetectionResult, []*domain.DetectionResult, error) {
+	r, ok := s.results[path]
+	if !ok {
+		return nil, nil, errors.New("detection failed")
+	}
+	return r, []*domain.DetectionResult{r}, nil
+}
+
+func newRefreshTestProject(t *testing.T, path string) *domain.Project {
+	t.Helper()
+	p, err := domain.NewProject(path, "")
+	if err != nil {
+		t.Fatalf("NewProject(%s): %v", path, err)
+	}
+	return p
+}
+
+func TestRefreshService_RefreshProject_PreservesUserFields(t *testing.T) {
+	p := newRefreshTestProject(t, "/projects/alpha")
+	p.Notes = "remember this"
+	p.IsFavorite = true
+	repo := newMockRefreshRepo(p)
+
+	plan := domain.NewDetectionResult("speckit", domain.StagePlan, domain.ConfidenceCertain, "plan.md")
+	svc := NewRefreshService(repo, &stubDetector{results: map[string]*domain.DetectionResult{p.Path: &plan}})
+
+	saved, err := svc.RefreshProject(context.Background(), p.ID, p.Path)
+	if err != nil {
+		t.Fatalf("RefreshProject returned error: %v", err)
+	}
+	if saved.DetectedMethod != "speckit" || saved.CurrentStage != domain.StagePlan {
+		t.Errorf("saved %s/%s, want speckit/Plan", saved.DetectedMethod, saved.CurrentStage)
+	}
+	stored, _ := repo.FindByID(context.Background(), p.ID)
+	if stored.Notes != "remember this" || !stored.IsFavorite {
+		t.Error("refresh must preserve notes and favorite")
+	}
+	if stored.CurrentStage != domain.StagePlan {
+		t.Errorf("stored stage = %s, want Plan", stored.CurrentStage)
+	}
+}
+
+func TestRefreshService_RefreshAll_CountsFailures(t *testing.T) {
+	ok := newRefreshTestProject(t, "/projects/ok")
+	broken := newRefreshTestProject(t, "/projects/broken")
+	repo := newMockRefreshRepo(ok, broken)
+
+	plan := domain.NewDetectionResult("speckit", domain.StagePlan, domain.ConfidenceCertain, "plan.md")
+	svc := NewRefreshService(repo, &stubDetector{results: map[string]*domain.DetectionResult{ok.Path: &plan}})
+
+	result := svc.RefreshAll(context.Background(), []*domain.Project{ok, broken})
+	if result.Refreshed != 1 || result.Failed != 1 {
+		t.Errorf("RefreshAll = %+v, want 1 refreshed, 1 failed", result)
+	}
+	if repo.saveCount() != 1 {
+		t.Errorf("saves = %d, want 1", repo.saveCount())
+	}
+}
+
+func TestRefreshService_RefreshAll_StopsOnCancel(t *testing.T) {
+	p := newRefreshTestProject(t, "/projects/alpha")
+	repo := newMockRefreshRepo(p)
+	plan := domain.NewDetectionResult("speckit", domain.StagePlan, domain.ConfidenceCertain, "plan.md")
+	svc := NewRefreshService(repo, &stubDetector{results: map[string]*domain.DetectionResult{p.Path: &plan}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if result := svc.RefreshAll(ctx, []*domain.Project{p}); result != (ports.RefreshResult{}) {
+		t.Errorf("RefreshAll after cancel = %+v, want zero", result)
+	}
+}
+
+// lastEventRepo returns a configurable last event and records appends.
+type lastEventRepo struct {
+	mockEventRepo
+	last *domain.ProjectEvent
+}
+
+func (r *lastEventRepo) LastEvent(context.Context, string, domain.EventType) (*domain.ProjectEvent, error) {
+	return r.last, nil
+}
+
+func TestRefreshService_RecordsAgentStateChanges(t *testing.T) {
+	p := newRefreshTestProject(t, "/projects/alpha")
+	repo := newMockRefreshRepo(p)
+	plan := domain.NewDetectionResult("speckit", domain.StagePlan, domain.ConfidenceCertain, "plan.md")
+	detector := &stubDetector{results: map[string]*domain.DetectionResult{p.Path: &plan}}
+
+	states := &mockAgentStates{states: map[string]domain.AgentState{}}
+	states.set(p.Path, domain.AgentWaitingForUser, time.Minute)
+
+	t.Run("records transition", func(t *testing.T) {
+		events := &lastEventRepo{}
+		svc := NewRefreshService(repo, detector, WithAgentStateRecording(events, states))
+		if _, err := svc.RefreshProject(context.Background(), p.ID, p.Path); err != nil {
+			t.Fatalf("RefreshProject: %v", err)
+		}
+		if len(events.events) != 1 {
+			t.Fatalf("expected 1 event, got %d", len(events.events))
+		}
+		e := events.events[0]
+		if e.Type != domain.EventAgentStateChanged || e.From != "" || e.To != domain.AgentWaitingForUser.String() {
+			t.Errorf("unexpected event: %+v", e)
+		}
+	})
+
+	t.Run("skips unchanged state", func(t *testing.T) {
+		last := domain.NewProjectEvent(p.ID, domain.EventAgentStateChanged, "", domain.AgentWaitingForUser.String(), "Claude Code", time.Now())
+		events := &lastEventRepo{last: &last}
+		svc := NewRefreshService(repo, detector, WithAgentStateRecording(events, states))
+		if _, err := svc.RefreshProject(context.Background(), p.ID, p.Path); err != nil {
+			t.Fatalf("RefreshProject: %v", err)
+		}
+		if len(events.events) != 0 {
+			t.Errorf("expected no events, got %d", len(events.events))
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// maxScanNameLength caps generated display names, as `vdash add` does.
+const maxScanNameLength = 50
+
+// Compile-time interface compliance check
+var _ ports.ProjectScanner = (*ScanService)(nil)
+
+// ScanService bulk-adds projects found under workspace roots.
+// Saves go through the repository, so directory name collisions are
+// resolved by the DirectoryManager exactly as for `vdash add`.
+type ScanService struct {
+	repo       ports.ProjectRepository
+	discoverer ports.ProjectDiscoverer
+	detector   ports.Detector
+}
+
+// NewScanService creates a new ScanService.
+func NewScanService(repo ports.ProjectRepository, discoverer ports.ProjectDiscoverer, detector ports.Detector) *ScanService {
+	return &ScanService{
+		repo:       repo,
+		discoverer: discoverer,
+		detector:   detector,
+	}
+}
+
+// Scan discovers candidates under roots and adds the untracked ones.
+// Name collisions are auto-resolved like `vdash add --force`.
+func (s *ScanService) Scan(ctx context.Context, roots []string, opts ports.ScanOptions) (ports.ScanReport, error) {
+	candidates, err := s.discoverer.Discover(ctx, roots, opts.MaxDepth)
+	if err != nil {
+		return ports.ScanReport{}, fmt.Errorf("failed to discover projects: %w", err)
+	}
+
+	existing, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return ports.ScanReport{}, fmt.Errorf("failed to load projects: %w", err)
+	}
+	tracked := make(map[string]*domain.Project, len(existing))
+	taken := make(map[string]bool, len(existing))
+	for _, p := range existing {
+		tracked[p.Path] = p
+		taken[p.Name] = true
+		if p.DisplayName != "" {
+			taken[p.DisplayName] = true
+		}
+	}
+
+	report := ports.ScanReport{Outcomes: make([]ports.ScanOutcome, 0, len(candidates))}
+	for _, c := range candidates {
+		select {
+		case <-ctx.Done():
+			return report, ctx.Err()
+		default:
+		}
+
+		outcome := ports.ScanOutcome{Candidate: c}
+		if p, ok := tracked[c.Path]; ok {
+			outcome.Status, outcome.Project = ports.ScanAlreadyTracked, p
+			report.Outcomes = append(report.Outcomes, outcome)
+			continue
+		}
+
+		project, methods, err := s.newProject(ctx, c.Path, taken)
+		if err != nil {
+			outcome.Status, outcome.Err = ports.ScanFailed, err
+			report.Outcomes = append(report.Outcomes, outcome)
+			continue
+		}
+		outcome.Project, outcome.Methods = project, methods
+
+		if opts.DryRun {
+			outcome.Status = ports.ScanWouldAdd
+		} else if err := s.repo.Save(ctx, project); err != nil {
+			outcome.Status, outcome.Err = ports.ScanFailed, fmt.Errorf("failed to save project: %w", err)
+		} else {
+			outcome.Status = ports.ScanAdded
+			slog.Info("project added by scan", "name", project.Name, "path", project.Path, "method", project.DetectedMethod)
+		}
+
+		if outcome.Status != ports.ScanFailed {
+			taken[project.Name] = true
+			if project.DisplayName != "" {
+				taken[project.DisplayName] = true
+			}
+		}
+		report.Outcomes = append(report.Outcomes, outcome)
+	}
+	return report, nil
+}
+
+// newProject builds a project for path with a unique name and detected methodology.
+// When several methodologies match, the first is primary and the next is
+// secondary; the next refresh applies full coexistence selection.
+func (s *ScanService) newProject(ctx context.Context, path string, taken map[string]bool) (*domain.Project, []string, error) {
+	project, err := domain.NewProject(path, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	if taken[project.Name] {
+		project.DisplayName = uniqueProjectName(project.Name, path, taken)
+	}
+
+	var methods []string
+	if s.detector != nil {
+		results, err := s.detector.DetectMultiple(ctx, path)
+		if err != nil {
+			// Detection failure is non-fatal - project defaults to unknown (as in `vdash add`)
+			slog.Debug("scan detection failed", "path", path, "error", err)
+		}
+		for _, r := range results {
+			methods = append(methods, r.Method)
+		}
+		if len(results) > 0 {
+			project.ApplyDetection(results[0], nil)
+			if len(results) > 1 {
+				project.SecondaryMethod = results[1].Method
+				project.SecondaryStage = results[1].Stage
+			}
+		} else {
+			project.ApplyDetection(nil, nil)
+		}
+	}
+	return project, methods, nil
+}
+
+// uniqueProjectName prepends parent directories to baseName until it is not
+// taken, mirroring the CLI's collision suggestion for `vdash add`.
+// Example: /home/user/clients/client-b/api-service → client-b-api-service
+func uniqueProjectName(baseName, fullPath string, taken map[string]bool) string {
+	var parents []string
+	for _, part := range strings.Split(filepath.Dir(fullPath), string(filepath.Separator)) {
+		if part != "" && part != "." {
+			parents = append(parents, part)
+		}
+	}
+
+	candidate := baseName
+	for i := len(parents) - 1; i >= 0; i-- {
+		candidate = parents[i] + "-" + candidate
+		if len(candidate) > maxScanNameLength {
+			break
+		}
+		if !taken[candidate] {
+			return candidate
+		}
+	}
+
+	if len(candidate) > maxScanNameLength-11 {
+		candidate = candidate[:maxScanNameLength-11]
+	}
+	return fmt.Sprintf("%s-%d", candidate, time.Now().Unix())
+}
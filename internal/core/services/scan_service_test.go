@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// stubDiscoverer returns fixed candidates and records the requested depth.
+type stubDiscoverer struct {
+	candidates []ports.ProjectCandidate
+	err        error
+	depth      int
+}
+
+func (s *stubDiscoverer) Discover(_ context.Context, _ []string, maxDepth int) ([]ports.ProjectCandidate, error) {
+	s.depth = maxDepth
+	return s.candidates, s.err
+}
+
+func candidates(paths ...string) []ports.ProjectCandidate {
+	result := make([]ports.ProjectCandidate, len(paths))
+	for i, p := range paths {
+		result[i] = ports.ProjectCandidate{Path: p, Markers: []string{".git"}}
+	}
+	return result
+}
+
+func TestScanService_AddsUntrackedProjects(t *testing.T) {
+	tracked := newRefreshTestProject(t, "/work/tracked")
+	repo := newMockRefreshRepo(tracked)
+
+	plan := domain.NewDetectionResult("speckit", domain.StagePlan, domain.ConfidenceCertain, "plan.md")
+	detector := &stubDetector{results: map[string]*domain.DetectionResult{"/work/spec-app": &plan}}
+	discoverer := &stubDiscoverer{candidates: candidates("/work/plain", "/work/spec-app", "/work/tracked")}
+
+	report, err := NewScanService(repo, discoverer, detector).Scan(context.Background(), []string{"/work"}, ports.ScanOptions{MaxDepth: 2})
+	if err != nil {
+		t.Fatalf("Scan returned error: %v", err)
+	}
+	if discoverer.depth != 2 {
+		t.Errorf("discover depth = %d, want 2", discoverer.depth)
+	}
+	if got := report.Count(ports.ScanAdded); got != 2 {
+		t.Errorf("added = %d, want 2", got)
+	}
+	if got := report.Count(ports.ScanAlreadyTracked); got != 1 {
+		t.Errorf("already tracked = %d, want 1", got)
+	}
+
+	spec := report.Outcomes[1]
+	if spec.Project.DetectedMethod != "speckit" || spec.Project.CurrentStage != domain.StagePlan {
+		t.Errorf("spec-app detected as %s/%s", spec.Project.DetectedMethod, spec.Project.CurrentStage)
+	}
+	if len(spec.Methods) != 1 || spec.Methods[0] != "speckit" {
+		t.Errorf("Methods = %v", spec.Methods)
+	}
+	// Detection failure is non-fatal: project is added as unknown
+	plain := report.Outcomes[0]
+	if plain.Status != ports.ScanAdded || plain.Project.DetectedMethod != "unknown" {
+		t.Errorf("plain outcome = %s / %s", plain.Status, plain.Project.DetectedMethod)
+	}
+
+	all, _ := repo.FindAll(context.Background())
+	if len(all) != 3 {
+		t.Errorf("repository has %d projects, want 3", len(all))
+	}
+}
+
+func TestScanService_DryRunSavesNothing(t *testing.T) {
+	repo := newMockRefreshRepo()
+	discoverer := &stubDiscoverer{candidates: candidates("/work/a", "/work/b")}
+
+	report, err := NewScanService(repo, discoverer, &stubDetector{}).Scan(context.Background(), []string{"/work"}, ports.ScanOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Scan returned error: %v", err)
+	}
+	if got := report.Count(ports.ScanWouldAdd); got != 2 {
+		t.Errorf("would add = %d, want 2", got)
+	}
+	if repo.saveCount() != 0 {
+		t.Errorf("saves = %d, want 0", repo.saveCount())
+	}
+}
+
+func TestScanService_ResolvesNameCollisions(t *testing.T) {
+	existing := newRefreshTestProject(t, "/work/personal/api")
+	repo := newMockRefreshRepo(existing)
+	discoverer := &stubDiscoverer{candidates: candidates("/work/client-a/api", "/work/client-b/api")}
+
+	report, err := NewScanService(repo, discoverer, nil).Scan(context.Background(), []string{"/work"}, ports.ScanOptions{})
+	if err != nil {
+		t.Fatalf("Scan returned error: %v", err)
+	}
+	names := []string{report.Outcomes[0].Project.DisplayName, report.Outcomes[1].Project.DisplayName}
+	if names[0] != "client-a-api" || names[1] != "client-b-api" {
+		t.Errorf("display names = %v, want [client-a-api client-b-api]", names)
+	}
+}
+
+func TestScanService_DiscoveryError(t *testing.T) {
+	discoverer := &stubDiscoverer{err: errors.New("boom")}
+	if _, err := NewScanService(newMockRefreshRepo(), discoverer, nil).Scan(context.Background(), []string{"/work"}, ports.ScanOptions{}); err == nil {
+		t.Error("expected error when discovery fails")
+	}
+}
+
+func TestUniqueProjectName(t *testing.T) {
+	tests := []struct {
+		name  string
+		taken map[string]bool
+		want  string
+	}{
+		{"parent prefix", map[string]bool{"api": true}, "client-b-api"},
+		{"grandparent prefix", map[string]bool{"api": true, "client-b-api": true}, "clients-client-b-api"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uniqueProjectName("api", "/home/clients/client-b/api", tt.taken); got != tt.want {
+				t.Errorf("uniqueProjectName = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("falls back to timestamp", func(t *testing.T) {
+		taken := map[string]bool{"api": true, "b-api": true}
+		got := uniqueProjectName("api", "/b/api", taken)
+		if !strings.HasPrefix(got, "b-api-") || len(got) > maxScanNameLength {
+			t.Errorf("uniqueProjectName = %q, want b-api-<timestamp>", got)
+		}
+	})
+}
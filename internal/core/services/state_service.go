@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
@@ -17,14 +18,29 @@ var _ ports.StateActivator = (*StateService)(nil)
 // - Only Hibernated projects can be activated
 // - Favorite projects cannot be hibernated (FR30)
 type StateService struct {
-	repo ports.ProjectRepository
+	repo   ports.ProjectRepository
+	events ports.ProjectEventRepository // Optional: records hibernate/activate history
+}
+
+// StateServiceOption is a functional option for configuring StateService.
+type StateServiceOption func(*StateService)
+
+// WithEventRepository records hibernate/activate transitions in project history.
+func WithEventRepository(events ports.ProjectEventRepository) StateServiceOption {
+	return func(s *StateService) {
+		s.events = events
+	}
 }
 
 // NewStateService creates a new StateService with the given repository.
-func NewStateService(repo ports.ProjectRepository) *StateService {
-	return &StateService{
+func NewStateService(repo ports.ProjectRepository, opts ...StateServiceOption) *StateService {
+	s := &StateService{
 		repo: repo,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Hibernate transitions a project from Active to Hibernated state.
@@ -53,7 +69,11 @@ func (s *StateService) Hibernate(ctx context.Context, projectID string) error {
 	project.HibernatedAt = &now
 	project.UpdatedAt = now
 
-	return s.repo.Save(ctx, project)
+	if err := s.repo.Save(ctx, project); err != nil {
+		return err
+	}
+	s.recordTransition(ctx, project.ID, domain.EventHibernated, domain.StateActive, domain.StateHibernated, now)
+	return nil
 }
 
 // Activate transitions a project from Hibernated to Active state.
@@ -76,5 +96,21 @@ func (s *StateService) Activate(ctx context.Context, projectID string) error {
 	project.HibernatedAt = nil
 	project.UpdatedAt = now
 
-	return s.repo.Save(ctx, project)
+	if err := s.repo.Save(ctx, project); err != nil {
+		return err
+	}
+	s.recordTransition(ctx, project.ID, domain.EventActivated, domain.StateHibernated, domain.StateActive, now)
+	return nil
+}
+
+// recordTransition appends a state change to project history.
+// Best-effort: the transition already succeeded, so failures are only logged.
+func (s *StateService) recordTransition(ctx context.Context, projectID string, eventType domain.EventType, from, to domain.ProjectState, at time.Time) {
+	if s.events == nil {
+		return
+	}
+	event := domain.NewProjectEvent(projectID, eventType, from.String(), to.String(), "", at)
+	if err := s.events.AppendEvent(ctx, event); err != nil {
+		slog.Warn("failed to record state transition", "project_id", projectID, "type", eventType, "error", err)
+	}
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
 )
 
 // mockStateRepo is a minimal mock for testing StateService
@@ -318,3 +319,49 @@ func TestStateService_Activate_SaveError_ReturnsError(t *testing.T) {
 		t.Errorf("expected errSaveTest, got %v", err)
 	}
 }
+
+// mockEventRepo records appended events for StateService tests
+type mockEventRepo struct {
+	events []domain.ProjectEvent
+}
+
+func (m *mockEventRepo) AppendEvent(_ context.Context, event domain.ProjectEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+func (m *mockEventRepo) FindEvents(context.Context, string, ports.EventFilter) ([]domain.ProjectEvent, error) {
+	return m.events, nil
+}
+func (m *mockEventRepo) LastEvent(context.Context, string, domain.EventType) (*domain.ProjectEvent, error) {
+	return nil, nil
+}
+
+func TestStateService_RecordsTransitionEvents(t *testing.T) {
+	repo := newMockStateRepo()
+	events := &mockEventRepo{}
+	svc := NewStateService(repo, WithEventRepository(events))
+
+	project, _ := domain.NewProject("/path/to/project", "test-project")
+	project.State = domain.StateActive
+	repo.projects[project.ID] = project
+
+	ctx := context.Background()
+	if err := svc.Hibernate(ctx, project.ID); err != nil {
+		t.Fatalf("Hibernate returned error: %v", err)
+	}
+	if err := svc.Activate(ctx, project.ID); err != nil {
+		t.Fatalf("Activate returned error: %v", err)
+	}
+	// Failed transition must not be recorded
+	_ = svc.Activate(ctx, project.ID)
+
+	if len(events.events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events.events))
+	}
+	if e := events.events[0]; e.Type != domain.EventHibernated || e.From != "Active" || e.To != "Hibernated" || e.ProjectID != project.ID {
+		t.Errorf("unexpected hibernate event: %+v", e)
+	}
+	if e := events.events[1]; e.Type != domain.EventActivated || e.From != "Hibernated" || e.To != "Active" {
+		t.Errorf("unexpected activate event: %+v", e)
+	}
+}
@@ -0,0 +1,293 @@
+// Package apitypes defines the versioned JSON contract shared by the CLI
+// (--json output) and the HTTP API server.
+//
+// This package is part of the shared layer and only imports from core.
+// It must NOT import from adapters or TUI packages.
+package apitypes
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+	"github.com/JeiKeiLim/vibe-dash/internal/core/ports"
+)
+
+// APIVersion is the current JSON schema version.
+const APIVersion = "v1"
+
+// ListResponse represents the JSON output structure for a list of projects.
+type ListResponse struct {
+	APIVersion    string           `json:"api_version"`
+	Projects      []ProjectSummary `json:"projects"`
+	ConfigWarning *string          `json:"config_warning,omitempty"` // Story 7.2: Config error message (null if no error)
+}
+
+// StatusResponse represents the JSON output structure for single project.
+// Different from ListResponse which uses "projects" array.
+type StatusResponse struct {
+	APIVersion    string         `json:"api_version"`              // Schema version (currently "v1")
+	Project       ProjectSummary `json:"project"`                  // Single project object (NOT array)
+	ConfigWarning *string        `json:"config_warning,omitempty"` // Story 7.2: Config error message (null if no error)
+}
+
+// ProjectSummary represents a single project in JSON output
+type ProjectSummary struct {
+	Name                   string         `json:"name"`
+	DisplayName            *string        `json:"display_name"` // null if not set
+	Path                   string         `json:"path"`
+	Method                 string         `json:"method"`
+	Stage                  string         `json:"stage"`       // Coarse stage, lowercase per Architecture spec
+	StageID                *string        `json:"stage_id"`    // Method's own stage ID ("code-review"), null if not mapped
+	StageLabel             string         `json:"stage_label"` // Method's stage label, or the coarse stage name
+	StageDone              bool           `json:"stage_done"`  // Method's workflow is finished
+	Confidence             string         `json:"confidence"`  // lowercase: "certain", "likely", "uncertain"
+	State                  string         `json:"state"`       // lowercase: "active" or "hibernated"
+	IsFavorite             bool           `json:"is_favorite"`
+	IsWaiting              bool           `json:"is_waiting"`               // Agent waiting detection status
+	WaitingDurationMinutes *int           `json:"waiting_duration_minutes"` // Minutes waiting, null if not waiting
+	Notes                  *string        `json:"notes"`                    // User notes, null if not set
+	DetectionReasoning     *string        `json:"detection_reasoning"`      // Detection explanation, null if empty
+	TasksDone              *int           `json:"tasks_done"`               // Checked tasks, null if the method has no task list
+	TasksTotal             *int           `json:"tasks_total"`              // All tasks, null if the method has no task list
+	NextTask               *string        `json:"next_task"`                // First open task, null if none
+	NextTaskPhase          *string        `json:"next_task_phase"`          // Heading next_task is listed under, null if none
+	TaskPhases             []TaskPhase    `json:"task_phases"`              // Task counts per heading, null if the list has no headings
+	Sprint                 *SprintSummary `json:"sprint"`                   // BMAD epic/story tree, null for other methods
+	LastActivityAt         string         `json:"last_activity_at"`         // ISO 8601 UTC (RFC3339)
+	Git                    *GitSummary    `json:"git"`                      // Repository status, null if not a git repo
+}
+
+// TaskPhase is the task completion of one heading of a task list.
+type TaskPhase struct {
+	Name     string `json:"name"`     // Heading text, "Phase 3: User Story 1"
+	Done     int    `json:"done"`     // Checked tasks
+	Total    int    `json:"total"`    // All tasks
+	Parallel int    `json:"parallel"` // Open tasks marked [P]
+}
+
+// SprintSummary is the structured progress of a BMAD sprint.
+type SprintSummary struct {
+	EpicsDone    int             `json:"epics_done"`    // Deferred epics are not counted
+	EpicsTotal   int             `json:"epics_total"`   // Deferred epics are not counted
+	StoriesDone  int             `json:"stories_done"`  // Deferred epics and stories are not counted
+	StoriesTotal int             `json:"stories_total"` // Deferred epics and stories are not counted
+	Focus        *SprintFocus    `json:"focus"`         // Item the stage was derived from, null if none
+	Epics        []SprintEpic    `json:"epics"`         // Natural order (never null)
+	Warnings     []SprintWarning `json:"warnings"`      // Data problems in sprint-status.yaml (never null)
+}
+
+// SprintEpic is an epic with its stories.
+type SprintEpic struct {
+	Key           string        `json:"key"`           // e.g. "epic-4-5"
+	ID            string        `json:"id"`            // e.g. "4.5"
+	Status        string        `json:"status"`        // backlog, in-progress, done, deferred, ...
+	Retrospective *string       `json:"retrospective"` // null if not listed
+	Stories       []SprintStory `json:"stories"`       // Natural order (never null)
+}
+
+// SprintStory is one story of an epic.
+type SprintStory struct {
+	Key       string `json:"key"`        // e.g. "4-5-2-stage-display"
+	ID        string `json:"id"`         // e.g. "4.5.2"
+	Status    string `json:"status"`     // backlog, drafted, ready-for-dev, in-progress, review, done, unknown
+	RawStatus string `json:"raw_status"` // Status as written in sprint-status.yaml
+}
+
+// SprintFocus is the sprint item the stage was derived from.
+type SprintFocus struct {
+	Kind   string  `json:"kind"`   // story, epic, retrospective, complete, planning
+	Epic   *string `json:"epic"`   // Epic ID, null for complete and planning
+	Story  *string `json:"story"`  // Story ID, null unless kind is story
+	Status string  `json:"status"` // Status that decided the stage
+}
+
+// SprintWarning is one data problem in sprint-status.yaml.
+type SprintWarning struct {
+	Kind    string `json:"kind"`    // empty-status, orphan-story, unknown-status
+	Key     string `json:"key"`     // Sprint status key
+	Message string `json:"message"` // Human-readable form, as in detection_reasoning
+}
+
+// GitSummary is the repository status of a project.
+type GitSummary struct {
+	Branch     string            `json:"branch"`      // Empty when detached
+	Detached   bool              `json:"detached"`    // HEAD points directly at a commit
+	Head       *string           `json:"head"`        // Full commit id, null on an unborn branch
+	Upstream   *string           `json:"upstream"`    // e.g. "origin/main", null if none
+	Ahead      int               `json:"ahead"`       // Commits not on upstream
+	Behind     int               `json:"behind"`      // Upstream commits not on HEAD
+	Dirty      int               `json:"dirty"`       // Tracked files with changes
+	Untracked  int               `json:"untracked"`   // Untracked paths
+	LastCommit *GitCommitSummary `json:"last_commit"` // null on an unborn branch
+	Worktrees  []GitWorktree     `json:"worktrees"`   // Linked worktrees (never null)
+}
+
+// GitCommitSummary describes the HEAD commit.
+type GitCommitSummary struct {
+	Subject     string `json:"subject"`
+	CommittedAt string `json:"committed_at"` // ISO 8601 UTC (RFC3339)
+}
+
+// GitWorktree is a linked worktree of the project's repository.
+type GitWorktree struct {
+	Path   string  `json:"path"`
+	Branch *string `json:"branch"` // null when detached
+}
+
+// NewProjectSummary converts a project into its JSON representation.
+// waitingDetector may be nil, in which case is_waiting is always false.
+// gitInspector may be nil, in which case git is always null.
+func NewProjectSummary(ctx context.Context, p *domain.Project, waitingDetector ports.WaitingDetector, gitInspector ports.GitInspector) ProjectSummary {
+	// Waiting detection (AC4: is_waiting and waiting_duration_minutes)
+	isWaiting := false
+	var waitingMinutes *int
+	if waitingDetector != nil {
+		isWaiting = waitingDetector.IsWaiting(ctx, p)
+		if isWaiting {
+			mins := int(waitingDetector.WaitingDuration(ctx, p).Minutes())
+			waitingMinutes = &mins
+		}
+	}
+
+	// Task counts, only for methods that report a task list
+	var tasksDone, tasksTotal *int
+	if p.TasksTotal > 0 {
+		done, total := p.TasksDone, p.TasksTotal
+		tasksDone, tasksTotal = &done, &total
+	}
+
+	return ProjectSummary{
+		Name:                   p.Name,
+		DisplayName:            optionalString(p.DisplayName),
+		Path:                   p.Path,
+		Method:                 p.DetectedMethod,
+		Stage:                  strings.ToLower(p.CurrentStage.String()),
+		StageID:                optionalString(p.MethodStage.ID),
+		StageLabel:             p.StageLabel(),
+		StageDone:              p.MethodStage.Done,
+		Confidence:             strings.ToLower(p.Confidence.String()),
+		State:                  strings.ToLower(p.State.String()),
+		IsFavorite:             p.IsFavorite,
+		IsWaiting:              isWaiting,
+		WaitingDurationMinutes: waitingMinutes,
+		Notes:                  optionalString(p.Notes),
+		DetectionReasoning:     optionalString(p.DetectionReasoning),
+		TasksDone:              tasksDone,
+		TasksTotal:             tasksTotal,
+		NextTask:               optionalString(p.NextTask),
+		NextTaskPhase:          optionalString(p.NextTaskPhase),
+		TaskPhases:             newTaskPhases(p.TaskPhases),
+		Sprint:                 NewSprintSummary(p.Sprint),
+		LastActivityAt:         p.LastActivityAt.UTC().Format(time.RFC3339),
+		Git:                    gitSummary(ctx, p, gitInspector),
+	}
+}
+
+// gitSummary inspects the project's repository. Inspection errors are
+// logged and rendered as null so one broken repo does not fail a listing.
+func gitSummary(ctx context.Context, p *domain.Project, gitInspector ports.GitInspector) *GitSummary {
+	if gitInspector == nil {
+		return nil
+	}
+	status, err := gitInspector.Inspect(ctx, p.Path)
+	if err != nil {
+		slog.Debug("git inspection failed", "path", p.Path, "error", err)
+		return nil
+	}
+	if status == nil {
+		return nil
+	}
+	return NewGitSummary(*status)
+}
+
+// NewGitSummary converts a git status into its JSON representation.
+func NewGitSummary(s domain.GitStatus) *GitSummary {
+	summary := &GitSummary{
+		Branch:    s.Branch,
+		Detached:  s.Detached,
+		Head:      optionalString(s.HeadSHA),
+		Upstream:  optionalString(s.Upstream),
+		Ahead:     s.Ahead,
+		Behind:    s.Behind,
+		Dirty:     s.Dirty,
+		Untracked: s.Untracked,
+		Worktrees: make([]GitWorktree, 0, len(s.Worktrees)),
+	}
+	if s.HeadSHA != "" {
+		summary.LastCommit = &GitCommitSummary{
+			Subject:     s.LastCommitSubject,
+			CommittedAt: s.LastCommitAt.UTC().Format(time.RFC3339),
+		}
+	}
+	for _, wt := range s.Worktrees {
+		summary.Worktrees = append(summary.Worktrees, GitWorktree{Path: wt.Path, Branch: optionalString(wt.Branch)})
+	}
+	return summary
+}
+
+// newTaskPhases converts per-heading task counts. Returns nil when there are none.
+func newTaskPhases(phases []domain.TaskPhase) []TaskPhase {
+	if len(phases) == 0 {
+		return nil
+	}
+	out := make([]TaskPhase, 0, len(phases))
+	for _, p := range phases {
+		out = append(out, TaskPhase{Name: p.Name, Done: p.Done, Total: p.Total, Parallel: p.Parallel})
+	}
+	return out
+}
+
+// NewSprintSummary converts sprint progress into its JSON representation.
+// Returns nil when there is none.
+func NewSprintSummary(s *domain.SprintProgress) *SprintSummary {
+	if s == nil {
+		return nil
+	}
+	summary := &SprintSummary{
+		Epics:    make([]SprintEpic, 0, len(s.Epics)),
+		Warnings: make([]SprintWarning, 0, len(s.Warnings)),
+	}
+	summary.EpicsDone, summary.EpicsTotal = s.EpicCounts()
+	summary.StoriesDone, summary.StoriesTotal = s.StoryCounts()
+	if s.Focus != nil {
+		summary.Focus = &SprintFocus{
+			Kind:   string(s.Focus.Kind),
+			Epic:   optionalString(s.Focus.Epic),
+			Story:  optionalString(s.Focus.Story),
+			Status: string(s.Focus.Status),
+		}
+	}
+	for _, e := range s.Epics {
+		epic := SprintEpic{
+			Key:           e.Key,
+			ID:            e.ID,
+			Status:        string(e.Status),
+			Retrospective: optionalString(string(e.Retrospective)),
+			Stories:       make([]SprintStory, 0, len(e.Stories)),
+		}
+		for _, story := range e.Stories {
+			epic.Stories = append(epic.Stories, SprintStory{
+				Key:       story.Key,
+				ID:        story.ID,
+				Status:    string(story.Status),
+				RawStatus: story.RawStatus,
+			})
+		}
+		summary.Epics = append(summary.Epics, epic)
+	}
+	for _, w := range s.Warnings {
+		summary.Warnings = append(summary.Warnings, SprintWarning{Kind: string(w.Kind), Key: w.Key, Message: w.String()})
+	}
+	return summary
+}
+
+// optionalString returns nil for empty strings so JSON renders null.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,63 @@
+package apitypes
+
+import (
+	"strings"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// WebhookPayload is the JSON body POSTed to configured webhooks.
+// The project field uses the same shape as `vdash status --json`.
+type WebhookPayload struct {
+	APIVersion string         `json:"api_version"`
+	DeliveryID string         `json:"delivery_id"` // Unique per event and webhook; unchanged across retries
+	Event      string         `json:"event"`       // e.g., "agent.waiting", "stage.changed"
+	From       *string        `json:"from"`        // Previous value, null if not applicable
+	To         *string        `json:"to"`          // New value, null if not applicable
+	Detail     *string        `json:"detail"`      // Extra context (detection reasoning, agent tool)
+	OccurredAt string         `json:"occurred_at"` // RFC3339, UTC
+	Project    ProjectSummary `json:"project"`
+}
+
+// webhookEventNames maps history event types to webhook event names.
+// Agent state changes are named per status (see WebhookEventName).
+var webhookEventNames = map[domain.EventType]string{
+	domain.EventProjectAdded:  "project.added",
+	domain.EventStageChanged:  "stage.changed",
+	domain.EventMethodChanged: "method.changed",
+	domain.EventHibernated:    "project.hibernated",
+	domain.EventActivated:     "project.activated",
+}
+
+// WebhookEventName returns the webhook event name for a history event:
+// "agent.<status>" (e.g., "agent.waiting") for agent state changes,
+// otherwise "<subject>.<change>" (e.g., "stage.changed").
+func WebhookEventName(e domain.ProjectEvent) string {
+	if e.Type == domain.EventAgentStateChanged {
+		return "agent." + strings.ToLower(e.To)
+	}
+	if name, ok := webhookEventNames[e.Type]; ok {
+		return name
+	}
+	return string(e.Type)
+}
+
+// NewWebhookPayload builds the payload for a history event.
+// A zero OccurredAt (storage default) is reported as now.
+func NewWebhookPayload(deliveryID string, e domain.ProjectEvent, project ProjectSummary) WebhookPayload {
+	occurred := e.OccurredAt
+	if occurred.IsZero() {
+		occurred = time.Now()
+	}
+	return WebhookPayload{
+		APIVersion: APIVersion,
+		DeliveryID: deliveryID,
+		Event:      WebhookEventName(e),
+		From:       optionalString(e.From),
+		To:         optionalString(e.To),
+		Detail:     optionalString(e.Detail),
+		OccurredAt: occurred.UTC().Format(time.RFC3339),
+		Project:    project,
+	}
+}
@@ -0,0 +1,47 @@
+package apitypes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func TestWebhookEventName(t *testing.T) {
+	tests := []struct {
+		event domain.ProjectEvent
+		want  string
+	}{
+		{domain.ProjectEvent{Type: domain.EventProjectAdded}, "project.added"},
+		{domain.ProjectEvent{Type: domain.EventStageChanged}, "stage.changed"},
+		{domain.ProjectEvent{Type: domain.EventMethodChanged}, "method.changed"},
+		{domain.ProjectEvent{Type: domain.EventHibernated}, "project.hibernated"},
+		{domain.ProjectEvent{Type: domain.EventActivated}, "project.activated"},
+		{domain.ProjectEvent{Type: domain.EventAgentStateChanged, To: "Waiting"}, "agent.waiting"},
+		{domain.ProjectEvent{Type: domain.EventAgentStateChanged, To: "Working"}, "agent.working"},
+	}
+	for _, tt := range tests {
+		if got := WebhookEventName(tt.event); got != tt.want {
+			t.Errorf("WebhookEventName(%s/%s) = %q, want %q", tt.event.Type, tt.event.To, got, tt.want)
+		}
+	}
+}
+
+func TestNewWebhookPayload(t *testing.T) {
+	at := time.Date(2026, 1, 11, 14, 22, 0, 0, time.FixedZone("KST", 9*3600))
+	e := domain.NewProjectEvent("id", domain.EventStageChanged, "Plan", "Implement", "", at)
+
+	p := NewWebhookPayload("d1", e, ProjectSummary{Name: "alpha"})
+	if p.APIVersion != APIVersion || p.DeliveryID != "d1" || p.Event != "stage.changed" {
+		t.Errorf("unexpected payload: %+v", p)
+	}
+	if p.OccurredAt != "2026-01-11T05:22:00Z" {
+		t.Errorf("OccurredAt = %q, want UTC RFC3339", p.OccurredAt)
+	}
+	if p.Detail != nil {
+		t.Errorf("Detail = %v, want nil for empty detail", *p.Detail)
+	}
+	if p.From == nil || *p.From != "Plan" || p.Project.Name != "alpha" {
+		t.Errorf("unexpected from/project: %+v", p)
+	}
+}
@@ -0,0 +1,126 @@
+// Package charts renders small text charts (bars, sparklines) for the stats
+// view and `vdash stats` plain-text output.
+package charts
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// barEighths are partial block characters for 1/8 .. 7/8 of a cell.
+var barEighths = []rune{'▏', '▎', '▍', '▌', '▋', '▊', '▉'}
+
+// sparkLevels are the eight sparkline heights, lowest first.
+var sparkLevels = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Bar renders value as a horizontal bar scaled so max fills width cells,
+// using eighth-block characters for sub-cell precision. Non-zero values
+// always render at least one eighth so they stay visible.
+func Bar(value, max float64, width int) string {
+	if width <= 0 || max <= 0 || value <= 0 {
+		return ""
+	}
+	if value > max {
+		value = max
+	}
+	eighths := int(value / max * float64(width*8))
+	if eighths == 0 {
+		eighths = 1
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.Repeat("█", eighths/8))
+	if rem := eighths % 8; rem > 0 {
+		sb.WriteRune(barEighths[rem-1])
+	}
+	return sb.String()
+}
+
+// Progress renders value of max as a bar exactly width cells wide, with
+// the unfilled remainder drawn as a light track: "███▍░░░░".
+func Progress(value, max float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	bar := Bar(value, max, width)
+	return bar + strings.Repeat("░", width-utf8.RuneCountInString(bar))
+}
+
+// Columns renders values as a vertical column chart height rows tall,
+// returned top row first, scaled from zero to the maximum value. Values are
+// resampled to width columns (each column shows the last value in its
+// bucket) so a series of any length spans the chart.
+func Columns(values []int, width, height int) []string {
+	if width <= 0 || height <= 0 || len(values) == 0 {
+		return nil
+	}
+
+	cols := resample(values, width)
+	peak := 0
+	for _, v := range cols {
+		peak = max(peak, v)
+	}
+
+	rows := make([]string, height)
+	for r := 0; r < height; r++ {
+		var sb strings.Builder
+		rowBase := (height - 1 - r) * 8 // Eighths below this row
+		for _, v := range cols {
+			filled := 0
+			if peak > 0 {
+				filled = v * height * 8 / peak
+			}
+			if v > 0 && filled == 0 {
+				filled = 1
+			}
+			switch n := filled - rowBase; {
+			case n >= 8:
+				sb.WriteRune('█')
+			case n > 0:
+				sb.WriteRune(sparkLevels[n-1])
+			default:
+				sb.WriteRune(' ')
+			}
+		}
+		rows[r] = sb.String()
+	}
+	return rows
+}
+
+// resample maps values onto n buckets, keeping the last value of each.
+// With fewer values than buckets, each value is repeated to fill.
+func resample(values []int, n int) []int {
+	out := make([]int, n)
+	for i := range out {
+		out[i] = values[((i+1)*len(values)+n-1)/n-1]
+	}
+	return out
+}
+
+// Sparkline renders values as a one-line chart scaled between their minimum
+// and maximum. When there are more values than width, the most recent
+// (last) width values are shown. Equal values render at mid height.
+func Sparkline(values []int, width int) string {
+	if width <= 0 || len(values) == 0 {
+		return ""
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+
+	lo, hi := values[0], values[0]
+	for _, v := range values {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		level := len(sparkLevels) / 2
+		if hi > lo {
+			level = (v - lo) * (len(sparkLevels) - 1) / (hi - lo)
+		}
+		sb.WriteRune(sparkLevels[level])
+	}
+	return sb.String()
+}
@@ -0,0 +1,90 @@
+package charts
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestBar(t *testing.T) {
+	tests := []struct {
+		name       string
+		value, max float64
+		width      int
+		want       string
+	}{
+		{"full", 10, 10, 4, "████"},
+		{"half", 5, 10, 4, "██"},
+		{"partial cell", 3, 8, 1, "▍"},
+		{"tiny value still visible", 0.001, 10, 4, "▏"},
+		{"over max clamps", 20, 10, 2, "██"},
+		{"zero", 0, 10, 4, ""},
+		{"zero max", 5, 0, 4, ""},
+		{"zero width", 5, 10, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bar(tt.value, tt.max, tt.width); got != tt.want {
+				t.Errorf("Bar(%v, %v, %d) = %q, want %q", tt.value, tt.max, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgress(t *testing.T) {
+	tests := []struct {
+		value, max float64
+		width      int
+		want       string
+	}{
+		{3, 8, 4, "█▌░░"},
+		{0, 8, 4, "░░░░"},
+		{8, 8, 4, "████"},
+		{0, 0, 3, "░░░"},
+		{1, 2, 0, ""},
+	}
+	for _, tt := range tests {
+		if got := Progress(tt.value, tt.max, tt.width); got != tt.want {
+			t.Errorf("Progress(%v, %v, %d) = %q, want %q", tt.value, tt.max, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	if got := Sparkline([]int{0, 7, 14}, 10); got != "▁▄█" {
+		t.Errorf("Sparkline = %q, want %q", got, "▁▄█")
+	}
+	if got := Sparkline([]int{3, 3}, 10); got != "▅▅" {
+		t.Errorf("flat Sparkline = %q, want mid height", got)
+	}
+	// Keeps the most recent values when too wide
+	if got := Sparkline([]int{9, 0, 1, 2}, 2); utf8.RuneCountInString(got) != 2 || got != "▁█" {
+		t.Errorf("truncated Sparkline = %q, want %q", got, "▁█")
+	}
+	if got := Sparkline(nil, 5); got != "" {
+		t.Errorf("empty Sparkline = %q", got)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	rows := Columns([]int{0, 4, 8}, 3, 2)
+	want := []string{"  █", " ██"}
+	if len(rows) != 2 || rows[0] != want[0] || rows[1] != want[1] {
+		t.Errorf("Columns = %q, want %q", rows, want)
+	}
+
+	// Fewer values than columns: values are stretched
+	rows = Columns([]int{2, 1}, 4, 1)
+	if rows[0] != "██▄▄" {
+		t.Errorf("stretched Columns = %q, want %q", rows[0], "██▄▄")
+	}
+
+	// More values than columns: last value of each bucket
+	rows = Columns([]int{8, 1, 8, 8}, 2, 1)
+	if rows[0] != "▁█" {
+		t.Errorf("resampled Columns = %q, want %q", rows[0], "▁█")
+	}
+
+	if Columns(nil, 3, 2) != nil {
+		t.Error("empty Columns should be nil")
+	}
+}
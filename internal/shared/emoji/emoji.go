@@ -80,3 +80,19 @@ func EmptyStar() string {
 	}
 	return "-"
 }
+
+// Working returns the agent working indicator (shown in monochrome mode).
+func Working() string {
+	if useEmoji {
+		return "🔨"
+	}
+	return "[>]"
+}
+
+// Hibernated returns the hibernated project indicator (shown in monochrome mode).
+func Hibernated() string {
+	if useEmoji {
+		return "💤"
+	}
+	return "[z]"
+}
@@ -114,3 +114,24 @@ func TestEmptyStar(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusIndicators(t *testing.T) {
+	tests := []struct {
+		useEmoji   bool
+		working    string
+		hibernated string
+	}{
+		{true, "🔨", "💤"},
+		{false, "[>]", "[z]"},
+	}
+
+	for _, tt := range tests {
+		InitEmoji(&tt.useEmoji)
+		if got := Working(); got != tt.working {
+			t.Errorf("Working() with emoji=%v = %q, want %q", tt.useEmoji, got, tt.working)
+		}
+		if got := Hibernated(); got != tt.hibernated {
+			t.Errorf("Hibernated() with emoji=%v = %q, want %q", tt.useEmoji, got, tt.hibernated)
+		}
+	}
+}
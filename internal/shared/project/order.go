@@ -0,0 +1,197 @@
+package project
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+// WaitingState reports whether a project's agent is waiting and for how long.
+// Callers adapt a ports.WaitingDetector; a nil WaitingState means nothing waits.
+type WaitingState func(p *domain.Project) (waiting bool, duration time.Duration)
+
+// Group is a labelled run of projects in display order.
+type Group struct {
+	Label    string
+	Projects []*domain.Project
+}
+
+// Group labels that are not derived from project data.
+const (
+	GroupLabelWaiting  = "Waiting"
+	GroupLabelNoMethod = "Unknown method"
+	GroupLabelNoTag    = "Untagged"
+	GroupLabelNoStage  = "Unknown stage"
+)
+
+// stageLabels names stage groups, e.g. "Implementing (7)".
+var stageLabels = map[domain.Stage]string{
+	domain.StageSpecify:   "Specifying",
+	domain.StagePlan:      "Planning",
+	domain.StageTasks:     "Tasks",
+	domain.StageImplement: "Implementing",
+	domain.StageUnknown:   GroupLabelNoStage,
+}
+
+// Sort orders projects in place by mode. Ties always fall back to
+// case-insensitive effective name so the order is stable across refreshes.
+// SortByName keeps the historical favorites-first order (see SortByName).
+func Sort(projects []*domain.Project, mode domain.SortMode, waiting WaitingState) {
+	if mode == domain.SortByName || mode == "" {
+		SortByName(projects)
+		return
+	}
+
+	state := waitingStates(projects, waiting)
+	sort.SliceStable(projects, func(i, j int) bool {
+		a, b := projects[i], projects[j]
+		switch mode {
+		case domain.SortByWaiting, domain.SortByWaitingDuration:
+			wa, wb := state[a], state[b]
+			if wa.waiting != wb.waiting {
+				return wa.waiting
+			}
+			if mode == domain.SortByWaitingDuration && wa.duration != wb.duration {
+				return wa.duration > wb.duration
+			}
+		case domain.SortByRecent:
+			if !a.LastActivityAt.Equal(b.LastActivityAt) {
+				return a.LastActivityAt.After(b.LastActivityAt)
+			}
+		case domain.SortByStage:
+			if a.CurrentStage != b.CurrentStage {
+				return stageRank(a.CurrentStage) < stageRank(b.CurrentStage)
+			}
+			// Within a coarse stage, sort by position in the method's own workflow,
+			// furthest along first (BMAD retrospective before development)
+			if a.MethodStage.Ordinal != b.MethodStage.Ordinal {
+				return a.MethodStage.Ordinal > b.MethodStage.Ordinal
+			}
+		case domain.SortByMethod:
+			if ma, mb := methodKey(a), methodKey(b); ma != mb {
+				return ma < mb
+			}
+		}
+		return lessByName(a, b)
+	})
+}
+
+// GroupProjects splits already-sorted projects into labelled groups,
+// keeping their order within each group. When sorting by waiting, waiting
+// projects form a leading "Waiting" group so the list reads
+// "Waiting (3) / Implementing (7) / Planning (4)". Returns nil for
+// GroupByNone. tagOf may be nil, in which case every project is untagged.
+func GroupProjects(projects []*domain.Project, by domain.GroupBy, mode domain.SortMode, waiting WaitingState, tagOf func(*domain.Project) string) []Group {
+	if by == domain.GroupByNone || by == "" {
+		return nil
+	}
+
+	waitingFirst := mode == domain.SortByWaiting || mode == domain.SortByWaitingDuration
+	state := waitingStates(projects, waiting)
+
+	type bucket struct {
+		label string
+		rank  string // Sort key for group order
+		items []*domain.Project
+	}
+	buckets := make(map[string]*bucket)
+	var waitingGroup []*domain.Project
+
+	for _, p := range projects {
+		if waitingFirst && state[p].waiting {
+			waitingGroup = append(waitingGroup, p)
+			continue
+		}
+		label, rank := groupKey(p, by, tagOf)
+		b, ok := buckets[label]
+		if !ok {
+			b = &bucket{label: label, rank: rank}
+			buckets[label] = b
+		}
+		b.items = append(b.items, p)
+	}
+
+	ordered := make([]*bucket, 0, len(buckets))
+	for _, b := range buckets {
+		ordered = append(ordered, b)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].rank < ordered[j].rank })
+
+	groups := make([]Group, 0, len(ordered)+1)
+	if len(waitingGroup) > 0 {
+		groups = append(groups, Group{Label: GroupLabelWaiting, Projects: waitingGroup})
+	}
+	for _, b := range ordered {
+		groups = append(groups, Group{Label: b.label, Projects: b.items})
+	}
+	return groups
+}
+
+// groupKey returns a project's group label and the key that orders groups.
+// Unknown methods and untagged projects sort after every named group.
+func groupKey(p *domain.Project, by domain.GroupBy, tagOf func(*domain.Project) string) (label, rank string) {
+	switch by {
+	case domain.GroupByStage:
+		return stageLabels[p.CurrentStage], strconv.Itoa(stageRank(p.CurrentStage))
+	case domain.GroupByMethod:
+		key := methodKey(p)
+		if key == unknownMethodKey {
+			return GroupLabelNoMethod, key
+		}
+		return key, "0" + key
+	case domain.GroupByTag:
+		tag := ""
+		if tagOf != nil {
+			tag = strings.TrimSpace(tagOf(p))
+		}
+		if tag == "" {
+			return GroupLabelNoTag, "1"
+		}
+		return tag, "0" + strings.ToLower(tag)
+	}
+	return "", ""
+}
+
+// unknownMethodKey sorts after every real method name.
+const unknownMethodKey = "\xff"
+
+func methodKey(p *domain.Project) string {
+	m := strings.ToLower(strings.TrimSpace(p.DetectedMethod))
+	if m == "" || m == "unknown" {
+		return unknownMethodKey
+	}
+	return m
+}
+
+// stageRank orders stages furthest-along first, unknown last.
+func stageRank(s domain.Stage) int {
+	if s == domain.StageUnknown {
+		return int(domain.StageImplement) + 1
+	}
+	return int(domain.StageImplement) - int(s)
+}
+
+func lessByName(a, b *domain.Project) bool {
+	return strings.ToLower(EffectiveName(a)) < strings.ToLower(EffectiveName(b))
+}
+
+type waitingInfo struct {
+	waiting  bool
+	duration time.Duration
+}
+
+// waitingStates evaluates waiting once per project; detectors may do I/O.
+func waitingStates(projects []*domain.Project, waiting WaitingState) map[*domain.Project]waitingInfo {
+	state := make(map[*domain.Project]waitingInfo, len(projects))
+	if waiting == nil {
+		return state
+	}
+	for _, p := range projects {
+		w, d := waiting(p)
+		state[p] = waitingInfo{waiting: w, duration: d}
+	}
+	return state
+}
@@ -0,0 +1,183 @@
+package project
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
+)
+
+func orderFixture() []*domain.Project {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	return []*domain.Project{
+		{Name: "delta", DetectedMethod: "speckit", CurrentStage: domain.StagePlan, LastActivityAt: now.Add(-3 * time.Hour)},
+		{Name: "alpha", DetectedMethod: "bmad", CurrentStage: domain.StageImplement, LastActivityAt: now.Add(-2 * time.Hour)},
+		{Name: "charlie", DetectedMethod: "", CurrentStage: domain.StageUnknown, LastActivityAt: now},
+		{Name: "bravo", DetectedMethod: "bmad", CurrentStage: domain.StagePlan, LastActivityAt: now.Add(-1 * time.Hour), IsFavorite: true},
+		{Name: "echo", DetectedMethod: "speckit", CurrentStage: domain.StageImplement, LastActivityAt: now.Add(-4 * time.Hour)},
+	}
+}
+
+// waitingFor marks the named projects as waiting for the given durations.
+func waitingFor(durations map[string]time.Duration) WaitingState {
+	return func(p *domain.Project) (bool, time.Duration) {
+		d, ok := durations[p.Name]
+		return ok, d
+	}
+}
+
+func names(projects []*domain.Project) []string {
+	result := make([]string, len(projects))
+	for i, p := range projects {
+		result[i] = p.Name
+	}
+	return result
+}
+
+func TestSort_Modes(t *testing.T) {
+	waiting := waitingFor(map[string]time.Duration{"delta": 5 * time.Minute, "echo": time.Hour})
+
+	tests := []struct {
+		mode domain.SortMode
+		want []string
+	}{
+		{domain.SortByName, []string{"bravo", "alpha", "charlie", "delta", "echo"}},
+		{"", []string{"bravo", "alpha", "charlie", "delta", "echo"}},
+		{domain.SortByWaiting, []string{"delta", "echo", "alpha", "bravo", "charlie"}},
+		{domain.SortByWaitingDuration, []string{"echo", "delta", "alpha", "bravo", "charlie"}},
+		{domain.SortByRecent, []string{"charlie", "bravo", "alpha", "delta", "echo"}},
+		{domain.SortByStage, []string{"alpha", "echo", "bravo", "delta", "charlie"}},
+		{domain.SortByMethod, []string{"alpha", "bravo", "delta", "echo", "charlie"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			projects := orderFixture()
+			Sort(projects, tt.mode, waiting)
+			if got := names(projects); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sort(%q) = %v, want %v", tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSort_StageUsesMethodOrdinal(t *testing.T) {
+	stage := func(name, method string, ordinal int) *domain.Project {
+		return &domain.Project{Name: name, DetectedMethod: method, CurrentStage: domain.StageImplement,
+			MethodStage: domain.MethodStage{ID: name, Ordinal: ordinal, Coarse: domain.StageImplement}}
+	}
+	projects := []*domain.Project{
+		stage("alpha", "bmad", 5), // development
+		stage("bravo", "speckit", 4),
+		stage("charlie", "bmad", 7), // retrospective
+		stage("delta", "bmad", 5),
+	}
+
+	Sort(projects, domain.SortByStage, nil)
+	want := []string{"charlie", "alpha", "delta", "bravo"}
+	if got := names(projects); !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort(stage) = %v, want %v", got, want)
+	}
+}
+
+func TestSort_NilWaitingState(t *testing.T) {
+	projects := orderFixture()
+	Sort(projects, domain.SortByWaiting, nil)
+	want := []string{"alpha", "bravo", "charlie", "delta", "echo"}
+	if got := names(projects); !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort(waiting, nil) = %v, want %v", got, want)
+	}
+}
+
+func groupSummary(groups []Group) map[string][]string {
+	result := make(map[string][]string, len(groups))
+	for _, g := range groups {
+		result[g.Label] = names(g.Projects)
+	}
+	return result
+}
+
+func groupLabels(groups []Group) []string {
+	labels := make([]string, len(groups))
+	for i, g := range groups {
+		labels[i] = g.Label
+	}
+	return labels
+}
+
+func TestGroupProjects_None(t *testing.T) {
+	if groups := GroupProjects(orderFixture(), domain.GroupByNone, domain.SortByName, nil, nil); groups != nil {
+		t.Errorf("GroupByNone returned %d groups, want nil", len(groups))
+	}
+}
+
+func TestGroupProjects_ByStage(t *testing.T) {
+	projects := orderFixture()
+	Sort(projects, domain.SortByName, nil)
+	groups := GroupProjects(projects, domain.GroupByStage, domain.SortByName, nil, nil)
+
+	wantLabels := []string{"Implementing", "Planning", GroupLabelNoStage}
+	if got := groupLabels(groups); !reflect.DeepEqual(got, wantLabels) {
+		t.Fatalf("labels = %v, want %v", got, wantLabels)
+	}
+	// Order within a group follows the sort order
+	if got := groupSummary(groups)["Planning"]; !reflect.DeepEqual(got, []string{"bravo", "delta"}) {
+		t.Errorf("Planning = %v, want [bravo delta]", got)
+	}
+}
+
+func TestGroupProjects_WaitingGroupLeads(t *testing.T) {
+	waiting := waitingFor(map[string]time.Duration{"alpha": time.Minute, "delta": time.Hour})
+	projects := orderFixture()
+	Sort(projects, domain.SortByWaiting, waiting)
+	groups := GroupProjects(projects, domain.GroupByStage, domain.SortByWaiting, waiting, nil)
+
+	wantLabels := []string{GroupLabelWaiting, "Implementing", "Planning", GroupLabelNoStage}
+	if got := groupLabels(groups); !reflect.DeepEqual(got, wantLabels) {
+		t.Fatalf("labels = %v, want %v", got, wantLabels)
+	}
+	summary := groupSummary(groups)
+	if got := summary[GroupLabelWaiting]; !reflect.DeepEqual(got, []string{"alpha", "delta"}) {
+		t.Errorf("Waiting = %v, want [alpha delta]", got)
+	}
+	if got := summary["Implementing"]; !reflect.DeepEqual(got, []string{"echo"}) {
+		t.Errorf("Implementing = %v, want [echo] (alpha is in Waiting)", got)
+	}
+
+	// Without a waiting sort, waiting projects stay in their own groups
+	groups = GroupProjects(projects, domain.GroupByStage, domain.SortByName, waiting, nil)
+	if groups[0].Label == GroupLabelWaiting {
+		t.Error("Waiting group created for a non-waiting sort mode")
+	}
+}
+
+func TestGroupProjects_ByMethod(t *testing.T) {
+	projects := orderFixture()
+	projects[0].DetectedMethod = "Speckit" // Case differences share a group
+	groups := GroupProjects(projects, domain.GroupByMethod, domain.SortByName, nil, nil)
+
+	wantLabels := []string{"bmad", "speckit", GroupLabelNoMethod}
+	if got := groupLabels(groups); !reflect.DeepEqual(got, wantLabels) {
+		t.Errorf("labels = %v, want %v", got, wantLabels)
+	}
+}
+
+func TestGroupProjects_ByTag(t *testing.T) {
+	tags := map[string]string{"alpha": "work", "bravo": "Clients", "delta": "work"}
+	tagOf := func(p *domain.Project) string { return tags[p.Name] }
+
+	groups := GroupProjects(orderFixture(), domain.GroupByTag, domain.SortByName, nil, tagOf)
+	wantLabels := []string{"Clients", "work", GroupLabelNoTag}
+	if got := groupLabels(groups); !reflect.DeepEqual(got, wantLabels) {
+		t.Fatalf("labels = %v, want %v", got, wantLabels)
+	}
+	if got := groupSummary(groups)[GroupLabelNoTag]; !reflect.DeepEqual(got, []string{"charlie", "echo"}) {
+		t.Errorf("Untagged = %v, want [charlie echo]", got)
+	}
+
+	// A nil tag lookup puts everything in one untagged group
+	groups = GroupProjects(orderFixture(), domain.GroupByTag, domain.SortByName, nil, nil)
+	if len(groups) != 1 || groups[0].Label != GroupLabelNoTag {
+		t.Errorf("nil tagOf groups = %v", groupLabels(groups))
+	}
+}
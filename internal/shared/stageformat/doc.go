@@ -1,5 +1,5 @@
 // Package stageformat provides formatting functions for stage information display.
-// It converts rich detection data (especially BMAD DetectionReasoning) into
+// It converts rich detection data (especially the BMAD sprint focus) into
 // condensed display strings suitable for the project list view.
 //
 // This package is part of the shared layer and only imports from core/domain.
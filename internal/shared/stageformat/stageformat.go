@@ -7,8 +7,11 @@ import (
 )
 
 // FormatStageInfo returns condensed stage info for project list display.
-// For BMAD: Parses DetectionReasoning -> "E8 S8.3 review"
-// For Speckit: Returns CurrentStage.String() -> "Plan", "Specify", etc.
+// For BMAD: Uses the sprint focus -> "E8 S8.3 review"
+// For Speckit: Returns the spec and stage -> "001-auth Plan"
+// For OpenSpec: Returns the change and stage label -> "add-2fa Apply"
+// For others: Returns the method's stage label -> "Design review", or the
+// coarse stage ("Plan") when the method does not map one
 // For Unknown: Returns "-"
 func FormatStageInfo(p *domain.Project) string {
 	// Handle nil pointer
@@ -26,13 +29,13 @@ func FormatStageInfo(p *domain.Project) string {
 		return p.CurrentStage.String()
 	}
 
-	// BMAD: Parse rich reasoning
+	// BMAD: Condense the item the stage was derived from
 	if p.DetectedMethod == "bmad" {
-		if result := parseBMADReasoning(p.DetectionReasoning); result != "" {
+		if result := formatSprintFocus(p.Sprint); result != "" {
 			return result
 		}
-		// Fallback to CurrentStage for unknown patterns
-		return p.CurrentStage.String()
+		// Fallback to the stage label without sprint-status.yaml ("Solutioning")
+		return p.StageLabel()
 	}
 
 	// Speckit: Parse spec name from reasoning
@@ -43,8 +46,16 @@ func FormatStageInfo(p *domain.Project) string {
 		return p.CurrentStage.String()
 	}
 
-	// Others: Use CurrentStage directly
-	return p.CurrentStage.String()
+	// OpenSpec: Prefix the stage label with the change it came from
+	if p.DetectedMethod == "openspec" {
+		if p.ActiveChange != "" {
+			return p.ActiveChange + " " + p.StageLabel()
+		}
+		return p.StageLabel()
+	}
+
+	// Others: Use the method's stage label
+	return p.StageLabel()
 }
 
 // FormatStageInfoWithWidth returns stage info truncated to maxWidth.
@@ -60,187 +71,79 @@ func FormatStageInfoWithWidth(p *domain.Project, maxWidth int) string {
 	return info[:maxWidth-3] + "..."
 }
 
-// parseBMADReasoning extracts display info from DetectionReasoning.
-func parseBMADReasoning(reasoning string) string {
-	if reasoning == "" {
+// formatSprintFocus condenses the item a BMAD stage was derived from.
+// Story 8.3 in review -> "E8 S8.3 review"; epic 4.5 started -> "E4.5 prep".
+// Stories are prefixed with the leading epic number of the story ID
+// ("E4 S4.5.2 impl"), as the list has always shown them.
+// Returns "" when there is no focus.
+func formatSprintFocus(s *domain.SprintProgress) string {
+	if s == nil || s.Focus == nil {
 		return ""
 	}
 
-	// Strip "BMAD vX.X.X, " prefix if present (detector.go:130 adds this)
-	reasoning = stripBMADVersionPrefix(reasoning)
-
-	switch {
-	case strings.HasPrefix(reasoning, "Story "):
-		return parseStoryReasoning(reasoning)
-	case strings.HasPrefix(reasoning, "Epic "):
-		return parseEpicReasoning(reasoning)
-	case strings.Contains(reasoning, "Retrospective for Epic"):
-		return parseRetroReasoning(reasoning)
-	case strings.Contains(reasoning, "All epics complete"):
+	f := s.Focus
+	switch f.Kind {
+	case domain.FocusStory:
+		info := "E" + storyEpic(f) + " S" + f.Story
+		if abbr := abbreviateStatus(f.Status); abbr != "" {
+			info += " " + abbr
+		}
+		return info
+	case domain.FocusEpic:
+		return "E" + f.Epic + " " + abbreviateEpicStatus(f.Status)
+	case domain.FocusRetrospective:
+		return "E" + f.Epic + " retro"
+	case domain.FocusComplete:
 		return "Done"
-	case strings.Contains(reasoning, "planning phase"):
+	case domain.FocusPlanning:
 		return "Planning"
 	default:
 		return ""
 	}
 }
 
-// stripBMADVersionPrefix removes "BMAD vX.X.X, " prefix from reasoning.
-// The BMAD detector (detector.go:130) prepends version info to stage reasoning.
-func stripBMADVersionPrefix(reasoning string) string {
-	// Pattern: "BMAD vX.X.X-suffix, actual reasoning"
-	const prefix = "BMAD v"
-	if !strings.HasPrefix(reasoning, prefix) {
-		return reasoning
+// storyEpic returns the epic number shown before a story: the part of the
+// story ID before the first dot ("4.5.2" -> "4"), or the focus epic when the
+// story ID has none.
+func storyEpic(f *domain.SprintFocus) string {
+	if epic, _, found := strings.Cut(f.Story, "."); found && epic != "" {
+		return epic
 	}
-	// Find the ", " separator after version
-	idx := strings.Index(reasoning, ", ")
-	if idx == -1 {
-		return reasoning
-	}
-	return reasoning[idx+2:]
+	return f.Epic
 }
 
-// parseStoryReasoning handles "Story X.Y.Z status" patterns.
-// "Story 8.3 in code review" -> "E8 S8.3 review"
-// "Story 4.5.2 being implemented" -> "E4 S4.5.2 impl"
-func parseStoryReasoning(reasoning string) string {
-	// Remove "Story " prefix
-	rest := strings.TrimPrefix(reasoning, "Story ")
-	if rest == "" {
-		return ""
-	}
-
-	// Find the first space after story number
-	parts := strings.SplitN(rest, " ", 2)
-	if len(parts) == 0 {
-		return ""
-	}
-
-	storyNum := parts[0] // e.g., "8.3" or "4.5.2"
-
-	// Extract epic number (first number before first dot)
-	epicNum := extractEpicFromStory(storyNum)
-	if epicNum == "" {
-		return ""
-	}
-
-	// Determine status abbreviation from the rest
-	statusAbbr := ""
-	if len(parts) > 1 {
-		statusAbbr = abbreviateStatus(parts[1])
-	}
-
-	// Format: "E{epic} S{story} {status}"
-	if statusAbbr != "" {
-		return "E" + epicNum + " S" + storyNum + " " + statusAbbr
-	}
-	return "E" + epicNum + " S" + storyNum
-}
-
-// parseEpicReasoning handles "Epic X.Y status" patterns.
-// "Epic 4.5 started, preparing stories" -> "E4.5 prep"
-// "Epic 4.5 stories complete, update epic status" -> "E4.5 done"
-func parseEpicReasoning(reasoning string) string {
-	// Remove "Epic " prefix
-	rest := strings.TrimPrefix(reasoning, "Epic ")
-	if rest == "" {
-		return ""
-	}
-
-	// Find the first space after epic number
-	parts := strings.SplitN(rest, " ", 2)
-	if len(parts) == 0 {
-		return ""
-	}
-
-	epicNum := parts[0] // e.g., "4.5" or "8"
-
-	// Determine status abbreviation
-	statusAbbr := ""
-	if len(parts) > 1 {
-		statusAbbr = abbreviateEpicStatus(parts[1])
-	}
-
-	if statusAbbr != "" {
-		return "E" + epicNum + " " + statusAbbr
-	}
-	return "E" + epicNum
-}
-
-// parseRetroReasoning handles retrospective patterns.
-// "Retrospective for Epic 7 in progress" -> "E7 retro"
-func parseRetroReasoning(reasoning string) string {
-	// Find "Epic " after "Retrospective for "
-	idx := strings.Index(reasoning, "Retrospective for Epic ")
-	if idx == -1 {
-		return ""
-	}
-
-	rest := reasoning[idx+len("Retrospective for Epic "):]
-	// Extract epic number (until space or end)
-	parts := strings.SplitN(rest, " ", 2)
-	if len(parts) == 0 {
-		return ""
-	}
-
-	epicNum := parts[0]
-	return "E" + epicNum + " retro"
-}
-
-// extractEpicFromStory extracts the epic number from a story number.
-// "8.3" -> "8"
-// "4.5.2" -> "4"
-// "" -> ""
-func extractEpicFromStory(storyNum string) string {
-	if storyNum == "" {
-		return ""
-	}
-	parts := strings.Split(storyNum, ".")
-	if len(parts) == 0 || parts[0] == "" {
-		return ""
-	}
-	return parts[0]
-}
-
-// abbreviateStatus converts status description to abbreviation.
-func abbreviateStatus(status string) string {
-	status = strings.ToLower(status)
-
-	switch {
-	case strings.Contains(status, "code review") || strings.Contains(status, "in review"):
+// abbreviateStatus converts a story status to its list abbreviation.
+// Unknown statuses have none.
+func abbreviateStatus(status domain.WorkStatus) string {
+	switch status {
+	case domain.WorkReview:
 		return "review"
-	case strings.Contains(status, "being implemented") || strings.Contains(status, "in-progress") || strings.Contains(status, "in progress"):
+	case domain.WorkInProgress:
 		return "impl"
-	case strings.Contains(status, "ready for development") || strings.Contains(status, "ready-for-dev"):
+	case domain.WorkReady:
 		return "ready"
-	case strings.Contains(status, "drafted"):
+	case domain.WorkDrafted:
 		return "draft"
-	case strings.Contains(status, "backlog"):
+	case domain.WorkBacklog:
 		return "backlog"
-	case strings.HasPrefix(status, "done") || strings.Contains(status, "completed"):
+	case domain.WorkDone:
 		return "done"
 	default:
 		return ""
 	}
 }
 
-// abbreviateEpicStatus converts epic status description to abbreviation.
-func abbreviateEpicStatus(status string) string {
-	status = strings.ToLower(status)
-
-	switch {
-	case strings.Contains(status, "stories complete"):
+// abbreviateEpicStatus converts the status of a focused epic to its list
+// abbreviation: an epic in progress without a story to pick is preparing
+// stories, a done focus means all stories are done but the epic is not.
+func abbreviateEpicStatus(status domain.WorkStatus) string {
+	switch status {
+	case domain.WorkDone:
 		return "done"
-	case strings.Contains(status, "started") || strings.Contains(status, "preparing"):
-		return "prep"
-	// G26: Backlog epic without stories
-	case strings.Contains(status, "backlog") && strings.Contains(status, "story planning"):
-		return "backlog"
-	case strings.Contains(status, "backlog"):
+	case domain.WorkBacklog:
 		return "backlog"
 	default:
-		return ""
+		return "prep"
 	}
 }
 
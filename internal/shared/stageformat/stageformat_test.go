@@ -6,120 +6,160 @@ import (
 	"github.com/JeiKeiLim/vibe-dash/internal/core/domain"
 )
 
+// bmadProject returns a BMAD project whose sprint focus is f.
+func bmadProject(stage domain.Stage, f *domain.SprintFocus) domain.Project {
+	return domain.Project{
+		DetectedMethod: "bmad",
+		CurrentStage:   stage,
+		Sprint:         &domain.SprintProgress{Focus: f},
+	}
+}
+
+func storyFocus(epic, story string, status domain.WorkStatus) *domain.SprintFocus {
+	return &domain.SprintFocus{Kind: domain.FocusStory, Epic: epic, Story: story, Status: status}
+}
+
+func epicFocus(epic string, status domain.WorkStatus) *domain.SprintFocus {
+	return &domain.SprintFocus{Kind: domain.FocusEpic, Epic: epic, Status: status}
+}
+
 func TestFormatStageInfo(t *testing.T) {
 	tests := []struct {
 		name     string
 		project  domain.Project
 		expected string
 	}{
-		// BMAD reasoning patterns
+		// BMAD sprint focus
 		{
-			name: "bmad story review",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 8.3 in code review",
-				CurrentStage:       domain.StageTasks,
-			},
+			name:     "bmad story review",
+			project:  bmadProject(domain.StageTasks, storyFocus("8", "8.3", domain.WorkReview)),
 			expected: "E8 S8.3 review",
 		},
 		{
-			name: "bmad story impl",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 4.5.2 being implemented",
-				CurrentStage:       domain.StageImplement,
-			},
+			name:     "bmad story impl",
+			project:  bmadProject(domain.StageImplement, storyFocus("4.5", "4.5.2", domain.WorkInProgress)),
 			expected: "E4 S4.5.2 impl",
 		},
 		{
-			name: "bmad story ready",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 1.2 ready for development",
-				CurrentStage:       domain.StagePlan,
-			},
+			name:     "bmad story ready",
+			project:  bmadProject(domain.StagePlan, storyFocus("1", "1.2", domain.WorkReady)),
 			expected: "E1 S1.2 ready",
 		},
 		{
-			name: "bmad story drafted",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 1.2 drafted, needs review",
-				CurrentStage:       domain.StagePlan,
-			},
+			name:     "bmad story drafted",
+			project:  bmadProject(domain.StagePlan, storyFocus("1", "1.2", domain.WorkDrafted)),
 			expected: "E1 S1.2 draft",
 		},
 		{
-			name: "bmad story backlog",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 1.2 in backlog, needs drafting",
-				CurrentStage:       domain.StagePlan,
-			},
+			name:     "bmad story backlog",
+			project:  bmadProject(domain.StagePlan, storyFocus("1", "1.2", domain.WorkBacklog)),
 			expected: "E1 S1.2 backlog",
 		},
 		{
-			name: "bmad epic prep",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Epic 4.5 started, preparing stories",
-				CurrentStage:       domain.StagePlan,
-			},
+			name:     "bmad story done",
+			project:  bmadProject(domain.StageSpecify, storyFocus("1", "1.2", domain.WorkDone)),
+			expected: "E1 S1.2 done",
+		},
+		{
+			name:     "bmad story unknown status",
+			project:  bmadProject(domain.StagePlan, storyFocus("1", "1.2", domain.WorkUnknown)),
+			expected: "E1 S1.2", // No status abbreviation for unknown statuses
+		},
+		{
+			name:     "bmad long story number",
+			project:  bmadProject(domain.StageImplement, storyFocus("10.10", "10.10.10", domain.WorkInProgress)),
+			expected: "E10 S10.10.10 impl",
+		},
+		{
+			name:     "bmad epic prep",
+			project:  bmadProject(domain.StagePlan, epicFocus("4.5", domain.WorkInProgress)),
 			expected: "E4.5 prep",
 		},
 		{
-			name: "bmad epic done",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Epic 4.5 stories complete, update epic status",
-				CurrentStage:       domain.StageImplement,
-			},
+			name:     "bmad epic stories done",
+			project:  bmadProject(domain.StageImplement, epicFocus("4.5", domain.WorkDone)),
 			expected: "E4.5 done",
 		},
+		// G26: Epic backlog with no stories (needs story planning)
+		{
+			name:     "bmad epic backlog",
+			project:  bmadProject(domain.StagePlan, epicFocus("10", domain.WorkBacklog)),
+			expected: "E10 backlog",
+		},
+		{
+			name:     "bmad sub-epic backlog",
+			project:  bmadProject(domain.StagePlan, epicFocus("9.5", domain.WorkBacklog)),
+			expected: "E9.5 backlog",
+		},
+		{
+			name:     "bmad retro",
+			project:  bmadProject(domain.StageImplement, &domain.SprintFocus{Kind: domain.FocusRetrospective, Epic: "7", Status: domain.WorkInProgress}),
+			expected: "E7 retro",
+		},
+		{
+			name:     "bmad sub-epic retro",
+			project:  bmadProject(domain.StageImplement, &domain.SprintFocus{Kind: domain.FocusRetrospective, Epic: "4.5", Status: domain.WorkInProgress}),
+			expected: "E4.5 retro",
+		},
+		{
+			name:     "bmad all done",
+			project:  bmadProject(domain.StageImplement, &domain.SprintFocus{Kind: domain.FocusComplete, Status: domain.WorkDone}),
+			expected: "Done",
+		},
+		{
+			name:     "bmad planning",
+			project:  bmadProject(domain.StageSpecify, &domain.SprintFocus{Kind: domain.FocusPlanning, Status: domain.WorkBacklog}),
+			expected: "Planning",
+		},
+		{
+			name:     "bmad no focus",
+			project:  bmadProject(domain.StageUnknown, nil),
+			expected: "-",
+		},
 		{
-			name: "bmad retro",
+			name: "bmad without sprint falls back to stage",
 			project: domain.Project{
 				DetectedMethod:     "bmad",
-				DetectionReasoning: "Retrospective for Epic 7 in progress",
-				CurrentStage:       domain.StageImplement,
+				DetectionReasoning: "BMAD v6.0.0, Story 8.3 in code review", // Reasoning is not parsed
+				CurrentStage:       domain.StageTasks,
 			},
-			expected: "E7 retro",
+			expected: "Tasks",
 		},
 		{
-			name: "bmad all done",
+			name: "bmad artifact detection",
 			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "All epics complete - project done",
-				CurrentStage:       domain.StageImplement,
+				DetectedMethod: "bmad",
+				CurrentStage:   domain.StagePlan,
 			},
-			expected: "Done",
+			expected: "Plan",
 		},
 		{
-			name: "bmad planning",
+			name: "bmad artifact detection with method stage",
 			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "No epics in progress - planning phase",
-				CurrentStage:       domain.StageSpecify,
+				DetectedMethod: "bmad",
+				CurrentStage:   domain.StagePlan,
+				MethodStage:    domain.MethodStage{ID: "solutioning", Label: "Solutioning", Coarse: domain.StagePlan},
 			},
-			expected: "Planning",
+			expected: "Solutioning",
 		},
+
+		// Declarative methods - use the workflow stage label
 		{
-			name: "bmad empty reasoning",
+			name: "declarative workflow stage",
 			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "",
-				CurrentStage:       domain.StagePlan,
+				DetectedMethod: "rfc-flow",
+				CurrentStage:   domain.StagePlan,
+				MethodStage:    domain.MethodStage{ID: "design-review", Label: "Design review", Coarse: domain.StagePlan},
 			},
-			expected: "Plan",
+			expected: "Design review",
 		},
 		{
-			name: "bmad unknown pattern",
+			name: "declarative without workflow",
 			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Something unexpected",
-				CurrentStage:       domain.StageImplement,
+				DetectedMethod: "rfc-flow",
+				CurrentStage:   domain.StageTasks,
 			},
-			expected: "Implement",
+			expected: "Tasks",
 		},
 
 		// Speckit - uses CurrentStage.String() directly
@@ -156,6 +196,29 @@ func TestFormatStageInfo(t *testing.T) {
 			expected: "Implement",
 		},
 
+		// OpenSpec - change ID and stage label
+		{
+			name: "openspec change",
+			project: domain.Project{
+				DetectedMethod:     "openspec",
+				CurrentStage:       domain.StageImplement,
+				MethodStage:        domain.MethodStage{ID: "apply", Label: "Apply", Coarse: domain.StageImplement},
+				ActiveChange:       "add-2fa",
+				DetectionReasoning: "2/5 tasks done (change: add-2fa; 2 active: add-2fa, fix-login)",
+			},
+			expected: "add-2fa Apply",
+		},
+		{
+			name: "openspec without change",
+			project: domain.Project{
+				DetectedMethod:     "openspec",
+				CurrentStage:       domain.StageImplement,
+				MethodStage:        domain.MethodStage{ID: "archived", Label: "Archived", Coarse: domain.StageImplement, Done: true},
+				DetectionReasoning: "no active changes, 2 archived (latest: add-2fa)",
+			},
+			expected: "Archived",
+		},
+
 		// Unknown method - return "-"
 		{
 			name: "unknown method",
@@ -179,109 +242,6 @@ func TestFormatStageInfo(t *testing.T) {
 			},
 			expected: "-",
 		},
-
-		// Edge cases
-		{
-			name: "bmad long story number",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 10.10.10 being implemented",
-				CurrentStage:       domain.StageImplement,
-			},
-			expected: "E10 S10.10.10 impl",
-		},
-		{
-			name: "bmad sub-epic retro",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Retrospective for Epic 4.5 in progress",
-				CurrentStage:       domain.StageImplement,
-			},
-			expected: "E4.5 retro",
-		},
-		{
-			name: "bmad story done",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 1.2 done and completed",
-				CurrentStage:       domain.StageImplement,
-			},
-			expected: "E1 S1.2 done",
-		},
-		{
-			name: "bmad unknown status pattern",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 1.2 has unknown status 'custom'",
-				CurrentStage:       domain.StagePlan,
-			},
-			expected: "E1 S1.2", // No status abbreviation for unknown patterns
-		},
-		// BMAD version prefix handling (detector.go:130 adds version prefix)
-		{
-			name: "bmad with version prefix story",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "BMAD v6.0.0-alpha.13, Story 8.4 in backlog, needs drafting",
-				CurrentStage:       domain.StagePlan,
-			},
-			expected: "E8 S8.4 backlog",
-		},
-		{
-			name: "bmad with version prefix impl",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "BMAD v6.0.0-alpha.13, Story 1.1 being implemented",
-				CurrentStage:       domain.StageImplement,
-			},
-			expected: "E1 S1.1 impl",
-		},
-		{
-			name: "bmad with version prefix all done",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "BMAD v6.0.0-alpha.13, All epics complete - project done",
-				CurrentStage:       domain.StageImplement,
-			},
-			expected: "Done",
-		},
-		{
-			name: "bmad with version prefix review",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "BMAD v6.0.0-alpha.13, Story 1.1 in code review",
-				CurrentStage:       domain.StageTasks,
-			},
-			expected: "E1 S1.1 review",
-		},
-		// G26: Epic backlog with no stories (needs story planning)
-		{
-			name: "bmad epic backlog needs planning",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Epic 10 in backlog, needs story planning",
-				CurrentStage:       domain.StagePlan,
-			},
-			expected: "E10 backlog",
-		},
-		{
-			name: "bmad sub-epic backlog needs planning",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Epic 9.5 in backlog, needs story planning",
-				CurrentStage:       domain.StagePlan,
-			},
-			expected: "E9.5 backlog",
-		},
-		{
-			name: "bmad epic backlog with version prefix",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "BMAD v6.0.0-alpha.13, Epic 10 in backlog, needs story planning",
-				CurrentStage:       domain.StagePlan,
-			},
-			expected: "E10 backlog",
-		},
 	}
 
 	for _, tt := range tests {
@@ -302,72 +262,44 @@ func TestFormatStageInfoWithWidth(t *testing.T) {
 		expected string
 	}{
 		{
-			name: "fits within width",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 8.3 in code review",
-				CurrentStage:       domain.StageTasks,
-			},
+			name:     "fits within width",
+			project:  bmadProject(domain.StageTasks, storyFocus("8", "8.3", domain.WorkReview)),
 			maxWidth: 20,
 			expected: "E8 S8.3 review",
 		},
 		{
-			name: "truncate with ellipsis",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 8.3 in code review",
-				CurrentStage:       domain.StageTasks,
-			},
+			name:     "truncate with ellipsis",
+			project:  bmadProject(domain.StageTasks, storyFocus("8", "8.3", domain.WorkReview)),
 			maxWidth: 10,
 			expected: "E8 S8.3...",
 		},
 		{
-			name: "very narrow truncate",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 8.3 in code review",
-				CurrentStage:       domain.StageTasks,
-			},
+			name:     "very narrow truncate",
+			project:  bmadProject(domain.StageTasks, storyFocus("8", "8.3", domain.WorkReview)),
 			maxWidth: 5,
 			expected: "E8...",
 		},
 		{
-			name: "extremely narrow no ellipsis",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 8.3 in code review",
-				CurrentStage:       domain.StageTasks,
-			},
+			name:     "extremely narrow no ellipsis",
+			project:  bmadProject(domain.StageTasks, storyFocus("8", "8.3", domain.WorkReview)),
 			maxWidth: 3,
 			expected: "E8 ",
 		},
 		{
-			name: "width 2 no ellipsis",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 8.3 in code review",
-				CurrentStage:       domain.StageTasks,
-			},
+			name:     "width 2 no ellipsis",
+			project:  bmadProject(domain.StageTasks, storyFocus("8", "8.3", domain.WorkReview)),
 			maxWidth: 2,
 			expected: "E8",
 		},
 		{
-			name: "width 4 with ellipsis",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 8.3 in code review",
-				CurrentStage:       domain.StageTasks,
-			},
+			name:     "width 4 with ellipsis",
+			project:  bmadProject(domain.StageTasks, storyFocus("8", "8.3", domain.WorkReview)),
 			maxWidth: 4,
 			expected: "E...",
 		},
 		{
-			name: "exact width match",
-			project: domain.Project{
-				DetectedMethod:     "bmad",
-				DetectionReasoning: "Story 8.3 in code review",
-				CurrentStage:       domain.StageTasks,
-			},
+			name:     "exact width match",
+			project:  bmadProject(domain.StageTasks, storyFocus("8", "8.3", domain.WorkReview)),
 			maxWidth: 14, // "E8 S8.3 review" is 14 chars
 			expected: "E8 S8.3 review",
 		},
@@ -383,59 +315,6 @@ func TestFormatStageInfoWithWidth(t *testing.T) {
 	}
 }
 
-func TestParseBMADReasoning(t *testing.T) {
-	tests := []struct {
-		name      string
-		reasoning string
-		expected  string
-	}{
-		{"empty", "", ""},
-		{"story review", "Story 8.3 in code review", "E8 S8.3 review"},
-		{"story impl", "Story 4.5.2 being implemented", "E4 S4.5.2 impl"},
-		{"story ready", "Story 1.2 ready for development", "E1 S1.2 ready"},
-		{"story drafted", "Story 1.2 drafted, needs review", "E1 S1.2 draft"},
-		{"story backlog", "Story 1.2 in backlog, needs drafting", "E1 S1.2 backlog"},
-		{"epic prep", "Epic 4.5 started, preparing stories", "E4.5 prep"},
-		{"epic done", "Epic 4.5 stories complete, update epic status", "E4.5 done"},
-		{"retro", "Retrospective for Epic 7 in progress", "E7 retro"},
-		{"all done", "All epics complete - project done", "Done"},
-		{"planning", "No epics in progress - planning phase", "Planning"},
-		{"unknown pattern", "Something unexpected", ""},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := parseBMADReasoning(tt.reasoning)
-			if result != tt.expected {
-				t.Errorf("parseBMADReasoning(%q) = %q, want %q", tt.reasoning, result, tt.expected)
-			}
-		})
-	}
-}
-
-func TestExtractEpicFromStory(t *testing.T) {
-	tests := []struct {
-		storyNum string
-		expected string
-	}{
-		{"8.3", "8"},
-		{"4.5.2", "4"},
-		{"1.2", "1"},
-		{"10.10.10", "10"},
-		{"1", "1"},
-		{"", ""},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.storyNum, func(t *testing.T) {
-			result := extractEpicFromStory(tt.storyNum)
-			if result != tt.expected {
-				t.Errorf("extractEpicFromStory(%q) = %q, want %q", tt.storyNum, result, tt.expected)
-			}
-		})
-	}
-}
-
 func TestFormatStageInfo_NilProject(t *testing.T) {
 	result := FormatStageInfo(nil)
 	if result != "-" {
@@ -443,29 +322,6 @@ func TestFormatStageInfo_NilProject(t *testing.T) {
 	}
 }
 
-func TestStripBMADVersionPrefix(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected string
-	}{
-		{"BMAD v6.0.0-alpha.13, Story 8.3 in code review", "Story 8.3 in code review"},
-		{"BMAD v6.0.0, All epics complete - project done", "All epics complete - project done"},
-		{"BMAD v1.0.0, Epic 4.5 started, preparing stories", "Epic 4.5 started, preparing stories"},
-		{"Story 8.3 in code review", "Story 8.3 in code review"},                             // No prefix
-		{"BMAD v6.0.0-alpha.13 without separator", "BMAD v6.0.0-alpha.13 without separator"}, // No ", " separator
-		{"", ""},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.input, func(t *testing.T) {
-			result := stripBMADVersionPrefix(tt.input)
-			if result != tt.expected {
-				t.Errorf("stripBMADVersionPrefix(%q) = %q, want %q", tt.input, result, tt.expected)
-			}
-		})
-	}
-}
-
 func TestFormatStageInfoWithWidth_NilProject(t *testing.T) {
 	result := FormatStageInfoWithWidth(nil, 10)
 	if result != "-" {
@@ -475,28 +331,21 @@ func TestFormatStageInfoWithWidth_NilProject(t *testing.T) {
 
 func TestAbbreviateStatus(t *testing.T) {
 	tests := []struct {
-		status   string
+		status   domain.WorkStatus
 		expected string
 	}{
-		{"in code review", "review"},
-		{"in review", "review"},
-		{"being implemented", "impl"},
-		{"in-progress", "impl"},
-		{"in progress", "impl"},
-		{"ready for development", "ready"},
-		{"ready-for-dev", "ready"},
-		{"drafted", "draft"},
-		{"drafted, needs review", "draft"},
-		{"in backlog", "backlog"},
-		{"in backlog, needs drafting", "backlog"},
-		{"done", "done"},
-		{"done and completed", "done"},
-		{"completed successfully", "done"},
-		{"something unknown", ""},
+		{domain.WorkReview, "review"},
+		{domain.WorkInProgress, "impl"},
+		{domain.WorkReady, "ready"},
+		{domain.WorkDrafted, "draft"},
+		{domain.WorkBacklog, "backlog"},
+		{domain.WorkDone, "done"},
+		{domain.WorkUnknown, ""},
+		{domain.WorkDeferred, ""},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.status, func(t *testing.T) {
+		t.Run(string(tt.status), func(t *testing.T) {
 			result := abbreviateStatus(tt.status)
 			if result != tt.expected {
 				t.Errorf("abbreviateStatus(%q) = %q, want %q", tt.status, result, tt.expected)
@@ -507,19 +356,16 @@ func TestAbbreviateStatus(t *testing.T) {
 
 func TestAbbreviateEpicStatus(t *testing.T) {
 	tests := []struct {
-		status   string
+		status   domain.WorkStatus
 		expected string
 	}{
-		{"stories complete", "done"},
-		{"stories complete, update epic status", "done"},
-		{"started, preparing stories", "prep"},
-		{"preparing stories", "prep"},
-		{"started", "prep"},
-		{"something unknown", ""},
+		{domain.WorkDone, "done"},
+		{domain.WorkInProgress, "prep"},
+		{domain.WorkBacklog, "backlog"},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.status, func(t *testing.T) {
+		t.Run(string(tt.status), func(t *testing.T) {
 			result := abbreviateEpicStatus(tt.status)
 			if result != tt.expected {
 				t.Errorf("abbreviateEpicStatus(%q) = %q, want %q", tt.status, result, tt.expected)
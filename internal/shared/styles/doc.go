@@ -20,8 +20,10 @@
 //	text := styles.SelectedStyle.Render("highlighted row")
 //	warning := styles.WarningStyle.Render("⚠️ Warning")
 //
-// Color Palette:
+// Themes:
 //
-// All styles use the 16-color ANSI palette for maximum terminal compatibility.
-// See styles.go for the complete color reference table.
+// Styles are built from the active Theme (theme.go). The default dark theme
+// uses the 16-color ANSI palette for maximum terminal compatibility; light,
+// high-contrast, solarized and monochrome are built in, and user themes are
+// loaded from YAML files. ApplyTheme must run before rendering starts.
 package styles
@@ -0,0 +1,347 @@
+package styles
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultThemeName is the theme used when none is configured.
+const DefaultThemeName = "dark"
+
+// Theme is a named color palette. Colors are lipgloss color strings: an ANSI
+// index ("1", "236") or a hex value ("#268bd2"). An empty color leaves the
+// terminal default.
+type Theme struct {
+	Name string
+
+	// Monochrome themes use no colors; status is shown by glyphs and text
+	// attributes (bold, reverse, underline) instead.
+	Monochrome bool
+
+	Title      string // Headings, accent borders
+	SelectedBg string // Selected row background
+	SelectedFg string // Selected row foreground
+	Waiting    string // WAITING indicator
+	Working    string // Agent working
+	Hibernated string // Hibernated projects
+	Recent     string // Activity today
+	Active     string // Activity this week
+	Uncertain  string // Uncertain detection
+	Favorite   string // Favorite star
+	Warning    string // Warnings
+	Match      string // Search match highlight
+	Border     string // Panel borders
+	Box        string // Rounded box borders
+	HeaderBg   string // Text/stats view header and footer background
+	HeaderFg   string // Text/stats view header text
+	FooterFg   string // Text/stats view footer text
+}
+
+// DarkTheme is the default theme, tuned for dark terminal backgrounds.
+// Uses the 16-color ANSI palette where possible for maximum compatibility.
+//
+// | ANSI Color | Value        | Usage                                  |
+// |------------|--------------|----------------------------------------|
+// | 1          | Red          | Waiting (bold)                         |
+// | 2          | Green        | Recent, Working                        |
+// | 3          | Yellow       | Active, Warning, Match                 |
+// | 5          | Magenta      | Favorite                               |
+// | 6          | Cyan         | Selected (background)                  |
+// | 8          | Bright Black | Uncertain, Hibernated, Border          |
+// | 39         | Cyan         | Title (foreground, bold)               |
+// | 240        | Gray         | Box border                             |
+// | 236/255/244| Grays        | View header background/text, footer    |
+var DarkTheme = Theme{
+	Name:       "dark",
+	Title:      "39",
+	SelectedBg: "6",
+	Waiting:    "1",
+	Working:    "2",
+	Hibernated: "8",
+	Recent:     "2",
+	Active:     "3",
+	Uncertain:  "8",
+	Favorite:   "5",
+	Warning:    "3",
+	Match:      "3",
+	Border:     "8",
+	Box:        "240",
+	HeaderBg:   "236",
+	HeaderFg:   "255",
+	FooterFg:   "244",
+}
+
+// LightTheme is tuned for light terminal backgrounds.
+var LightTheme = Theme{
+	Name:       "light",
+	Title:      "25",
+	SelectedBg: "152",
+	SelectedFg: "232",
+	Waiting:    "160",
+	Working:    "28",
+	Hibernated: "245",
+	Recent:     "28",
+	Active:     "130",
+	Uncertain:  "245",
+	Favorite:   "127",
+	Warning:    "130",
+	Match:      "166",
+	Border:     "245",
+	Box:        "250",
+	HeaderBg:   "254",
+	HeaderFg:   "232",
+	FooterFg:   "240",
+}
+
+// HighContrastTheme uses bright colors and a reversed selection for
+// low-vision use and washed-out displays.
+var HighContrastTheme = Theme{
+	Name:       "high-contrast",
+	Title:      "14",
+	SelectedBg: "15",
+	SelectedFg: "0",
+	Waiting:    "9",
+	Working:    "10",
+	Hibernated: "7",
+	Recent:     "10",
+	Active:     "11",
+	Uncertain:  "7",
+	Favorite:   "13",
+	Warning:    "11",
+	Match:      "11",
+	Border:     "15",
+	Box:        "15",
+	HeaderBg:   "15",
+	HeaderFg:   "0",
+	FooterFg:   "0",
+}
+
+// SolarizedTheme uses the Solarized dark palette (truecolor terminals;
+// lipgloss degrades it on others).
+var SolarizedTheme = Theme{
+	Name:       "solarized",
+	Title:      "#268bd2",
+	SelectedBg: "#073642",
+	SelectedFg: "#93a1a1",
+	Waiting:    "#dc322f",
+	Working:    "#859900",
+	Hibernated: "#586e75",
+	Recent:     "#859900",
+	Active:     "#b58900",
+	Uncertain:  "#586e75",
+	Favorite:   "#d33682",
+	Warning:    "#cb4b16",
+	Match:      "#b58900",
+	Border:     "#586e75",
+	Box:        "#586e75",
+	HeaderBg:   "#073642",
+	HeaderFg:   "#93a1a1",
+	FooterFg:   "#839496",
+}
+
+// MonochromeTheme uses no colors, only text attributes and glyphs. NO_COLOR,
+// TERM=dumb and --no-color render any theme like this (see DisableColor).
+var MonochromeTheme = Theme{Name: "monochrome", Monochrome: true}
+
+// builtinThemes maps theme names to built-in themes.
+var builtinThemes = map[string]Theme{
+	DarkTheme.Name:         DarkTheme,
+	LightTheme.Name:        LightTheme,
+	HighContrastTheme.Name: HighContrastTheme,
+	SolarizedTheme.Name:    SolarizedTheme,
+	MonochromeTheme.Name:   MonochromeTheme,
+}
+
+// ThemeNames returns the built-in theme names, sorted.
+func ThemeNames() []string {
+	names := make([]string, 0, len(builtinThemes))
+	for name := range builtinThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BuiltinTheme returns the built-in theme with the given name.
+func BuiltinTheme(name string) (Theme, bool) {
+	t, ok := builtinThemes[strings.ToLower(name)]
+	return t, ok
+}
+
+// current is the most recently applied theme.
+var current Theme
+
+// Current returns the active theme.
+func Current() Theme {
+	return current
+}
+
+// Monochrome reports whether status must be conveyed without color: either
+// color output is disabled or a monochrome theme is active.
+func Monochrome() bool {
+	return !UseColor || current.Monochrome
+}
+
+// ApplyTheme rebuilds all package styles from t. While color is disabled
+// (see DisableColor) the colors are still set but never rendered.
+// Not safe for concurrent use with rendering; call at startup.
+func ApplyTheme(t Theme) {
+	current = t
+
+	fg := func(s lipgloss.Style, c string) lipgloss.Style {
+		if c == "" {
+			return s
+		}
+		return s.Foreground(lipgloss.Color(c))
+	}
+	bg := func(s lipgloss.Style, c string) lipgloss.Style {
+		if c == "" {
+			return s
+		}
+		return s.Background(lipgloss.Color(c))
+	}
+	borderFg := func(s lipgloss.Style, c string) lipgloss.Style {
+		if c == "" {
+			return s
+		}
+		return s.BorderForeground(lipgloss.Color(c))
+	}
+	plain := lipgloss.NewStyle()
+
+	BoxStyle = borderFg(plain.Border(lipgloss.RoundedBorder()), t.Box).Padding(1, 2)
+	PopupStyle = borderFg(plain.Border(lipgloss.RoundedBorder()), t.Title).Padding(1, 2)
+	TitleStyle = fg(plain.Bold(true), t.Title)
+	HintStyle = plain.Faint(true)
+
+	SelectedStyle = fg(bg(plain, t.SelectedBg), t.SelectedFg)
+	WaitingStyle = fg(plain.Bold(true), t.Waiting)
+	WorkingStyle = fg(plain, t.Working)
+	HibernatedStyle = fg(plain.Faint(true), t.Hibernated)
+	RecentStyle = fg(plain, t.Recent)
+	ActiveStyle = fg(plain, t.Active)
+	UncertainStyle = fg(plain.Faint(true), t.Uncertain)
+	FavoriteStyle = fg(plain, t.Favorite)
+	WarningStyle = fg(plain.Bold(true), t.Warning)
+	MatchStyle = fg(plain.Bold(true).Underline(true), t.Match)
+	DimStyle = plain.Faint(true)
+	BorderStyle = borderFg(plain.Border(lipgloss.NormalBorder()), t.Border)
+	HorizontalBorderStyle = BorderStyle.
+		BorderTop(false).
+		BorderLeft(true).
+		BorderRight(true).
+		BorderBottom(true)
+	HeaderStyle = fg(bg(plain.Bold(true), t.HeaderBg), t.HeaderFg)
+	FooterStyle = fg(bg(plain, t.HeaderBg), t.FooterFg)
+
+	if t.Monochrome {
+		// Attributes only: reverse video marks the selection and the view bars
+		SelectedStyle = plain.Reverse(true)
+		HeaderStyle = plain.Bold(true).Reverse(true)
+		FooterStyle = plain.Reverse(true)
+	}
+}
+
+// themeFile is the YAML layout of a user theme file:
+//
+//	base: dark          # built-in theme to start from (default: dark)
+//	colors:
+//	  title: "#ff8800"
+//	  waiting: "196"
+type themeFile struct {
+	Base   string            `yaml:"base"`
+	Colors map[string]string `yaml:"colors"`
+}
+
+// themeColorFields maps theme file color keys to Theme fields.
+func themeColorFields(t *Theme) map[string]*string {
+	return map[string]*string{
+		"title":       &t.Title,
+		"selected_bg": &t.SelectedBg,
+		"selected_fg": &t.SelectedFg,
+		"waiting":     &t.Waiting,
+		"working":     &t.Working,
+		"hibernated":  &t.Hibernated,
+		"recent":      &t.Recent,
+		"active":      &t.Active,
+		"uncertain":   &t.Uncertain,
+		"favorite":    &t.Favorite,
+		"warning":     &t.Warning,
+		"match":       &t.Match,
+		"border":      &t.Border,
+		"box":         &t.Box,
+		"header_bg":   &t.HeaderBg,
+		"header_fg":   &t.HeaderFg,
+		"footer_fg":   &t.FooterFg,
+	}
+}
+
+// LoadThemeFile reads a user theme from a YAML file. Colors not listed keep
+// the base theme's value.
+func LoadThemeFile(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, fmt.Errorf("failed to read theme %s: %w", path, err)
+	}
+	var f themeFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return Theme{}, fmt.Errorf("invalid theme %s: %w", path, err)
+	}
+
+	baseName := f.Base
+	if baseName == "" {
+		baseName = DefaultThemeName
+	}
+	t, ok := BuiltinTheme(baseName)
+	if !ok {
+		return Theme{}, fmt.Errorf("invalid theme %s: unknown base theme %q (available: %s)",
+			path, baseName, strings.Join(ThemeNames(), ", "))
+	}
+	t.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	fields := themeColorFields(&t)
+	keys := make([]string, 0, len(f.Colors))
+	for key := range f.Colors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var errs []error
+	for _, key := range keys {
+		field, ok := fields[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown color %q", key))
+			continue
+		}
+		*field = f.Colors[key]
+	}
+	if len(errs) > 0 {
+		return Theme{}, fmt.Errorf("invalid theme %s: %w", path, errors.Join(errs...))
+	}
+	return t, nil
+}
+
+// ResolveTheme finds a theme by built-in name, by file name under themesDir
+// (name.yaml), or by path to a YAML file. An empty name is the default theme.
+func ResolveTheme(name, themesDir string) (Theme, error) {
+	if name == "" {
+		return DarkTheme, nil
+	}
+	if t, ok := BuiltinTheme(name); ok {
+		return t, nil
+	}
+	path := name
+	if !strings.ContainsRune(name, filepath.Separator) && filepath.Ext(name) == "" {
+		path = filepath.Join(themesDir, name+".yaml")
+	}
+	if _, err := os.Stat(path); err != nil {
+		return Theme{}, fmt.Errorf("unknown theme %q (built-in: %s; or a file in %s)",
+			name, strings.Join(ThemeNames(), ", "), themesDir)
+	}
+	return LoadThemeFile(path)
+}
@@ -0,0 +1,135 @@
+package styles
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func writeTheme(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write theme: %v", err)
+	}
+	return path
+}
+
+func TestResolveTheme_Builtins(t *testing.T) {
+	for _, name := range ThemeNames() {
+		theme, err := ResolveTheme(name, t.TempDir())
+		if err != nil || theme.Name != name {
+			t.Errorf("ResolveTheme(%q) = %q, %v", name, theme.Name, err)
+		}
+	}
+	if theme, err := ResolveTheme("", ""); err != nil || theme.Name != DefaultThemeName {
+		t.Errorf("empty name should resolve to %s, got %q, %v", DefaultThemeName, theme.Name, err)
+	}
+	if theme, _ := ResolveTheme("Solarized", ""); theme.Name != "solarized" {
+		t.Errorf("built-in names should be case-insensitive, got %q", theme.Name)
+	}
+}
+
+func TestResolveTheme_File(t *testing.T) {
+	dir := t.TempDir()
+	writeTheme(t, dir, "ocean.yaml", `base: light
+colors:
+  title: "#0077be"
+  waiting: "196"
+`)
+
+	theme, err := ResolveTheme("ocean", dir)
+	if err != nil {
+		t.Fatalf("ResolveTheme() error = %v", err)
+	}
+	if theme.Name != "ocean" || theme.Title != "#0077be" || theme.Waiting != "196" {
+		t.Errorf("overrides not applied: %+v", theme)
+	}
+	if theme.Recent != LightTheme.Recent {
+		t.Errorf("Recent = %q, want base light value %q", theme.Recent, LightTheme.Recent)
+	}
+
+	// A path works too, and base defaults to dark
+	path := writeTheme(t, dir, "plain.yml", "colors:\n  match: \"5\"\n")
+	theme, err = ResolveTheme(path, "")
+	if err != nil || theme.Match != "5" || theme.Title != DarkTheme.Title {
+		t.Errorf("ResolveTheme(path) = %+v, %v", theme, err)
+	}
+}
+
+func TestResolveTheme_Errors(t *testing.T) {
+	dir := t.TempDir()
+	writeTheme(t, dir, "bad-color.yaml", "colors:\n  titel: \"1\"\n")
+	writeTheme(t, dir, "bad-base.yaml", "base: neon\n")
+	writeTheme(t, dir, "bad-yaml.yaml", "colors: [\n")
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"missing", "unknown theme"},
+		{"bad-color", `unknown color "titel"`},
+		{"bad-base", `unknown base theme "neon"`},
+		{"bad-yaml", "invalid theme"},
+	}
+	for _, tt := range tests {
+		_, err := ResolveTheme(tt.name, dir)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ResolveTheme(%q) error = %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestApplyTheme(t *testing.T) {
+	prevUseColor := UseColor
+	UseColor = true // Monochrome() also reports NO_COLOR (as in CI)
+	t.Cleanup(func() {
+		UseColor = prevUseColor
+		ApplyTheme(DarkTheme)
+	})
+
+	ApplyTheme(SolarizedTheme)
+	if Current().Name != "solarized" || Monochrome() {
+		t.Errorf("Current() = %q, Monochrome() = %v", Current().Name, Monochrome())
+	}
+	if got := WaitingStyle.GetForeground(); got != lipgloss.Color("#dc322f") {
+		t.Errorf("WaitingStyle foreground = %v, want solarized red", got)
+	}
+
+	ApplyTheme(MonochromeTheme)
+	if !Monochrome() {
+		t.Error("monochrome theme should report Monochrome()")
+	}
+	if _, ok := WaitingStyle.GetForeground().(lipgloss.NoColor); !ok {
+		t.Errorf("monochrome WaitingStyle should have no color, got %v", WaitingStyle.GetForeground())
+	}
+	if !SelectedStyle.GetReverse() {
+		t.Error("monochrome selection should use reverse video")
+	}
+}
+
+func TestDisableColor(t *testing.T) {
+	prevUseColor, prevProfile := UseColor, lipgloss.ColorProfile()
+	t.Cleanup(func() {
+		UseColor = prevUseColor
+		lipgloss.SetColorProfile(prevProfile)
+		ApplyTheme(DarkTheme)
+	})
+
+	DisableColor()
+	ApplyTheme(DarkTheme) // Colors are set but never rendered once color is off
+
+	if !Monochrome() {
+		t.Error("Monochrome() should be true after DisableColor")
+	}
+	if lipgloss.ColorProfile() != termenv.Ascii {
+		t.Error("DisableColor should switch to the Ascii color profile")
+	}
+	if got := WaitingStyle.Render("WAITING"); got != "WAITING" {
+		t.Errorf("Render() = %q, want plain text", got)
+	}
+}
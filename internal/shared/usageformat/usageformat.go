@@ -0,0 +1,27 @@
+// Package usageformat formats token counts and estimated costs for display.
+package usageformat
+
+import "fmt"
+
+// FormatTokens abbreviates a token count: "950", "12.3k", "4.1M".
+func FormatTokens(n int64) string {
+	switch {
+	case n < 1_000:
+		return fmt.Sprintf("%d", n)
+	case n < 1_000_000:
+		return fmt.Sprintf("%.1fk", float64(n)/1_000)
+	case n < 1_000_000_000:
+		return fmt.Sprintf("%.1fM", float64(n)/1_000_000)
+	default:
+		return fmt.Sprintf("%.1fB", float64(n)/1_000_000_000)
+	}
+}
+
+// FormatCost formats an estimated cost in USD with cents: "$3.42".
+// Costs too small to show a cent are "<$0.01".
+func FormatCost(usd float64) string {
+	if usd > 0 && usd < 0.005 {
+		return "<$0.01"
+	}
+	return fmt.Sprintf("$%.2f", usd)
+}
@@ -0,0 +1,38 @@
+package usageformat
+
+import "testing"
+
+func TestFormatTokens(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0"},
+		{950, "950"},
+		{12_345, "12.3k"},
+		{4_100_000, "4.1M"},
+		{2_500_000_000, "2.5B"},
+	}
+	for _, tt := range tests {
+		if got := FormatTokens(tt.n); got != tt.want {
+			t.Errorf("FormatTokens(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFormatCost(t *testing.T) {
+	tests := []struct {
+		usd  float64
+		want string
+	}{
+		{0, "$0.00"},
+		{0.001, "<$0.01"},
+		{0.006, "$0.01"},
+		{3.4249, "$3.42"},
+	}
+	for _, tt := range tests {
+		if got := FormatCost(tt.usd); got != tt.want {
+			t.Errorf("FormatCost(%v) = %q, want %q", tt.usd, got, tt.want)
+		}
+	}
+}